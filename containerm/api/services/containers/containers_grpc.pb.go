@@ -32,20 +32,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Containers_Create_FullMethodName     = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Create"
-	Containers_Get_FullMethodName        = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Get"
-	Containers_List_FullMethodName       = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/List"
-	Containers_ListStream_FullMethodName = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/ListStream"
-	Containers_Start_FullMethodName      = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Start"
-	Containers_Attach_FullMethodName     = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Attach"
-	Containers_Stop_FullMethodName       = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Stop"
-	Containers_Update_FullMethodName     = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Update"
-	Containers_Restart_FullMethodName    = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Restart"
-	Containers_Pause_FullMethodName      = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Pause"
-	Containers_Unpause_FullMethodName    = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Unpause"
-	Containers_Rename_FullMethodName     = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Rename"
-	Containers_Remove_FullMethodName     = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Remove"
-	Containers_Logs_FullMethodName       = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Logs"
+	Containers_Create_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Create"
+	Containers_Get_FullMethodName              = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Get"
+	Containers_List_FullMethodName             = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/List"
+	Containers_ListStream_FullMethodName       = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/ListStream"
+	Containers_Start_FullMethodName            = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Start"
+	Containers_Attach_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Attach"
+	Containers_Stop_FullMethodName             = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Stop"
+	Containers_Update_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Update"
+	Containers_Restart_FullMethodName          = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Restart"
+	Containers_Pause_FullMethodName            = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Pause"
+	Containers_Unpause_FullMethodName          = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Unpause"
+	Containers_Rename_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Rename"
+	Containers_Remove_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Remove"
+	Containers_Logs_FullMethodName             = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Logs"
+	Containers_Exec_FullMethodName             = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Exec"
+	Containers_Checkpoint_FullMethodName       = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Checkpoint"
+	Containers_ListCheckpoints_FullMethodName  = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/ListCheckpoints"
+	Containers_RemoveCheckpoint_FullMethodName = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/RemoveCheckpoint"
+	Containers_Restore_FullMethodName          = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Restore"
+	Containers_Metrics_FullMethodName          = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Metrics"
+	Containers_Stats_FullMethodName            = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Stats"
+	Containers_Events_FullMethodName           = "/github.com.eclipse_kanto.container_management.containerm.api.services.containers.Containers/Events"
 )
 
 // ContainersClient is the client API for Containers service.
@@ -66,6 +74,14 @@ type ContainersClient interface {
 	Rename(ctx context.Context, in *RenameContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Remove(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Logs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (Containers_LogsClient, error)
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Containers_ExecClient, error)
+	Checkpoint(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error)
+	RemoveCheckpoint(ctx context.Context, in *RemoveCheckpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Restore(ctx context.Context, in *RestoreContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Metrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (Containers_StatsClient, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Containers_EventsClient, error)
 }
 
 type containersClient struct {
@@ -270,6 +286,146 @@ func (x *containersLogsClient) Recv() (*GetLogsResponse, error) {
 	return m, nil
 }
 
+func (c *containersClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Containers_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Containers_ServiceDesc.Streams[3], Containers_Exec_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containersExecClient{stream}
+	return x, nil
+}
+
+type Containers_ExecClient interface {
+	Send(*ExecContainerRequest) error
+	Recv() (*ExecContainerResponse, error)
+	grpc.ClientStream
+}
+
+type containersExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *containersExecClient) Send(m *ExecContainerRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *containersExecClient) Recv() (*ExecContainerResponse, error) {
+	m := new(ExecContainerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containersClient) Checkpoint(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Containers_Checkpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containersClient) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error) {
+	out := new(ListCheckpointsResponse)
+	err := c.cc.Invoke(ctx, Containers_ListCheckpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containersClient) RemoveCheckpoint(ctx context.Context, in *RemoveCheckpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Containers_RemoveCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containersClient) Restore(ctx context.Context, in *RestoreContainerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Containers_Restore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containersClient) Metrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, Containers_Metrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containersClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (Containers_StatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Containers_ServiceDesc.Streams[4], Containers_Stats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containersStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Containers_StatsClient interface {
+	Recv() (*StatsResponse, error)
+	grpc.ClientStream
+}
+
+type containersStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *containersStatsClient) Recv() (*StatsResponse, error) {
+	m := new(StatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containersClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Containers_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Containers_ServiceDesc.Streams[5], Containers_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containersEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Containers_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
+}
+
+type containersEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *containersEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContainersServer is the server API for Containers service.
 // All implementations should embed UnimplementedContainersServer
 // for forward compatibility
@@ -288,6 +444,14 @@ type ContainersServer interface {
 	Rename(context.Context, *RenameContainerRequest) (*emptypb.Empty, error)
 	Remove(context.Context, *RemoveContainerRequest) (*emptypb.Empty, error)
 	Logs(*GetLogsRequest, Containers_LogsServer) error
+	Exec(Containers_ExecServer) error
+	Checkpoint(context.Context, *CheckpointContainerRequest) (*emptypb.Empty, error)
+	ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
+	RemoveCheckpoint(context.Context, *RemoveCheckpointRequest) (*emptypb.Empty, error)
+	Restore(context.Context, *RestoreContainerRequest) (*emptypb.Empty, error)
+	Metrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	Stats(*StatsRequest, Containers_StatsServer) error
+	Events(*EventsRequest, Containers_EventsServer) error
 }
 
 // UnimplementedContainersServer should be embedded to have forward compatible implementations.
@@ -336,6 +500,30 @@ func (UnimplementedContainersServer) Remove(context.Context, *RemoveContainerReq
 func (UnimplementedContainersServer) Logs(*GetLogsRequest, Containers_LogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
 }
+func (UnimplementedContainersServer) Exec(Containers_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedContainersServer) Checkpoint(context.Context, *CheckpointContainerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (UnimplementedContainersServer) ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckpoints not implemented")
+}
+func (UnimplementedContainersServer) RemoveCheckpoint(context.Context, *RemoveCheckpointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCheckpoint not implemented")
+}
+func (UnimplementedContainersServer) Restore(context.Context, *RestoreContainerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedContainersServer) Metrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
+}
+func (UnimplementedContainersServer) Stats(*StatsRequest, Containers_StatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedContainersServer) Events(*EventsRequest, Containers_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 
 // UnsafeContainersServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ContainersServer will
@@ -614,6 +802,164 @@ func (x *containersLogsServer) Send(m *GetLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Containers_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContainersServer).Exec(&containersExecServer{stream})
+}
+
+type Containers_ExecServer interface {
+	Send(*ExecContainerResponse) error
+	Recv() (*ExecContainerRequest, error)
+	grpc.ServerStream
+}
+
+type containersExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *containersExecServer) Send(m *ExecContainerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *containersExecServer) Recv() (*ExecContainerRequest, error) {
+	m := new(ExecContainerRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Containers_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainersServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Containers_Checkpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainersServer).Checkpoint(ctx, req.(*CheckpointContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Containers_ListCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainersServer).ListCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Containers_ListCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainersServer).ListCheckpoints(ctx, req.(*ListCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Containers_RemoveCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainersServer).RemoveCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Containers_RemoveCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainersServer).RemoveCheckpoint(ctx, req.(*RemoveCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Containers_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainersServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Containers_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainersServer).Restore(ctx, req.(*RestoreContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Containers_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainersServer).Metrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Containers_Metrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainersServer).Metrics(ctx, req.(*GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Containers_Stats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainersServer).Stats(m, &containersStatsServer{stream})
+}
+
+type Containers_StatsServer interface {
+	Send(*StatsResponse) error
+	grpc.ServerStream
+}
+
+type containersStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *containersStatsServer) Send(m *StatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Containers_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainersServer).Events(m, &containersEventsServer{stream})
+}
+
+type Containers_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
+}
+
+type containersEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *containersEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Containers_ServiceDesc is the grpc.ServiceDesc for Containers service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -665,6 +1011,26 @@ var Containers_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Remove",
 			Handler:    _Containers_Remove_Handler,
 		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Containers_Checkpoint_Handler,
+		},
+		{
+			MethodName: "ListCheckpoints",
+			Handler:    _Containers_ListCheckpoints_Handler,
+		},
+		{
+			MethodName: "RemoveCheckpoint",
+			Handler:    _Containers_RemoveCheckpoint_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Containers_Restore_Handler,
+		},
+		{
+			MethodName: "Metrics",
+			Handler:    _Containers_Metrics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -683,6 +1049,22 @@ var Containers_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Containers_Logs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Containers_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Stats",
+			Handler:       _Containers_Stats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Events",
+			Handler:       _Containers_Events_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/services/containers/containers.proto",
 }
@@ -33,6 +33,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	SystemInfo_ProjectInfo_FullMethodName = "/github.com.eclipse_kanto.container_management.containerm.api.services.sysinfo.SystemInfo/ProjectInfo"
+	SystemInfo_RuntimeInfo_FullMethodName = "/github.com.eclipse_kanto.container_management.containerm.api.services.sysinfo.SystemInfo/RuntimeInfo"
 )
 
 // SystemInfoClient is the client API for SystemInfo service.
@@ -40,6 +41,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SystemInfoClient interface {
 	ProjectInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
+	RuntimeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RuntimeInfoResponse, error)
 }
 
 type systemInfoClient struct {
@@ -59,11 +61,21 @@ func (c *systemInfoClient) ProjectInfo(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *systemInfoClient) RuntimeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RuntimeInfoResponse, error) {
+	out := new(RuntimeInfoResponse)
+	err := c.cc.Invoke(ctx, SystemInfo_RuntimeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemInfoServer is the server API for SystemInfo service.
 // All implementations should embed UnimplementedSystemInfoServer
 // for forward compatibility
 type SystemInfoServer interface {
 	ProjectInfo(context.Context, *emptypb.Empty) (*ProjectInfoResponse, error)
+	RuntimeInfo(context.Context, *emptypb.Empty) (*RuntimeInfoResponse, error)
 }
 
 // UnimplementedSystemInfoServer should be embedded to have forward compatible implementations.
@@ -73,6 +85,9 @@ type UnimplementedSystemInfoServer struct {
 func (UnimplementedSystemInfoServer) ProjectInfo(context.Context, *emptypb.Empty) (*ProjectInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProjectInfo not implemented")
 }
+func (UnimplementedSystemInfoServer) RuntimeInfo(context.Context, *emptypb.Empty) (*RuntimeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RuntimeInfo not implemented")
+}
 
 // UnsafeSystemInfoServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SystemInfoServer will
@@ -103,6 +118,24 @@ func _SystemInfo_ProjectInfo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemInfo_RuntimeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInfoServer).RuntimeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInfo_RuntimeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInfoServer).RuntimeInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemInfo_ServiceDesc is the grpc.ServiceDesc for SystemInfo service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -114,6 +147,10 @@ var SystemInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectInfo",
 			Handler:    _SystemInfo_ProjectInfo_Handler,
 		},
+		{
+			MethodName: "RuntimeInfo",
+			Handler:    _SystemInfo_RuntimeInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/services/sysinfo/system_info.proto",
@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	utilcli "github.com/eclipse-kanto/container-management/containerm/util/cli"
+	"github.com/spf13/cobra"
+)
+
+type checkpointCmd struct {
+	baseCommand
+}
+
+func (cc *checkpointCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Manage the checkpoints of containers.",
+		Long:  "Manage the checkpoints of containers. A checkpoint stores the state of a running container so that it can be restored later on.",
+		Args:  cobra.NoArgs,
+	}
+}
+
+type checkpointCreateCmd struct {
+	baseCommand
+	config checkpointCreateConfig
+}
+
+type checkpointCreateConfig struct {
+	name string
+	exit bool
+}
+
+func (cc *checkpointCreateCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "create <container-id> <checkpoint-id>",
+		Short: "Create a checkpoint of a running container.",
+		Long:  "Create a checkpoint of a running container.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: "checkpoint create <container-id> <checkpoint-id>\n checkpoint create --name <container-name> <checkpoint-id>\n checkpoint create --exit -n <container-name> <checkpoint-id>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *checkpointCreateCmd) run(args []string) error {
+	var (
+		ctr *types.Container
+		err error
+		ctx = context.Background()
+	)
+	ctrArgs, checkpointID := parseCheckpointArgs(args)
+	if err = util.ValidateCheckpointID(checkpointID); err != nil {
+		return err
+	}
+	if ctr, err = utilcli.ValidateContainerByNameArgsSingle(ctx, ctrArgs, cc.config.name, cc.cli.gwManClient); err != nil {
+		return err
+	}
+	return cc.cli.gwManClient.Checkpoint(ctx, ctr.ID, &types.CheckpointOpts{CheckpointID: checkpointID, Exit: cc.config.exit})
+}
+
+func (cc *checkpointCreateCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	// init name flags
+	flagSet.StringVarP(&cc.config.name, "name", "n", "", "Create a checkpoint of a container with a specific name.")
+	// init exit flags
+	flagSet.BoolVar(&cc.config.exit, "exit", false, "Stop the container after the checkpoint is created.")
+}
+
+type checkpointListCmd struct {
+	baseCommand
+	config checkpointListConfig
+}
+
+type checkpointListConfig struct {
+	name string
+}
+
+func (cc *checkpointListCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "list <container-id>",
+		Aliases: []string{"ls"},
+		Short:   "List the checkpoints of a container.",
+		Long:    "List the checkpoints of a container.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: "checkpoint list <container-id>\n checkpoint list --name <container-name>\n checkpoint ls -n <container-name>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *checkpointListCmd) run(args []string) error {
+	var (
+		ctr *types.Container
+		err error
+		ctx = context.Background()
+	)
+	if ctr, err = utilcli.ValidateContainerByNameArgsSingle(ctx, args, cc.config.name, cc.cli.gwManClient); err != nil {
+		return err
+	}
+	checkpoints, err := cc.cli.gwManClient.ListCheckpoints(ctx, ctr.ID)
+	if err != nil {
+		return err
+	}
+	if len(checkpoints) == 0 {
+		fmt.Println("No checkpoints found.")
+	} else {
+		prettyPrintCheckpoints(checkpoints)
+	}
+	return nil
+}
+
+func (cc *checkpointListCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	// init name flags
+	flagSet.StringVarP(&cc.config.name, "name", "n", "", "List the checkpoints of a container with a specific name.")
+}
+
+type checkpointRemoveCmd struct {
+	baseCommand
+	config checkpointRemoveConfig
+}
+
+type checkpointRemoveConfig struct {
+	name string
+}
+
+func (cc *checkpointRemoveCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "remove <container-id> <checkpoint-id>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a checkpoint of a container.",
+		Long:    "Remove a checkpoint of a container.",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: "checkpoint remove <container-id> <checkpoint-id>\n checkpoint remove --name <container-name> <checkpoint-id>\n checkpoint rm -n <container-name> <checkpoint-id>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *checkpointRemoveCmd) run(args []string) error {
+	var (
+		ctr *types.Container
+		err error
+		ctx = context.Background()
+	)
+	ctrArgs, checkpointID := parseCheckpointArgs(args)
+	if err = util.ValidateCheckpointID(checkpointID); err != nil {
+		return err
+	}
+	if ctr, err = utilcli.ValidateContainerByNameArgsSingle(ctx, ctrArgs, cc.config.name, cc.cli.gwManClient); err != nil {
+		return err
+	}
+	return cc.cli.gwManClient.RemoveCheckpoint(ctx, ctr.ID, checkpointID)
+}
+
+func (cc *checkpointRemoveCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	// init name flags
+	flagSet.StringVarP(&cc.config.name, "name", "n", "", "Remove a checkpoint of a container with a specific name.")
+}
+
+// parseCheckpointArgs splits the arguments to the container ID ones and the checkpoint ID which is always the last one
+func parseCheckpointArgs(args []string) ([]string, string) {
+	if len(args) == 0 {
+		return args, ""
+	}
+	return args[:len(args)-1], args[len(args)-1]
+}
+
+const checkpointsTableRowTemplate = "%-37s\t%-32s\t\n"
+
+func prettyPrintCheckpoints(checkpoints []*types.Checkpoint) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+	defer w.Flush()
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, checkpointsTableRowTemplate, "ID", "Created")
+	fmt.Fprintf(w, checkpointsTableRowTemplate, "-------------------------------------", "------------------------------")
+	for _, checkpoint := range checkpoints {
+		fmt.Fprintf(w, checkpointsTableRowTemplate, checkpoint.ID, checkpoint.Created)
+	}
+	fmt.Fprintln(w, "")
+}
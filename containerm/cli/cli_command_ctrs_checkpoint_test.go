@@ -0,0 +1,392 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/client"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	checkpointCmdFlagName = "name"
+	checkpointCmdFlagExit = "exit"
+
+	// test input constants
+	checkpointContainerID      = "test-ctr"
+	checkpointContainerName    = "test-ctr-name"
+	checkpointID               = "test-checkpoint"
+	invalidCheckpointID        = "@test-checkpoint"
+	checkpointCreatedTimestamp = "2026-01-02T15:04:05Z"
+)
+
+var (
+	// command args ---------------
+	checkpointCmdArgsWithID = []string{checkpointContainerID, checkpointID}
+
+	checkpointCtr = &types.Container{
+		ID:   checkpointContainerID,
+		Name: checkpointContainerName,
+	}
+)
+
+// Tests ------------------------------
+func TestCheckpointCreateCmdInit(t *testing.T) {
+	checkpointCreateCliTest := &checkpointCreateCommandTest{}
+	checkpointCreateCliTest.init()
+
+	execTestInit(t, checkpointCreateCliTest)
+}
+
+func TestCheckpointCreateCmdFlags(t *testing.T) {
+	checkpointCreateCliTest := &checkpointCreateCommandTest{}
+	checkpointCreateCliTest.init()
+
+	expectedCfg := checkpointCreateConfig{
+		name: checkpointContainerName,
+		exit: true,
+	}
+
+	flagsToApply := map[string]string{
+		checkpointCmdFlagName: expectedCfg.name,
+		checkpointCmdFlagExit: "true",
+	}
+
+	execTestSetupFlags(t, checkpointCreateCliTest, flagsToApply, expectedCfg)
+}
+
+func TestCheckpointCreateCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	checkpointCreateCliTest := &checkpointCreateCommandTest{}
+	checkpointCreateCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, checkpointCreateCliTest)
+}
+
+func TestCheckpointListCmdInit(t *testing.T) {
+	checkpointListCliTest := &checkpointListCommandTest{}
+	checkpointListCliTest.init()
+
+	execTestInit(t, checkpointListCliTest)
+}
+
+func TestCheckpointListCmdFlags(t *testing.T) {
+	checkpointListCliTest := &checkpointListCommandTest{}
+	checkpointListCliTest.init()
+
+	expectedCfg := checkpointListConfig{
+		name: checkpointContainerName,
+	}
+
+	flagsToApply := map[string]string{
+		checkpointCmdFlagName: expectedCfg.name,
+	}
+
+	execTestSetupFlags(t, checkpointListCliTest, flagsToApply, expectedCfg)
+}
+
+func TestCheckpointListCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	checkpointListCliTest := &checkpointListCommandTest{}
+	checkpointListCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, checkpointListCliTest)
+}
+
+func TestCheckpointRemoveCmdInit(t *testing.T) {
+	checkpointRemoveCliTest := &checkpointRemoveCommandTest{}
+	checkpointRemoveCliTest.init()
+
+	execTestInit(t, checkpointRemoveCliTest)
+}
+
+func TestCheckpointRemoveCmdFlags(t *testing.T) {
+	checkpointRemoveCliTest := &checkpointRemoveCommandTest{}
+	checkpointRemoveCliTest.init()
+
+	expectedCfg := checkpointRemoveConfig{
+		name: checkpointContainerName,
+	}
+
+	flagsToApply := map[string]string{
+		checkpointCmdFlagName: expectedCfg.name,
+	}
+
+	execTestSetupFlags(t, checkpointRemoveCliTest, flagsToApply, expectedCfg)
+}
+
+func TestCheckpointRemoveCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	checkpointRemoveCliTest := &checkpointRemoveCommandTest{}
+	checkpointRemoveCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, checkpointRemoveCliTest)
+}
+
+// EOF Tests --------------------------
+
+type checkpointCreateCommandTest struct {
+	cliCommandTestBase
+	checkpointCreateCmd *checkpointCreateCmd
+}
+
+func (checkpointTc *checkpointCreateCommandTest) commandConfig() interface{} {
+	return checkpointTc.checkpointCreateCmd.config
+}
+
+func (checkpointTc *checkpointCreateCommandTest) commandConfigDefault() interface{} {
+	return checkpointCreateConfig{}
+}
+
+func (checkpointTc *checkpointCreateCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &checkpointCreateCmd{}
+	checkpointTc.checkpointCreateCmd, checkpointTc.baseCmd = cmd, cmd
+
+	checkpointTc.checkpointCreateCmd.init(checkpointTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, checkpointTc.checkpointCreateCmd.cmd)
+}
+
+func (checkpointTc *checkpointCreateCommandTest) runCommand(args []string) error {
+	return checkpointTc.checkpointCreateCmd.run(args)
+}
+
+func (checkpointTc *checkpointCreateCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_checkpoint_create_error_id_and_name_provided": {
+			args: checkpointCmdArgsWithID,
+			flags: map[string]string{
+				checkpointCmdFlagName: checkpointContainerName,
+			},
+			mockExecution: checkpointTc.mockExecCheckpointCreateErrIDAndName,
+		},
+		"test_checkpoint_create_by_id": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: checkpointTc.mockExecCheckpointCreateByID,
+		},
+		"test_checkpoint_create_by_name_with_exit": {
+			args: []string{checkpointID},
+			flags: map[string]string{
+				checkpointCmdFlagName: checkpointContainerName,
+				checkpointCmdFlagExit: "true",
+			},
+			mockExecution: checkpointTc.mockExecCheckpointCreateByNameWithExit,
+		},
+		"test_checkpoint_create_error_invalid_checkpoint_id": {
+			args:          []string{checkpointContainerID, invalidCheckpointID},
+			mockExecution: checkpointTc.mockExecCheckpointCreateErrInvalidCheckpointID,
+		},
+		"test_checkpoint_create_error": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: checkpointTc.mockExecCheckpointCreateErr,
+		},
+	}
+}
+
+type checkpointListCommandTest struct {
+	cliCommandTestBase
+	checkpointListCmd *checkpointListCmd
+}
+
+func (checkpointTc *checkpointListCommandTest) commandConfig() interface{} {
+	return checkpointTc.checkpointListCmd.config
+}
+
+func (checkpointTc *checkpointListCommandTest) commandConfigDefault() interface{} {
+	return checkpointListConfig{}
+}
+
+func (checkpointTc *checkpointListCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &checkpointListCmd{}
+	checkpointTc.checkpointListCmd, checkpointTc.baseCmd = cmd, cmd
+
+	checkpointTc.checkpointListCmd.init(checkpointTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, checkpointTc.checkpointListCmd.cmd)
+}
+
+func (checkpointTc *checkpointListCommandTest) runCommand(args []string) error {
+	return checkpointTc.checkpointListCmd.run(args)
+}
+
+func (checkpointTc *checkpointListCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_checkpoint_list_no_id_or_name_provided": {
+			mockExecution: checkpointTc.mockExecCheckpointListNoIDOrName,
+		},
+		"test_checkpoint_list_by_id": {
+			args:          []string{checkpointContainerID},
+			mockExecution: checkpointTc.mockExecCheckpointListByID,
+		},
+		"test_checkpoint_list_by_name_no_checkpoints": {
+			flags: map[string]string{
+				checkpointCmdFlagName: checkpointContainerName,
+			},
+			mockExecution: checkpointTc.mockExecCheckpointListByNameNoCheckpoints,
+		},
+		"test_checkpoint_list_error": {
+			args:          []string{checkpointContainerID},
+			mockExecution: checkpointTc.mockExecCheckpointListErr,
+		},
+	}
+}
+
+type checkpointRemoveCommandTest struct {
+	cliCommandTestBase
+	checkpointRemoveCmd *checkpointRemoveCmd
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) commandConfig() interface{} {
+	return checkpointTc.checkpointRemoveCmd.config
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) commandConfigDefault() interface{} {
+	return checkpointRemoveConfig{}
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &checkpointRemoveCmd{}
+	checkpointTc.checkpointRemoveCmd, checkpointTc.baseCmd = cmd, cmd
+
+	checkpointTc.checkpointRemoveCmd.init(checkpointTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, checkpointTc.checkpointRemoveCmd.cmd)
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) runCommand(args []string) error {
+	return checkpointTc.checkpointRemoveCmd.run(args)
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_checkpoint_remove_by_id": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: checkpointTc.mockExecCheckpointRemoveByID,
+		},
+		"test_checkpoint_remove_by_name": {
+			args: []string{checkpointID},
+			flags: map[string]string{
+				checkpointCmdFlagName: checkpointContainerName,
+			},
+			mockExecution: checkpointTc.mockExecCheckpointRemoveByName,
+		},
+		"test_checkpoint_remove_error_invalid_checkpoint_id": {
+			args:          []string{checkpointContainerID, invalidCheckpointID},
+			mockExecution: checkpointTc.mockExecCheckpointRemoveErrInvalidCheckpointID,
+		},
+		"test_checkpoint_remove_error": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: checkpointTc.mockExecCheckpointRemoveErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (checkpointTc *checkpointCreateCommandTest) mockExecCheckpointCreateErrIDAndName(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	checkpointTc.mockClient.EXPECT().Checkpoint(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("Container ID and --name (-n) cannot be provided at the same time - use only one of them")
+}
+
+func (checkpointTc *checkpointCreateCommandTest) mockExecCheckpointCreateByID(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().Checkpoint(context.Background(), args[0], &types.CheckpointOpts{CheckpointID: args[1]}).Times(1).Return(nil)
+	return nil
+}
+
+func (checkpointTc *checkpointCreateCommandTest) mockExecCheckpointCreateByNameWithExit(args []string) error {
+	res := []*types.Container{checkpointCtr}
+	checkpointTc.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(checkpointContainerName))).Times(1).Return(res, nil)
+	checkpointTc.mockClient.EXPECT().Checkpoint(context.Background(), checkpointCtr.ID, &types.CheckpointOpts{CheckpointID: args[0], Exit: true}).Times(1).Return(nil)
+	return nil
+}
+
+func (checkpointTc *checkpointCreateCommandTest) mockExecCheckpointCreateErrInvalidCheckpointID(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	checkpointTc.mockClient.EXPECT().Checkpoint(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("invalid checkpoint ID format : %s", args[1])
+}
+
+func (checkpointTc *checkpointCreateCommandTest) mockExecCheckpointCreateErr(args []string) error {
+	err := log.NewError("failed to checkpoint container")
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().Checkpoint(context.Background(), args[0], gomock.Any()).Times(1).Return(err)
+	return err
+}
+
+func (checkpointTc *checkpointListCommandTest) mockExecCheckpointListNoIDOrName(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	checkpointTc.mockClient.EXPECT().ListCheckpoints(context.Background(), gomock.Any()).Times(0)
+	return log.NewError("You must provide either an ID or a name for the container via --name (-n) ")
+}
+
+func (checkpointTc *checkpointListCommandTest) mockExecCheckpointListByID(args []string) error {
+	checkpoints := []*types.Checkpoint{{ID: checkpointID, Created: checkpointCreatedTimestamp}}
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().ListCheckpoints(context.Background(), args[0]).Times(1).Return(checkpoints, nil)
+	return nil
+}
+
+func (checkpointTc *checkpointListCommandTest) mockExecCheckpointListByNameNoCheckpoints(args []string) error {
+	res := []*types.Container{checkpointCtr}
+	checkpointTc.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(checkpointContainerName))).Times(1).Return(res, nil)
+	checkpointTc.mockClient.EXPECT().ListCheckpoints(context.Background(), checkpointCtr.ID).Times(1).Return([]*types.Checkpoint{}, nil)
+	return nil
+}
+
+func (checkpointTc *checkpointListCommandTest) mockExecCheckpointListErr(args []string) error {
+	err := log.NewError("failed to list checkpoints")
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().ListCheckpoints(context.Background(), args[0]).Times(1).Return(nil, err)
+	return err
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) mockExecCheckpointRemoveByID(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().RemoveCheckpoint(context.Background(), args[0], args[1]).Times(1).Return(nil)
+	return nil
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) mockExecCheckpointRemoveByName(args []string) error {
+	res := []*types.Container{checkpointCtr}
+	checkpointTc.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(checkpointContainerName))).Times(1).Return(res, nil)
+	checkpointTc.mockClient.EXPECT().RemoveCheckpoint(context.Background(), checkpointCtr.ID, args[0]).Times(1).Return(nil)
+	return nil
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) mockExecCheckpointRemoveErrInvalidCheckpointID(args []string) error {
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	checkpointTc.mockClient.EXPECT().RemoveCheckpoint(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("invalid checkpoint ID format : %s", args[1])
+}
+
+func (checkpointTc *checkpointRemoveCommandTest) mockExecCheckpointRemoveErr(args []string) error {
+	err := log.NewError("failed to remove checkpoint")
+	checkpointTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	checkpointTc.mockClient.EXPECT().RemoveCheckpoint(context.Background(), args[0], args[1]).Times(1).Return(err)
+	return err
+}
@@ -17,6 +17,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
@@ -35,38 +37,119 @@ type restartPolicy struct {
 	kind          string
 	timeout       int64
 	maxRetryCount int
+	onUnhealthy   bool
 }
 
 type resources struct {
-	memory            string
-	memoryReservation string
-	memorySwap        string
+	memory               string
+	memoryReservation    string
+	memorySwap           string
+	cpuQuota             string
+	cpuPeriod            string
+	cpuShares            string
+	cpusetCpus           string
+	cpusetMems           string
+	pidsLimit            string
+	blkioWeight          string
+	blkioDeviceReadBps   []string
+	blkioDeviceWriteBps  []string
+	blkioDeviceReadIOps  []string
+	blkioDeviceWriteIOps []string
+}
+
+func (r resources) isEmpty() bool {
+	return r.memory == "" && r.memoryReservation == "" && r.memorySwap == "" &&
+		r.cpuQuota == "" && r.cpuPeriod == "" && r.cpuShares == "" && r.cpusetCpus == "" && r.cpusetMems == "" &&
+		r.pidsLimit == "" && r.blkioWeight == "" &&
+		len(r.blkioDeviceReadBps) == 0 && len(r.blkioDeviceWriteBps) == 0 &&
+		len(r.blkioDeviceReadIOps) == 0 && len(r.blkioDeviceWriteIOps) == 0
+}
+
+type networkPolicy struct {
+	ingressRate   string
+	egressRate    string
+	egressDefault string
+	egressRules   []string
+}
+
+func (n networkPolicy) isEmpty() bool {
+	return n.ingressRate == "" && n.egressRate == "" && n.egressDefault == "" && len(n.egressRules) == 0
+}
+
+type securityOpts struct {
+	seccomp         string
+	appArmorProfile string
+	seLinuxLabel    string
+	noNewPrivileges bool
+}
+
+func (s securityOpts) isEmpty() bool {
+	return s.seccomp == "" && s.appArmorProfile == "" && s.seLinuxLabel == "" && !s.noNewPrivileges
+}
+
+type healthCheck struct {
+	cmd         string
+	httpPort    int
+	httpPath    string
+	tcpPort     int
+	interval    int64
+	timeout     int64
+	retries     int
+	startPeriod int64
 }
 
 type createConfig struct {
-	name              string
-	terminal          bool
-	interactive       bool
-	privileged        bool
-	network           string
-	containerFile     string
-	extraHosts        []string
-	extraCapabilities []string
-	devices           []string
-	mountPoints       []string
-	ports             []string
-	env               []string
+	name                string
+	terminal            bool
+	interactive         bool
+	privileged          bool
+	readOnlyRootfs      bool
+	runtimeHandler      string
+	usernsMode          string
+	ipcMode             string
+	pidMode             string
+	shmSize             string
+	ulimits             []string
+	sysctls             []string
+	network             string
+	networks            []string
+	endpoints           []string
+	containerFile       string
+	extraHosts          []string
+	dns                 []string
+	dnsSearch           []string
+	dnsOptions          []string
+	extraCapabilities   []string
+	droppedCapabilities []string
+	devices             []string
+	mountPoints         []string
+	mounts              []string
+	ports               []string
+	env                 []string
+	entrypoint          string
+	workingDir          string
+	user                string
+	groups              []string
+	labels              []string
 	// log configs
 	logDriver        string
 	logMaxFiles      int
 	logMaxSize       string
 	logRootDirPath   string
+	logCompress      string
+	logMaxAge        string
+	logAddress       string
+	logFacility      string
+	logTag           string
 	logMode          string
 	logMaxBufferSize string
 	decKeys          []string
 	decRecipients    []string
 	restartPolicy
 	resources
+	networkPolicy
+	securityOpts
+	healthCheck
 }
 
 func (cc *createCmd) init(cli *cli) {
@@ -92,10 +175,18 @@ func initContainer(config createConfig, imageName string) *types.Container {
 			Name: imageName,
 		},
 		HostConfig: &types.HostConfig{
-			Privileged:        config.privileged,
-			ExtraHosts:        config.extraHosts,
-			ExtraCapabilities: config.extraCapabilities,
-			NetworkMode:       types.NetworkMode(config.network),
+			Privileged:          config.privileged,
+			ExtraHosts:          config.extraHosts,
+			ExtraCapabilities:   config.extraCapabilities,
+			DroppedCapabilities: config.droppedCapabilities,
+			NetworkMode:         types.NetworkMode(config.network),
+			Networks:            config.networks,
+			ReadOnlyRootfs:      config.readOnlyRootfs,
+			RuntimeHandler:      config.runtimeHandler,
+			UsernsMode:          types.UsernsMode(config.usernsMode),
+			IpcMode:             types.NamespaceMode(config.ipcMode),
+			PidMode:             types.NamespaceMode(config.pidMode),
+			ShmSize:             config.shmSize,
 		},
 		IOConfig: &types.IOConfig{
 			Tty:       config.terminal,
@@ -141,10 +232,46 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 		return nil, log.NewError("cannot create the container as privileged and with extra capabilities at the same time - choose one of the options")
 	}
 
-	if cc.config.env != nil || command != nil {
+	if cc.config.privileged && cc.config.droppedCapabilities != nil {
+		return nil, log.NewError("cannot create the container as privileged and with dropped capabilities at the same time - choose one of the options")
+	}
+
+	if cc.config.privileged && types.UsernsMode(cc.config.usernsMode) == types.UsernsModeRemap {
+		return nil, log.NewError("cannot create the container as privileged and with a remapped user namespace at the same time - choose one of the options")
+	}
+
+	if cc.config.ulimits != nil {
+		ulimits, err := util.ParseUlimits(cc.config.ulimits)
+		if err != nil {
+			return nil, err
+		}
+		ctrToCreate.HostConfig.Ulimits = ulimits
+	}
+
+	if cc.config.sysctls != nil {
+		sysctls, err := util.ParseSysctls(cc.config.sysctls)
+		if err != nil {
+			return nil, err
+		}
+		ctrToCreate.HostConfig.Sysctls = sysctls
+	}
+
+	if cc.config.env != nil || command != nil || cc.config.entrypoint != "" || cc.config.workingDir != "" ||
+		cc.config.user != "" || cc.config.groups != nil || cc.config.labels != nil {
+		labels, err := util.ParseLabels(cc.config.labels)
+		if err != nil {
+			return nil, err
+		}
 		ctrToCreate.Config = &types.ContainerConfiguration{
-			Env: cc.config.env,
-			Cmd: command,
+			Env:        cc.config.env,
+			Cmd:        command,
+			WorkingDir: cc.config.workingDir,
+			User:       cc.config.user,
+			Groups:     cc.config.groups,
+			Labels:     labels,
+		}
+		if cc.config.entrypoint != "" {
+			ctrToCreate.Config.Entrypoint = []string{cc.config.entrypoint}
 		}
 	}
 
@@ -164,6 +291,13 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 			ctrToCreate.Mounts = mounts
 		}
 	}
+	if cc.config.mounts != nil {
+		mounts, err := util.ParseMountPoints(cc.config.mounts)
+		if err != nil {
+			return nil, err
+		}
+		ctrToCreate.Mounts = append(ctrToCreate.Mounts, mounts...)
+	}
 	if cc.config.ports != nil {
 		mappings, err := util.ParsePortMappings(cc.config.ports)
 		if err != nil {
@@ -171,6 +305,22 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 		}
 		ctrToCreate.HostConfig.PortMappings = mappings
 	}
+	if cc.config.endpoints != nil {
+		endpointsConfig, err := util.ParseEndpointsConfig(cc.config.endpoints)
+		if err != nil {
+			return nil, err
+		}
+		ctrToCreate.HostConfig.EndpointsConfig = endpointsConfig
+	}
+	ctrToCreate.HostConfig.DNS = cc.config.dns
+	ctrToCreate.HostConfig.DNSSearch = cc.config.dnsSearch
+	ctrToCreate.HostConfig.DNSOptions = cc.config.dnsOptions
+	policy, err := getNetworkPolicy(cc.config.networkPolicy)
+	if err != nil {
+		return nil, err
+	}
+	ctrToCreate.HostConfig.NetworkPolicy = policy
+	ctrToCreate.HostConfig.SecurityOpts = getSecurityOptions(cc.config.securityOpts)
 
 	switch cc.config.restartPolicy.kind {
 	case string(types.Always):
@@ -194,6 +344,18 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 	default:
 		ctrToCreate.HostConfig.RestartPolicy = nil
 	}
+	if cc.config.restartPolicy.onUnhealthy {
+		if ctrToCreate.HostConfig.RestartPolicy == nil {
+			return nil, log.NewError("the restart policy must be set via --rp to restart unhealthy containers")
+		}
+		ctrToCreate.HostConfig.RestartPolicy.RestartOnUnhealthy = true
+	}
+
+	healthCheck, err := getHealthCheck(cc.config.healthCheck)
+	if err != nil {
+		return nil, err
+	}
+	ctrToCreate.HealthCheck = healthCheck
 
 	ctrToCreate.HostConfig.LogConfig = &types.LogConfiguration{}
 	switch cc.config.logDriver {
@@ -203,6 +365,27 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 			MaxFiles: cc.config.logMaxFiles,
 			MaxSize:  cc.config.logMaxSize,
 			RootDir:  cc.config.logRootDirPath,
+			Compress: types.LogCompression(cc.config.logCompress),
+			MaxAge:   cc.config.logMaxAge,
+		}
+	case string(types.LogConfigDriverLocal):
+		ctrToCreate.HostConfig.LogConfig.DriverConfig = &types.LogDriverConfiguration{
+			Type:     types.LogConfigDriverLocal,
+			MaxFiles: cc.config.logMaxFiles,
+			MaxSize:  cc.config.logMaxSize,
+			RootDir:  cc.config.logRootDirPath,
+		}
+	case string(types.LogConfigDriverSyslog):
+		ctrToCreate.HostConfig.LogConfig.DriverConfig = &types.LogDriverConfiguration{
+			Type:     types.LogConfigDriverSyslog,
+			Address:  cc.config.logAddress,
+			Facility: cc.config.logFacility,
+			Tag:      cc.config.logTag,
+		}
+	case string(types.LogConfigDriverJournald):
+		ctrToCreate.HostConfig.LogConfig.DriverConfig = &types.LogDriverConfiguration{
+			Type: types.LogConfigDriverJournald,
+			Tag:  cc.config.logTag,
 		}
 	case string(types.LogConfigDriverNone):
 		ctrToCreate.HostConfig.LogConfig.DriverConfig = &types.LogDriverConfiguration{
@@ -226,7 +409,9 @@ func (cc *createCmd) containerFromFlags(args []string) (*types.Container, error)
 		ctrToCreate.HostConfig.LogConfig.ModeConfig = nil
 	}
 
-	ctrToCreate.HostConfig.Resources = getResourceLimits(cc.config.resources)
+	if ctrToCreate.HostConfig.Resources, err = getResourceLimits(cc.config.resources); err != nil {
+		return nil, err
+	}
 	ctrToCreate.Image.DecryptConfig = getDecryptConfig(cc.config)
 
 	return ctrToCreate, nil
@@ -274,15 +459,183 @@ func getDecryptConfig(config createConfig) *types.DecryptConfig {
 	}
 }
 
-func getResourceLimits(r resources) *types.Resources {
-	if r.memory != "" || r.memoryReservation != "" || r.memorySwap != "" {
-		return &types.Resources{
+func getResourceLimits(r resources) (*types.Resources, error) {
+	if r.isEmpty() {
+		return nil, nil
+	}
+	var (
+		limits = &types.Resources{
 			Memory:            r.memory,
 			MemoryReservation: r.memoryReservation,
 			MemorySwap:        r.memorySwap,
+			CPUSetCPUs:        r.cpusetCpus,
+			CPUSetMems:        r.cpusetMems,
 		}
+		err error
+	)
+	if limits.CPUQuota, err = parseIntResource("cpu-quota", r.cpuQuota); err != nil {
+		return nil, err
+	}
+	if limits.CPUPeriod, err = parseUintResource("cpu-period", r.cpuPeriod, 64); err != nil {
+		return nil, err
+	}
+	if limits.CPUShares, err = parseUintResource("cpu-shares", r.cpuShares, 64); err != nil {
+		return nil, err
 	}
-	return nil
+	if limits.PidsLimit, err = parseIntResource("pids-limit", r.pidsLimit); err != nil {
+		return nil, err
+	}
+	weight, err := parseUintResource("blkio-weight", r.blkioWeight, 16)
+	if err != nil {
+		return nil, err
+	}
+	limits.BlkioWeight = uint16(weight)
+	if limits.BlkioDeviceReadBps, err = parseThrottleDevices(r.blkioDeviceReadBps, true); err != nil {
+		return nil, err
+	}
+	if limits.BlkioDeviceWriteBps, err = parseThrottleDevices(r.blkioDeviceWriteBps, true); err != nil {
+		return nil, err
+	}
+	if limits.BlkioDeviceReadIOps, err = parseThrottleDevices(r.blkioDeviceReadIOps, false); err != nil {
+		return nil, err
+	}
+	if limits.BlkioDeviceWriteIOps, err = parseThrottleDevices(r.blkioDeviceWriteIOps, false); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func getNetworkPolicy(n networkPolicy) (*types.NetworkPolicy, error) {
+	if n.isEmpty() {
+		return nil, nil
+	}
+	var (
+		policy = &types.NetworkPolicy{
+			EgressDefault: types.EgressAction(n.egressDefault),
+		}
+		err error
+	)
+	if policy.IngressRate, err = parseNetworkRate("net-ingress-rate", n.ingressRate); err != nil {
+		return nil, err
+	}
+	if policy.EgressRate, err = parseNetworkRate("net-egress-rate", n.egressRate); err != nil {
+		return nil, err
+	}
+	if policy.EgressRules, err = util.ParseEgressRules(n.egressRules); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func getSecurityOptions(s securityOpts) *types.SecurityOptions {
+	if s.isEmpty() {
+		return nil
+	}
+	return &types.SecurityOptions{
+		Seccomp:         s.seccomp,
+		AppArmorProfile: s.appArmorProfile,
+		SELinuxLabel:    s.seLinuxLabel,
+		NoNewPrivileges: s.noNewPrivileges,
+	}
+}
+
+// parseNetworkRate parses a rate in bytes per second which can also be in the form of 1m, 1.2g
+func parseNetworkRate(name, value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	rate, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		var bytes int64
+		if bytes, err = util.SizeToBytes(value); err != nil || bytes < 0 {
+			return 0, log.NewErrorf("invalid format of %s - %s", name, value)
+		}
+		rate = uint64(bytes)
+	}
+	return rate, nil
+}
+
+func parseIntResource(name, value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	res, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, log.NewErrorf("invalid format of %s - %s", name, value)
+	}
+	return res, nil
+}
+
+func parseUintResource(name, value string, bitSize int) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	res, err := strconv.ParseUint(value, 10, bitSize)
+	if err != nil {
+		return 0, log.NewErrorf("invalid format of %s - %s", name, value)
+	}
+	return res, nil
+}
+
+// parseThrottleDevices parses block IO rate limits in the form of <path>:<rate>, where the rate of a bps limit can also be in the form of 1m, 1.2g
+func parseThrottleDevices(devices []string, bps bool) ([]types.ThrottleDevice, error) {
+	var throttleDevices []types.ThrottleDevice
+	for _, device := range devices {
+		sep := strings.LastIndex(device, ":")
+		if sep <= 0 || sep == len(device)-1 {
+			return nil, log.NewErrorf("invalid format of block IO device limit - %s, expected <path>:<rate>", device)
+		}
+		path, rateStr := device[:sep], device[sep+1:]
+		rate, err := strconv.ParseUint(rateStr, 10, 64)
+		if err != nil && bps {
+			var bytes int64
+			if bytes, err = util.SizeToBytes(rateStr); err == nil {
+				rate = uint64(bytes)
+			}
+		}
+		if err != nil {
+			return nil, log.NewErrorf("invalid rate of block IO device limit - %s", device)
+		}
+		throttleDevices = append(throttleDevices, types.ThrottleDevice{Path: path, Rate: rate})
+	}
+	return throttleDevices, nil
+}
+
+func getHealthCheck(h healthCheck) (*types.HealthCheckConfig, error) {
+	var healthCheck *types.HealthCheckConfig
+	if h.cmd != "" {
+		healthCheck = &types.HealthCheckConfig{
+			Type: types.HealthCheckExec,
+			Cmd:  []string{"/bin/sh", "-c", h.cmd},
+		}
+	}
+	if h.httpPort != 0 {
+		if healthCheck != nil {
+			return nil, log.NewError("only one of --health-cmd, --health-http-port and --health-tcp-port can be set")
+		}
+		healthCheck = &types.HealthCheckConfig{
+			Type: types.HealthCheckHTTP,
+			Port: h.httpPort,
+			Path: h.httpPath,
+		}
+	}
+	if h.tcpPort != 0 {
+		if healthCheck != nil {
+			return nil, log.NewError("only one of --health-cmd, --health-http-port and --health-tcp-port can be set")
+		}
+		healthCheck = &types.HealthCheckConfig{
+			Type: types.HealthCheckTCP,
+			Port: h.tcpPort,
+		}
+	}
+	if healthCheck == nil {
+		return nil, nil
+	}
+	healthCheck.Interval = time.Duration(h.interval) * time.Second
+	healthCheck.Timeout = time.Duration(h.timeout) * time.Second
+	healthCheck.Retries = h.retries
+	healthCheck.StartPeriod = time.Duration(h.startPeriod) * time.Second
+	return healthCheck, nil
 }
 
 func (cc *createCmd) setupFlags() {
@@ -295,6 +648,38 @@ func (cc *createCmd) setupFlags() {
 	flagSet.BoolVar(&cc.config.interactive, "i", false, "Enable interaction with the current container")
 	// init interactive flags
 	flagSet.BoolVar(&cc.config.privileged, "privileged", false, "Create the container as privileged")
+	// init read-only root filesystem flags
+	flagSet.BoolVar(&cc.config.readOnlyRootfs, "read-only", false, "Mount the container's root filesystem as read-only")
+	// init runtime handler flags
+	flagSet.StringVar(&cc.config.runtimeHandler, "runtime", "", "Sets the name of the runtime handler configured for the container management that the container is run with, e.g. crun or runsc. "+
+		"The default runtime handler is used if not set")
+
+	flagSet.StringVar(&cc.config.securityOpts.seccomp, "seccomp", "", "Sets the seccomp profile restricting the syscalls of the container - default (the built-in profile), unconfined "+
+		"or an absolute path on the host to a JSON profile in the OCI runtime spec format. No seccomp filter is applied if not set")
+	flagSet.StringVar(&cc.config.securityOpts.appArmorProfile, "apparmor", "", "Sets the AppArmor profile of the container - the name of a profile already loaded on the host or unconfined")
+	flagSet.StringVar(&cc.config.securityOpts.seLinuxLabel, "selinux-label", "", "Sets the SELinux label of the container's process in the format user:role:type:level. Example:\n"+
+		"--selinux-label system_u:system_r:container_t:s0:c1,c2")
+	flagSet.BoolVar(&cc.config.securityOpts.noNewPrivileges, "no-new-privileges", false, "Prevents the container's process from gaining additional privileges, e.g. via setuid or setgid binaries")
+	flagSet.StringVar(&cc.config.usernsMode, "userns", "", "Sets the user namespace mode of the container. Possible options are:\n"+
+		"host - the container shares the user namespace of the host, i.e. its root user is the host's root user\n"+
+		"remap - the user and group IDs of the container are remapped to the subordinate ranges configured for the container management\n"+
+		"The default mode configured for the container management is used if not set")
+	flagSet.StringVar(&cc.config.ipcMode, "ipc", "", "Sets the IPC namespace mode of the container. Possible options are:\n"+
+		"private - the container has its own IPC namespace, this is the default\n"+
+		"host - the container shares the IPC namespace and /dev/shm of the host\n"+
+		"container:<name|id> - the container shares the IPC namespace and /dev/shm of another running container")
+	flagSet.StringVar(&cc.config.pidMode, "pid", "", "Sets the PID namespace mode of the container. Possible options are:\n"+
+		"private - the container has its own PID namespace, this is the default\n"+
+		"host - the container shares the PID namespace of the host\n"+
+		"container:<name|id> - the container shares the PID namespace of another running container")
+	flagSet.StringVar(&cc.config.shmSize, "shm-size", "", "Sets the size of the container's /dev/shm. The format is <number><unit>. Example:\n"+
+		"--shm-size=256M")
+	flagSet.StringSliceVar(&cc.config.ulimits, "ulimit", nil, "Sets resource limits of the container's process in the format <name>=<soft>[:<hard>], "+
+		"where unlimited or -1 means no limit. Example:\n"+
+		"--ulimit=nofile=1024:65536,memlock=unlimited")
+	flagSet.StringSliceVar(&cc.config.sysctls, "sysctl", nil, "Sets namespaced kernel parameters of the container - the IPC (kernel.msg*, kernel.sem, kernel.shm*, fs.mqueue.*) "+
+		"and the network (net.*) ones. Example:\n"+
+		"--sysctl=kernel.shmmax=68719476736,net.ipv4.tcp_keepalive_time=60")
 	// init restart policy flags
 	flagSet.StringVar(&cc.config.restartPolicy.kind, "rp", "",
 		"Sets the restart policy for the container.Supported restart policies are - no, always, unless-stopped (the default), always. \n"+
@@ -307,6 +692,17 @@ func (cc *createCmd) setupFlags() {
 	flagSet.IntVar(&cc.config.restartPolicy.maxRetryCount, "rp-cnt", 1, "Sets the number of retries that will be made to restart the container on exit if the policy is set to Always")
 	// init  restart policy max retry count flags
 	flagSet.Int64Var(&cc.config.restartPolicy.timeout, "rp-to", 30, "Sets the time out period in seconds for each retry that will be made to restart the container on exit if the policy is set to Always")
+	// init restart policy on unhealthy flags
+	flagSet.BoolVar(&cc.config.restartPolicy.onUnhealthy, "rp-unhealthy", false, "Restart the container when its health check reports it as unhealthy - applicable for all restart policies except no")
+	// init health check flags
+	flagSet.StringVar(&cc.config.healthCheck.cmd, "health-cmd", "", "Sets a command to be run inside the container via /bin/sh -c to check its health - an exit code of 0 means that the container is healthy")
+	flagSet.IntVar(&cc.config.healthCheck.httpPort, "health-http-port", 0, "Sets a container port to be probed with an HTTP GET request to check the container's health - a 2xx or 3xx response means that the container is healthy")
+	flagSet.StringVar(&cc.config.healthCheck.httpPath, "health-http-path", "/", "Sets the path of the HTTP GET request used to check the container's health - applicable for --health-http-port only")
+	flagSet.IntVar(&cc.config.healthCheck.tcpPort, "health-tcp-port", 0, "Sets a container port to be probed with a TCP connection to check the container's health")
+	flagSet.Int64Var(&cc.config.healthCheck.interval, "health-interval", 30, "Sets the time in seconds between two consecutive health checks")
+	flagSet.Int64Var(&cc.config.healthCheck.timeout, "health-timeout", 30, "Sets the time in seconds after which a single health check is considered failed")
+	flagSet.IntVar(&cc.config.healthCheck.retries, "health-retries", 3, "Sets the number of consecutive failed health checks after which the container is considered unhealthy")
+	flagSet.Int64Var(&cc.config.healthCheck.startPeriod, "health-start-period", 0, "Sets the initialization time in seconds of the container during which failed health checks are not counted")
 	// init devices
 	flagSet.StringSliceVar(&cc.config.devices, "devices", nil, "Devices to be made available in the current container and optional cgroups permissions configuration. Both path on host and in container must be set. Possible cgroup permissions options are \"r\" (read), \"w\" (write), \"m\" (mknod) and all combinations of the three are possible. If not set, \"rwm\" is default device configuration. Example: \n"+
 		"--devices=/dev/ttyACM0:/dev/ttyUSB0[:rwm]")
@@ -320,13 +716,34 @@ func (cc *createCmd) setupFlags() {
 		"Specifying port protocol (default is tcp): \n"+
 		"--ports=80:80/udp\n"+
 		"By default the port mappings will set on all network interfaces, but this is also manageable. Example with two mappings including an optional host port range and udp: \n"+
-		"--ports=0.0.0.0:80-100:80/udp",
+		"--ports=0.0.0.0:80-100:80/udp\n"+
+		"An IPv6 host ip must be enclosed in square brackets: \n"+
+		"--ports=[2001:db8::1]:80:80",
 	)
 	// init network mode
 	flagSet.StringVar(&cc.config.network, "network", string(types.NetworkModeBridge),
 		"Sets the networking mode for the container. Possible options are:\n"+
 			"bridge - the container is connected to the default bridge network interface of the engine and is assigned an IP (this is the default)\n"+
-			"host - the container shares the network stack of the host (use with caution as this breaks the network's isolation!)")
+			"host - the container shares the network stack of the host (use with caution as this breaks the network's isolation!)\n"+
+			"none - the container has its own network stack with the loopback interface only\n"+
+			"container:<name|id> - the container shares the network stack of the given container which must be running when the container is started\n"+
+			"<network-name> - the container is connected to the given user-defined bridge network and is assigned an IP from its subnet")
+	flagSet.StringSliceVar(&cc.config.networks, "network-add", nil, "Connects the container to an additional user-defined bridge network. Can be repeated to connect the container to multiple networks. Example:\n"+
+		"--network-add=backend --network-add=monitoring")
+	flagSet.StringArrayVar(&cc.config.endpoints, "network-address", nil, "Sets a static IPv4 address, IPv6 address and/or MAC address of the container in the given network "+
+		"which must be either the one set as network mode or one of the additionally added networks. The IPv4 and IPv6 addresses must be within the subnets of the network. Can be repeated for multiple networks. Template:\n"+
+		"--network-address=<network-name>[,ip=<ipv4>][,ip6=<ipv6>][,mac=<mac>]\n"+
+		"Example:\n"+
+		"--network-address=backend,ip=172.20.0.10,mac=02:42:ac:14:00:0a")
+	// init network policy
+	flagSet.StringVar(&cc.config.networkPolicy.ingressRate, "net-ingress-rate", "", "Sets the max rate in bytes per second of the network traffic received by the container in the form of 1000, 1m, 1.2g")
+	flagSet.StringVar(&cc.config.networkPolicy.egressRate, "net-egress-rate", "", "Sets the max rate in bytes per second of the network traffic sent by the container in the form of 1000, 1m, 1.2g")
+	flagSet.StringVar(&cc.config.networkPolicy.egressDefault, "net-egress-default", "", "Sets the action for the network traffic sent by the container which does not match any of the egress rules - allow (the default) or deny")
+	flagSet.StringArrayVar(&cc.config.networkPolicy.egressRules, "net-egress-rule", nil, "Adds a rule allowing or denying the network traffic sent by the container to the given destination. "+
+		"The rules are evaluated in the given order and the first matching one is applied. Can be repeated. Template:\n"+
+		"--net-egress-rule=<allow|deny>,<cidr>[,proto=<tcp|udp>][,ports=<port>[-<port>]]\n"+
+		"Example allowing only HTTPS traffic to a subnet:\n"+
+		"--net-egress-default=deny --net-egress-rule=allow,10.0.0.0/8,proto=tcp,ports=443")
 	// init extra hosts
 	flagSet.StringSliceVar(&cc.config.extraHosts, "hosts", nil, "Extra hosts to be added in the current container's /etc/hosts file. Example: \n"+
 		"--hosts=\"hostname1:<IP1>, hostname2:<IP2>..\" \n"+
@@ -337,18 +754,47 @@ func (cc *createCmd) setupFlags() {
 		"this will automatically resolve the host's IP on the default bridge network interface for containerm (the default configuration is kanto-cm0) and add it to the container's hosts file if the container is configured to use it\n"+
 		"If the IP of a container in the same bridge network is to be added to the hosts file the reserved container_<container-host_name> must be provided. Example:\n"+
 		"--hosts=\"service:container_service-host\"")
+	// init DNS
+	flagSet.StringSliceVar(&cc.config.dns, "dns", nil, "Sets the DNS servers used by the container instead of the host's ones. "+
+		"The queries which are not for containers in the same bridge network are forwarded to them. Example:\n"+
+		"--dns=8.8.8.8,2001:4860:4860::8888")
+	flagSet.StringSliceVar(&cc.config.dnsSearch, "dns-search", nil, "Sets the DNS search domains in the container's resolv.conf instead of the host's ones. Example:\n"+
+		"--dns-search=example.com,corp.example.com")
+	flagSet.StringSliceVar(&cc.config.dnsOptions, "dns-option", nil, "Sets the DNS resolver options in the container's resolv.conf instead of the host's ones. Example:\n"+
+		"--dns-option=ndots:2,timeout:1")
 	flagSet.StringSliceVar(&cc.config.mountPoints, "mp", nil, "Sets mount points so a source directory on the host can be accessed via a destination directory in the container. Example:\n"+
 		"--mp=\"source1:destination1:propagation_mode, source2:destination2\" \n"+
 		"If the propagation mode parameter is omitted, 'rprivate' will be set by default.  \n"+
-		"Available propagation modes are: rprivate, private, rshared, shared, rslave, slave")
+		"If the source is a name rather than an absolute path, the named volume with that name is mounted and it is created with the default options if missing. Example:\n"+
+		"--mp=\"volume1:/var/data\" \n"+
+		"Available propagation modes are: rprivate, private, rshared, shared, rslave, slave \n"+
+		"Use --mount to additionally set mount options such as ro, nosuid, noexec or nodev")
+	flagSet.StringArrayVar(&cc.config.mounts, "mount", nil, "Sets a mount point in the format source:destination[:options], where options is a comma-separated list of "+
+		"an optional propagation mode and mount options. Can be provided multiple times. Example:\n"+
+		"--mount=/var/config:/config:ro --mount=/var/data:/data:rshared,nosuid,noexec \n"+
+		"Available mount options are: ro, rw, nosuid, suid, noexec, exec, nodev, dev")
 	flagSet.StringArrayVar(&cc.config.env, "e", nil, "Sets the provided environment variables in the root container's process environment. Example:\n"+
 		"--e=VAR1=2 --e=VAR2=\"a bc\"\n"+
 		"If --e=VAR1= is used, the environment variable would be set to empty.\n"+
 		"If --e=VAR1 is used, the environment variable would be removed from the container environment inherited from the image.")
-	flagSet.StringVar(&cc.config.logDriver, "log-driver", string(types.LogConfigDriverJSONFile), "Sets the type of the log driver to be used for the container - json-file (default), none")
-	flagSet.IntVar(&cc.config.logMaxFiles, "log-max-files", 2, "Sets the max number of log files to be rotated - applicable for json-file log driver only")
-	flagSet.StringVar(&cc.config.logMaxSize, "log-max-size", "100M", "Sets the max size of the logs files for rotation in the form of 1, 1.2m,1g, etc. - applicable for json-file log driver only")
-	flagSet.StringVar(&cc.config.logRootDirPath, "log-path", "", "Sets the path to the directory where the log files will be stored - applicable for json-file log driver only")
+	flagSet.StringVar(&cc.config.entrypoint, "entrypoint", "", "Overrides the default executable of the image. The provided command and arguments are passed to it.")
+	flagSet.StringVar(&cc.config.workingDir, "workdir", "", "Overrides the default working directory of the image for the container's process. Must be an absolute path.")
+	flagSet.StringVar(&cc.config.user, "user", "", "Sets the user the container's process is run as in the format user[:group], both can be a name or an ID. Example:\n"+
+		"--user=1000:1000")
+	flagSet.StringSliceVar(&cc.config.groups, "group-add", nil, "Sets additional groups the container's process is run with, both names and IDs are supported. Example:\n"+
+		"--group-add=audio,44")
+	flagSet.StringArrayVar(&cc.config.labels, "label", nil, "Sets metadata labels on the container. Example:\n"+
+		"--label=app=web --label=tier=frontend")
+	flagSet.StringVar(&cc.config.logDriver, "log-driver", string(types.LogConfigDriverJSONFile), "Sets the type of the log driver to be used for the container - json-file (default), local, syslog, journald, none")
+	flagSet.IntVar(&cc.config.logMaxFiles, "log-max-files", 2, "Sets the max number of log files to be rotated - applicable for json-file and local log drivers only")
+	flagSet.StringVar(&cc.config.logMaxSize, "log-max-size", "100M", "Sets the max size of the logs files for rotation in the form of 1, 1.2m,1g, etc. - applicable for json-file and local log drivers only")
+	flagSet.StringVar(&cc.config.logRootDirPath, "log-path", "", "Sets the path to the directory where the log files will be stored - applicable for json-file and local log drivers only")
+	flagSet.StringVar(&cc.config.logCompress, "log-compress", "", "Sets the compression of the rotated log files - none, gzip, zstd. By default, the daemon configuration is used - applicable for json-file log driver only")
+	flagSet.StringVar(&cc.config.logMaxAge, "log-max-age", "", "Sets the max age of the rotated log files in the form of 72h, 30m, etc., the older ones are removed. By default, the daemon configuration is used - applicable for json-file log driver only")
+	flagSet.StringVar(&cc.config.logAddress, "log-address", "", "Sets the address of the syslog server in the form of [unix|unixgram|tcp|udp]://<address>, e.g. udp://192.168.1.10:514. "+
+		"If not set, the local syslog socket is used - applicable for syslog log driver only")
+	flagSet.StringVar(&cc.config.logFacility, "log-facility", "", "Sets the syslog facility of the container's logs, e.g. daemon (default), local0 - applicable for syslog log driver only")
+	flagSet.StringVar(&cc.config.logTag, "log-tag", "", "Sets the tag that identifies the container's logs. By default, the container name is used - applicable for syslog and journald log drivers only")
 	flagSet.StringVar(&cc.config.logMode, "log-mode", string(types.LogModeBlocking), "Sets the mode of the logger - blocking (default), non-blocking")
 	flagSet.StringVar(&cc.config.logMaxBufferSize, "log-max-buffer-size", "1M", "Sets the max size of the logger buffer in the form of 1, 1.2m - applicable for non-blocking mode only")
 	flagSet.StringVarP(&cc.config.resources.memory, "memory", "m", "", "Sets the max amount of memory the container can use in the form of 200m, 1.2g. The minimum allowed value is 3m\n"+
@@ -360,9 +806,29 @@ func (cc *createCmd) setupFlags() {
 		"If set must not be smaller than --memory. If equal to --memory, than the container will not have access to swap.\n"+
 		"If not set and --memory is set, than the container can use as much swap as the --memory setting.\n"+
 		"If set to -1, the container can use unlimited swap, up to the amount available on the host.")
+	flagSet.StringVar(&cc.config.resources.cpuQuota, "cpu-quota", "", "Sets the CPU CFS (Completely Fair Scheduler) quota in microseconds which the container can use per CPU period.\n"+
+		"The allowed range is from 1000 to 1000000. By default, a container has no CPU quota.")
+	flagSet.StringVar(&cc.config.resources.cpuPeriod, "cpu-period", "", "Sets the CPU CFS (Completely Fair Scheduler) period in microseconds. The allowed range is from 1000 to 1000000, the default is 100000")
+	flagSet.StringVar(&cc.config.resources.cpuShares, "cpu-shares", "", "Sets the CPU shares, i.e. the relative weight of the container compared to the other containers when there is CPU contention.\n"+
+		"The allowed range is from 2 to 262144, the default is 1024")
+	flagSet.StringVar(&cc.config.resources.cpusetCpus, "cpuset-cpus", "", "Sets the CPUs in which the container is allowed to execute in the form of 0-3, 0,1")
+	flagSet.StringVar(&cc.config.resources.cpusetMems, "cpuset-mems", "", "Sets the memory nodes in which the container is allowed to execute in the form of 0-3, 0,1 - effective on NUMA systems only")
+	flagSet.StringVar(&cc.config.resources.pidsLimit, "pids-limit", "", "Sets the max number of processes in the container. By default, a container has no processes number limit")
+	flagSet.StringVar(&cc.config.resources.blkioWeight, "blkio-weight", "", "Sets the block IO weight, i.e. the relative weight of the container compared to the other containers. The allowed range is from 10 to 1000")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceReadBps, "device-read-bps", nil, "Limits the read rate from a block device in the form of <path>:<rate>, where the rate is in bytes per second in the form of 1024, 200k, 1.2m. Example:\n"+
+		"--device-read-bps=/dev/sda:1m")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceWriteBps, "device-write-bps", nil, "Limits the write rate to a block device in the form of <path>:<rate>, where the rate is in bytes per second in the form of 1024, 200k, 1.2m. Example:\n"+
+		"--device-write-bps=/dev/sda:1m")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceReadIOps, "device-read-iops", nil, "Limits the read rate from a block device in the form of <path>:<rate>, where the rate is in IO operations per second. Example:\n"+
+		"--device-read-iops=/dev/sda:1000")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceWriteIOps, "device-write-iops", nil, "Limits the write rate to a block device in the form of <path>:<rate>, where the rate is in IO operations per second. Example:\n"+
+		"--device-write-iops=/dev/sda:1000")
 	flagSet.StringSliceVar(&cc.config.decKeys, "dec-keys", nil, "Sets a list of private keys filenames (GPG private key ring, JWE and PKCS7 private key). Each entry can include an optional password separated by a colon after the filename.")
 	flagSet.StringSliceVar(&cc.config.decRecipients, "dec-recipients", nil, "Sets a recipients certificates list of the image (used only for PKCS7 and must be an x509)")
 	//init extra capabilities
 	flagSet.StringSliceVar(&cc.config.extraCapabilities, "cap-add", nil, "Add Linux capabilities to the container")
+	flagSet.StringSliceVar(&cc.config.droppedCapabilities, "cap-drop", nil, "Drop Linux capabilities from the default ones of the container, ALL drops all of them. "+
+		"The capabilities added via --cap-add take precedence. Example:\n"+
+		"--cap-drop ALL --cap-add CAP_NET_BIND_SERVICE")
 	flagSet.StringVarP(&cc.config.containerFile, "file", "f", "", "Creates a container with a predefined config given by the user.")
 }
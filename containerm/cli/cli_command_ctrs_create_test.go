@@ -32,28 +32,81 @@ const (
 	createCmdFlagTerminal              = "t"
 	createCmdFlagInteractive           = "i"
 	createCmdFlagPrivileged            = "privileged"
+	createCmdFlagReadOnly              = "read-only"
+	createCmdFlagRuntime               = "runtime"
+	createCmdFlagSeccomp               = "seccomp"
+	createCmdFlagAppArmor              = "apparmor"
+	createCmdFlagSELinuxLabel          = "selinux-label"
+	createCmdFlagNoNewPrivileges       = "no-new-privileges"
+	createCmdFlagUserns                = "userns"
+	createCmdFlagIpc                   = "ipc"
+	createCmdFlagPid                   = "pid"
+	createCmdFlagShmSize               = "shm-size"
+	createCmdFlagUlimit                = "ulimit"
+	createCmdFlagSysctl                = "sysctl"
 	createCmdFlagContainerFile         = "file"
 	createCmdFlagRestartPolicy         = "rp"
 	createCmdFlagRestartPolicyMaxCount = "rp-cnt"
 	createCmdFlagRestartPolicyTimeout  = "rp-to"
 	createCmdFlagNetwork               = "network"
+	createCmdFlagNetworkAdd            = "network-add"
+	createCmdFlagNetworkAddress        = "network-address"
+	createCmdFlagNetIngressRate        = "net-ingress-rate"
+	createCmdFlagNetEgressRate         = "net-egress-rate"
+	createCmdFlagNetEgressDefault      = "net-egress-default"
+	createCmdFlagNetEgressRule         = "net-egress-rule"
 	createCmdFlagExtraHosts            = "hosts"
+	createCmdFlagDNS                   = "dns"
+	createCmdFlagDNSSearch             = "dns-search"
+	createCmdFlagDNSOption             = "dns-option"
 	createCmdFlagExtraCapabilities     = "cap-add"
+	createCmdFlagDroppedCapabilities   = "cap-drop"
 	createCmdFlagDevices               = "devices"
 	createCmdFlagMountPoints           = "mp"
+	createCmdFlagMounts                = "mount"
 	createCmdFlagPorts                 = "ports"
 	createCmdFlagEnv                   = "e"
+	createCmdFlagEntrypoint            = "entrypoint"
+	createCmdFlagWorkingDir            = "workdir"
+	createCmdFlagUser                  = "user"
+	createCmdFlagGroups                = "group-add"
+	createCmdFlagLabels                = "label"
 	createCmdFlagLogDriver             = "log-driver"
 	createCmdFlagLogDriverMaxFiles     = "log-max-files"
 	createCmdFlagLogDriverMaxSize      = "log-max-size"
 	createCmdFlagLogDriverPath         = "log-path"
+	createCmdFlagLogCompress           = "log-compress"
+	createCmdFlagLogMaxAge             = "log-max-age"
+	createCmdFlagLogAddress            = "log-address"
+	createCmdFlagLogFacility           = "log-facility"
+	createCmdFlagLogTag                = "log-tag"
 	createCmdFlagLogMode               = "log-mode"
 	createCmdFlagLogModeMaxBufferSize  = "log-max-buffer-size"
 	createCmdFlagMemory                = "memory"
 	createCmdFlagMemoryReservation     = "memory-reservation"
 	createCmdFlagMemorySwap            = "memory-swap"
+	createCmdFlagCPUQuota              = "cpu-quota"
+	createCmdFlagCPUPeriod             = "cpu-period"
+	createCmdFlagCPUShares             = "cpu-shares"
+	createCmdFlagCPUSetCPUs            = "cpuset-cpus"
+	createCmdFlagCPUSetMems            = "cpuset-mems"
+	createCmdFlagPidsLimit             = "pids-limit"
+	createCmdFlagBlkioWeight           = "blkio-weight"
+	createCmdFlagDeviceReadBps         = "device-read-bps"
+	createCmdFlagDeviceWriteBps        = "device-write-bps"
+	createCmdFlagDeviceReadIOps        = "device-read-iops"
+	createCmdFlagDeviceWriteIOps       = "device-write-iops"
 	createCmdFlagKeys                  = "dec-keys"
 	createCmdFlagDecRecipients         = "dec-recipients"
+	createCmdFlagRestartOnUnhealthy    = "rp-unhealthy"
+	createCmdFlagHealthCmd             = "health-cmd"
+	createCmdFlagHealthHTTPPort        = "health-http-port"
+	createCmdFlagHealthHTTPPath        = "health-http-path"
+	createCmdFlagHealthTCPPort         = "health-tcp-port"
+	createCmdFlagHealthInterval        = "health-interval"
+	createCmdFlagHealthTimeout         = "health-timeout"
+	createCmdFlagHealthRetries         = "health-retries"
+	createCmdFlagHealthStartPeriod     = "health-start-period"
 
 	// test input constants
 	createContainerImageName = "host/group/image:latest"
@@ -77,35 +130,87 @@ func TestCreateCmdSetupFlags(t *testing.T) {
 	createCliTest.init()
 
 	expectedCfg := createConfig{
-		name:          "",
-		terminal:      true,
-		interactive:   true,
-		privileged:    true,
-		containerFile: string("config.json"),
+		name:           "",
+		terminal:       true,
+		interactive:    true,
+		privileged:     true,
+		readOnlyRootfs: true,
+		runtimeHandler: "crun",
+		usernsMode:     string(types.UsernsModeRemap),
+		ipcMode:        types.NamespaceModeContainerPrefix + "sidecar",
+		pidMode:        string(types.NamespaceModeHost),
+		shmSize:        "256M",
+		ulimits:        []string{"nofile=1024:65536", "memlock=unlimited"},
+		sysctls:        []string{"kernel.shmmax=68719476736", "net.ipv4.tcp_keepalive_time=60"},
+		containerFile:  string("config.json"),
 		restartPolicy: restartPolicy{
 			kind:          string(types.Always),
 			timeout:       10,
 			maxRetryCount: 3,
-		},
-		network:           string(types.NetworkModeHost),
-		extraHosts:        []string{"ctrhost:host_ip"},
-		extraCapabilities: []string{"CAP_NET_ADMIN"},
-		devices:           []string{"/dev/ttyACM0:/dev/ttyACM1:rwm"},
-		mountPoints:       []string{"/proc:/proc:rprivate"},
-		ports:             []string{"192.168.1.100:80-100:80/udp"},
-		logDriver:         string(types.LogConfigDriverNone),
-		logMaxFiles:       5,
-		logMaxSize:        "200M",
-		logRootDirPath:    "/",
-		logMode:           string(types.LogModeNonBlocking),
-		logMaxBufferSize:  "2M",
+			onUnhealthy:   true,
+		},
+		network:             string(types.NetworkModeHost),
+		extraHosts:          []string{"ctrhost:host_ip"},
+		dns:                 []string{"8.8.8.8", "1.1.1.1"},
+		dnsSearch:           []string{"example.com"},
+		dnsOptions:          []string{"ndots:2", "timeout:1"},
+		extraCapabilities:   []string{"CAP_NET_ADMIN"},
+		droppedCapabilities: []string{"CAP_NET_RAW", "CAP_MKNOD"},
+		devices:             []string{"/dev/ttyACM0:/dev/ttyACM1:rwm"},
+		mountPoints:         []string{"/proc:/proc:rprivate"},
+		mounts:              []string{"/data:/data:rshared,ro,noexec"},
+		ports:               []string{"192.168.1.100:80-100:80/udp"},
+		entrypoint:          "/bin/app",
+		workingDir:          "/app",
+		user:                "1000:1000",
+		groups:              []string{"audio", "44"},
+		labels:              []string{"app=test"},
+		networks:            []string{"backend", "monitoring"},
+		logDriver:           string(types.LogConfigDriverNone),
+		logMaxFiles:         5,
+		logMaxSize:          "200M",
+		logRootDirPath:      "/",
+		logCompress:         string(types.LogCompressionGzip),
+		logMaxAge:           "72h",
+		logAddress:          "udp://localhost:514",
+		logFacility:         "local0",
+		logTag:              "app",
+		logMode:             string(types.LogModeNonBlocking),
+		logMaxBufferSize:    "2M",
 		resources: resources{
-			memory:            "500M",
-			memoryReservation: "300M",
-			memorySwap:        "800M",
+			memory:               "500M",
+			memoryReservation:    "300M",
+			memorySwap:           "800M",
+			cpuQuota:             "50000",
+			cpuPeriod:            "100000",
+			cpuShares:            "512",
+			cpusetCpus:           "0-1",
+			cpusetMems:           "0",
+			pidsLimit:            "100",
+			blkioWeight:          "300",
+			blkioDeviceReadBps:   []string{"/dev/sda:1m"},
+			blkioDeviceWriteBps:  []string{"/dev/sda:2m"},
+			blkioDeviceReadIOps:  []string{"/dev/sda:1000"},
+			blkioDeviceWriteIOps: []string{"/dev/sda:2000"},
 		},
 		decKeys:       []string{"key_filepath:password"},
 		decRecipients: []string{"pkcs7:cert_filepath"},
+		securityOpts: securityOpts{
+			seccomp:         types.SeccompProfileDefault,
+			appArmorProfile: "kanto-vendor",
+			seLinuxLabel:    "system_u:system_r:container_t:s0",
+			noNewPrivileges: true,
+		},
+		healthCheck: healthCheck{
+			cmd:         "test -f /tmp/healthy",
+			httpPort:    8080,
+			httpPath:    "/health",
+			tcpPort:     8081,
+			interval:    10,
+			timeout:     5,
+			retries:     2,
+			startPeriod: 15,
+		},
 	}
 
 	flagsToApply := map[string]string{
@@ -113,27 +218,75 @@ func TestCreateCmdSetupFlags(t *testing.T) {
 		createCmdFlagTerminal:              strconv.FormatBool(expectedCfg.terminal),
 		createCmdFlagInteractive:           strconv.FormatBool(expectedCfg.interactive),
 		createCmdFlagPrivileged:            strconv.FormatBool(expectedCfg.privileged),
+		createCmdFlagReadOnly:              strconv.FormatBool(expectedCfg.readOnlyRootfs),
+		createCmdFlagRuntime:               expectedCfg.runtimeHandler,
+		createCmdFlagSeccomp:               expectedCfg.securityOpts.seccomp,
+		createCmdFlagAppArmor:              expectedCfg.securityOpts.appArmorProfile,
+		createCmdFlagSELinuxLabel:          expectedCfg.securityOpts.seLinuxLabel,
+		createCmdFlagNoNewPrivileges:       strconv.FormatBool(expectedCfg.securityOpts.noNewPrivileges),
+		createCmdFlagUserns:                expectedCfg.usernsMode,
+		createCmdFlagIpc:                   expectedCfg.ipcMode,
+		createCmdFlagPid:                   expectedCfg.pidMode,
+		createCmdFlagShmSize:               expectedCfg.shmSize,
+		createCmdFlagUlimit:                strings.Join(expectedCfg.ulimits, ","),
+		createCmdFlagSysctl:                strings.Join(expectedCfg.sysctls, ","),
 		createCmdFlagContainerFile:         expectedCfg.containerFile,
 		createCmdFlagRestartPolicy:         expectedCfg.restartPolicy.kind,
 		createCmdFlagRestartPolicyMaxCount: strconv.Itoa(expectedCfg.restartPolicy.maxRetryCount),
 		createCmdFlagRestartPolicyTimeout:  strconv.FormatInt(expectedCfg.restartPolicy.timeout, 10),
 		createCmdFlagNetwork:               expectedCfg.network,
+		createCmdFlagNetworkAdd:            strings.Join(expectedCfg.networks, ","),
 		createCmdFlagExtraHosts:            strings.Join(expectedCfg.extraHosts, ","),
+		createCmdFlagDNS:                   strings.Join(expectedCfg.dns, ","),
+		createCmdFlagDNSSearch:             strings.Join(expectedCfg.dnsSearch, ","),
+		createCmdFlagDNSOption:             strings.Join(expectedCfg.dnsOptions, ","),
 		createCmdFlagExtraCapabilities:     strings.Join(expectedCfg.extraCapabilities, ","),
+		createCmdFlagDroppedCapabilities:   strings.Join(expectedCfg.droppedCapabilities, ","),
 		createCmdFlagDevices:               strings.Join(expectedCfg.devices, ","),
 		createCmdFlagMountPoints:           strings.Join(expectedCfg.mountPoints, ","),
+		createCmdFlagMounts:                expectedCfg.mounts[0],
 		createCmdFlagPorts:                 strings.Join(expectedCfg.ports, ","),
+		createCmdFlagEntrypoint:            expectedCfg.entrypoint,
+		createCmdFlagWorkingDir:            expectedCfg.workingDir,
+		createCmdFlagUser:                  expectedCfg.user,
+		createCmdFlagGroups:                strings.Join(expectedCfg.groups, ","),
+		createCmdFlagLabels:                expectedCfg.labels[0],
 		createCmdFlagLogDriver:             expectedCfg.logDriver,
 		createCmdFlagLogDriverMaxFiles:     strconv.Itoa(expectedCfg.logMaxFiles),
 		createCmdFlagLogDriverMaxSize:      expectedCfg.logMaxSize,
 		createCmdFlagLogDriverPath:         expectedCfg.logRootDirPath,
+		createCmdFlagLogCompress:           expectedCfg.logCompress,
+		createCmdFlagLogMaxAge:             expectedCfg.logMaxAge,
+		createCmdFlagLogAddress:            expectedCfg.logAddress,
+		createCmdFlagLogFacility:           expectedCfg.logFacility,
+		createCmdFlagLogTag:                expectedCfg.logTag,
 		createCmdFlagLogMode:               expectedCfg.logMode,
 		createCmdFlagLogModeMaxBufferSize:  expectedCfg.logMaxBufferSize,
 		createCmdFlagMemory:                expectedCfg.memory,
 		createCmdFlagMemoryReservation:     expectedCfg.memoryReservation,
 		createCmdFlagMemorySwap:            expectedCfg.memorySwap,
+		createCmdFlagCPUQuota:              expectedCfg.cpuQuota,
+		createCmdFlagCPUPeriod:             expectedCfg.cpuPeriod,
+		createCmdFlagCPUShares:             expectedCfg.cpuShares,
+		createCmdFlagCPUSetCPUs:            expectedCfg.cpusetCpus,
+		createCmdFlagCPUSetMems:            expectedCfg.cpusetMems,
+		createCmdFlagPidsLimit:             expectedCfg.pidsLimit,
+		createCmdFlagBlkioWeight:           expectedCfg.blkioWeight,
+		createCmdFlagDeviceReadBps:         strings.Join(expectedCfg.blkioDeviceReadBps, ","),
+		createCmdFlagDeviceWriteBps:        strings.Join(expectedCfg.blkioDeviceWriteBps, ","),
+		createCmdFlagDeviceReadIOps:        strings.Join(expectedCfg.blkioDeviceReadIOps, ","),
+		createCmdFlagDeviceWriteIOps:       strings.Join(expectedCfg.blkioDeviceWriteIOps, ","),
 		createCmdFlagKeys:                  strings.Join(expectedCfg.decKeys, ","),
 		createCmdFlagDecRecipients:         strings.Join(expectedCfg.decRecipients, ","),
+		createCmdFlagRestartOnUnhealthy:    strconv.FormatBool(expectedCfg.restartPolicy.onUnhealthy),
+		createCmdFlagHealthCmd:             expectedCfg.healthCheck.cmd,
+		createCmdFlagHealthHTTPPort:        strconv.Itoa(expectedCfg.healthCheck.httpPort),
+		createCmdFlagHealthHTTPPath:        expectedCfg.healthCheck.httpPath,
+		createCmdFlagHealthTCPPort:         strconv.Itoa(expectedCfg.healthCheck.tcpPort),
+		createCmdFlagHealthInterval:        strconv.FormatInt(expectedCfg.healthCheck.interval, 10),
+		createCmdFlagHealthTimeout:         strconv.FormatInt(expectedCfg.healthCheck.timeout, 10),
+		createCmdFlagHealthRetries:         strconv.Itoa(expectedCfg.healthCheck.retries),
+		createCmdFlagHealthStartPeriod:     strconv.FormatInt(expectedCfg.healthCheck.startPeriod, 10),
 	}
 
 	execTestSetupFlags(t, createCliTest, flagsToApply, expectedCfg)
@@ -181,6 +334,11 @@ func (createTc *createCommandTest) commandConfigDefault() interface{} {
 		logMaxFiles:       2,
 		logMaxSize:        "100M",
 		logRootDirPath:    "",
+		logCompress:       "",
+		logMaxAge:         "",
+		logAddress:        "",
+		logFacility:       "",
+		logTag:            "",
 		logMode:           string(types.LogModeBlocking),
 		logMaxBufferSize:  "1M",
 		resources: resources{
@@ -188,6 +346,12 @@ func (createTc *createCommandTest) commandConfigDefault() interface{} {
 			memoryReservation: "",
 			memorySwap:        "",
 		},
+		healthCheck: healthCheck{
+			httpPath: "/",
+			interval: 30,
+			timeout:  30,
+			retries:  3,
+		},
 	}
 }
 
@@ -299,6 +463,21 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateWithMountPoints,
 		},
+		"test_create_mounts_with_options": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagMountPoints: "/proc:/proc",
+				createCmdFlagMounts:      "/data:/data:rshared,ro,noexec",
+			},
+			mockExecution: createTc.mockExecCreateWithMountsOptions,
+		},
+		"test_create_mounts_invalid_options": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagMounts: "/data:/data:private,shared",
+			},
+			mockExecution: createTc.mockExecCreateWithMountsErrIncorrectOptions,
+		},
 		// Test port mappings
 		"test_create_port_mappings": {
 			args: createCmdArgs,
@@ -464,6 +643,23 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateWithExtraCapabilitiesWithPrivileged,
 		},
+		// Test dropped capabilities
+		"test_create_dropped_capabilities": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagDroppedCapabilities: "ALL",
+				createCmdFlagExtraCapabilities:   "CAP_NET_BIND_SERVICE",
+			},
+			mockExecution: createTc.mockExecCreateWithDroppedCapabilities,
+		},
+		"test_create_dropped_capabilities_with_privileged": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagDroppedCapabilities: "CAP_NET_RAW",
+				createCmdFlagPrivileged:          "true",
+			},
+			mockExecution: createTc.mockExecCreateWithDroppedCapabilitiesWithPrivileged,
+		},
 		// Test privileged
 		"test_create_privileged": {
 			args: createCmdArgs,
@@ -472,6 +668,70 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateWithPrivileged,
 		},
+		// Test read-only root filesystem
+		"test_create_read_only": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagReadOnly: "true",
+			},
+			mockExecution: createTc.mockExecCreateWithReadOnlyRootfs,
+		},
+		// Test user namespace mode
+		"test_create_userns": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagUserns: string(types.UsernsModeRemap),
+			},
+			mockExecution: createTc.mockExecCreateWithUsernsMode,
+		},
+		"test_create_userns_remap_with_privileged": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagUserns:     string(types.UsernsModeRemap),
+				createCmdFlagPrivileged: "true",
+			},
+			mockExecution: createTc.mockExecCreateWithUsernsModeWithPrivileged,
+		},
+		// Test IPC and PID namespaces, /dev/shm size, ulimits and sysctls
+		"test_create_ipc_pid_shm_ulimits_sysctls": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagIpc:    types.NamespaceModeContainerPrefix + "sidecar",
+				createCmdFlagPid:    string(types.NamespaceModeHost),
+				createCmdFlagUlimit: "nofile=1024:65536,memlock=unlimited",
+				createCmdFlagSysctl: "net.ipv4.tcp_keepalive_time=60",
+			},
+			mockExecution: createTc.mockExecCreateWithNamespacesAndLimits,
+		},
+		"test_create_shm_size": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagShmSize: "256M",
+				createCmdFlagSysctl:  "kernel.shmmax=68719476736",
+			},
+			mockExecution: createTc.mockExecCreateWithShmSize,
+		},
+		"test_create_runtime_handler": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagRuntime: "runsc",
+			},
+			mockExecution: createTc.mockExecCreateWithRuntimeHandler,
+		},
+		"test_create_ulimit_invalid": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagUlimit: "nofile=many",
+			},
+			mockExecution: createTc.mockExecCreateWithInvalidUlimit,
+		},
+		"test_create_sysctl_not_namespaced": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagSysctl: "vm.swappiness=10",
+			},
+			mockExecution: createTc.mockExecCreateWithNotNamespacedSysctl,
+		},
 		// Test container file
 		"test_create_no_args": {
 			mockExecution: createTc.mockExecCreateWithNoArgs,
@@ -624,6 +884,24 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			args:          append(createCmdArgs, "echo", "test", "execution!"),
 			mockExecution: createTc.mockExecArgsDefault,
 		},
+		"test_create_process_config": {
+			args: append(createCmdArgs, "-v"),
+			flags: map[string]string{
+				createCmdFlagEntrypoint: "/bin/app",
+				createCmdFlagWorkingDir: "/app",
+				createCmdFlagUser:       "app:1000",
+				createCmdFlagGroups:     "audio,44",
+				createCmdFlagLabels:     "app=test",
+			},
+			mockExecution: createTc.mockExecCreateProcessConfig,
+		},
+		"test_create_labels_invalid": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagLabels: "app",
+			},
+			mockExecution: createTc.mockExecCreateLabelsInvalid,
+		},
 		"test_create_name": {
 			args: createCmdArgs,
 			flags: map[string]string{
@@ -659,11 +937,41 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 				createCmdFlagLogDriverMaxSize:     "10M",
 				createCmdFlagLogDriverMaxFiles:    "5",
 				createCmdFlagLogDriverPath:        "/",
+				createCmdFlagLogCompress:          string(types.LogCompressionZstd),
+				createCmdFlagLogMaxAge:            "72h",
 				createCmdFlagLogMode:              string(types.LogModeNonBlocking),
 				createCmdFlagLogModeMaxBufferSize: "5M",
 			},
 			mockExecution: createTc.mockExecCreateLogFullyConfigured,
 		},
+		"test_create_log_local": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagLogDriver:         string(types.LogConfigDriverLocal),
+				createCmdFlagLogDriverMaxFiles: "3",
+				createCmdFlagLogTag:            "ignored",
+			},
+			mockExecution: createTc.mockExecCreateLogDriverLocal,
+		},
+		"test_create_log_syslog": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagLogDriver:   string(types.LogConfigDriverSyslog),
+				createCmdFlagLogAddress:  "tcp://192.168.1.10:514",
+				createCmdFlagLogFacility: "local0",
+				createCmdFlagLogTag:      "app",
+			},
+			mockExecution: createTc.mockExecCreateLogDriverSyslog,
+		},
+		"test_create_log_journald": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagLogDriver:   string(types.LogConfigDriverJournald),
+				createCmdFlagLogFacility: "ignored",
+				createCmdFlagLogTag:      "app",
+			},
+			mockExecution: createTc.mockExecCreateLogDriverJournald,
+		},
 		"test_create_log_mode_blocking_buff_ignored": {
 			args: createCmdArgs,
 			flags: map[string]string{
@@ -680,6 +988,78 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateNetworkModeHost,
 		},
+		"test_create_network_user_defined": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetwork:    "frontend",
+				createCmdFlagNetworkAdd: "backend,monitoring",
+			},
+			mockExecution: createTc.mockExecCreateNetworkUserDefined,
+		},
+		"test_create_network_address": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetwork:        "frontend",
+				createCmdFlagNetworkAddress: "frontend,ip=172.20.0.10,mac=02:42:ac:14:00:0a",
+			},
+			mockExecution: createTc.mockExecCreateNetworkAddress,
+		},
+		"test_create_network_address_invalid": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetworkAddress: "bridge,gateway=172.17.0.1",
+			},
+			mockExecution: createTc.mockExecCreateNetworkAddressInvalid,
+		},
+		"test_create_network_policy": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetIngressRate:   "1m",
+				createCmdFlagNetEgressRate:    "65536",
+				createCmdFlagNetEgressDefault: string(types.EgressActionDeny),
+				createCmdFlagNetEgressRule:    "allow,10.0.0.0/8,proto=tcp,ports=8000-8080",
+			},
+			mockExecution: createTc.mockExecCreateNetworkPolicy,
+		},
+		"test_create_network_policy_invalid_rate": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetIngressRate: "1X",
+			},
+			mockExecution: createTc.mockExecCreateNetworkPolicyInvalidRate,
+		},
+		"test_create_network_policy_invalid_default": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetEgressDefault: "reject",
+			},
+			mockExecution: createTc.mockExecCreateNetworkPolicyInvalidDefault,
+		},
+		"test_create_security_opts": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagSeccomp:         "/etc/kanto/seccomp/vendor.json",
+				createCmdFlagAppArmor:        "kanto-vendor",
+				createCmdFlagSELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+				createCmdFlagNoNewPrivileges: "true",
+			},
+			mockExecution: createTc.mockExecCreateSecurityOpts,
+		},
+		"test_create_security_opts_privileged_seccomp": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagPrivileged: "true",
+				createCmdFlagSeccomp:    types.SeccompProfileDefault,
+			},
+			mockExecution: createTc.mockExecCreateSecurityOptsPrivilegedSeccomp,
+		},
+		"test_create_network_add_host": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetworkAdd: string(types.NetworkModeHost),
+			},
+			mockExecution: createTc.mockExecCreateNetworkAddHost,
+		},
 		"test_create_network_mode_with_key_used": {
 			args: createCmdArgs,
 			flags: map[string]string{
@@ -696,13 +1076,50 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateNetworkModeHostReservedKeyUsed,
 		},
+		"test_create_dns": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagDNS:       "8.8.8.8,2001:4860:4860::8888",
+				createCmdFlagDNSSearch: "example.com",
+				createCmdFlagDNSOption: "ndots:2",
+			},
+			mockExecution: createTc.mockExecCreateDNS,
+		},
+		"test_create_dns_invalid_server": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagDNS: "dns.example.com",
+			},
+			mockExecution: createTc.mockExecCreateDNSInvalidServer,
+		},
 		"test_create_network_mode_invalid": {
 			args: createCmdArgs,
 			flags: map[string]string{
-				createCmdFlagNetwork: "custom",
+				createCmdFlagNetwork: "-custom",
 			},
 			mockExecution: createTc.mockExecCreateNetworkModeInvalid,
 		},
+		"test_create_network_mode_none": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetwork: "none",
+			},
+			mockExecution: createTc.mockExecCreateNetworkModeNone,
+		},
+		"test_create_network_mode_container": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetwork: "container:web",
+			},
+			mockExecution: createTc.mockExecCreateNetworkModeContainer,
+		},
+		"test_create_network_mode_container_no_target": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagNetwork: "container:",
+			},
+			mockExecution: createTc.mockExecCreateNetworkModeContainerNoTarget,
+		},
 		// Tests default create
 		"test_create_ID_and_image_default": {
 			args:          createCmdArgs,
@@ -718,6 +1135,42 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateMemoryFullyConfigured,
 		},
+		// Test CPU, pids and block IO
+		"test_create_cpu_pids_blkio_configured": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagCPUQuota:        "50000",
+				createCmdFlagCPUPeriod:       "100000",
+				createCmdFlagCPUShares:       "512",
+				createCmdFlagCPUSetCPUs:      "0-1",
+				createCmdFlagPidsLimit:       "100",
+				createCmdFlagBlkioWeight:     "300",
+				createCmdFlagDeviceReadBps:   "/dev/sda:1k",
+				createCmdFlagDeviceWriteIOps: "/dev/sda:1000",
+			},
+			mockExecution: createTc.mockExecCreateCPUPidsBlkioConfigured,
+		},
+		"test_create_cpu_quota_invalid": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagCPUQuota: "1X",
+			},
+			mockExecution: createTc.mockExecCreateCPUQuotaInvalid,
+		},
+		"test_create_cpu_shares_out_of_range": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagCPUShares: "1",
+			},
+			mockExecution: createTc.mockExecCreateCPUSharesOutOfRange,
+		},
+		"test_create_device_read_bps_invalid": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagDeviceReadBps: "/dev/sda",
+			},
+			mockExecution: createTc.mockExecCreateDeviceReadBpsInvalid,
+		},
 		// Test decryption
 		"test_create_decryption_configured": {
 			args: createCmdArgs,
@@ -727,6 +1180,50 @@ func (createTc *createCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: createTc.mockExecCreateImageDecryptionConfigured,
 		},
+		// Test health check
+		"test_create_health_check_cmd": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagHealthCmd:         "test -f /tmp/healthy",
+				createCmdFlagHealthInterval:    "10",
+				createCmdFlagHealthTimeout:     "5",
+				createCmdFlagHealthRetries:     "2",
+				createCmdFlagHealthStartPeriod: "15",
+			},
+			mockExecution: createTc.mockExecCreateHealthCheckCmd,
+		},
+		"test_create_health_check_http": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagHealthHTTPPort: "8080",
+				createCmdFlagHealthHTTPPath: "/health",
+			},
+			mockExecution: createTc.mockExecCreateHealthCheckHTTP,
+		},
+		"test_create_health_check_tcp_restart_on_unhealthy": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagHealthTCPPort:      "8080",
+				createCmdFlagRestartPolicy:      string(types.Always),
+				createCmdFlagRestartOnUnhealthy: "true",
+			},
+			mockExecution: createTc.mockExecCreateHealthCheckTCPRestartOnUnhealthy,
+		},
+		"test_create_health_check_multiple_probes": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagHealthCmd:     "test -f /tmp/healthy",
+				createCmdFlagHealthTCPPort: "8080",
+			},
+			mockExecution: createTc.mockExecCreateHealthCheckMultipleProbes,
+		},
+		"test_create_restart_on_unhealthy_no_policy": {
+			args: createCmdArgs,
+			flags: map[string]string{
+				createCmdFlagRestartOnUnhealthy: "true",
+			},
+			mockExecution: createTc.mockExecCreateRestartOnUnhealthyNoPolicy,
+		},
 	}
 }
 
@@ -780,6 +1277,32 @@ func (createTc *createCommandTest) mockExecCreateWithMountPoints(args []string)
 	return nil
 }
 
+func (createTc *createCommandTest) mockExecCreateWithMountsOptions(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		Mounts: []types.MountPoint{{
+			Destination:     "/proc",
+			Source:          "/proc",
+			PropagationMode: types.RPrivatePropagationMode,
+		}, {
+			Destination:     "/data",
+			Source:          "/data",
+			PropagationMode: types.RSharedPropagationMode,
+			Options:         []string{types.MountOptionReadOnly, types.MountOptionNoExec},
+		}},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithMountsErrIncorrectOptions(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("Incorrect options of the mount point")
+}
+
 func (createTc *createCommandTest) mockExecCreateWithMountPointsErrIncorrectParams(args []string) error {
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
 	return log.NewError("Incorrect number of parameters of the mount point")
@@ -990,6 +1513,26 @@ func (createTc *createCommandTest) mockExecCreateWithExtraCapabilitiesWithPrivil
 	return log.NewError("cannot create the container as privileged and with extra capabilities at the same time - choose one of the options")
 }
 
+func (createTc *createCommandTest) mockExecCreateWithDroppedCapabilities(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			ExtraCapabilities:   []string{"CAP_NET_BIND_SERVICE"},
+			DroppedCapabilities: []string{types.CapabilityAll},
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithDroppedCapabilitiesWithPrivileged(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("cannot create the container as privileged and with dropped capabilities at the same time - choose one of the options")
+}
+
 func (createTc *createCommandTest) mockExecCreateWithPrivileged(args []string) error {
 	container := initExpectedCtr(&types.Container{
 		Image: types.Image{
@@ -1004,6 +1547,95 @@ func (createTc *createCommandTest) mockExecCreateWithPrivileged(args []string) e
 	return nil
 }
 
+func (createTc *createCommandTest) mockExecCreateWithReadOnlyRootfs(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			ReadOnlyRootfs: true,
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithUsernsMode(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			UsernsMode: types.UsernsModeRemap,
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithUsernsModeWithPrivileged(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("cannot create the container as privileged and with a remapped user namespace at the same time - choose one of the options")
+}
+
+func (createTc *createCommandTest) mockExecCreateWithNamespacesAndLimits(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			IpcMode: types.NamespaceModeContainerPrefix + "sidecar",
+			PidMode: types.NamespaceModeHost,
+			Ulimits: []types.Ulimit{{Name: "nofile", Soft: 1024, Hard: 65536}, {Name: "memlock", Soft: -1, Hard: -1}},
+			Sysctls: map[string]string{"net.ipv4.tcp_keepalive_time": "60"},
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithShmSize(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			ShmSize: "256M",
+			Sysctls: map[string]string{"kernel.shmmax": "68719476736"},
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithRuntimeHandler(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			RuntimeHandler: "runsc",
+		},
+	})
+
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateWithInvalidUlimit(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("incorrect soft limit in ulimit nofile=many")
+}
+
+func (createTc *createCommandTest) mockExecCreateWithNotNamespacedSysctl(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("sysctl vm.swappiness is not namespaced and cannot be set for a container")
+}
+
 func (createTc *createCommandTest) mockExecCreateContainerFile(_ []string) error {
 	byteValue, _ := os.ReadFile("../pkg/testutil/config/container/valid.json")
 	container := &types.Container{
@@ -1276,6 +1908,29 @@ func (createTc *createCommandTest) mockExecArgsDefault(args []string) error {
 	return nil
 }
 
+func (createTc *createCommandTest) mockExecCreateProcessConfig(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		Config: &types.ContainerConfiguration{
+			Cmd:        args[1:],
+			Entrypoint: []string{"/bin/app"},
+			WorkingDir: "/app",
+			User:       "app:1000",
+			Groups:     []string{"audio", "44"},
+			Labels:     map[string]string{"app": "test"},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateLabelsInvalid(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("Incorrect label configuration app")
+}
+
 func (createTc *createCommandTest) mockExecCreateName(args []string) error {
 	container := initExpectedCtr(&types.Container{
 		Image: types.Image{
@@ -1315,6 +1970,69 @@ func (createTc *createCommandTest) mockExecCreateLogDriverNone(args []string) er
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
 	return nil
 }
+func (createTc *createCommandTest) mockExecCreateLogDriverLocal(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			LogConfig: &types.LogConfiguration{
+				DriverConfig: &types.LogDriverConfiguration{
+					Type:     types.LogConfigDriverLocal,
+					MaxSize:  "100M",
+					MaxFiles: 3,
+				},
+				ModeConfig: &types.LogModeConfiguration{
+					Mode: types.LogModeBlocking,
+				},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateLogDriverSyslog(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			LogConfig: &types.LogConfiguration{
+				DriverConfig: &types.LogDriverConfiguration{
+					Type:     types.LogConfigDriverSyslog,
+					Address:  "tcp://192.168.1.10:514",
+					Facility: "local0",
+					Tag:      "app",
+				},
+				ModeConfig: &types.LogModeConfiguration{
+					Mode: types.LogModeBlocking,
+				},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateLogDriverJournald(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			LogConfig: &types.LogConfiguration{
+				DriverConfig: &types.LogDriverConfiguration{
+					Type: types.LogConfigDriverJournald,
+					Tag:  "app",
+				},
+				ModeConfig: &types.LogModeConfiguration{
+					Mode: types.LogModeBlocking,
+				},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
 func (createTc *createCommandTest) mockExecCreateLogFullyConfigured(args []string) error {
 	container := initExpectedCtr(&types.Container{
 		Image: types.Image{
@@ -1327,6 +2045,8 @@ func (createTc *createCommandTest) mockExecCreateLogFullyConfigured(args []strin
 					MaxSize:  "10M",
 					MaxFiles: 5,
 					RootDir:  "/",
+					Compress: types.LogCompressionZstd,
+					MaxAge:   "72h",
 				},
 				ModeConfig: &types.LogModeConfiguration{
 					Mode:          types.LogModeNonBlocking,
@@ -1372,9 +2092,140 @@ func (createTc *createCommandTest) mockExecCreateNetworkModeHost(args []string)
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
 	return nil
 }
+func (createTc *createCommandTest) mockExecCreateNetworkUserDefined(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: "frontend",
+			Networks:    []string{"backend", "monitoring"},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateNetworkAddress(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: "frontend",
+			EndpointsConfig: map[string]*types.EndpointConfig{
+				"frontend": {IPv4Address: "172.20.0.10", MacAddress: "02:42:ac:14:00:0a"},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateDNS(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeBridge,
+			DNS:         []string{"8.8.8.8", "2001:4860:4860::8888"},
+			DNSSearch:   []string{"example.com"},
+			DNSOptions:  []string{"ndots:2"},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateDNSInvalidServer(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("invalid DNS server dns.example.com, must be an IPv4 or IPv6 address")
+}
+func (createTc *createCommandTest) mockExecCreateNetworkPolicy(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeBridge,
+			NetworkPolicy: &types.NetworkPolicy{
+				IngressRate:   1024 * 1024,
+				EgressRate:    65536,
+				EgressDefault: types.EgressActionDeny,
+				EgressRules:   []types.EgressRule{{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "8000-8080"}},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateNetworkPolicyInvalidRate(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("invalid format of net-ingress-rate - 1X")
+}
+func (createTc *createCommandTest) mockExecCreateNetworkPolicyInvalidDefault(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("unsupported default egress action reject")
+}
+func (createTc *createCommandTest) mockExecCreateSecurityOpts(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeBridge,
+			SecurityOpts: &types.SecurityOptions{
+				Seccomp:         "/etc/kanto/seccomp/vendor.json",
+				AppArmorProfile: "kanto-vendor",
+				SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+				NoNewPrivileges: true,
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateSecurityOptsPrivilegedSeccomp(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("cannot create the container as privileged and with a seccomp profile at the same time - choose one of the options")
+}
+func (createTc *createCommandTest) mockExecCreateNetworkAddressInvalid(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("unsupported key gateway in endpoint configuration bridge,gateway=172.17.0.1")
+}
+func (createTc *createCommandTest) mockExecCreateNetworkAddHost(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("cannot connect to the host network as an additional network")
+}
 func (createTc *createCommandTest) mockExecCreateNetworkModeInvalid(args []string) error {
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
-	return log.NewErrorf("unsupported network mode custom")
+	return log.NewErrorf("unsupported network mode -custom")
+}
+func (createTc *createCommandTest) mockExecCreateNetworkModeNone(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeNone,
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateNetworkModeContainer(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode: "container:web",
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateNetworkModeContainerNoTarget(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("the name or ID of the container must be provided for network mode container:<name|id>")
 }
 func (createTc *createCommandTest) mockExecCreateNetworkModeHostReservedKeyUsed(args []string) error {
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
@@ -1396,6 +2247,39 @@ func (createTc *createCommandTest) mockExecCreateMemoryFullyConfigured(args []st
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
 	return nil
 }
+func (createTc *createCommandTest) mockExecCreateCPUPidsBlkioConfigured(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			Resources: &types.Resources{
+				CPUQuota:             50000,
+				CPUPeriod:            100000,
+				CPUShares:            512,
+				CPUSetCPUs:           "0-1",
+				PidsLimit:            100,
+				BlkioWeight:          300,
+				BlkioDeviceReadBps:   []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}},
+				BlkioDeviceWriteIOps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1000}},
+			},
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+func (createTc *createCommandTest) mockExecCreateCPUQuotaInvalid(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("invalid format of cpu-quota - 1X")
+}
+func (createTc *createCommandTest) mockExecCreateCPUSharesOutOfRange(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("invalid CPU shares - 1, must be in the range of 2 to 262144")
+}
+func (createTc *createCommandTest) mockExecCreateDeviceReadBpsInvalid(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("invalid format of block IO device limit - /dev/sda, expected <path>:<rate>")
+}
 func (createTc *createCommandTest) mockExecCreateImageDecryptionConfigured(args []string) error {
 	container := initExpectedCtr(&types.Container{
 		Image: types.Image{
@@ -1409,3 +2293,72 @@ func (createTc *createCommandTest) mockExecCreateImageDecryptionConfigured(args
 	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
 	return nil
 }
+
+func (createTc *createCommandTest) mockExecCreateHealthCheckCmd(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HealthCheck: &types.HealthCheckConfig{
+			Type:        types.HealthCheckExec,
+			Cmd:         []string{"/bin/sh", "-c", "test -f /tmp/healthy"},
+			Interval:    10 * time.Second,
+			Timeout:     5 * time.Second,
+			Retries:     2,
+			StartPeriod: 15 * time.Second,
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateHealthCheckHTTP(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HealthCheck: &types.HealthCheckConfig{
+			Type:     types.HealthCheckHTTP,
+			Port:     8080,
+			Path:     "/health",
+			Interval: 30 * time.Second,
+			Timeout:  30 * time.Second,
+			Retries:  3,
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateHealthCheckTCPRestartOnUnhealthy(args []string) error {
+	container := initExpectedCtr(&types.Container{
+		Image: types.Image{
+			Name: args[0],
+		},
+		HostConfig: &types.HostConfig{
+			RestartPolicy: &types.RestartPolicy{
+				Type:               types.Always,
+				RestartOnUnhealthy: true,
+			},
+		},
+		HealthCheck: &types.HealthCheckConfig{
+			Type:     types.HealthCheckTCP,
+			Port:     8080,
+			Interval: 30 * time.Second,
+			Timeout:  30 * time.Second,
+			Retries:  3,
+		},
+	})
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(container)).Times(1).Return(container, nil)
+	return nil
+}
+
+func (createTc *createCommandTest) mockExecCreateHealthCheckMultipleProbes(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("only one of --health-cmd, --health-http-port and --health-tcp-port can be set")
+}
+
+func (createTc *createCommandTest) mockExecCreateRestartOnUnhealthyNoPolicy(args []string) error {
+	createTc.mockClient.EXPECT().Create(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Times(0)
+	return log.NewError("the restart policy must be set via --rp to restart unhealthy containers")
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	eventsFormatTable = "table"
+	eventsFormatJSON  = "json"
+)
+
+var eventsSupportedActions = []types.EventAction{
+	types.EventActionContainersCreated,
+	types.EventActionContainersRunning,
+	types.EventActionContainersPaused,
+	types.EventActionContainersResumed,
+	types.EventActionContainersStopped,
+	types.EventActionContainersExited,
+	types.EventActionContainersRemoved,
+	types.EventActionContainersRenamed,
+	types.EventActionContainersUpdated,
+	types.EventActionContainersHealthChanged,
+}
+
+type eventsCmd struct {
+	baseCommand
+	config eventsConfig
+}
+
+type eventsConfig struct {
+	ids     []string
+	names   []string
+	actions []string
+	since   string
+	until   string
+	format  string
+}
+
+func (cc *eventsCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "events",
+		Short: "Stream the container events.",
+		Long:  "Stream the container events as they occur, optionally filtered by container ID, container name, action and time range.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " events\n events --name <container-name> --action running,stopped\n events --until 10m --format json",
+	}
+	cc.setupFlags()
+}
+
+func (cc *eventsCmd) run(args []string) error {
+	filter, err := cc.eventsFilter()
+	if err != nil {
+		return err
+	}
+	var handler func(event *types.Event) error
+	switch cc.config.format {
+	case eventsFormatTable:
+		w := new(tabwriter.Writer)
+		w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+		fmt.Fprintln(w, "")
+		fmt.Fprintf(w, eventsTableRowTemplate, "Time", "Action", "ID", "Name", "Status")
+		fmt.Fprintf(w, eventsTableRowTemplate, "-------------------------", "--------------", "-------------------------------------", "-------------------------------------", "----------")
+		w.Flush()
+		handler = func(event *types.Event) error {
+			prettyPrintEvent(w, event)
+			return w.Flush()
+		}
+	case eventsFormatJSON:
+		encoder := json.NewEncoder(os.Stdout)
+		handler = func(event *types.Event) error {
+			return encoder.Encode(event)
+		}
+	default:
+		return log.NewErrorf("unsupported format %s - use one of %s or %s", cc.config.format, eventsFormatTable, eventsFormatJSON)
+	}
+	return cc.cli.gwManClient.Events(context.Background(), filter, handler)
+}
+
+func (cc *eventsCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringSliceVar(&cc.config.ids, "id", nil, "Stream only the events of the containers with the provided IDs.")
+	flagSet.StringSliceVarP(&cc.config.names, "name", "n", nil, "Stream only the events of the containers with the provided names.")
+	flagSet.StringSliceVar(&cc.config.actions, "action", nil, "Stream only the events with the provided actions. Supported actions are "+joinEventActions(eventsSupportedActions)+".")
+	flagSet.StringVar(&cc.config.since, "since", "", "Stream only the events that occurred at or after the provided time. The time can be a RFC 3339 timestamp, Unix time in seconds or a duration before the current time, e.g. 10m. The past events are streamed as long as they are among the most recent ones kept by the daemon.")
+	flagSet.StringVar(&cc.config.until, "until", "", "Stream the events until the provided time is reached. The time can be a RFC 3339 timestamp, Unix time in seconds or a duration after the current time, e.g. 10m.")
+	flagSet.StringVar(&cc.config.format, "format", eventsFormatTable, "Output format of the events - "+eventsFormatTable+" or "+eventsFormatJSON+". The "+eventsFormatJSON+" format prints each event as a JSON object on a separate line.")
+}
+
+func (cc *eventsCmd) eventsFilter() (*types.EventsFilter, error) {
+	var err error
+	filter := &types.EventsFilter{
+		IDs:   cc.config.ids,
+		Names: cc.config.names,
+	}
+	for _, action := range cc.config.actions {
+		eventAction := types.EventAction(action)
+		if !isSupportedEventAction(eventAction) {
+			return nil, log.NewErrorf("unsupported event action %s - use one of %s", action, joinEventActions(eventsSupportedActions))
+		}
+		filter.Actions = append(filter.Actions, eventAction)
+	}
+	if filter.Since, err = parseEventsTime(cc.config.since, -1); err != nil {
+		return nil, err
+	}
+	if filter.Until, err = parseEventsTime(cc.config.until, 1); err != nil {
+		return nil, err
+	}
+	if filter.Since > 0 && filter.Until > 0 && filter.Since > filter.Until {
+		return nil, log.NewError("the since time must not be after the until time")
+	}
+	return filter, nil
+}
+
+// parseEventsTime parses a RFC 3339 timestamp, Unix time in seconds or a duration relative to the current time
+// in the provided direction to Unix time in seconds. An empty value is parsed to 0.
+func parseEventsTime(value string, direction time.Duration) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Unix(), nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return seconds, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return time.Now().Add(direction * d).Unix(), nil
+	}
+	return 0, log.NewErrorf("invalid time %s - use a RFC 3339 timestamp, Unix time in seconds or a duration", value)
+}
+
+func isSupportedEventAction(action types.EventAction) bool {
+	for _, supported := range eventsSupportedActions {
+		if supported == action {
+			return true
+		}
+	}
+	return false
+}
+
+func joinEventActions(actions []types.EventAction) string {
+	values := make([]string, len(actions))
+	for i, action := range actions {
+		values[i] = string(action)
+	}
+	return strings.Join(values, ", ")
+}
+
+const eventsTableRowTemplate = "%-25s\t%-14s\t%-37s\t%-37s\t%-10s\t\n"
+
+func prettyPrintEvent(w *tabwriter.Writer, event *types.Event) {
+	status := ""
+	if event.Source.State != nil {
+		status = event.Source.State.Status.String()
+	}
+	fmt.Fprintf(w, eventsTableRowTemplate, time.Unix(event.Time, 0).Format(time.RFC3339), event.Action, event.Source.ID, event.Source.Name, status)
+}
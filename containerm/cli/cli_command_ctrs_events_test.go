@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/client"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	eventsCmdFlagID     = "id"
+	eventsCmdFlagName   = "name"
+	eventsCmdFlagAction = "action"
+	eventsCmdFlagSince  = "since"
+	eventsCmdFlagUntil  = "until"
+	eventsCmdFlagFormat = "format"
+
+	// test input constants
+	eventsContainerID   = "test-ctr"
+	eventsContainerName = "test-ctr-name"
+	eventsSince         = "2026-01-02T15:04:05Z"
+	eventsSinceUnix     = int64(1767366245)
+	eventsUntilUnix     = int64(1767369845)
+)
+
+var (
+	eventsTestEvent = &types.Event{
+		Type:   types.EventTypeContainers,
+		Action: types.EventActionContainersRunning,
+		Source: types.Container{ID: eventsContainerID, Name: eventsContainerName, State: &types.State{Running: true, Status: types.Running}},
+		Time:   eventsSinceUnix,
+	}
+)
+
+// Tests ------------------------------
+func TestEventsCmdInit(t *testing.T) {
+	eventsCliTest := &eventsCommandTest{}
+	eventsCliTest.init()
+
+	execTestInit(t, eventsCliTest)
+}
+
+func TestEventsCmdFlags(t *testing.T) {
+	eventsCliTest := &eventsCommandTest{}
+	eventsCliTest.init()
+
+	expectedCfg := eventsConfig{
+		ids:     []string{eventsContainerID},
+		names:   []string{eventsContainerName},
+		actions: []string{"running", "stopped"},
+		since:   eventsSince,
+		until:   "10m",
+		format:  eventsFormatJSON,
+	}
+
+	flagsToApply := map[string]string{
+		eventsCmdFlagID:     eventsContainerID,
+		eventsCmdFlagName:   eventsContainerName,
+		eventsCmdFlagAction: "running,stopped",
+		eventsCmdFlagSince:  eventsSince,
+		eventsCmdFlagUntil:  "10m",
+		eventsCmdFlagFormat: eventsFormatJSON,
+	}
+
+	execTestSetupFlags(t, eventsCliTest, flagsToApply, expectedCfg)
+}
+
+func TestEventsCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	eventsCliTest := &eventsCommandTest{}
+	eventsCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, eventsCliTest)
+}
+
+func TestParseEventsTime(t *testing.T) {
+	tests := map[string]struct {
+		value       string
+		direction   time.Duration
+		expected    int64
+		expectedErr error
+	}{
+		"test_empty": {},
+		"test_rfc3339": {
+			value:    eventsSince,
+			expected: eventsSinceUnix,
+		},
+		"test_unix_seconds": {
+			value:    "1767369845",
+			expected: eventsUntilUnix,
+		},
+		"test_invalid": {
+			value:       "yesterday",
+			expectedErr: log.NewErrorf("invalid time %s - use a RFC 3339 timestamp, Unix time in seconds or a duration", "yesterday"),
+		},
+		"test_negative_duration": {
+			value:       "-10m",
+			expectedErr: log.NewErrorf("invalid time %s - use a RFC 3339 timestamp, Unix time in seconds or a duration", "-10m"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			actual, err := parseEventsTime(testCase.value, testCase.direction)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expected, actual)
+		})
+	}
+
+	t.Run("test_duration", func(t *testing.T) {
+		before := time.Now().Add(-time.Hour).Unix()
+		actual, err := parseEventsTime("1h", -1)
+		testutil.AssertNil(t, err)
+		testutil.AssertTrue(t, actual >= before && actual <= time.Now().Add(-time.Hour).Unix())
+
+		after := time.Now().Add(time.Hour).Unix()
+		actual, err = parseEventsTime("1h", 1)
+		testutil.AssertNil(t, err)
+		testutil.AssertTrue(t, actual >= after && actual <= time.Now().Add(time.Hour).Unix())
+	})
+}
+
+// EOF Tests --------------------------
+
+type eventsCommandTest struct {
+	cliCommandTestBase
+	eventsCmd *eventsCmd
+}
+
+func (eventsTc *eventsCommandTest) commandConfig() interface{} {
+	return eventsTc.eventsCmd.config
+}
+
+func (eventsTc *eventsCommandTest) commandConfigDefault() interface{} {
+	return eventsConfig{
+		format: eventsFormatTable,
+	}
+}
+
+func (eventsTc *eventsCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &eventsCmd{}
+	eventsTc.eventsCmd, eventsTc.baseCmd = cmd, cmd
+
+	eventsTc.eventsCmd.init(eventsTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, eventsTc.eventsCmd.cmd)
+}
+
+func (eventsTc *eventsCommandTest) runCommand(args []string) error {
+	return eventsTc.eventsCmd.run(args)
+}
+
+func (eventsTc *eventsCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_events_table": {
+			mockExecution: eventsTc.mockExecEvents(&types.EventsFilter{}),
+		},
+		"test_events_json": {
+			flags: map[string]string{
+				eventsCmdFlagFormat: eventsFormatJSON,
+			},
+			mockExecution: eventsTc.mockExecEvents(&types.EventsFilter{}),
+		},
+		"test_events_filtered": {
+			flags: map[string]string{
+				eventsCmdFlagID:     eventsContainerID,
+				eventsCmdFlagName:   eventsContainerName,
+				eventsCmdFlagAction: "running,health_changed",
+				eventsCmdFlagSince:  eventsSince,
+				eventsCmdFlagUntil:  "1767369845",
+			},
+			mockExecution: eventsTc.mockExecEvents(&types.EventsFilter{
+				IDs:     []string{eventsContainerID},
+				Names:   []string{eventsContainerName},
+				Actions: []types.EventAction{types.EventActionContainersRunning, types.EventActionContainersHealthChanged},
+				Since:   eventsSinceUnix,
+				Until:   eventsUntilUnix,
+			}),
+		},
+		"test_events_unsupported_action": {
+			flags: map[string]string{
+				eventsCmdFlagAction: "unknown",
+			},
+			mockExecution: eventsTc.mockExecEventsInvalidInput(log.NewErrorf("unsupported event action %s - use one of %s", "unknown", joinEventActions(eventsSupportedActions))),
+		},
+		"test_events_invalid_since": {
+			flags: map[string]string{
+				eventsCmdFlagSince: "yesterday",
+			},
+			mockExecution: eventsTc.mockExecEventsInvalidInput(log.NewErrorf("invalid time %s - use a RFC 3339 timestamp, Unix time in seconds or a duration", "yesterday")),
+		},
+		"test_events_since_after_until": {
+			flags: map[string]string{
+				eventsCmdFlagSince: "1767369845",
+				eventsCmdFlagUntil: eventsSince,
+			},
+			mockExecution: eventsTc.mockExecEventsInvalidInput(log.NewError("the since time must not be after the until time")),
+		},
+		"test_events_unsupported_format": {
+			flags: map[string]string{
+				eventsCmdFlagFormat: "yaml",
+			},
+			mockExecution: eventsTc.mockExecEventsInvalidInput(log.NewErrorf("unsupported format %s - use one of %s or %s", "yaml", eventsFormatTable, eventsFormatJSON)),
+		},
+		"test_events_error": {
+			mockExecution: eventsTc.mockExecEventsErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (eventsTc *eventsCommandTest) mockExecEvents(expectedFilter *types.EventsFilter) mockExecution {
+	return func(args []string) error {
+		eventsTc.mockClient.EXPECT().Events(context.Background(), gomock.Eq(expectedFilter), gomock.Any()).Times(1).DoAndReturn(
+			func(ctx context.Context, filter *types.EventsFilter, handler client.EventsHandler) error {
+				return handler(eventsTestEvent)
+			})
+		return nil
+	}
+}
+
+func (eventsTc *eventsCommandTest) mockExecEventsInvalidInput(err error) mockExecution {
+	return func(args []string) error {
+		eventsTc.mockClient.EXPECT().Events(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		return err
+	}
+}
+
+func (eventsTc *eventsCommandTest) mockExecEventsErr(args []string) error {
+	err := log.NewError("failed to stream events")
+	eventsTc.mockClient.EXPECT().Events(context.Background(), gomock.Any(), gomock.Any()).Times(1).Return(err)
+	return err
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	utilcli "github.com/eclipse-kanto/container-management/containerm/util/cli"
+	"github.com/spf13/cobra"
+)
+
+type execCmd struct {
+	baseCommand
+	config  execConfig
+	termMgr terminalManager
+}
+
+type execConfig struct {
+	name        string
+	interactive bool
+	terminal    bool
+	env         []string
+	workDir     string
+}
+
+func (cc *execCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.termMgr = &termMgr{}
+	cc.cmd = &cobra.Command{
+		Use:   "exec <container-id> <command> [<arg>...]",
+		Short: "Execute a command inside a running container.",
+		Long:  "Execute a command inside a running container.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: "exec <container-id> ls -la\n exec --name <container-name> ls -la\n exec --i --t -n <container-name> sh",
+	}
+	// all arguments following the container ID are part of the command to be executed
+	cc.cmd.Flags().SetInterspersed(false)
+	cc.setupFlags()
+}
+
+func (cc *execCmd) run(args []string) error {
+	var (
+		container *types.Container
+		err       error
+		ctx       = context.Background()
+		ctrArgs   []string
+		command   = args
+	)
+	if cc.config.name == "" {
+		ctrArgs, command = args[:1], args[1:]
+	}
+	if len(command) == 0 {
+		return log.NewError("the command to be executed must be provided")
+	}
+	if container, err = utilcli.ValidateContainerByNameArgsSingle(ctx, ctrArgs, cc.config.name, cc.cli.gwManClient); err != nil {
+		return err
+	}
+
+	if err = cc.termMgr.CheckTty(cc.config.interactive, cc.config.terminal, os.Stdin.Fd()); err != nil {
+		return err
+	}
+	if cc.config.terminal {
+		in, out, err := cc.termMgr.SetRawMode(cc.config.interactive, false)
+		if err != nil {
+			return log.NewError("failed to set raw mode")
+		}
+		defer func() {
+			if err := cc.termMgr.RestoreMode(in, out); err != nil {
+				log.ErrorErr(err, "failed to restore term mode")
+			}
+		}()
+	}
+
+	var stdin io.Reader
+	if cc.config.interactive {
+		stdin = os.Stdin
+	}
+	exitCode, err := cc.cli.gwManClient.Exec(ctx, container.ID, &types.ExecConfig{
+		Cmd:        command,
+		Env:        cc.config.env,
+		WorkingDir: cc.config.workDir,
+		Tty:        cc.config.terminal,
+		OpenStdin:  cc.config.interactive,
+	}, stdin, os.Stdout)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return log.NewErrorf("the process exited with code %d", exitCode)
+	}
+	return nil
+}
+
+func (cc *execCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	// init name flags
+	flagSet.StringVarP(&cc.config.name, "name", "n", "", "Execute the command inside a container with a specific name. If set, all arguments are treated as the command to be executed.")
+	// init interactive flags
+	flagSet.BoolVar(&cc.config.interactive, "i", false, "Keep the STDIN of the executed process open and attach to it")
+	// init terminal flags
+	flagSet.BoolVar(&cc.config.terminal, "t", false, "Allocate a terminal for the executed process")
+	// init env flags
+	flagSet.StringArrayVar(&cc.config.env, "e", nil, "Sets additional environment variables in the executed process' environment. Example:\n"+
+		"--e=VAR1=2 --e=VAR2=\"a bc\"")
+	// init working dir flags
+	flagSet.StringVarP(&cc.config.workDir, "workdir", "w", "", "Sets the working directory of the executed process inside the container")
+}
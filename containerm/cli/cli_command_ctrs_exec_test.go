@@ -0,0 +1,257 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/client"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	mockscli "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/cli"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	execCmdFlagName        = "name"
+	execCmdFlagInteractive = "i"
+	execCmdFlagTerminal    = "t"
+	execCmdFlagEnv         = "e"
+	execCmdFlagWorkDir     = "workdir"
+
+	// test input constants
+	execContainerID   = "test-ctr"
+	execContainerName = "test-ctr-name"
+)
+
+var (
+	// command args ---------------
+	execCmdArgs     = []string{execContainerID, "ls", "-la"}
+	execCmdArgsName = []string{"ls", "-la"}
+)
+
+// Tests ------------------------------
+func TestExecCmdInit(t *testing.T) {
+	execCommandTest := &execCommandTest{}
+	execCommandTest.init()
+
+	execTestInit(t, execCommandTest)
+}
+
+func TestExecCmdFlags(t *testing.T) {
+	execCommandTest := &execCommandTest{}
+	execCommandTest.init()
+
+	expectedCfg := execConfig{
+		name:        execContainerName,
+		interactive: true,
+		terminal:    true,
+		env:         []string{"VAR1=2"},
+		workDir:     "/tmp",
+	}
+
+	flagsToApply := map[string]string{
+		execCmdFlagName:        expectedCfg.name,
+		execCmdFlagInteractive: "true",
+		execCmdFlagTerminal:    "true",
+		execCmdFlagEnv:         expectedCfg.env[0],
+		execCmdFlagWorkDir:     expectedCfg.workDir,
+	}
+
+	execTestSetupFlags(t, execCommandTest, flagsToApply, expectedCfg)
+}
+
+func TestExecCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	execCommandTest := &execCommandTest{}
+	execCommandTest.initWithCtrl(controller)
+
+	execTestsRun(t, execCommandTest)
+}
+
+type execCommandTest struct {
+	cliCommandTestBase
+	execCmd *execCmd
+}
+
+func (e *execCommandTest) commandConfig() interface{} {
+	return e.execCmd.config
+}
+
+func (e *execCommandTest) commandConfigDefault() interface{} {
+	return execConfig{}
+}
+
+func (e *execCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &execCmd{}
+	e.execCmd, e.baseCmd = cmd, cmd
+
+	e.execCmd.init(e.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, e.execCmd.cmd)
+}
+
+func (e *execCommandTest) runCommand(args []string) error {
+	return e.execCmd.run(args)
+}
+
+func (e *execCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_exec_by_id": {
+			args:          execCmdArgs,
+			mockExecution: e.mockExecExecByID,
+		},
+		"test_exec_by_id_with_config": {
+			args: execCmdArgs,
+			flags: map[string]string{
+				execCmdFlagEnv:     "VAR1=2",
+				execCmdFlagWorkDir: "/tmp",
+			},
+			mockExecution: e.mockExecExecByIDWithConfig,
+		},
+		"test_exec_by_name": {
+			args: execCmdArgsName,
+			flags: map[string]string{
+				execCmdFlagName: execContainerName,
+			},
+			mockExecution: e.mockExecExecByName,
+		},
+		"test_exec_by_name_zero_ctrs": {
+			args: execCmdArgsName,
+			flags: map[string]string{
+				execCmdFlagName: execContainerName,
+			},
+			mockExecution: e.mockExecExecByNameZeroCtrs,
+		},
+		"test_exec_no_command": {
+			args:          []string{execContainerID},
+			mockExecution: e.mockExecExecNoCommand,
+		},
+		"test_exec_get_err": {
+			args:          execCmdArgs,
+			mockExecution: e.mockExecExecGetErr,
+		},
+		"test_exec_err": {
+			args:          execCmdArgs,
+			mockExecution: e.mockExecExecErr,
+		},
+		"test_exec_non_zero_exit_code": {
+			args:          execCmdArgs,
+			mockExecution: e.mockExecExecNonZeroExitCode,
+		},
+		"test_exec_terminal": {
+			args: execCmdArgs,
+			flags: map[string]string{
+				execCmdFlagInteractive: "true",
+				execCmdFlagTerminal:    "true",
+			},
+			mockExecution: e.mockExecExecTerminal,
+		},
+		"test_exec_terminal_not_tty": {
+			args: execCmdArgs,
+			flags: map[string]string{
+				execCmdFlagInteractive: "true",
+				execCmdFlagTerminal:    "true",
+			},
+			mockExecution: e.mockExecExecTerminalNotTty,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (e *execCommandTest) mockExecExecByID(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	e.mockClient.EXPECT().Exec(context.Background(), args[0], &types.ExecConfig{Cmd: args[1:]}, nil, os.Stdout).Times(1).Return(int64(0), nil)
+	return nil
+}
+
+func (e *execCommandTest) mockExecExecByIDWithConfig(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	e.mockClient.EXPECT().Exec(context.Background(), args[0], &types.ExecConfig{Cmd: args[1:], Env: []string{"VAR1=2"}, WorkingDir: "/tmp"}, nil, os.Stdout).Times(1).Return(int64(0), nil)
+	return nil
+}
+
+func (e *execCommandTest) mockExecExecByName(args []string) error {
+	res := []*types.Container{{ID: execContainerID, Name: execContainerName}}
+	e.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(execContainerName))).Times(1).Return(res, nil)
+	e.mockClient.EXPECT().Exec(context.Background(), execContainerID, &types.ExecConfig{Cmd: args}, nil, os.Stdout).Times(1).Return(int64(0), nil)
+	return nil
+}
+
+func (e *execCommandTest) mockExecExecByNameZeroCtrs(args []string) error {
+	e.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(execContainerName))).Times(1).Return([]*types.Container{}, nil)
+	e.mockClient.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("The requested container with name = %s was not found. Try using an ID instead.", execContainerName)
+}
+
+func (e *execCommandTest) mockExecExecNoCommand(args []string) error {
+	e.mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("the command to be executed must be provided")
+}
+
+func (e *execCommandTest) mockExecExecGetErr(args []string) error {
+	err := errors.New("error getting container")
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(nil, err)
+	e.mockClient.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return err
+}
+
+func (e *execCommandTest) mockExecExecErr(args []string) error {
+	err := errors.New("error executing command")
+	ctr := &types.Container{ID: args[0]}
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	e.mockClient.EXPECT().Exec(context.Background(), args[0], gomock.Any(), nil, os.Stdout).Times(1).Return(int64(-1), err)
+	return err
+}
+
+func (e *execCommandTest) mockExecExecNonZeroExitCode(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	e.mockClient.EXPECT().Exec(context.Background(), args[0], gomock.Any(), nil, os.Stdout).Times(1).Return(int64(127), nil)
+	return log.NewError("the process exited with code 127")
+}
+
+func (e *execCommandTest) mockExecExecTerminal(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	tMgr := mockscli.NewMockterminalManager(e.gomockCtrl)
+	e.execCmd.termMgr = tMgr
+
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	tMgr.EXPECT().CheckTty(true, true, gomock.Any()).Times(1).Return(nil)
+	tMgr.EXPECT().SetRawMode(true, false).Times(1).Return(nil, nil, nil)
+	tMgr.EXPECT().RestoreMode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	e.mockClient.EXPECT().Exec(context.Background(), args[0], &types.ExecConfig{Cmd: args[1:], Tty: true, OpenStdin: true}, os.Stdin, os.Stdout).Times(1).Return(int64(0), nil)
+	return nil
+}
+
+func (e *execCommandTest) mockExecExecTerminalNotTty(args []string) error {
+	err := log.NewError("the input device is not a TTY")
+	ctr := &types.Container{ID: args[0]}
+	tMgr := mockscli.NewMockterminalManager(e.gomockCtrl)
+	e.execCmd.termMgr = tMgr
+
+	e.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	tMgr.EXPECT().CheckTty(true, true, gomock.Any()).Times(1).Return(err)
+	tMgr.EXPECT().SetRawMode(gomock.Any(), gomock.Any()).Times(0)
+	e.mockClient.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return err
+}
@@ -144,7 +144,11 @@ func prettyPrint(ctrs []*types.Container) {
 	fmt.Fprintf(w, tableRowTemplate, "ID", "Name", "Image", "Status", "Finished At", "Exit Code")
 	fmt.Fprintf(w, tableRowTemplate, "-------------------------------------", "-------------------------------------", "------------------------------------------------------------", "----------", "------------------------------", "----------")
 	for _, ctr := range ctrs {
-		fmt.Fprintf(w, tableRowTemplate, ctr.ID, ctr.Name, ctr.Image.Name, ctr.State.Status.String(), ctr.State.FinishedAt, strconv.FormatInt(ctr.State.ExitCode, 10))
+		status := ctr.State.Status.String()
+		if ctr.State.Health != nil {
+			status = fmt.Sprintf("%s (%s)", status, ctr.State.Health.Status)
+		}
+		fmt.Fprintf(w, tableRowTemplate, ctr.ID, ctr.Name, ctr.Image.Name, status, ctr.State.FinishedAt, strconv.FormatInt(ctr.State.ExitCode, 10))
 	}
 	fmt.Fprintln(w, "")
 }
@@ -14,8 +14,10 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
 	utilcli "github.com/eclipse-kanto/container-management/containerm/util/cli"
 
 	"github.com/spf13/cobra"
@@ -27,8 +29,14 @@ type logsCmd struct {
 }
 
 type logsConfig struct {
-	name string
-	tail int32
+	name       string
+	tail       int32
+	follow     bool
+	since      string
+	until      string
+	stdout     bool
+	stderr     bool
+	timestamps bool
 }
 
 func (cc *logsCmd) init(cli *cli) {
@@ -41,7 +49,7 @@ func (cc *logsCmd) init(cli *cli) {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cc.run(args)
 		},
-		Example: "logs <container-id>\nlogs --name <container-name>\nlogs -n <container-name>",
+		Example: "logs <container-id>\nlogs --name <container-name>\nlogs -n <container-name>\nlogs -f --since 10m --stderr <container-id>",
 	}
 	cc.setupFlags()
 }
@@ -58,7 +66,12 @@ func (cc *logsCmd) run(args []string) error {
 		return err
 	}
 
-	err = cc.cli.gwManClient.Logs(ctx, ctr.ID, cc.config.tail)
+	opts, err := cc.logsOpts()
+	if err != nil {
+		return err
+	}
+
+	err = cc.cli.gwManClient.Logs(ctx, ctr.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -66,9 +79,52 @@ func (cc *logsCmd) run(args []string) error {
 	return nil
 }
 
+func (cc *logsCmd) logsOpts() (*types.LogsOpts, error) {
+	opts := &types.LogsOpts{
+		Tail:       cc.config.tail,
+		Follow:     cc.config.follow,
+		Stdout:     cc.config.stdout,
+		Stderr:     cc.config.stderr,
+		Timestamps: cc.config.timestamps,
+	}
+	var err error
+	if opts.Since, err = parseLogsTime(cc.config.since); err != nil {
+		return nil, err
+	}
+	if opts.Until, err = parseLogsTime(cc.config.until); err != nil {
+		return nil, err
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Since.After(opts.Until) {
+		return nil, log.NewError("the since time must not be after the until time")
+	}
+	return opts, nil
+}
+
+// parseLogsTime parses a RFC 3339 timestamp, Unix time in seconds or a duration before the current time.
+// An empty value is parsed to the zero time.
+func parseLogsTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	seconds, err := parseEventsTime(value, -1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, 0), nil
+}
+
 func (cc *logsCmd) setupFlags() {
 	flagSet := cc.cmd.Flags()
 	// init name flags
 	flagSet.StringVarP(&cc.config.name, "name", "n", "", "Print the logs of a container with a specific name.")
 	flagSet.Int32VarP(&cc.config.tail, "tail", "t", 100, "Lines of recent log file to display. Setting it to -1 will return all logs.")
+	flagSet.BoolVarP(&cc.config.follow, "follow", "f", false, "Keep streaming the new log output until the container exits.")
+	flagSet.StringVar(&cc.config.since, "since", "", "Print only the logs written at or after the provided time. The time can be a RFC 3339 timestamp, Unix time in seconds or a duration before the current time, e.g. 10m.")
+	flagSet.StringVar(&cc.config.until, "until", "", "Print only the logs written at or before the provided time. The time can be a RFC 3339 timestamp, Unix time in seconds or a duration before the current time, e.g. 10m.")
+	flagSet.BoolVar(&cc.config.stdout, "stdout", false, "Print only the logs from the container's STDOUT. If neither --stdout nor --stderr is set, both streams are printed.")
+	flagSet.BoolVar(&cc.config.stderr, "stderr", false, "Print only the logs from the container's STDERR. If neither --stdout nor --stderr is set, both streams are printed.")
+	flagSet.BoolVar(&cc.config.timestamps, "timestamps", false, "Print the log messages prefixed with the time they were written instead of the raw log entries.")
 }
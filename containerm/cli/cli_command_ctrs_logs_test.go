@@ -16,6 +16,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/client"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
@@ -25,13 +26,21 @@ import (
 
 const (
 	// command flags
-	logsCmdFlagName = "name"
-	logsCmdFlagTail = "tail"
+	logsCmdFlagName       = "name"
+	logsCmdFlagTail       = "tail"
+	logsCmdFlagFollow     = "follow"
+	logsCmdFlagSince      = "since"
+	logsCmdFlagUntil      = "until"
+	logsCmdFlagStdout     = "stdout"
+	logsCmdFlagStderr     = "stderr"
+	logsCmdFlagTimestamps = "timestamps"
 
 	// test input constants
 	logsContainerID   = "test-ctr"
 	logsContainerName = "test-ctr-name"
 	logsTailStr       = "42"
+	logsSinceStr      = "2023-05-10T10:00:00Z"
+	logsUntilStr      = "2023-05-10T11:30:00.5Z"
 )
 
 var (
@@ -52,13 +61,25 @@ func TestLogsCmdFlags(t *testing.T) {
 	logsCommandTest.init()
 
 	expectedCfg := logsConfig{
-		name: logsContainerName,
-		tail: 42,
+		name:       logsContainerName,
+		tail:       42,
+		follow:     true,
+		since:      logsSinceStr,
+		until:      logsUntilStr,
+		stdout:     true,
+		stderr:     true,
+		timestamps: true,
 	}
 
 	flagsToApply := map[string]string{
-		logsCmdFlagName: expectedCfg.name,
-		logsCmdFlagTail: fmt.Sprintf("%d", 42),
+		logsCmdFlagName:       expectedCfg.name,
+		logsCmdFlagTail:       fmt.Sprintf("%d", 42),
+		logsCmdFlagFollow:     "true",
+		logsCmdFlagSince:      expectedCfg.since,
+		logsCmdFlagUntil:      expectedCfg.until,
+		logsCmdFlagStdout:     "true",
+		logsCmdFlagStderr:     "true",
+		logsCmdFlagTimestamps: "true",
 	}
 
 	execTestSetupFlags(t, logsCommandTest, flagsToApply, expectedCfg)
@@ -85,8 +106,14 @@ func (l *logsCommandTest) commandConfig() interface{} {
 
 func (l *logsCommandTest) commandConfigDefault() interface{} {
 	return logsConfig{
-		name: "",
-		tail: 100,
+		name:       "",
+		tail:       100,
+		follow:     false,
+		since:      "",
+		until:      "",
+		stdout:     false,
+		stderr:     false,
+		timestamps: false,
 	}
 }
 
@@ -120,6 +147,46 @@ func (l *logsCommandTest) generateRunExecutionConfigs() map[string]testRunExecut
 			},
 			mockExecution: l.mockExecLogsByNameMoreThanOneCtrs,
 		},
+		"test_logs_with_options": {
+			args: logsCmdArgs,
+			flags: map[string]string{
+				logsCmdFlagFollow:     "true",
+				logsCmdFlagSince:      logsSinceStr,
+				logsCmdFlagUntil:      logsUntilStr,
+				logsCmdFlagStderr:     "true",
+				logsCmdFlagTimestamps: "true",
+			},
+			mockExecution: l.mockExecLogsWithOptions,
+		},
+		"test_logs_since_unix_time": {
+			args: logsCmdArgs,
+			flags: map[string]string{
+				logsCmdFlagSince: "1683712800",
+			},
+			mockExecution: l.mockExecLogsSinceUnixTime,
+		},
+		"test_logs_invalid_since": {
+			args: logsCmdArgs,
+			flags: map[string]string{
+				logsCmdFlagSince: "yesterday",
+			},
+			mockExecution: l.mockExecLogsInvalidSince,
+		},
+		"test_logs_invalid_until": {
+			args: logsCmdArgs,
+			flags: map[string]string{
+				logsCmdFlagUntil: "-10m",
+			},
+			mockExecution: l.mockExecLogsInvalidUntil,
+		},
+		"test_logs_since_after_until": {
+			args: logsCmdArgs,
+			flags: map[string]string{
+				logsCmdFlagSince: logsUntilStr,
+				logsCmdFlagUntil: logsSinceStr,
+			},
+			mockExecution: l.mockExecLogsSinceAfterUntil,
+		},
 		"test_logs_no_id_or_name_provided": {
 			mockExecution: l.mockExecLogsNoIDorName,
 		},
@@ -170,10 +237,55 @@ func (l *logsCommandTest) mockExecLogsNoIDorName(args []string) error {
 func (l *logsCommandTest) mockExecLogsNoErrors(args []string) error {
 	ctr := &types.Container{ID: args[0]}
 	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
-	l.mockClient.EXPECT().Logs(context.Background(), args[0], int32(100)).Times(1).Return(nil)
+	l.mockClient.EXPECT().Logs(context.Background(), args[0], &types.LogsOpts{Tail: 100}).Times(1).Return(nil)
+	return nil
+}
+
+func (l *logsCommandTest) mockExecLogsWithOptions(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	since, _ := time.Parse(time.RFC3339Nano, logsSinceStr)
+	until, _ := time.Parse(time.RFC3339Nano, logsUntilStr)
+	expectedOpts := &types.LogsOpts{
+		Tail:       100,
+		Follow:     true,
+		Since:      since,
+		Until:      until,
+		Stderr:     true,
+		Timestamps: true,
+	}
+	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	l.mockClient.EXPECT().Logs(context.Background(), args[0], expectedOpts).Times(1).Return(nil)
+	return nil
+}
+
+func (l *logsCommandTest) mockExecLogsSinceUnixTime(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	l.mockClient.EXPECT().Logs(context.Background(), args[0], &types.LogsOpts{Tail: 100, Since: time.Unix(1683712800, 0)}).Times(1).Return(nil)
 	return nil
 }
 
+func (l *logsCommandTest) mockExecLogsInvalidSince(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	l.mockClient.EXPECT().Logs(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("invalid time yesterday - use a RFC 3339 timestamp, Unix time in seconds or a duration")
+}
+
+func (l *logsCommandTest) mockExecLogsInvalidUntil(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	l.mockClient.EXPECT().Logs(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("invalid time -10m - use a RFC 3339 timestamp, Unix time in seconds or a duration")
+}
+
+func (l *logsCommandTest) mockExecLogsSinceAfterUntil(args []string) error {
+	ctr := &types.Container{ID: args[0]}
+	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(ctr, nil)
+	l.mockClient.EXPECT().Logs(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("the since time must not be after the until time")
+}
+
 func (l *logsCommandTest) mockExecLogsByIDNilCtr(args []string) error {
 	l.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(nil, nil)
 	return log.NewErrorf("The requested container with ID = %s was not found.", args[0])
@@ -207,7 +319,7 @@ func (l *logsCommandTest) mockExecutionGetLogs(args []string) error {
 		Name: logsContainerName,
 	}}
 	l.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(logsContainerName))).Times(1).Return(res, nil)
-	l.mockClient.EXPECT().Logs(context.Background(), logsContainerID, int32(42)).Times(1).Return(nil)
+	l.mockClient.EXPECT().Logs(context.Background(), logsContainerID, &types.LogsOpts{Tail: 42}).Times(1).Return(nil)
 	return nil
 }
 
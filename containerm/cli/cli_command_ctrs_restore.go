@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	utilcli "github.com/eclipse-kanto/container-management/containerm/util/cli"
+	"github.com/spf13/cobra"
+)
+
+type restoreCmd struct {
+	baseCommand
+	config restoreConfig
+}
+
+type restoreConfig struct {
+	name string
+}
+
+func (cc *restoreCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "restore <container-id> <checkpoint-id>",
+		Short: "Start a container restoring its state from a checkpoint.",
+		Long:  "Start a container that is not running restoring its state from a previously created checkpoint.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: "restore <container-id> <checkpoint-id>\n restore --name <container-name> <checkpoint-id>\n restore -n <container-name> <checkpoint-id>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *restoreCmd) run(args []string) error {
+	var (
+		ctr *types.Container
+		err error
+		ctx = context.Background()
+	)
+	ctrArgs, checkpointID := parseCheckpointArgs(args)
+	if err = util.ValidateCheckpointID(checkpointID); err != nil {
+		return err
+	}
+	if ctr, err = utilcli.ValidateContainerByNameArgsSingle(ctx, ctrArgs, cc.config.name, cc.cli.gwManClient); err != nil {
+		return err
+	}
+	return cc.cli.gwManClient.Restore(ctx, ctr.ID, checkpointID)
+}
+
+func (cc *restoreCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	// init name flags
+	flagSet.StringVarP(&cc.config.name, "name", "n", "", "Restore a container with a specific name.")
+}
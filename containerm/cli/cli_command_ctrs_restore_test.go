@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/client"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	restoreCmdFlagName = "name"
+)
+
+// Tests ------------------------------
+func TestRestoreCmdInit(t *testing.T) {
+	restoreCliTest := &restoreCommandTest{}
+	restoreCliTest.init()
+
+	execTestInit(t, restoreCliTest)
+}
+
+func TestRestoreCmdFlags(t *testing.T) {
+	restoreCliTest := &restoreCommandTest{}
+	restoreCliTest.init()
+
+	expectedCfg := restoreConfig{
+		name: checkpointContainerName,
+	}
+
+	flagsToApply := map[string]string{
+		restoreCmdFlagName: expectedCfg.name,
+	}
+
+	execTestSetupFlags(t, restoreCliTest, flagsToApply, expectedCfg)
+}
+
+func TestRestoreCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	restoreCliTest := &restoreCommandTest{}
+	restoreCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, restoreCliTest)
+}
+
+// EOF Tests --------------------------
+
+type restoreCommandTest struct {
+	cliCommandTestBase
+	restoreCmd *restoreCmd
+}
+
+func (restoreTc *restoreCommandTest) commandConfig() interface{} {
+	return restoreTc.restoreCmd.config
+}
+
+func (restoreTc *restoreCommandTest) commandConfigDefault() interface{} {
+	return restoreConfig{}
+}
+
+func (restoreTc *restoreCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &restoreCmd{}
+	restoreTc.restoreCmd, restoreTc.baseCmd = cmd, cmd
+
+	restoreTc.restoreCmd.init(restoreTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, restoreTc.restoreCmd.cmd)
+}
+
+func (restoreTc *restoreCommandTest) runCommand(args []string) error {
+	return restoreTc.restoreCmd.run(args)
+}
+
+func (restoreTc *restoreCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_restore_error_id_and_name_provided": {
+			args: checkpointCmdArgsWithID,
+			flags: map[string]string{
+				restoreCmdFlagName: checkpointContainerName,
+			},
+			mockExecution: restoreTc.mockExecRestoreErrIDAndName,
+		},
+		"test_restore_by_id": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: restoreTc.mockExecRestoreByID,
+		},
+		"test_restore_by_name": {
+			args: []string{checkpointID},
+			flags: map[string]string{
+				restoreCmdFlagName: checkpointContainerName,
+			},
+			mockExecution: restoreTc.mockExecRestoreByName,
+		},
+		"test_restore_error_invalid_checkpoint_id": {
+			args:          []string{checkpointContainerID, invalidCheckpointID},
+			mockExecution: restoreTc.mockExecRestoreErrInvalidCheckpointID,
+		},
+		"test_restore_error": {
+			args:          checkpointCmdArgsWithID,
+			mockExecution: restoreTc.mockExecRestoreErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (restoreTc *restoreCommandTest) mockExecRestoreErrIDAndName(args []string) error {
+	restoreTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	restoreTc.mockClient.EXPECT().Restore(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("Container ID and --name (-n) cannot be provided at the same time - use only one of them")
+}
+
+func (restoreTc *restoreCommandTest) mockExecRestoreByID(args []string) error {
+	restoreTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	restoreTc.mockClient.EXPECT().Restore(context.Background(), args[0], args[1]).Times(1).Return(nil)
+	return nil
+}
+
+func (restoreTc *restoreCommandTest) mockExecRestoreByName(args []string) error {
+	res := []*types.Container{checkpointCtr}
+	restoreTc.mockClient.EXPECT().List(context.Background(), gomock.AssignableToTypeOf(client.WithName(checkpointContainerName))).Times(1).Return(res, nil)
+	restoreTc.mockClient.EXPECT().Restore(context.Background(), checkpointCtr.ID, args[0]).Times(1).Return(nil)
+	return nil
+}
+
+func (restoreTc *restoreCommandTest) mockExecRestoreErrInvalidCheckpointID(args []string) error {
+	restoreTc.mockClient.EXPECT().Get(context.Background(), gomock.Any()).Times(0)
+	restoreTc.mockClient.EXPECT().Restore(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("invalid checkpoint ID format : %s", args[1])
+}
+
+func (restoreTc *restoreCommandTest) mockExecRestoreErr(args []string) error {
+	err := log.NewError("failed to restore container")
+	restoreTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(checkpointCtr, nil)
+	restoreTc.mockClient.EXPECT().Restore(context.Background(), args[0], args[1]).Times(1).Return(err)
+	return err
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/spf13/cobra"
+)
+
+const (
+	statsNotAvailable = "--"
+	clearScreen       = "\033[2J\033[H"
+)
+
+// errStatsDone stops the stats stream once the single sample requested via --no-stream is printed.
+var errStatsDone = errors.New("stats done")
+
+type statsCmd struct {
+	baseCommand
+	config      statsConfig
+	previousCPU map[string]*types.CPUStats
+}
+
+type statsConfig struct {
+	interval int64
+	noStream bool
+}
+
+func (cc *statsCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "stats [<container-id> ...]",
+		Short: "Display a live stream of container resource usage statistics.",
+		Long:  "Display a live stream of resource usage statistics for the provided containers or for all running containers if none are provided.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " stats\n stats <container-id> <container-id>\n stats --no-stream\n stats --interval 5",
+	}
+	cc.setupFlags()
+}
+
+func (cc *statsCmd) run(args []string) error {
+	if cc.config.interval <= 0 {
+		return log.NewError("the interval must be a positive number of seconds")
+	}
+	cc.previousCPU = map[string]*types.CPUStats{}
+	samples := 0
+	err := cc.cli.gwManClient.Stats(context.Background(), time.Duration(cc.config.interval)*time.Second, func(stats []*types.ContainerStats) error {
+		samples++
+		if cc.config.noStream {
+			// the CPU usage is calculated as a delta, so a second sample is needed
+			if samples < 2 {
+				cc.updatePreviousCPU(stats)
+				return nil
+			}
+			cc.prettyPrintStats(stats)
+			return errStatsDone
+		}
+		fmt.Print(clearScreen)
+		cc.prettyPrintStats(stats)
+		return nil
+	}, args...)
+	if err == errStatsDone {
+		return nil
+	}
+	return err
+}
+
+func (cc *statsCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.Int64Var(&cc.config.interval, "interval", 1, "Interval in seconds between the statistics samples.")
+	flagSet.BoolVar(&cc.config.noStream, "no-stream", false, "Print a single sample of the statistics and exit.")
+}
+
+const statsTableRowTemplate = "%-37s\t%-37s\t%-8s\t%-22s\t%-8s\t%-22s\t%-22s\t%-6s\t\n"
+
+func (cc *statsCmd) prettyPrintStats(stats []*types.ContainerStats) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+	defer w.Flush()
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, statsTableRowTemplate, "ID", "Name", "CPU %", "Mem Usage / Limit", "Mem %", "Net I/O", "Block I/O", "PIDs")
+	fmt.Fprintf(w, statsTableRowTemplate, "-------------------------------------", "-------------------------------------", "--------", "----------------------", "--------", "----------------------", "----------------------", "------")
+	for _, ctrStats := range stats {
+		metrics := ctrStats.Metrics
+		if metrics == nil {
+			metrics = &types.Metrics{}
+		}
+		fmt.Fprintf(w, statsTableRowTemplate, ctrStats.ID, ctrStats.Name,
+			formatPercent(util.CalculateCPUPercent(metrics.CPU, cc.previousCPU[ctrStats.ID])),
+			formatMemoryUsage(metrics.Memory),
+			formatPercent(util.CalculateMemoryPercent(metrics.Memory)),
+			formatIOStats(metrics.Network),
+			formatIOStats(metrics.IO),
+			strconv.FormatUint(metrics.PIDs, 10))
+	}
+	fmt.Fprintln(w, "")
+	cc.updatePreviousCPU(stats)
+}
+
+func (cc *statsCmd) updatePreviousCPU(stats []*types.ContainerStats) {
+	for _, ctrStats := range stats {
+		if ctrStats.Metrics != nil && ctrStats.Metrics.CPU != nil {
+			cc.previousCPU[ctrStats.ID] = ctrStats.Metrics.CPU
+		}
+	}
+}
+
+func formatPercent(percent float64, err error) string {
+	if err != nil {
+		return statsNotAvailable
+	}
+	return fmt.Sprintf("%.2f%%", percent)
+}
+
+func formatMemoryUsage(memory *types.MemoryStats) string {
+	if memory == nil {
+		return statsNotAvailable
+	}
+	return fmt.Sprintf("%s / %s", formatImageSize(int64(memory.Used)), formatImageSize(int64(memory.Total)))
+}
+
+func formatIOStats(ioStats *types.IOStats) string {
+	if ioStats == nil {
+		return statsNotAvailable
+	}
+	return fmt.Sprintf("%s / %s", formatImageSize(int64(ioStats.Read)), formatImageSize(int64(ioStats.Write)))
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/client"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	statsCmdFlagInterval = "interval"
+	statsCmdFlagNoStream = "no-stream"
+
+	// test input constants
+	statsContainerID   = "test-ctr"
+	statsContainerName = "test-ctr-name"
+)
+
+var (
+	statsFirstSample = []*types.ContainerStats{{
+		ID:   statsContainerID,
+		Name: statsContainerName,
+		Metrics: &types.Metrics{
+			CPU:    &types.CPUStats{Used: 100, Total: 1000},
+			Memory: &types.MemoryStats{Used: 512 * 1024, Total: 1024 * 1024},
+			PIDs:   2,
+		},
+	}}
+	statsSecondSample = []*types.ContainerStats{{
+		ID:   statsContainerID,
+		Name: statsContainerName,
+		Metrics: &types.Metrics{
+			CPU:     &types.CPUStats{Used: 200, Total: 2000},
+			Memory:  &types.MemoryStats{Used: 256 * 1024, Total: 1024 * 1024},
+			IO:      &types.IOStats{Read: 1024, Write: 2048},
+			Network: &types.IOStats{Read: 4096, Write: 8192},
+			PIDs:    3,
+		},
+	}}
+)
+
+// Tests ------------------------------
+func TestStatsCmdInit(t *testing.T) {
+	statsCliTest := &statsCommandTest{}
+	statsCliTest.init()
+
+	execTestInit(t, statsCliTest)
+}
+
+func TestStatsCmdFlags(t *testing.T) {
+	statsCliTest := &statsCommandTest{}
+	statsCliTest.init()
+
+	expectedCfg := statsConfig{
+		interval: 5,
+		noStream: true,
+	}
+
+	flagsToApply := map[string]string{
+		statsCmdFlagInterval: "5",
+		statsCmdFlagNoStream: "true",
+	}
+
+	execTestSetupFlags(t, statsCliTest, flagsToApply, expectedCfg)
+}
+
+func TestStatsCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	statsCliTest := &statsCommandTest{}
+	statsCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, statsCliTest)
+}
+
+func TestStatsFormatting(t *testing.T) {
+	tests := map[string]struct {
+		actual   string
+		expected string
+	}{
+		"test_format_percent": {
+			actual:   formatPercent(12.345, nil),
+			expected: "12.35%",
+		},
+		"test_format_percent_err": {
+			actual:   formatPercent(0, log.NewError("no CPU data")),
+			expected: statsNotAvailable,
+		},
+		"test_format_memory_usage": {
+			actual:   formatMemoryUsage(&types.MemoryStats{Used: 512 * 1024, Total: 2 * 1024 * 1024}),
+			expected: "512.0KiB / 2.0MiB",
+		},
+		"test_format_memory_usage_nil": {
+			actual:   formatMemoryUsage(nil),
+			expected: statsNotAvailable,
+		},
+		"test_format_io_stats": {
+			actual:   formatIOStats(&types.IOStats{Read: 100, Write: 2048}),
+			expected: "100B / 2.0KiB",
+		},
+		"test_format_io_stats_nil": {
+			actual:   formatIOStats(nil),
+			expected: statsNotAvailable,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expected, testCase.actual)
+		})
+	}
+}
+
+// EOF Tests --------------------------
+
+type statsCommandTest struct {
+	cliCommandTestBase
+	statsCmd *statsCmd
+}
+
+func (statsTc *statsCommandTest) commandConfig() interface{} {
+	return statsTc.statsCmd.config
+}
+
+func (statsTc *statsCommandTest) commandConfigDefault() interface{} {
+	return statsConfig{
+		interval: 1,
+	}
+}
+
+func (statsTc *statsCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &statsCmd{}
+	statsTc.statsCmd, statsTc.baseCmd = cmd, cmd
+
+	statsTc.statsCmd.init(statsTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, statsTc.statsCmd.cmd)
+}
+
+func (statsTc *statsCommandTest) runCommand(args []string) error {
+	return statsTc.statsCmd.run(args)
+}
+
+func (statsTc *statsCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_stats_all": {
+			mockExecution: statsTc.mockExecStatsStream,
+		},
+		"test_stats_by_ids": {
+			args:          []string{statsContainerID},
+			mockExecution: statsTc.mockExecStatsStream,
+		},
+		"test_stats_no_stream": {
+			flags: map[string]string{
+				statsCmdFlagNoStream: "true",
+				statsCmdFlagInterval: "2",
+			},
+			mockExecution: statsTc.mockExecStatsNoStream,
+		},
+		"test_stats_invalid_interval": {
+			flags: map[string]string{
+				statsCmdFlagInterval: "0",
+			},
+			mockExecution: statsTc.mockExecStatsInvalidInterval,
+		},
+		"test_stats_error": {
+			mockExecution: statsTc.mockExecStatsErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (statsTc *statsCommandTest) mockExecStatsStream(args []string) error {
+	ids := make([]interface{}, len(args))
+	for i, id := range args {
+		ids[i] = id
+	}
+	statsTc.mockClient.EXPECT().Stats(context.Background(), time.Second, gomock.Any(), ids...).Times(1).DoAndReturn(
+		func(ctx context.Context, interval time.Duration, handler client.StatsHandler, ids ...string) error {
+			if err := handler(statsFirstSample); err != nil {
+				return err
+			}
+			return handler(statsSecondSample)
+		})
+	return nil
+}
+
+func (statsTc *statsCommandTest) mockExecStatsNoStream(args []string) error {
+	statsTc.mockClient.EXPECT().Stats(context.Background(), 2*time.Second, gomock.Any()).Times(1).DoAndReturn(
+		func(ctx context.Context, interval time.Duration, handler client.StatsHandler, ids ...string) error {
+			if err := handler(statsFirstSample); err != nil {
+				return err
+			}
+			if err := handler(statsSecondSample); err != nil {
+				return err
+			}
+			// the stream must be stopped after the second sample
+			return log.NewError("unexpected stats sample requested")
+		})
+	return nil
+}
+
+func (statsTc *statsCommandTest) mockExecStatsInvalidInterval(args []string) error {
+	statsTc.mockClient.EXPECT().Stats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewError("the interval must be a positive number of seconds")
+}
+
+func (statsTc *statsCommandTest) mockExecStatsErr(args []string) error {
+	err := log.NewError("failed to get stats")
+	statsTc.mockClient.EXPECT().Stats(context.Background(), time.Second, gomock.Any()).Times(1).Return(err)
+	return err
+}
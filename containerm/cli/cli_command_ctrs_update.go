@@ -23,6 +23,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// egressRulesNone is the value of the egress rule flag which removes all current egress rules
+const egressRulesNone = "none"
+
 type updateCmd struct {
 	baseCommand
 	config updateConfig
@@ -34,6 +37,7 @@ type updateConfig struct {
 
 	restartPolicy
 	resources
+	networkPolicy
 }
 
 func (cc *updateCmd) init(cli *cli) {
@@ -70,22 +74,73 @@ func (cc *updateCmd) run(args []string) error {
 		return err
 	}
 
-	updateOpts.Resources = cc.updatedResources(container.HostConfig.Resources)
+	if updateOpts.Resources, err = cc.updatedResources(container.HostConfig.Resources); err != nil {
+		return err
+	}
 	if err = util.ValidateResources(updateOpts.Resources); err != nil {
 		return err
 	}
 
+	if updateOpts.NetworkPolicy, err = cc.updatedNetworkPolicy(container.HostConfig.NetworkPolicy); err != nil {
+		return err
+	}
+	if err = util.ValidateNetworkPolicy(updateOpts.NetworkPolicy); err != nil {
+		return err
+	}
+
 	return cc.cli.gwManClient.Update(ctx, container.ID, updateOpts)
 }
 
-func (cc *updateCmd) updatedResources(resources *types.Resources) *types.Resources {
-	if cc.config.resources.memory == "" && cc.config.resources.memoryReservation == "" && cc.config.resources.memorySwap == "" {
+func (cc *updateCmd) updatedNetworkPolicy(current *types.NetworkPolicy) (*types.NetworkPolicy, error) {
+	if cc.config.networkPolicy.isEmpty() {
 		// nothing to update
-		return nil
+		return nil, nil
+	}
+
+	if current == nil {
+		current = &types.NetworkPolicy{}
+	}
+
+	var egressRules []string
+	if len(cc.config.networkPolicy.egressRules) != 1 || cc.config.networkPolicy.egressRules[0] != egressRulesNone {
+		egressRules = cc.config.networkPolicy.egressRules
+	}
+	// a rate of 0 is parsed as unset which removes the limit
+	newPolicy, err := getNetworkPolicy(networkPolicy{
+		ingressRate:   cc.config.networkPolicy.ingressRate,
+		egressRate:    cc.config.networkPolicy.egressRate,
+		egressDefault: cc.config.networkPolicy.egressDefault,
+		egressRules:   egressRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newPolicy == nil {
+		newPolicy = &types.NetworkPolicy{}
+	}
+	if cc.config.networkPolicy.ingressRate == "" {
+		newPolicy.IngressRate = current.IngressRate
+	}
+	if cc.config.networkPolicy.egressRate == "" {
+		newPolicy.EgressRate = current.EgressRate
+	}
+	if cc.config.networkPolicy.egressDefault == "" {
+		newPolicy.EgressDefault = current.EgressDefault
+	}
+	if len(cc.config.networkPolicy.egressRules) == 0 {
+		newPolicy.EgressRules = current.EgressRules
+	}
+	return newPolicy, nil
+}
+
+func (cc *updateCmd) updatedResources(current *types.Resources) (*types.Resources, error) {
+	if cc.config.resources.isEmpty() {
+		// nothing to update
+		return nil, nil
 	}
 
-	if resources == nil {
-		return getResourceLimits(cc.config.resources)
+	if current == nil {
+		current = &types.Resources{}
 	}
 
 	get := func(newValue string, defaultValue string) string {
@@ -97,17 +152,82 @@ func (cc *updateCmd) updatedResources(resources *types.Resources) *types.Resourc
 		}
 		return newValue
 	}
-	return &types.Resources{
-		Memory:            get(cc.config.resources.memory, resources.Memory),
-		MemoryReservation: get(cc.config.resources.memoryReservation, resources.MemoryReservation),
-		MemorySwap:        get(cc.config.resources.memorySwap, resources.MemorySwap),
+	// the values which are not updated or are removed with -1 are left empty and parsed as unset
+	newLimits, err := getResourceLimits(resources{
+		memory:               get(cc.config.resources.memory, current.Memory),
+		memoryReservation:    get(cc.config.resources.memoryReservation, current.MemoryReservation),
+		memorySwap:           get(cc.config.resources.memorySwap, current.MemorySwap),
+		cpuQuota:             get(cc.config.resources.cpuQuota, ""),
+		cpuPeriod:            get(cc.config.resources.cpuPeriod, ""),
+		cpuShares:            get(cc.config.resources.cpuShares, ""),
+		cpusetCpus:           get(cc.config.resources.cpusetCpus, current.CPUSetCPUs),
+		cpusetMems:           get(cc.config.resources.cpusetMems, current.CPUSetMems),
+		pidsLimit:            get(cc.config.resources.pidsLimit, ""),
+		blkioWeight:          get(cc.config.resources.blkioWeight, ""),
+		blkioDeviceReadBps:   cc.config.resources.blkioDeviceReadBps,
+		blkioDeviceWriteBps:  cc.config.resources.blkioDeviceWriteBps,
+		blkioDeviceReadIOps:  cc.config.resources.blkioDeviceReadIOps,
+		blkioDeviceWriteIOps: cc.config.resources.blkioDeviceWriteIOps,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newLimits == nil {
+		newLimits = &types.Resources{}
+	}
+	if cc.config.resources.cpuQuota == "" {
+		newLimits.CPUQuota = current.CPUQuota
+	}
+	if cc.config.resources.cpuPeriod == "" {
+		newLimits.CPUPeriod = current.CPUPeriod
+	}
+	if cc.config.resources.cpuShares == "" {
+		newLimits.CPUShares = current.CPUShares
 	}
+	if cc.config.resources.pidsLimit == "" {
+		newLimits.PidsLimit = current.PidsLimit
+	}
+	if cc.config.resources.blkioWeight == "" {
+		newLimits.BlkioWeight = current.BlkioWeight
+	}
+	newLimits.BlkioDeviceReadBps = mergeThrottleDevices(current.BlkioDeviceReadBps, newLimits.BlkioDeviceReadBps)
+	newLimits.BlkioDeviceWriteBps = mergeThrottleDevices(current.BlkioDeviceWriteBps, newLimits.BlkioDeviceWriteBps)
+	newLimits.BlkioDeviceReadIOps = mergeThrottleDevices(current.BlkioDeviceReadIOps, newLimits.BlkioDeviceReadIOps)
+	newLimits.BlkioDeviceWriteIOps = mergeThrottleDevices(current.BlkioDeviceWriteIOps, newLimits.BlkioDeviceWriteIOps)
+	return newLimits, nil
+}
+
+// mergeThrottleDevices updates the current block IO rate limits with the provided ones, a rate of 0 removes the limit for the device
+func mergeThrottleDevices(current, updated []types.ThrottleDevice) []types.ThrottleDevice {
+	var merged []types.ThrottleDevice
+	for _, device := range current {
+		if !containsThrottleDevice(updated, device.Path) {
+			merged = append(merged, device)
+		}
+	}
+	for _, device := range updated {
+		if device.Rate != 0 {
+			merged = append(merged, device)
+		}
+	}
+	return merged
+}
+
+func containsThrottleDevice(devices []types.ThrottleDevice, path string) bool {
+	for _, device := range devices {
+		if device.Path == path {
+			return true
+		}
+	}
+	return false
 }
 
 func (cc *updateCmd) updatedRestartPolicy(restartPolicy *types.RestartPolicy) *types.RestartPolicy {
+	onUnhealthyChanged := cc.cmd.Flags().Changed("rp-unhealthy")
 	if cc.config.restartPolicy.kind == "" &&
 		cc.config.restartPolicy.timeout == math.MinInt64 &&
-		cc.config.restartPolicy.maxRetryCount == math.MinInt32 {
+		cc.config.restartPolicy.maxRetryCount == math.MinInt32 &&
+		!onUnhealthyChanged {
 		// nothing to update
 		return nil
 	}
@@ -124,6 +244,9 @@ func (cc *updateCmd) updatedRestartPolicy(restartPolicy *types.RestartPolicy) *t
 		newRestartPolicy.RetryTimeout = restartPolicy.RetryTimeout
 		newRestartPolicy.MaximumRetryCount = restartPolicy.MaximumRetryCount
 	}
+	if newRestartPolicy.Type != types.No {
+		newRestartPolicy.RestartOnUnhealthy = restartPolicy.RestartOnUnhealthy
+	}
 
 	if cc.config.restartPolicy.timeout != math.MinInt64 {
 		newRestartPolicy.RetryTimeout = time.Duration(cc.config.restartPolicy.timeout) * time.Second
@@ -133,6 +256,10 @@ func (cc *updateCmd) updatedRestartPolicy(restartPolicy *types.RestartPolicy) *t
 		newRestartPolicy.MaximumRetryCount = cc.config.restartPolicy.maxRetryCount
 	}
 
+	if onUnhealthyChanged {
+		newRestartPolicy.RestartOnUnhealthy = cc.config.restartPolicy.onUnhealthy
+	}
+
 	return newRestartPolicy
 }
 
@@ -152,10 +279,41 @@ func (cc *updateCmd) setupFlags() {
 			"the additional flags (--rp-cnt and --rp-to) apply only for this policy; if max retry count is not provided - the system will retry until it succeeds endlessly \n")
 	flagSet.IntVar(&cc.config.restartPolicy.maxRetryCount, "rp-cnt", math.MinInt32, "Updates the number of retries that will be made to restart the container on exit if the policy is on-failure")
 	flagSet.Int64Var(&cc.config.restartPolicy.timeout, "rp-to", math.MinInt64, "Updates the time out period in seconds for each retry that will be made to restart the container on exit if the policy is set to on-failure")
+	flagSet.BoolVar(&cc.config.restartPolicy.onUnhealthy, "rp-unhealthy", false, "Updates whether the container is restarted when its health check reports it as unhealthy - applicable for all restart policies except no.\n"+
+		"Use --rp-unhealthy=false, to stop restarting the container when it is unhealthy.")
 	flagSet.StringVarP(&cc.config.resources.memory, "memory", "m", "", "Updates the max amount of memory the container can use in the form of 200m, 1.2g.\n"+
 		"Use -1, to remove the memory usage limit.")
 	flagSet.StringVar(&cc.config.resources.memoryReservation, "memory-reservation", "", "Updates the soft memory limitation in the form of 200m, 1.2g.\n"+
 		"Use -1, to remove the reservation memory limit.")
 	flagSet.StringVar(&cc.config.resources.memorySwap, "memory-swap", "", "Updates the total amount of memory + swap that the container can use in the form of 200m, 1.2g.\n"+
 		"Use -1, to remove the swap memory limit.")
+	flagSet.StringVar(&cc.config.resources.cpuQuota, "cpu-quota", "", "Updates the CPU CFS (Completely Fair Scheduler) quota in microseconds which the container can use per CPU period.\n"+
+		"Use -1, to remove the CPU quota.")
+	flagSet.StringVar(&cc.config.resources.cpuPeriod, "cpu-period", "", "Updates the CPU CFS (Completely Fair Scheduler) period in microseconds.\n"+
+		"Use -1, to reset the CPU period to the default one.")
+	flagSet.StringVar(&cc.config.resources.cpuShares, "cpu-shares", "", "Updates the CPU shares, i.e. the relative weight of the container compared to the other containers when there is CPU contention.\n"+
+		"Use -1, to reset the CPU shares to the default ones.")
+	flagSet.StringVar(&cc.config.resources.cpusetCpus, "cpuset-cpus", "", "Updates the CPUs in which the container is allowed to execute in the form of 0-3, 0,1.\n"+
+		"Use -1, to allow all CPUs.")
+	flagSet.StringVar(&cc.config.resources.cpusetMems, "cpuset-mems", "", "Updates the memory nodes in which the container is allowed to execute in the form of 0-3, 0,1.\n"+
+		"Use -1, to allow all memory nodes.")
+	flagSet.StringVar(&cc.config.resources.pidsLimit, "pids-limit", "", "Updates the max number of processes in the container.\n"+
+		"Use -1, to remove the processes number limit.")
+	flagSet.StringVar(&cc.config.resources.blkioWeight, "blkio-weight", "", "Updates the block IO weight, i.e. the relative weight of the container compared to the other containers.\n"+
+		"Use -1, to reset the block IO weight to the default one.")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceReadBps, "device-read-bps", nil, "Updates the read rate limit in bytes per second from a block device in the form of <path>:<rate>.\n"+
+		"Use a rate of 0, to remove the limit for the device.")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceWriteBps, "device-write-bps", nil, "Updates the write rate limit in bytes per second to a block device in the form of <path>:<rate>.\n"+
+		"Use a rate of 0, to remove the limit for the device.")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceReadIOps, "device-read-iops", nil, "Updates the read rate limit in IO operations per second from a block device in the form of <path>:<rate>.\n"+
+		"Use a rate of 0, to remove the limit for the device.")
+	flagSet.StringSliceVar(&cc.config.resources.blkioDeviceWriteIOps, "device-write-iops", nil, "Updates the write rate limit in IO operations per second to a block device in the form of <path>:<rate>.\n"+
+		"Use a rate of 0, to remove the limit for the device.")
+	flagSet.StringVar(&cc.config.networkPolicy.ingressRate, "net-ingress-rate", "", "Updates the max rate in bytes per second of the network traffic received by the container in the form of 1000, 1m, 1.2g.\n"+
+		"Use 0, to remove the rate limit.")
+	flagSet.StringVar(&cc.config.networkPolicy.egressRate, "net-egress-rate", "", "Updates the max rate in bytes per second of the network traffic sent by the container in the form of 1000, 1m, 1.2g.\n"+
+		"Use 0, to remove the rate limit.")
+	flagSet.StringVar(&cc.config.networkPolicy.egressDefault, "net-egress-default", "", "Updates the action for the network traffic sent by the container which does not match any of the egress rules - allow or deny")
+	flagSet.StringArrayVar(&cc.config.networkPolicy.egressRules, "net-egress-rule", nil, "Replaces the egress rules of the container in the form of <allow|deny>,<cidr>[,proto=<tcp|udp>][,ports=<port>[-<port>]]. Can be repeated.\n"+
+		"Use none, to remove all egress rules.")
 }
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,9 +32,19 @@ const (
 	updateCmdFlagRestartPolicy              = "rp"
 	updateCmdFlagRestartPolicyTimeout       = "rp-to"
 	updateCmdFlagRestartPolicyMaxRetryCount = "rp-cnt"
+	updateCmdFlagRestartPolicyOnUnhealthy   = "rp-unhealthy"
 	updateCmdFlagMemory                     = "memory"
 	updateCmdFlagMemoryReservation          = "memory-reservation"
 	updateCmdFlagMemorySwap                 = "memory-swap"
+	updateCmdFlagCPUQuota                   = "cpu-quota"
+	updateCmdFlagCPUShares                  = "cpu-shares"
+	updateCmdFlagCPUSetCPUs                 = "cpuset-cpus"
+	updateCmdFlagPidsLimit                  = "pids-limit"
+	updateCmdFlagDeviceReadBps              = "device-read-bps"
+	updateCmdFlagNetIngressRate             = "net-ingress-rate"
+	updateCmdFlagNetEgressRate              = "net-egress-rate"
+	updateCmdFlagNetEgressDefault           = "net-egress-default"
+	updateCmdFlagNetEgressRule              = "net-egress-rule"
 
 	// test input constants
 	updateContainerID   = "test-ctr"
@@ -45,6 +56,10 @@ const (
 	updatedMemory                     = "500M"
 	updatedSwapLimit                  = "1G"
 	invalidMemory                     = "1X"
+	updatedCPUQuota                   = 20000
+	updatedPidsLimit                  = 50
+	invalidPidsLimit                  = "1X"
+	invalidEgressRule                 = "allow,10.0.0.1"
 )
 
 var (
@@ -79,6 +94,46 @@ var (
 			},
 		},
 	}
+
+	testCtr3 = &types.Container{
+		ID:   updateContainerID,
+		Name: updateContainerName,
+		HostConfig: &types.HostConfig{
+			RestartPolicy: onFailureRestartPolicy,
+			Resources: &types.Resources{
+				Memory:             "300M",
+				CPUQuota:           50000,
+				CPUShares:          512,
+				CPUSetCPUs:         "0-1",
+				PidsLimit:          100,
+				BlkioDeviceReadBps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}, {Path: "/dev/sdb", Rate: 2048}},
+			},
+		},
+	}
+
+	testCtrOnUnhealthy = &types.Container{
+		ID:   updateContainerID,
+		Name: updateContainerName,
+		HostConfig: &types.HostConfig{
+			RestartPolicy: &types.RestartPolicy{
+				Type:               types.Always,
+				RestartOnUnhealthy: true,
+			},
+		},
+	}
+
+	testCtr4 = &types.Container{
+		ID:   updateContainerID,
+		Name: updateContainerName,
+		HostConfig: &types.HostConfig{
+			NetworkPolicy: &types.NetworkPolicy{
+				IngressRate:   1024 * 1024,
+				EgressRate:    512 * 1024,
+				EgressDefault: types.EgressActionDeny,
+				EgressRules:   []types.EgressRule{{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8"}},
+			},
+		},
+	}
 )
 
 // Tests ------------------------------
@@ -99,11 +154,23 @@ func TestUpdateCmdFlags(t *testing.T) {
 			kind:          string(types.OnFailure),
 			timeout:       10000,
 			maxRetryCount: 3,
+			onUnhealthy:   true,
 		},
 		resources: resources{
-			memory:            "2G",
-			memoryReservation: "1.5G",
-			memorySwap:        "4G",
+			memory:             "2G",
+			memoryReservation:  "1.5G",
+			memorySwap:         "4G",
+			cpuQuota:           "20000",
+			cpuShares:          "256",
+			cpusetCpus:         "0",
+			pidsLimit:          "50",
+			blkioDeviceReadBps: []string{"/dev/sda:1m"},
+		},
+		networkPolicy: networkPolicy{
+			ingressRate:   "2m",
+			egressRate:    "1m",
+			egressDefault: string(types.EgressActionDeny),
+			egressRules:   []string{"allow,10.0.0.0/8,proto=tcp,ports=443"},
 		},
 	}
 
@@ -112,9 +179,19 @@ func TestUpdateCmdFlags(t *testing.T) {
 		updateCmdFlagRestartPolicy:              expectedCfg.restartPolicy.kind,
 		updateCmdFlagRestartPolicyTimeout:       strconv.FormatInt(expectedCfg.restartPolicy.timeout, 10),
 		updateCmdFlagRestartPolicyMaxRetryCount: strconv.Itoa(expectedCfg.restartPolicy.maxRetryCount),
+		updateCmdFlagRestartPolicyOnUnhealthy:   strconv.FormatBool(expectedCfg.restartPolicy.onUnhealthy),
 		updateCmdFlagMemory:                     expectedCfg.resources.memory,
 		updateCmdFlagMemoryReservation:          expectedCfg.resources.memoryReservation,
 		updateCmdFlagMemorySwap:                 expectedCfg.resources.memorySwap,
+		updateCmdFlagCPUQuota:                   expectedCfg.resources.cpuQuota,
+		updateCmdFlagCPUShares:                  expectedCfg.resources.cpuShares,
+		updateCmdFlagCPUSetCPUs:                 expectedCfg.resources.cpusetCpus,
+		updateCmdFlagPidsLimit:                  expectedCfg.resources.pidsLimit,
+		updateCmdFlagDeviceReadBps:              strings.Join(expectedCfg.resources.blkioDeviceReadBps, ","),
+		updateCmdFlagNetIngressRate:             expectedCfg.networkPolicy.ingressRate,
+		updateCmdFlagNetEgressRate:              expectedCfg.networkPolicy.egressRate,
+		updateCmdFlagNetEgressDefault:           expectedCfg.networkPolicy.egressDefault,
+		updateCmdFlagNetEgressRule:              expectedCfg.networkPolicy.egressRules[0],
 	}
 
 	execTestSetupFlags(t, updateCliTest, flagsToApply, expectedCfg)
@@ -199,6 +276,21 @@ func (updateTc *updateCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: updateTc.mockExecUpdateRestartPolicyMaxRetryCount,
 		},
+		"test_update_restart_policy_keeps_on_unhealthy": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagRestartPolicy:              string(types.OnFailure),
+				updateCmdFlagRestartPolicyMaxRetryCount: strconv.Itoa(updatedRestartPolicyMaxRetryCount),
+			},
+			mockExecution: updateTc.mockExecUpdateRestartPolicyKeepsOnUnhealthy,
+		},
+		"test_update_restart_policy_on_unhealthy_disabled": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagRestartPolicyOnUnhealthy: "false",
+			},
+			mockExecution: updateTc.mockExecUpdateRestartPolicyOnUnhealthyDisabled,
+		},
 		"test_update_restart_policy_error": {
 			args: updateCmdArgs,
 			flags: map[string]string{
@@ -237,6 +329,60 @@ func (updateTc *updateCommandTest) generateRunExecutionConfigs() map[string]test
 			},
 			mockExecution: updateTc.mockExecUpdateMemoryError,
 		},
+		// Test CPU, pids and block IO
+		"test_update_cpu_pids_blkio": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagCPUQuota:      strconv.Itoa(updatedCPUQuota),
+				updateCmdFlagPidsLimit:     strconv.Itoa(updatedPidsLimit),
+				updateCmdFlagDeviceReadBps: "/dev/sda:2k",
+			},
+			mockExecution: updateTc.mockExecUpdateCPUPidsBlkio,
+		},
+		"test_update_cpu_pids_blkio_no_limits": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagCPUQuota:      "-1",
+				updateCmdFlagCPUShares:     "-1",
+				updateCmdFlagCPUSetCPUs:    "-1",
+				updateCmdFlagPidsLimit:     "-1",
+				updateCmdFlagDeviceReadBps: "/dev/sdb:0",
+			},
+			mockExecution: updateTc.mockExecUpdateCPUPidsBlkioNoLimits,
+		},
+		"test_update_pids_limit_error": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagPidsLimit: invalidPidsLimit,
+			},
+			mockExecution: updateTc.mockExecUpdatePidsLimitError,
+		},
+		// Test network policy
+		"test_update_network_policy": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagNetIngressRate: "2m",
+				updateCmdFlagNetEgressRule:  "deny,192.168.0.0/16,proto=tcp,ports=22",
+			},
+			mockExecution: updateTc.mockExecUpdateNetworkPolicy,
+		},
+		"test_update_network_policy_no_limits": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagNetIngressRate:   "0",
+				updateCmdFlagNetEgressRate:    "0",
+				updateCmdFlagNetEgressDefault: string(types.EgressActionAllow),
+				updateCmdFlagNetEgressRule:    egressRulesNone,
+			},
+			mockExecution: updateTc.mockExecUpdateNetworkPolicyNoLimits,
+		},
+		"test_update_network_policy_error": {
+			args: updateCmdArgs,
+			flags: map[string]string{
+				updateCmdFlagNetEgressRule: invalidEgressRule,
+			},
+			mockExecution: updateTc.mockExecUpdateNetworkPolicyError,
+		},
 	}
 }
 
@@ -284,6 +430,32 @@ func (updateTc *updateCommandTest) mockExecUpdateRestartPolicyMaxRetryCount(args
 	return nil
 }
 
+func (updateTc *updateCommandTest) mockExecUpdateRestartPolicyKeepsOnUnhealthy(args []string) error {
+	opts := &types.UpdateOpts{
+		RestartPolicy: &types.RestartPolicy{
+			Type:               types.OnFailure,
+			MaximumRetryCount:  updatedRestartPolicyMaxRetryCount,
+			RestartOnUnhealthy: true,
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtrOnUnhealthy, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtrOnUnhealthy.ID, opts).Times(1)
+	return nil
+}
+
+func (updateTc *updateCommandTest) mockExecUpdateRestartPolicyOnUnhealthyDisabled(args []string) error {
+	opts := &types.UpdateOpts{
+		RestartPolicy: &types.RestartPolicy{
+			Type: types.Always,
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtrOnUnhealthy, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtrOnUnhealthy.ID, opts).Times(1)
+	return nil
+}
+
 func (updateTc *updateCommandTest) mockExecUpdateRestartPolicyError(args []string) error {
 	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr, nil)
 	updateTc.mockClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).Times(0)
@@ -331,3 +503,72 @@ func (updateTc *updateCommandTest) mockExecUpdateMemoryError(args []string) erro
 	updateTc.mockClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).Times(0)
 	return log.NewErrorf("invalid format of memory - %s", invalidMemory)
 }
+
+func (updateTc *updateCommandTest) mockExecUpdateCPUPidsBlkio(args []string) error {
+	opts := &types.UpdateOpts{
+		Resources: &types.Resources{
+			Memory:             testCtr3.HostConfig.Resources.Memory,
+			CPUQuota:           updatedCPUQuota,
+			CPUShares:          testCtr3.HostConfig.Resources.CPUShares,
+			CPUSetCPUs:         testCtr3.HostConfig.Resources.CPUSetCPUs,
+			PidsLimit:          updatedPidsLimit,
+			BlkioDeviceReadBps: []types.ThrottleDevice{{Path: "/dev/sdb", Rate: 2048}, {Path: "/dev/sda", Rate: 2048}},
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr3, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtr3.ID, opts).Times(1)
+	return nil
+}
+
+func (updateTc *updateCommandTest) mockExecUpdateCPUPidsBlkioNoLimits(args []string) error {
+	opts := &types.UpdateOpts{
+		Resources: &types.Resources{
+			Memory:             testCtr3.HostConfig.Resources.Memory,
+			BlkioDeviceReadBps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}},
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr3, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtr3.ID, opts).Times(1)
+	return nil
+}
+
+func (updateTc *updateCommandTest) mockExecUpdatePidsLimitError(args []string) error {
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr3, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("invalid format of pids-limit - %s", invalidPidsLimit)
+}
+
+func (updateTc *updateCommandTest) mockExecUpdateNetworkPolicy(args []string) error {
+	opts := &types.UpdateOpts{
+		NetworkPolicy: &types.NetworkPolicy{
+			IngressRate:   2 * 1024 * 1024,
+			EgressRate:    testCtr4.HostConfig.NetworkPolicy.EgressRate,
+			EgressDefault: types.EgressActionDeny,
+			EgressRules:   []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "192.168.0.0/16", Protocol: "tcp", Ports: "22"}},
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr4, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtr4.ID, opts).Times(1)
+	return nil
+}
+
+func (updateTc *updateCommandTest) mockExecUpdateNetworkPolicyNoLimits(args []string) error {
+	opts := &types.UpdateOpts{
+		NetworkPolicy: &types.NetworkPolicy{
+			EgressDefault: types.EgressActionAllow,
+		},
+	}
+
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr4, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), testCtr4.ID, opts).Times(1)
+	return nil
+}
+
+func (updateTc *updateCommandTest) mockExecUpdateNetworkPolicyError(args []string) error {
+	updateTc.mockClient.EXPECT().Get(context.Background(), args[0]).Times(1).Return(testCtr4, nil)
+	updateTc.mockClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+	return log.NewErrorf("invalid egress destination %s, must be in CIDR notation", "10.0.0.1")
+}
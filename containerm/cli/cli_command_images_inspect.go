@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+type imageCmd struct {
+	baseCommand
+}
+
+func (cc *imageCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "image",
+		Short: "Manage the locally available images.",
+		Long:  "Manage the locally available images.",
+		Args:  cobra.NoArgs,
+	}
+}
+
+type imageInspectCmd struct {
+	baseCommand
+}
+
+func (cc *imageInspectCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "inspect <image>",
+		Short: "Get detailed information about a given image.",
+		Long:  "Get detailed information about a given image including its digest, size, platform, expiry time and the containers using it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " image inspect <image>",
+	}
+}
+
+func (cc *imageInspectCmd) run(args []string) error {
+	imageInfo, err := cc.cli.gwManClient.GetImage(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	byteArray, err := json.MarshalIndent(imageInfo, "", "   ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(byteArray))
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/golang/mock/gomock"
+)
+
+// Tests ------------------------------
+func TestImageInspectCmdInit(t *testing.T) {
+	imageInspectCliTest := &imageInspectCommandTest{}
+	imageInspectCliTest.init()
+
+	execTestInit(t, imageInspectCliTest)
+}
+
+func TestImageInspectCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	imageInspectCliTest := &imageInspectCommandTest{}
+	imageInspectCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, imageInspectCliTest)
+}
+
+// EOF Tests --------------------------
+
+type imageInspectCommandTest struct {
+	cliCommandTestBase
+	imageInspectCmd *imageInspectCmd
+}
+
+func (inspectTc *imageInspectCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &imageInspectCmd{}
+	inspectTc.imageInspectCmd, inspectTc.baseCmd = cmd, cmd
+
+	inspectTc.imageInspectCmd.init(inspectTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, inspectTc.imageInspectCmd.cmd)
+}
+
+func (inspectTc *imageInspectCommandTest) runCommand(args []string) error {
+	return inspectTc.imageInspectCmd.run(args)
+}
+
+func (inspectTc *imageInspectCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_image_inspect": {
+			args:          []string{imagesImageName},
+			mockExecution: inspectTc.mockExecImageInspect,
+		},
+		"test_image_inspect_error": {
+			args:          []string{imagesImageName},
+			mockExecution: inspectTc.mockExecImageInspectErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (inspectTc *imageInspectCommandTest) mockExecImageInspect(args []string) error {
+	inspectTc.mockClient.EXPECT().GetImage(context.Background(), args[0]).Times(1).Return(imagesImageInfo, nil)
+	return nil
+}
+
+func (inspectTc *imageInspectCommandTest) mockExecImageInspectErr(args []string) error {
+	err := log.NewErrorf("image with ID = %s does not exist", args[0])
+	inspectTc.mockClient.EXPECT().GetImage(context.Background(), args[0]).Times(1).Return(nil, err)
+	return err
+}
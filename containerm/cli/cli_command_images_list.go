@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/spf13/cobra"
+)
+
+type imagesCmd struct {
+	baseCommand
+	config imagesConfig
+}
+
+type imagesConfig struct {
+	quiet bool
+}
+
+func (cc *imagesCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "images",
+		Short: "List all locally available images.",
+		Long:  "List all locally available images together with their digest, size, platform, expiry time and the containers using them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " images\n images --quiet",
+	}
+	cc.setupFlags()
+}
+
+func (cc *imagesCmd) run(args []string) error {
+	imageInfos, err := cc.cli.gwManClient.ListImages(context.Background())
+	if err != nil {
+		return err
+	}
+	if cc.config.quiet {
+		names := make([]string, len(imageInfos))
+		for i, imageInfo := range imageInfos {
+			names[i] = imageInfo.Name
+		}
+		if len(names) > 0 {
+			fmt.Println(strings.Join(names, " "))
+		}
+		return nil
+	}
+	if len(imageInfos) == 0 {
+		fmt.Println("No images found.")
+	} else {
+		prettyPrintImages(imageInfos)
+	}
+	return nil
+}
+
+func (cc *imagesCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.BoolVarP(&cc.config.quiet, "quiet", "q", false, "List only image names.")
+}
+
+const imagesTableRowTemplate = "%-60s\t%-71s\t%-10s\t%-14s\t%-30s\t%-37s\t\n"
+
+func prettyPrintImages(imageInfos []*imagestypes.ImageInfo) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+	defer w.Flush()
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, imagesTableRowTemplate, "Name", "Digest", "Size", "Platform", "Expiry", "Containers")
+	fmt.Fprintf(w, imagesTableRowTemplate, "------------------------------------------------------------", "-----------------------------------------------------------------------", "----------", "--------------", "------------------------------", "-------------------------------------")
+	for _, imageInfo := range imageInfos {
+		fmt.Fprintf(w, imagesTableRowTemplate, imageInfo.Name, imageInfo.Digest, formatImageSize(imageInfo.Size), imageInfo.Platform, imageInfo.Expiry, strings.Join(imageInfo.Containers, ","))
+	}
+	fmt.Fprintln(w, "")
+}
+
+func formatImageSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	imagesCmdFlagQuiet = "quiet"
+
+	// test input constants
+	imagesImageName = "host/group/image:tag"
+)
+
+var (
+	imagesImageInfo = &imagestypes.ImageInfo{
+		Name:       imagesImageName,
+		Digest:     "sha256:1f1e11e4b5ecad1c45f3d2f1a7d3e0c9b7f8d4c3b2a1e0f9d8c7b6a5f4e3d2c1",
+		Size:       3 * 1024 * 1024,
+		Platform:   "linux/arm64",
+		Expiry:     "2026-11-15T10:00:00Z",
+		Containers: []string{"test-ctr"},
+	}
+)
+
+// Tests ------------------------------
+func TestImagesCmdInit(t *testing.T) {
+	imagesCliTest := &imagesCommandTest{}
+	imagesCliTest.init()
+
+	execTestInit(t, imagesCliTest)
+}
+
+func TestImagesCmdFlags(t *testing.T) {
+	imagesCliTest := &imagesCommandTest{}
+	imagesCliTest.init()
+
+	expectedCfg := imagesConfig{
+		quiet: true,
+	}
+
+	flagsToApply := map[string]string{
+		imagesCmdFlagQuiet: "true",
+	}
+
+	execTestSetupFlags(t, imagesCliTest, flagsToApply, expectedCfg)
+}
+
+func TestImagesCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	imagesCliTest := &imagesCommandTest{}
+	imagesCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, imagesCliTest)
+}
+
+func TestFormatImageSize(t *testing.T) {
+	tests := map[string]struct {
+		size     int64
+		expected string
+	}{
+		"test_bytes": {
+			size:     512,
+			expected: "512B",
+		},
+		"test_kibibytes": {
+			size:     1536,
+			expected: "1.5KiB",
+		},
+		"test_mebibytes": {
+			size:     3 * 1024 * 1024,
+			expected: "3.0MiB",
+		},
+		"test_gibibytes": {
+			size:     5 * 1024 * 1024 * 1024,
+			expected: "5.0GiB",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expected, formatImageSize(testCase.size))
+		})
+	}
+}
+
+// EOF Tests --------------------------
+
+type imagesCommandTest struct {
+	cliCommandTestBase
+	imagesCmd *imagesCmd
+}
+
+func (imagesTc *imagesCommandTest) commandConfig() interface{} {
+	return imagesTc.imagesCmd.config
+}
+
+func (imagesTc *imagesCommandTest) commandConfigDefault() interface{} {
+	return imagesConfig{}
+}
+
+func (imagesTc *imagesCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &imagesCmd{}
+	imagesTc.imagesCmd, imagesTc.baseCmd = cmd, cmd
+
+	imagesTc.imagesCmd.init(imagesTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, imagesTc.imagesCmd.cmd)
+}
+
+func (imagesTc *imagesCommandTest) runCommand(args []string) error {
+	return imagesTc.imagesCmd.run(args)
+}
+
+func (imagesTc *imagesCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_images": {
+			mockExecution: imagesTc.mockExecImages,
+		},
+		"test_images_quiet": {
+			flags: map[string]string{
+				imagesCmdFlagQuiet: "true",
+			},
+			mockExecution: imagesTc.mockExecImages,
+		},
+		"test_images_no_images": {
+			mockExecution: imagesTc.mockExecImagesNoImages,
+		},
+		"test_images_error": {
+			mockExecution: imagesTc.mockExecImagesErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (imagesTc *imagesCommandTest) mockExecImages(args []string) error {
+	imagesTc.mockClient.EXPECT().ListImages(context.Background()).Times(1).Return([]*imagestypes.ImageInfo{imagesImageInfo}, nil)
+	return nil
+}
+
+func (imagesTc *imagesCommandTest) mockExecImagesNoImages(args []string) error {
+	imagesTc.mockClient.EXPECT().ListImages(context.Background()).Times(1).Return(nil, nil)
+	return nil
+}
+
+func (imagesTc *imagesCommandTest) mockExecImagesErr(args []string) error {
+	err := log.NewError("failed to list images")
+	imagesTc.mockClient.EXPECT().ListImages(context.Background()).Times(1).Return(nil, err)
+	return err
+}
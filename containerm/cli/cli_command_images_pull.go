@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/spf13/cobra"
+)
+
+type pullCmd struct {
+	baseCommand
+	config pullConfig
+}
+
+type pullConfig struct {
+	decKeys       []string
+	decRecipients []string
+}
+
+func (cc *pullCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "pull <image>",
+		Short: "Pull an image from its registry.",
+		Long:  "Pull an image from its registry so that it is locally available for creating containers.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " pull <image>\n pull --dec-keys <key-file> <image>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *pullCmd) run(args []string) error {
+	image := types.Image{Name: args[0]}
+	if len(cc.config.decKeys) != 0 || len(cc.config.decRecipients) != 0 {
+		image.DecryptConfig = &types.DecryptConfig{
+			Keys:       cc.config.decKeys,
+			Recipients: cc.config.decRecipients,
+		}
+	}
+	imageInfo, err := cc.cli.gwManClient.PullImage(context.Background(), image)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", imageInfo.Name, imageInfo.Digest)
+	return nil
+}
+
+func (cc *pullCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringSliceVar(&cc.config.decKeys, "dec-keys", nil, "Sets a list of private keys filenames (GPG private key ring, JWE and PKCS7 private key). Each entry can include an optional password separated by a colon after the filename.")
+	flagSet.StringSliceVar(&cc.config.decRecipients, "dec-recipients", nil, "Sets a recipients certificates list of the image (used only for PKCS7 and must be an x509)")
+}
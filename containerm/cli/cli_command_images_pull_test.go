@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	pullCmdFlagDecKeys       = "dec-keys"
+	pullCmdFlagDecRecipients = "dec-recipients"
+)
+
+// Tests ------------------------------
+func TestPullCmdInit(t *testing.T) {
+	pullCliTest := &pullCommandTest{}
+	pullCliTest.init()
+
+	execTestInit(t, pullCliTest)
+}
+
+func TestPullCmdFlags(t *testing.T) {
+	pullCliTest := &pullCommandTest{}
+	pullCliTest.init()
+
+	expectedCfg := pullConfig{
+		decKeys:       []string{"key.pem"},
+		decRecipients: []string{"pkcs7:cert.pem"},
+	}
+
+	flagsToApply := map[string]string{
+		pullCmdFlagDecKeys:       "key.pem",
+		pullCmdFlagDecRecipients: "pkcs7:cert.pem",
+	}
+
+	execTestSetupFlags(t, pullCliTest, flagsToApply, expectedCfg)
+}
+
+func TestPullCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pullCliTest := &pullCommandTest{}
+	pullCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, pullCliTest)
+}
+
+// EOF Tests --------------------------
+
+type pullCommandTest struct {
+	cliCommandTestBase
+	pullCmd *pullCmd
+}
+
+func (pullTc *pullCommandTest) commandConfig() interface{} {
+	return pullTc.pullCmd.config
+}
+
+func (pullTc *pullCommandTest) commandConfigDefault() interface{} {
+	return pullConfig{}
+}
+
+func (pullTc *pullCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &pullCmd{}
+	pullTc.pullCmd, pullTc.baseCmd = cmd, cmd
+
+	pullTc.pullCmd.init(pullTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, pullTc.pullCmd.cmd)
+}
+
+func (pullTc *pullCommandTest) runCommand(args []string) error {
+	return pullTc.pullCmd.run(args)
+}
+
+func (pullTc *pullCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_pull": {
+			args:          []string{imagesImageName},
+			mockExecution: pullTc.mockExecPull,
+		},
+		"test_pull_with_decrypt_config": {
+			args: []string{imagesImageName},
+			flags: map[string]string{
+				pullCmdFlagDecKeys:       "key.pem",
+				pullCmdFlagDecRecipients: "pkcs7:cert.pem",
+			},
+			mockExecution: pullTc.mockExecPullWithDecryptConfig,
+		},
+		"test_pull_error": {
+			args:          []string{imagesImageName},
+			mockExecution: pullTc.mockExecPullErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (pullTc *pullCommandTest) mockExecPull(args []string) error {
+	pullTc.mockClient.EXPECT().PullImage(context.Background(), types.Image{Name: args[0]}).Times(1).Return(imagesImageInfo, nil)
+	return nil
+}
+
+func (pullTc *pullCommandTest) mockExecPullWithDecryptConfig(args []string) error {
+	image := types.Image{
+		Name: args[0],
+		DecryptConfig: &types.DecryptConfig{
+			Keys:       []string{"key.pem"},
+			Recipients: []string{"pkcs7:cert.pem"},
+		},
+	}
+	pullTc.mockClient.EXPECT().PullImage(context.Background(), image).Times(1).Return(imagesImageInfo, nil)
+	return nil
+}
+
+func (pullTc *pullCommandTest) mockExecPullErr(args []string) error {
+	err := log.NewError("failed to pull image")
+	pullTc.mockClient.EXPECT().PullImage(context.Background(), types.Image{Name: args[0]}).Times(1).Return(nil, err)
+	return err
+}
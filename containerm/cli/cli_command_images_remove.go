@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	errorutil "github.com/eclipse-kanto/container-management/containerm/util/error"
+	"github.com/spf13/cobra"
+)
+
+type rmiCmd struct {
+	baseCommand
+}
+
+func (cc *rmiCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "rmi <image> ...",
+		Short: "Remove one or more images.",
+		Long:  "Remove one or more locally available images. Images that are used by containers cannot be removed.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " rmi <image>\n rmi <image> <image>",
+	}
+}
+
+func (cc *rmiCmd) run(args []string) error {
+	var (
+		ctx  = context.Background()
+		errs errorutil.CompoundError
+	)
+	for _, arg := range args {
+		if err := cc.cli.gwManClient.RemoveImage(ctx, arg); err != nil {
+			errs.Append(err)
+		}
+	}
+	if errs.Size() > 0 {
+		return errors.New(errs.ErrorWithMessage("images couldn't be removed due to the following reasons: "))
+	}
+	return nil
+}
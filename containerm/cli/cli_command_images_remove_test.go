@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/golang/mock/gomock"
+)
+
+// Tests ------------------------------
+func TestRmiCmdInit(t *testing.T) {
+	rmiCliTest := &rmiCommandTest{}
+	rmiCliTest.init()
+
+	execTestInit(t, rmiCliTest)
+}
+
+func TestRmiCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	rmiCliTest := &rmiCommandTest{}
+	rmiCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, rmiCliTest)
+}
+
+// EOF Tests --------------------------
+
+type rmiCommandTest struct {
+	cliCommandTestBase
+	rmiCmd *rmiCmd
+}
+
+func (rmiTc *rmiCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &rmiCmd{}
+	rmiTc.rmiCmd, rmiTc.baseCmd = cmd, cmd
+
+	rmiTc.rmiCmd.init(rmiTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, rmiTc.rmiCmd.cmd)
+}
+
+func (rmiTc *rmiCommandTest) runCommand(args []string) error {
+	return rmiTc.rmiCmd.run(args)
+}
+
+func (rmiTc *rmiCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_rmi": {
+			args:          []string{imagesImageName},
+			mockExecution: rmiTc.mockExecRmi,
+		},
+		"test_rmi_multiple": {
+			args:          []string{imagesImageName, "host/group/image2:tag"},
+			mockExecution: rmiTc.mockExecRmi,
+		},
+		"test_rmi_error": {
+			args:          []string{imagesImageName, "host/group/image2:tag"},
+			mockExecution: rmiTc.mockExecRmiErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (rmiTc *rmiCommandTest) mockExecRmi(args []string) error {
+	for _, arg := range args {
+		rmiTc.mockClient.EXPECT().RemoveImage(context.Background(), arg).Times(1).Return(nil)
+	}
+	return nil
+}
+
+func (rmiTc *rmiCommandTest) mockExecRmiErr(args []string) error {
+	err := log.NewErrorf("image with ID = %s is in use by containers [test-ctr]", args[0])
+	rmiTc.mockClient.EXPECT().RemoveImage(context.Background(), args[0]).Times(1).Return(err)
+	rmiTc.mockClient.EXPECT().RemoveImage(context.Background(), args[1]).Times(1).Return(nil)
+	return err
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	errorutil "github.com/eclipse-kanto/container-management/containerm/util/error"
+	"github.com/spf13/cobra"
+)
+
+type networkCmd struct {
+	baseCommand
+}
+
+func (cc *networkCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "network",
+		Short: "Manage the networks.",
+		Long:  "Manage the networks. A user-defined bridge network isolates the containers connected to it from the ones in the other networks and containers can be connected to it via the --network and --network-add flags of the create command.",
+		Args:  cobra.NoArgs,
+	}
+}
+
+type networkCreateCmd struct {
+	baseCommand
+	config networkCreateConfig
+}
+
+type networkCreateConfig struct {
+	subnet       string
+	gateway      string
+	mtu          int
+	icc          bool
+	ipMasquerade bool
+}
+
+func (cc *networkCreateCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "create <network-name>",
+		Short: "Create a user-defined bridge network.",
+		Long:  "Create a user-defined bridge network. A dedicated bridge interface is created on the host for the network and a free subnet is allocated for it, unless one is provided.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " network create <network-name>\n network create --subnet 10.10.0.0/24 --gateway 10.10.0.1 <network-name>\n network create --icc=false --ip-masq=false <network-name>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *networkCreateCmd) run(args []string) error {
+	network, err := cc.cli.gwManClient.CreateNetwork(context.Background(), args[0], &networktypes.NetworkOpts{
+		Subnet:              cc.config.subnet,
+		Gateway:             cc.config.gateway,
+		MTU:                 cc.config.mtu,
+		DisableICC:          !cc.config.icc,
+		DisableIPMasquerade: !cc.config.ipMasquerade,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(network.Name)
+	return nil
+}
+
+func (cc *networkCreateCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringVar(&cc.config.subnet, "subnet", "", "Sets the IPv4 subnet of the network in CIDR format. A free subnet is allocated if not set.")
+	flagSet.StringVar(&cc.config.gateway, "gateway", "", "Sets the IPv4 address of the network's gateway. It must be within the subnet, which must be set as well.")
+	flagSet.IntVar(&cc.config.mtu, "mtu", 0, "Sets the maximum transmission unit of the network's interfaces. The one of the default bridge network is used if not set.")
+	flagSet.BoolVar(&cc.config.icc, "icc", true, "Enables the inter-container communication in the network.")
+	flagSet.BoolVar(&cc.config.ipMasquerade, "ip-masq", true, "Enables the IP masquerading for the outgoing traffic of the network.")
+}
+
+type networkListCmd struct {
+	baseCommand
+	config networkListConfig
+}
+
+type networkListConfig struct {
+	quiet bool
+}
+
+func (cc *networkListCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List all networks.",
+		Long:    "List all networks, including the built-in bridge and host ones, together with their driver, subnet, gateway and the containers connected to them.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " network list\n network ls --quiet",
+	}
+	cc.setupFlags()
+}
+
+func (cc *networkListCmd) run(args []string) error {
+	networks, err := cc.cli.gwManClient.ListNetworks(context.Background())
+	if err != nil {
+		return err
+	}
+	if cc.config.quiet {
+		names := make([]string, len(networks))
+		for i, network := range networks {
+			names[i] = network.Name
+		}
+		if len(names) > 0 {
+			fmt.Println(strings.Join(names, " "))
+		}
+		return nil
+	}
+	if len(networks) == 0 {
+		fmt.Println("No networks found.")
+	} else {
+		prettyPrintNetworks(networks)
+	}
+	return nil
+}
+
+func (cc *networkListCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.BoolVarP(&cc.config.quiet, "quiet", "q", false, "List only network names.")
+}
+
+type networkInspectCmd struct {
+	baseCommand
+}
+
+func (cc *networkInspectCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "inspect <network-name>",
+		Short: "Get detailed information about a given network.",
+		Long:  "Get detailed information about a given network including its driver, subnet, gateway, options and the containers connected to it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " network inspect <network-name>",
+	}
+}
+
+func (cc *networkInspectCmd) run(args []string) error {
+	network, err := cc.cli.gwManClient.GetNetwork(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	byteArray, err := json.MarshalIndent(network, "", "   ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(byteArray))
+	return nil
+}
+
+type networkRemoveCmd struct {
+	baseCommand
+}
+
+func (cc *networkRemoveCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "remove <network-name> ...",
+		Aliases: []string{"rm"},
+		Short:   "Remove one or more user-defined networks.",
+		Long:    "Remove one or more user-defined networks. The built-in networks and the networks that running containers are connected to cannot be removed.",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " network remove <network-name>\n network rm <network-name> <network-name>",
+	}
+}
+
+func (cc *networkRemoveCmd) run(args []string) error {
+	var (
+		ctx  = context.Background()
+		errs errorutil.CompoundError
+	)
+	for _, arg := range args {
+		if err := cc.cli.gwManClient.RemoveNetwork(ctx, arg); err != nil {
+			errs.Append(err)
+		}
+	}
+	if errs.Size() > 0 {
+		return errors.New(errs.ErrorWithMessage("networks couldn't be removed due to the following reasons: "))
+	}
+	return nil
+}
+
+const networksTableRowTemplate = "%-30s\t%-8s\t%-18s\t%-15s\t%-37s\t\n"
+
+func prettyPrintNetworks(networks []*networktypes.Network) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+	defer w.Flush()
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, networksTableRowTemplate, "Name", "Driver", "Subnet", "Gateway", "Containers")
+	fmt.Fprintf(w, networksTableRowTemplate, "------------------------------", "--------", "------------------", "---------------", "-------------------------------------")
+	for _, network := range networks {
+		fmt.Fprintf(w, networksTableRowTemplate, network.Name, network.Driver, network.Subnet, network.Gateway, strings.Join(network.Containers, ","))
+	}
+	fmt.Fprintln(w, "")
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	networkCmdFlagSubnet  = "subnet"
+	networkCmdFlagGateway = "gateway"
+	networkCmdFlagMTU     = "mtu"
+	networkCmdFlagICC     = "icc"
+	networkCmdFlagIPMasq  = "ip-masq"
+	networkCmdFlagQuiet   = "quiet"
+
+	// test input constants
+	networkName  = "test-network"
+	networkName2 = "test-network-2"
+)
+
+var testNetwork = &networktypes.Network{
+	Name:         networkName,
+	ID:           "test-network-id",
+	Driver:       networktypes.NetworkDriverBridge,
+	Subnet:       "10.10.0.0/24",
+	Gateway:      "10.10.0.1",
+	MTU:          1400,
+	ICC:          true,
+	IPMasquerade: true,
+	Containers:   []string{"test-ctr"},
+}
+
+// Tests ------------------------------
+func TestNetworkCreateCmdInit(t *testing.T) {
+	networkCreateCliTest := &networkCreateCommandTest{}
+	networkCreateCliTest.init()
+
+	execTestInit(t, networkCreateCliTest)
+}
+
+func TestNetworkCreateCmdFlags(t *testing.T) {
+	networkCreateCliTest := &networkCreateCommandTest{}
+	networkCreateCliTest.init()
+
+	expectedCfg := networkCreateConfig{
+		subnet:  "10.10.0.0/24",
+		gateway: "10.10.0.1",
+		mtu:     1400,
+	}
+
+	flagsToApply := map[string]string{
+		networkCmdFlagSubnet:  expectedCfg.subnet,
+		networkCmdFlagGateway: expectedCfg.gateway,
+		networkCmdFlagMTU:     "1400",
+		networkCmdFlagICC:     "false",
+		networkCmdFlagIPMasq:  "false",
+	}
+
+	execTestSetupFlags(t, networkCreateCliTest, flagsToApply, expectedCfg)
+}
+
+func TestNetworkCreateCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	networkCreateCliTest := &networkCreateCommandTest{}
+	networkCreateCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, networkCreateCliTest)
+}
+
+func TestNetworkListCmdInit(t *testing.T) {
+	networkListCliTest := &networkListCommandTest{}
+	networkListCliTest.init()
+
+	execTestInit(t, networkListCliTest)
+}
+
+func TestNetworkListCmdFlags(t *testing.T) {
+	networkListCliTest := &networkListCommandTest{}
+	networkListCliTest.init()
+
+	expectedCfg := networkListConfig{
+		quiet: true,
+	}
+
+	flagsToApply := map[string]string{
+		networkCmdFlagQuiet: "true",
+	}
+
+	execTestSetupFlags(t, networkListCliTest, flagsToApply, expectedCfg)
+}
+
+func TestNetworkListCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	networkListCliTest := &networkListCommandTest{}
+	networkListCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, networkListCliTest)
+}
+
+func TestNetworkInspectCmdInit(t *testing.T) {
+	networkInspectCliTest := &networkInspectCommandTest{}
+	networkInspectCliTest.init()
+
+	execTestInit(t, networkInspectCliTest)
+}
+
+func TestNetworkInspectCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	networkInspectCliTest := &networkInspectCommandTest{}
+	networkInspectCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, networkInspectCliTest)
+}
+
+func TestNetworkRemoveCmdInit(t *testing.T) {
+	networkRemoveCliTest := &networkRemoveCommandTest{}
+	networkRemoveCliTest.init()
+
+	execTestInit(t, networkRemoveCliTest)
+}
+
+func TestNetworkRemoveCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	networkRemoveCliTest := &networkRemoveCommandTest{}
+	networkRemoveCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, networkRemoveCliTest)
+}
+
+// EOF Tests --------------------------
+
+type networkCreateCommandTest struct {
+	cliCommandTestBase
+	networkCreateCmd *networkCreateCmd
+}
+
+func (networkTc *networkCreateCommandTest) commandConfig() interface{} {
+	return networkTc.networkCreateCmd.config
+}
+
+func (networkTc *networkCreateCommandTest) commandConfigDefault() interface{} {
+	return networkCreateConfig{
+		icc:          true,
+		ipMasquerade: true,
+	}
+}
+
+func (networkTc *networkCreateCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &networkCreateCmd{}
+	networkTc.networkCreateCmd, networkTc.baseCmd = cmd, cmd
+
+	networkTc.networkCreateCmd.init(networkTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, networkTc.networkCreateCmd.cmd)
+}
+
+func (networkTc *networkCreateCommandTest) runCommand(args []string) error {
+	return networkTc.networkCreateCmd.run(args)
+}
+
+func (networkTc *networkCreateCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_network_create_default": {
+			args:          []string{networkName},
+			mockExecution: networkTc.mockExecNetworkCreateDefault,
+		},
+		"test_network_create_with_opts": {
+			args: []string{networkName},
+			flags: map[string]string{
+				networkCmdFlagSubnet:  "10.10.0.0/24",
+				networkCmdFlagGateway: "10.10.0.1",
+				networkCmdFlagMTU:     "1400",
+				networkCmdFlagICC:     "false",
+				networkCmdFlagIPMasq:  "false",
+			},
+			mockExecution: networkTc.mockExecNetworkCreateWithOpts,
+		},
+		"test_network_create_error": {
+			args:          []string{networkName},
+			mockExecution: networkTc.mockExecNetworkCreateErr,
+		},
+	}
+}
+
+type networkListCommandTest struct {
+	cliCommandTestBase
+	networkListCmd *networkListCmd
+}
+
+func (networkTc *networkListCommandTest) commandConfig() interface{} {
+	return networkTc.networkListCmd.config
+}
+
+func (networkTc *networkListCommandTest) commandConfigDefault() interface{} {
+	return networkListConfig{}
+}
+
+func (networkTc *networkListCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &networkListCmd{}
+	networkTc.networkListCmd, networkTc.baseCmd = cmd, cmd
+
+	networkTc.networkListCmd.init(networkTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, networkTc.networkListCmd.cmd)
+}
+
+func (networkTc *networkListCommandTest) runCommand(args []string) error {
+	return networkTc.networkListCmd.run(args)
+}
+
+func (networkTc *networkListCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_network_list": {
+			mockExecution: networkTc.mockExecNetworkList,
+		},
+		"test_network_list_quiet": {
+			flags: map[string]string{
+				networkCmdFlagQuiet: "true",
+			},
+			mockExecution: networkTc.mockExecNetworkList,
+		},
+		"test_network_list_no_networks": {
+			mockExecution: networkTc.mockExecNetworkListNoNetworks,
+		},
+		"test_network_list_error": {
+			mockExecution: networkTc.mockExecNetworkListErr,
+		},
+	}
+}
+
+type networkInspectCommandTest struct {
+	cliCommandTestBase
+	networkInspectCmd *networkInspectCmd
+}
+
+func (networkTc *networkInspectCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &networkInspectCmd{}
+	networkTc.networkInspectCmd, networkTc.baseCmd = cmd, cmd
+
+	networkTc.networkInspectCmd.init(networkTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, networkTc.networkInspectCmd.cmd)
+}
+
+func (networkTc *networkInspectCommandTest) runCommand(args []string) error {
+	return networkTc.networkInspectCmd.run(args)
+}
+
+func (networkTc *networkInspectCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_network_inspect": {
+			args:          []string{networkName},
+			mockExecution: networkTc.mockExecNetworkInspect,
+		},
+		"test_network_inspect_error": {
+			args:          []string{networkName},
+			mockExecution: networkTc.mockExecNetworkInspectErr,
+		},
+	}
+}
+
+type networkRemoveCommandTest struct {
+	cliCommandTestBase
+	networkRemoveCmd *networkRemoveCmd
+}
+
+func (networkTc *networkRemoveCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &networkRemoveCmd{}
+	networkTc.networkRemoveCmd, networkTc.baseCmd = cmd, cmd
+
+	networkTc.networkRemoveCmd.init(networkTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, networkTc.networkRemoveCmd.cmd)
+}
+
+func (networkTc *networkRemoveCommandTest) runCommand(args []string) error {
+	return networkTc.networkRemoveCmd.run(args)
+}
+
+func (networkTc *networkRemoveCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_network_remove": {
+			args:          []string{networkName},
+			mockExecution: networkTc.mockExecNetworkRemove,
+		},
+		"test_network_remove_multiple": {
+			args:          []string{networkName, networkName2},
+			mockExecution: networkTc.mockExecNetworkRemove,
+		},
+		"test_network_remove_error": {
+			args:          []string{networkName, networkName2},
+			mockExecution: networkTc.mockExecNetworkRemoveErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (networkTc *networkCreateCommandTest) mockExecNetworkCreateDefault(args []string) error {
+	networkTc.mockClient.EXPECT().CreateNetwork(context.Background(), args[0], &networktypes.NetworkOpts{}).Times(1).Return(&networktypes.Network{Name: args[0], Driver: networktypes.NetworkDriverBridge}, nil)
+	return nil
+}
+
+func (networkTc *networkCreateCommandTest) mockExecNetworkCreateWithOpts(args []string) error {
+	networkTc.mockClient.EXPECT().CreateNetwork(context.Background(), args[0], &networktypes.NetworkOpts{Subnet: "10.10.0.0/24", Gateway: "10.10.0.1", MTU: 1400, DisableICC: true, DisableIPMasquerade: true}).Times(1).Return(testNetwork, nil)
+	return nil
+}
+
+func (networkTc *networkCreateCommandTest) mockExecNetworkCreateErr(args []string) error {
+	err := log.NewErrorf("network with name = %s already exists", args[0])
+	networkTc.mockClient.EXPECT().CreateNetwork(context.Background(), args[0], gomock.Any()).Times(1).Return(nil, err)
+	return err
+}
+
+func (networkTc *networkListCommandTest) mockExecNetworkList(args []string) error {
+	networkTc.mockClient.EXPECT().ListNetworks(context.Background()).Times(1).Return([]*networktypes.Network{testNetwork, {Name: networkName2, Driver: networktypes.NetworkDriverBridge}}, nil)
+	return nil
+}
+
+func (networkTc *networkListCommandTest) mockExecNetworkListNoNetworks(args []string) error {
+	networkTc.mockClient.EXPECT().ListNetworks(context.Background()).Times(1).Return([]*networktypes.Network{}, nil)
+	return nil
+}
+
+func (networkTc *networkListCommandTest) mockExecNetworkListErr(args []string) error {
+	err := log.NewError("failed to list networks")
+	networkTc.mockClient.EXPECT().ListNetworks(context.Background()).Times(1).Return(nil, err)
+	return err
+}
+
+func (networkTc *networkInspectCommandTest) mockExecNetworkInspect(args []string) error {
+	networkTc.mockClient.EXPECT().GetNetwork(context.Background(), args[0]).Times(1).Return(testNetwork, nil)
+	return nil
+}
+
+func (networkTc *networkInspectCommandTest) mockExecNetworkInspectErr(args []string) error {
+	err := log.NewErrorf("no such network with name = %s exists", args[0])
+	networkTc.mockClient.EXPECT().GetNetwork(context.Background(), args[0]).Times(1).Return(nil, err)
+	return err
+}
+
+func (networkTc *networkRemoveCommandTest) mockExecNetworkRemove(args []string) error {
+	for _, arg := range args {
+		networkTc.mockClient.EXPECT().RemoveNetwork(context.Background(), arg).Times(1).Return(nil)
+	}
+	return nil
+}
+
+func (networkTc *networkRemoveCommandTest) mockExecNetworkRemoveErr(args []string) error {
+	err := log.NewErrorf("network with name = %s is in use by containers [test-ctr]", args[0])
+	networkTc.mockClient.EXPECT().RemoveNetwork(context.Background(), args[0]).Times(1).Return(err)
+	networkTc.mockClient.EXPECT().RemoveNetwork(context.Background(), args[1]).Times(1).Return(nil)
+	return err
+}
@@ -27,6 +27,8 @@ type sysInfoCmd struct {
 const (
 	containermInfoFormat = "Engine v%s, API v%s, (build %s %s) \n"
 	cliInfoFormat        = "CLI v%s, API v%s, (build %s %s) \n"
+	runtimeHandlerFormat = "  %s %s%s \n"
+	defaultHandlerSuffix = " (default)"
 )
 
 func (cc *sysInfoCmd) init(cli *cli) {
@@ -53,5 +55,17 @@ func (cc *sysInfoCmd) run(args []string) error {
 		cmVersion.ProjectVersion, cmVersion.APIVersion, cmVersion.GitCommit, cmVersion.BuildTime)
 	fmt.Printf(cliInfoFormat,
 		version.ProjectVersion, version.APIVersion, version.GitCommit, version.BuildTime)
+	runtimeInfo, err := cc.cli.gwManClient.RuntimeInfo(context.Background())
+	if err != nil {
+		return err
+	}
+	fmt.Println("Runtime handlers:")
+	for _, handler := range runtimeInfo.RuntimeHandlers {
+		suffix := ""
+		if handler.Name == runtimeInfo.DefaultRuntimeHandler {
+			suffix = defaultHandlerSuffix
+		}
+		fmt.Printf(runtimeHandlerFormat, handler.Name, handler.RuntimeType, suffix)
+	}
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 	"errors"
 	"testing"
 
+	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	"github.com/golang/mock/gomock"
 )
@@ -61,6 +62,9 @@ func (sysInfoTc *sysInfoCommandTest) generateRunExecutionConfigs() map[string]te
 		"test_sys_info_err": {
 			mockExecution: sysInfoTc.mockExecSysInfoErrors,
 		},
+		"test_sys_info_runtime_info_err": {
+			mockExecution: sysInfoTc.mockExecSysInfoRuntimeInfoErrors,
+		},
 	}
 }
 
@@ -74,6 +78,14 @@ func (sysInfoTc *sysInfoCommandTest) mockExecSysInfoNoErrors(args []string) erro
 		GitCommit:      "test-git-commit",
 	}
 	sysInfoTc.mockClient.EXPECT().ProjectInfo(gomock.AssignableToTypeOf(context.Background())).Times(1).Return(info, nil)
+	runtimeInfo := types.RuntimeInfo{
+		DefaultRuntimeHandler: "runc",
+		RuntimeHandlers: []*ctrtypes.RuntimeHandler{
+			{Name: "crun", RuntimeType: ctrtypes.RuntimeTypeV2runcV2},
+			{Name: "runc", RuntimeType: ctrtypes.RuntimeTypeV2runcV2},
+		},
+	}
+	sysInfoTc.mockClient.EXPECT().RuntimeInfo(gomock.AssignableToTypeOf(context.Background())).Times(1).Return(runtimeInfo, nil)
 	// no error expected
 	return nil
 }
@@ -85,3 +97,11 @@ func (sysInfoTc *sysInfoCommandTest) mockExecSysInfoErrors(args []string) error
 	// no error expected
 	return err
 }
+
+func (sysInfoTc *sysInfoCommandTest) mockExecSysInfoRuntimeInfoErrors(args []string) error {
+	// setup expected calls
+	err := errors.New("failed to get runtime info")
+	sysInfoTc.mockClient.EXPECT().ProjectInfo(gomock.AssignableToTypeOf(context.Background())).Times(1).Return(types.ProjectInfo{}, nil)
+	sysInfoTc.mockClient.EXPECT().RuntimeInfo(gomock.AssignableToTypeOf(context.Background())).Times(1).Return(types.RuntimeInfo{}, err)
+	return err
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	errorutil "github.com/eclipse-kanto/container-management/containerm/util/error"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
+	"github.com/spf13/cobra"
+)
+
+type volumeCmd struct {
+	baseCommand
+}
+
+func (cc *volumeCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "volume",
+		Short: "Manage the named volumes.",
+		Long:  "Manage the named volumes. A named volume keeps data that outlives the containers using it and can be mounted in containers via the --mp flag of the create command.",
+		Args:  cobra.NoArgs,
+	}
+}
+
+type volumeCreateCmd struct {
+	baseCommand
+	config volumeCreateConfig
+}
+
+type volumeCreateConfig struct {
+	driver string
+	size   string
+}
+
+func (cc *volumeCreateCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "create <volume-name>",
+		Short: "Create a named volume.",
+		Long:  "Create a named volume. The data of local volumes is stored in the daemon's home directory and can be limited in size, while tmpfs volumes are kept in memory only.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " volume create <volume-name>\n volume create --size 100M <volume-name>\n volume create --driver tmpfs --size 64M <volume-name>",
+	}
+	cc.setupFlags()
+}
+
+func (cc *volumeCreateCmd) run(args []string) error {
+	volume, err := cc.cli.gwManClient.CreateVolume(context.Background(), args[0], &volumestypes.VolumeOpts{Driver: cc.config.driver, Size: cc.config.size})
+	if err != nil {
+		return err
+	}
+	fmt.Println(volume.Name)
+	return nil
+}
+
+func (cc *volumeCreateCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringVar(&cc.config.driver, "driver", volumestypes.VolumeDriverLocal, "Sets the driver of the volume. The supported drivers are local and tmpfs.")
+	flagSet.StringVar(&cc.config.size, "size", "", "Sets the maximum size of the volume data. The format is <number>[<unit>], where unit = b, k, m or g. The local volumes are not limited in size by default, while the tmpfs ones are limited by the memory available on the host.")
+}
+
+type volumeListCmd struct {
+	baseCommand
+	config volumeListConfig
+}
+
+type volumeListConfig struct {
+	quiet bool
+}
+
+func (cc *volumeListCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List all named volumes.",
+		Long:    "List all named volumes together with their driver, size and the containers using them.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " volume list\n volume ls --quiet",
+	}
+	cc.setupFlags()
+}
+
+func (cc *volumeListCmd) run(args []string) error {
+	volumes, err := cc.cli.gwManClient.ListVolumes(context.Background())
+	if err != nil {
+		return err
+	}
+	if cc.config.quiet {
+		names := make([]string, len(volumes))
+		for i, volume := range volumes {
+			names[i] = volume.Name
+		}
+		if len(names) > 0 {
+			fmt.Println(strings.Join(names, " "))
+		}
+		return nil
+	}
+	if len(volumes) == 0 {
+		fmt.Println("No volumes found.")
+	} else {
+		prettyPrintVolumes(volumes)
+	}
+	return nil
+}
+
+func (cc *volumeListCmd) setupFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.BoolVarP(&cc.config.quiet, "quiet", "q", false, "List only volume names.")
+}
+
+type volumeInspectCmd struct {
+	baseCommand
+}
+
+func (cc *volumeInspectCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:   "inspect <volume-name>",
+		Short: "Get detailed information about a given named volume.",
+		Long:  "Get detailed information about a given named volume including its driver, size, mount point on the host and the containers using it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " volume inspect <volume-name>",
+	}
+}
+
+func (cc *volumeInspectCmd) run(args []string) error {
+	volume, err := cc.cli.gwManClient.GetVolume(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	byteArray, err := json.MarshalIndent(volume, "", "   ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(byteArray))
+	return nil
+}
+
+type volumeRemoveCmd struct {
+	baseCommand
+}
+
+func (cc *volumeRemoveCmd) init(cli *cli) {
+	cc.cli = cli
+	cc.cmd = &cobra.Command{
+		Use:     "remove <volume-name> ...",
+		Aliases: []string{"rm"},
+		Short:   "Remove one or more named volumes.",
+		Long:    "Remove one or more named volumes together with their data. Volumes that are used by containers cannot be removed.",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.run(args)
+		},
+		Example: " volume remove <volume-name>\n volume rm <volume-name> <volume-name>",
+	}
+}
+
+func (cc *volumeRemoveCmd) run(args []string) error {
+	var (
+		ctx  = context.Background()
+		errs errorutil.CompoundError
+	)
+	for _, arg := range args {
+		if err := cc.cli.gwManClient.RemoveVolume(ctx, arg); err != nil {
+			errs.Append(err)
+		}
+	}
+	if errs.Size() > 0 {
+		return errors.New(errs.ErrorWithMessage("volumes couldn't be removed due to the following reasons: "))
+	}
+	return nil
+}
+
+const volumesTableRowTemplate = "%-30s\t%-8s\t%-10s\t%-37s\t\n"
+
+func prettyPrintVolumes(volumes []*volumestypes.Volume) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 8, 8, 0, '\t', tabwriter.Debug)
+	defer w.Flush()
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, volumesTableRowTemplate, "Name", "Driver", "Size", "Containers")
+	fmt.Fprintf(w, volumesTableRowTemplate, "------------------------------", "--------", "----------", "-------------------------------------")
+	for _, volume := range volumes {
+		fmt.Fprintf(w, volumesTableRowTemplate, volume.Name, volume.Driver, volume.Size, strings.Join(volume.Containers, ","))
+	}
+	fmt.Fprintln(w, "")
+}
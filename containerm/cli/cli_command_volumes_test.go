@@ -0,0 +1,367 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// command flags
+	volumeCmdFlagDriver = "driver"
+	volumeCmdFlagSize   = "size"
+	volumeCmdFlagQuiet  = "quiet"
+
+	// test input constants
+	volumeName  = "test-volume"
+	volumeName2 = "test-volume-2"
+)
+
+var testVolume = &volumestypes.Volume{
+	Name:       volumeName,
+	Driver:     volumestypes.VolumeDriverTmpfs,
+	Size:       "64M",
+	Mountpoint: "/var/lib/container-management/volumes/test-volume/_data",
+	Containers: []string{"test-ctr"},
+}
+
+// Tests ------------------------------
+func TestVolumeCreateCmdInit(t *testing.T) {
+	volumeCreateCliTest := &volumeCreateCommandTest{}
+	volumeCreateCliTest.init()
+
+	execTestInit(t, volumeCreateCliTest)
+}
+
+func TestVolumeCreateCmdFlags(t *testing.T) {
+	volumeCreateCliTest := &volumeCreateCommandTest{}
+	volumeCreateCliTest.init()
+
+	expectedCfg := volumeCreateConfig{
+		driver: volumestypes.VolumeDriverTmpfs,
+		size:   "64M",
+	}
+
+	flagsToApply := map[string]string{
+		volumeCmdFlagDriver: expectedCfg.driver,
+		volumeCmdFlagSize:   expectedCfg.size,
+	}
+
+	execTestSetupFlags(t, volumeCreateCliTest, flagsToApply, expectedCfg)
+}
+
+func TestVolumeCreateCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	volumeCreateCliTest := &volumeCreateCommandTest{}
+	volumeCreateCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, volumeCreateCliTest)
+}
+
+func TestVolumeListCmdInit(t *testing.T) {
+	volumeListCliTest := &volumeListCommandTest{}
+	volumeListCliTest.init()
+
+	execTestInit(t, volumeListCliTest)
+}
+
+func TestVolumeListCmdFlags(t *testing.T) {
+	volumeListCliTest := &volumeListCommandTest{}
+	volumeListCliTest.init()
+
+	expectedCfg := volumeListConfig{
+		quiet: true,
+	}
+
+	flagsToApply := map[string]string{
+		volumeCmdFlagQuiet: "true",
+	}
+
+	execTestSetupFlags(t, volumeListCliTest, flagsToApply, expectedCfg)
+}
+
+func TestVolumeListCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	volumeListCliTest := &volumeListCommandTest{}
+	volumeListCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, volumeListCliTest)
+}
+
+func TestVolumeInspectCmdInit(t *testing.T) {
+	volumeInspectCliTest := &volumeInspectCommandTest{}
+	volumeInspectCliTest.init()
+
+	execTestInit(t, volumeInspectCliTest)
+}
+
+func TestVolumeInspectCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	volumeInspectCliTest := &volumeInspectCommandTest{}
+	volumeInspectCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, volumeInspectCliTest)
+}
+
+func TestVolumeRemoveCmdInit(t *testing.T) {
+	volumeRemoveCliTest := &volumeRemoveCommandTest{}
+	volumeRemoveCliTest.init()
+
+	execTestInit(t, volumeRemoveCliTest)
+}
+
+func TestVolumeRemoveCmdRun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	volumeRemoveCliTest := &volumeRemoveCommandTest{}
+	volumeRemoveCliTest.initWithCtrl(controller)
+
+	execTestsRun(t, volumeRemoveCliTest)
+}
+
+// EOF Tests --------------------------
+
+type volumeCreateCommandTest struct {
+	cliCommandTestBase
+	volumeCreateCmd *volumeCreateCmd
+}
+
+func (volumeTc *volumeCreateCommandTest) commandConfig() interface{} {
+	return volumeTc.volumeCreateCmd.config
+}
+
+func (volumeTc *volumeCreateCommandTest) commandConfigDefault() interface{} {
+	return volumeCreateConfig{
+		driver: volumestypes.VolumeDriverLocal,
+	}
+}
+
+func (volumeTc *volumeCreateCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &volumeCreateCmd{}
+	volumeTc.volumeCreateCmd, volumeTc.baseCmd = cmd, cmd
+
+	volumeTc.volumeCreateCmd.init(volumeTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, volumeTc.volumeCreateCmd.cmd)
+}
+
+func (volumeTc *volumeCreateCommandTest) runCommand(args []string) error {
+	return volumeTc.volumeCreateCmd.run(args)
+}
+
+func (volumeTc *volumeCreateCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_volume_create_default": {
+			args:          []string{volumeName},
+			mockExecution: volumeTc.mockExecVolumeCreateDefault,
+		},
+		"test_volume_create_tmpfs_with_size": {
+			args: []string{volumeName},
+			flags: map[string]string{
+				volumeCmdFlagDriver: volumestypes.VolumeDriverTmpfs,
+				volumeCmdFlagSize:   "64M",
+			},
+			mockExecution: volumeTc.mockExecVolumeCreateTmpfsWithSize,
+		},
+		"test_volume_create_error": {
+			args:          []string{volumeName},
+			mockExecution: volumeTc.mockExecVolumeCreateErr,
+		},
+	}
+}
+
+type volumeListCommandTest struct {
+	cliCommandTestBase
+	volumeListCmd *volumeListCmd
+}
+
+func (volumeTc *volumeListCommandTest) commandConfig() interface{} {
+	return volumeTc.volumeListCmd.config
+}
+
+func (volumeTc *volumeListCommandTest) commandConfigDefault() interface{} {
+	return volumeListConfig{}
+}
+
+func (volumeTc *volumeListCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &volumeListCmd{}
+	volumeTc.volumeListCmd, volumeTc.baseCmd = cmd, cmd
+
+	volumeTc.volumeListCmd.init(volumeTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, volumeTc.volumeListCmd.cmd)
+}
+
+func (volumeTc *volumeListCommandTest) runCommand(args []string) error {
+	return volumeTc.volumeListCmd.run(args)
+}
+
+func (volumeTc *volumeListCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_volume_list": {
+			mockExecution: volumeTc.mockExecVolumeList,
+		},
+		"test_volume_list_quiet": {
+			flags: map[string]string{
+				volumeCmdFlagQuiet: "true",
+			},
+			mockExecution: volumeTc.mockExecVolumeList,
+		},
+		"test_volume_list_no_volumes": {
+			mockExecution: volumeTc.mockExecVolumeListNoVolumes,
+		},
+		"test_volume_list_error": {
+			mockExecution: volumeTc.mockExecVolumeListErr,
+		},
+	}
+}
+
+type volumeInspectCommandTest struct {
+	cliCommandTestBase
+	volumeInspectCmd *volumeInspectCmd
+}
+
+func (volumeTc *volumeInspectCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &volumeInspectCmd{}
+	volumeTc.volumeInspectCmd, volumeTc.baseCmd = cmd, cmd
+
+	volumeTc.volumeInspectCmd.init(volumeTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, volumeTc.volumeInspectCmd.cmd)
+}
+
+func (volumeTc *volumeInspectCommandTest) runCommand(args []string) error {
+	return volumeTc.volumeInspectCmd.run(args)
+}
+
+func (volumeTc *volumeInspectCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_volume_inspect": {
+			args:          []string{volumeName},
+			mockExecution: volumeTc.mockExecVolumeInspect,
+		},
+		"test_volume_inspect_error": {
+			args:          []string{volumeName},
+			mockExecution: volumeTc.mockExecVolumeInspectErr,
+		},
+	}
+}
+
+type volumeRemoveCommandTest struct {
+	cliCommandTestBase
+	volumeRemoveCmd *volumeRemoveCmd
+}
+
+func (volumeTc *volumeRemoveCommandTest) prepareCommand(flagsCfg map[string]string) error {
+	// setup command to test
+	cmd := &volumeRemoveCmd{}
+	volumeTc.volumeRemoveCmd, volumeTc.baseCmd = cmd, cmd
+
+	volumeTc.volumeRemoveCmd.init(volumeTc.mockRootCommand)
+	// setup command flags
+	return setCmdFlags(flagsCfg, volumeTc.volumeRemoveCmd.cmd)
+}
+
+func (volumeTc *volumeRemoveCommandTest) runCommand(args []string) error {
+	return volumeTc.volumeRemoveCmd.run(args)
+}
+
+func (volumeTc *volumeRemoveCommandTest) generateRunExecutionConfigs() map[string]testRunExecutionConfig {
+	return map[string]testRunExecutionConfig{
+		"test_volume_remove": {
+			args:          []string{volumeName},
+			mockExecution: volumeTc.mockExecVolumeRemove,
+		},
+		"test_volume_remove_multiple": {
+			args:          []string{volumeName, volumeName2},
+			mockExecution: volumeTc.mockExecVolumeRemove,
+		},
+		"test_volume_remove_error": {
+			args:          []string{volumeName, volumeName2},
+			mockExecution: volumeTc.mockExecVolumeRemoveErr,
+		},
+	}
+}
+
+// Mocked executions---------------------------------------------------------------------------------
+func (volumeTc *volumeCreateCommandTest) mockExecVolumeCreateDefault(args []string) error {
+	volumeTc.mockClient.EXPECT().CreateVolume(context.Background(), args[0], &volumestypes.VolumeOpts{Driver: volumestypes.VolumeDriverLocal}).Times(1).Return(&volumestypes.Volume{Name: args[0], Driver: volumestypes.VolumeDriverLocal}, nil)
+	return nil
+}
+
+func (volumeTc *volumeCreateCommandTest) mockExecVolumeCreateTmpfsWithSize(args []string) error {
+	volumeTc.mockClient.EXPECT().CreateVolume(context.Background(), args[0], &volumestypes.VolumeOpts{Driver: volumestypes.VolumeDriverTmpfs, Size: "64M"}).Times(1).Return(testVolume, nil)
+	return nil
+}
+
+func (volumeTc *volumeCreateCommandTest) mockExecVolumeCreateErr(args []string) error {
+	err := log.NewErrorf("volume with name = %s already exists", args[0])
+	volumeTc.mockClient.EXPECT().CreateVolume(context.Background(), args[0], gomock.Any()).Times(1).Return(nil, err)
+	return err
+}
+
+func (volumeTc *volumeListCommandTest) mockExecVolumeList(args []string) error {
+	volumeTc.mockClient.EXPECT().ListVolumes(context.Background()).Times(1).Return([]*volumestypes.Volume{testVolume, {Name: volumeName2, Driver: volumestypes.VolumeDriverLocal}}, nil)
+	return nil
+}
+
+func (volumeTc *volumeListCommandTest) mockExecVolumeListNoVolumes(args []string) error {
+	volumeTc.mockClient.EXPECT().ListVolumes(context.Background()).Times(1).Return([]*volumestypes.Volume{}, nil)
+	return nil
+}
+
+func (volumeTc *volumeListCommandTest) mockExecVolumeListErr(args []string) error {
+	err := log.NewError("failed to list volumes")
+	volumeTc.mockClient.EXPECT().ListVolumes(context.Background()).Times(1).Return(nil, err)
+	return err
+}
+
+func (volumeTc *volumeInspectCommandTest) mockExecVolumeInspect(args []string) error {
+	volumeTc.mockClient.EXPECT().GetVolume(context.Background(), args[0]).Times(1).Return(testVolume, nil)
+	return nil
+}
+
+func (volumeTc *volumeInspectCommandTest) mockExecVolumeInspectErr(args []string) error {
+	err := log.NewErrorf("no such volume with name = %s exists", args[0])
+	volumeTc.mockClient.EXPECT().GetVolume(context.Background(), args[0]).Times(1).Return(nil, err)
+	return err
+}
+
+func (volumeTc *volumeRemoveCommandTest) mockExecVolumeRemove(args []string) error {
+	for _, arg := range args {
+		volumeTc.mockClient.EXPECT().RemoveVolume(context.Background(), arg).Times(1).Return(nil)
+	}
+	return nil
+}
+
+func (volumeTc *volumeRemoveCommandTest) mockExecVolumeRemoveErr(args []string) error {
+	err := log.NewErrorf("volume with name = %s is in use by containers [test-ctr]", args[0])
+	volumeTc.mockClient.EXPECT().RemoveVolume(context.Background(), args[0]).Times(1).Return(err)
+	volumeTc.mockClient.EXPECT().RemoveVolume(context.Background(), args[1]).Times(1).Return(nil)
+	return err
+}
@@ -36,6 +36,34 @@ func main() {
 	cli.addCommand(base, &updateCmd{})
 	cli.addCommand(base, &renameCtrCmd{})
 	cli.addCommand(base, &logsCmd{})
+	cli.addCommand(base, &execCmd{})
+	cli.addCommand(base, &statsCmd{})
+	cli.addCommand(base, &eventsCmd{})
+
+	checkpointCmd := &checkpointCmd{}
+	cli.addCommand(base, checkpointCmd)
+	cli.addCommand(checkpointCmd, &checkpointCreateCmd{})
+	cli.addCommand(checkpointCmd, &checkpointListCmd{})
+	cli.addCommand(checkpointCmd, &checkpointRemoveCmd{})
+	cli.addCommand(base, &restoreCmd{})
+	cli.addCommand(base, &imagesCmd{})
+	cli.addCommand(base, &pullCmd{})
+	cli.addCommand(base, &rmiCmd{})
+	imageCmd := &imageCmd{}
+	cli.addCommand(base, imageCmd)
+	cli.addCommand(imageCmd, &imageInspectCmd{})
+	volumeCmd := &volumeCmd{}
+	cli.addCommand(base, volumeCmd)
+	cli.addCommand(volumeCmd, &volumeCreateCmd{})
+	cli.addCommand(volumeCmd, &volumeListCmd{})
+	cli.addCommand(volumeCmd, &volumeInspectCmd{})
+	cli.addCommand(volumeCmd, &volumeRemoveCmd{})
+	networkCmd := &networkCmd{}
+	cli.addCommand(base, networkCmd)
+	cli.addCommand(networkCmd, &networkCreateCmd{})
+	cli.addCommand(networkCmd, &networkListCmd{})
+	cli.addCommand(networkCmd, &networkInspectCmd{})
+	cli.addCommand(networkCmd, &networkRemoveCmd{})
 
 	if err := cli.run(); err != nil {
 		// not ExitError, print error to os.Stderr, exit code 1.
@@ -16,12 +16,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
+	pbimages "github.com/eclipse-kanto/container-management/containerm/api/services/images"
+	pbnetworks "github.com/eclipse-kanto/container-management/containerm/api/services/networks"
 	pbsysinfo "github.com/eclipse-kanto/container-management/containerm/api/services/sysinfo"
+	pbvolumes "github.com/eclipse-kanto/container-management/containerm/api/services/volumes"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	sysinfotypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 )
@@ -30,6 +37,9 @@ type client struct {
 	connection           *grpc.ClientConn
 	grpcContainersClient pbcontainers.ContainersClient
 	grpcSystemInfoClient pbsysinfo.SystemInfoClient
+	grpcImagesClient     pbimages.ImagesClient
+	grpcVolumesClient    pbvolumes.VolumesClient
+	grpcNetworksClient   pbnetworks.NetworksClient
 }
 
 // Create a new container.
@@ -124,6 +134,62 @@ func (cl *client) Attach(ctx context.Context, id string, stdin bool) (io.Writer,
 	return writer, reader, nil
 }
 
+// Exec executes a new process inside a running container, streams its IO and returns its exit code.
+func (cl *client) Exec(ctx context.Context, id string, execConfig *types.ExecConfig, stdin io.Reader, stdout io.Writer) (int64, error) {
+	execClient, err := cl.grpcContainersClient.Exec(ctx)
+	if err != nil {
+		return -1, err
+	}
+	if err = execClient.Send(&pbcontainers.ExecContainerRequest{
+		Id:         id,
+		ExecConfig: protobuf.ToProtoExecConfig(execConfig),
+	}); err != nil {
+		return -1, err
+	}
+
+	if stdin != nil && execConfig.OpenStdin {
+		go func() {
+			defer execClient.CloseSend()
+			buf := make([]byte, 32*1024)
+			for {
+				n, readErr := stdin.Read(buf)
+				if n > 0 {
+					if sendErr := execClient.Send(&pbcontainers.ExecContainerRequest{DataToWrite: append([]byte{}, buf[:n]...)}); sendErr != nil {
+						return
+					}
+				}
+				if readErr != nil {
+					break
+				}
+			}
+			execClient.Send(&pbcontainers.ExecContainerRequest{FinishWrite: true})
+		}()
+	} else {
+		if err = execClient.Send(&pbcontainers.ExecContainerRequest{FinishWrite: true}); err != nil {
+			return -1, err
+		}
+		execClient.CloseSend()
+	}
+
+	for {
+		resp, err := execClient.Recv()
+		if err == io.EOF {
+			return -1, fmt.Errorf("the exec stream for container %s was closed before the process exited", id)
+		}
+		if err != nil {
+			return -1, err
+		}
+		if len(resp.ReadData) > 0 && stdout != nil {
+			if _, err = stdout.Write(resp.ReadData); err != nil {
+				return -1, err
+			}
+		}
+		if resp.Exited {
+			return resp.ExitCode, nil
+		}
+	}
+}
+
 // Restart restart a running container.
 func (cl *client) Restart(ctx context.Context, id string, timeout int64) error {
 	_, err := cl.grpcContainersClient.Restart(ctx, &pbcontainers.RestartContainerRequest{Id: id})
@@ -154,6 +220,206 @@ func (cl *client) Remove(ctx context.Context, id string, force bool, stopOpts *t
 	return err
 }
 
+// Checkpoint creates a checkpoint of a running container.
+func (cl *client) Checkpoint(ctx context.Context, id string, checkpointOpts *types.CheckpointOpts) error {
+	_, err := cl.grpcContainersClient.Checkpoint(ctx, &pbcontainers.CheckpointContainerRequest{Id: id, CheckpointOptions: protobuf.ToProtoCheckpointOptions(checkpointOpts)})
+	return err
+}
+
+// ListCheckpoints returns the list of the checkpoints stored for a container.
+func (cl *client) ListCheckpoints(ctx context.Context, id string) ([]*types.Checkpoint, error) {
+	pbResponse, err := cl.grpcContainersClient.ListCheckpoints(ctx, &pbcontainers.ListCheckpointsRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalCheckpoints(pbResponse.Checkpoints), nil
+}
+
+// RemoveCheckpoint removes a stored checkpoint of a container.
+func (cl *client) RemoveCheckpoint(ctx context.Context, id string, checkpointID string) error {
+	_, err := cl.grpcContainersClient.RemoveCheckpoint(ctx, &pbcontainers.RemoveCheckpointRequest{Id: id, CheckpointId: checkpointID})
+	return err
+}
+
+// Restore starts a container restoring its state from a stored checkpoint.
+func (cl *client) Restore(ctx context.Context, id string, checkpointID string) error {
+	_, err := cl.grpcContainersClient.Restore(ctx, &pbcontainers.RestoreContainerRequest{Id: id, CheckpointId: checkpointID})
+	return err
+}
+
+// Metrics returns the current measurements of a container, nil if the container is not running.
+func (cl *client) Metrics(ctx context.Context, id string) (*types.Metrics, error) {
+	pbResponse, err := cl.grpcContainersClient.Metrics(ctx, &pbcontainers.GetMetricsRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalMetrics(pbResponse.Metrics), nil
+}
+
+// Stats streams the measurements of the provided containers, or of all running ones if none are provided, on the given interval.
+func (cl *client) Stats(ctx context.Context, interval time.Duration, handler StatsHandler, ids ...string) error {
+	stream, err := cl.grpcContainersClient.Stats(ctx, &pbcontainers.StatsRequest{Ids: ids, Interval: int64(interval.Seconds())})
+	if err != nil {
+		return fmt.Errorf("error while opening stream: %s", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = handler(protobuf.ToInternalContainerStats(resp.Stats)); err != nil {
+			return err
+		}
+	}
+}
+
+// Events streams the container events matching the provided filter until the context is done or the filter's until time is reached.
+func (cl *client) Events(ctx context.Context, filter *types.EventsFilter, handler EventsHandler) error {
+	request := &pbcontainers.EventsRequest{}
+	if filter != nil {
+		request.Ids = filter.IDs
+		request.Names = filter.Names
+		request.Since = filter.Since
+		request.Until = filter.Until
+		for _, action := range filter.Actions {
+			request.Actions = append(request.Actions, string(action))
+		}
+	}
+	stream, err := cl.grpcContainersClient.Events(ctx, request)
+	if err != nil {
+		return fmt.Errorf("error while opening stream: %s", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = handler(protobuf.ToInternalEvent(resp.Event)); err != nil {
+			return err
+		}
+	}
+}
+
+// ListImages returns the list of the images available locally.
+func (cl *client) ListImages(ctx context.Context) ([]*imagestypes.ImageInfo, error) {
+	pbResponse, err := cl.grpcImagesClient.List(ctx, &pbimages.ListImagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalImageInfos(pbResponse.Images), nil
+}
+
+// GetImage returns the information for a locally available image.
+func (cl *client) GetImage(ctx context.Context, imageRef string) (*imagestypes.ImageInfo, error) {
+	pbResponse, err := cl.grpcImagesClient.Get(ctx, &pbimages.GetImageRequest{Name: imageRef})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalImageInfo(pbResponse.Image), nil
+}
+
+// PullImage downloads an image from its registry.
+func (cl *client) PullImage(ctx context.Context, image types.Image) (*imagestypes.ImageInfo, error) {
+	pbResponse, err := cl.grpcImagesClient.Pull(ctx, &pbimages.PullImageRequest{Image: protobuf.ToProtoImage(&image)})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalImageInfo(pbResponse.Image), nil
+}
+
+// RemoveImage removes a locally available image that is not used by any container.
+func (cl *client) RemoveImage(ctx context.Context, imageRef string) error {
+	_, err := cl.grpcImagesClient.Remove(ctx, &pbimages.RemoveImageRequest{Name: imageRef})
+	return err
+}
+
+// CreateVolume creates a new named volume.
+func (cl *client) CreateVolume(ctx context.Context, name string, opts *volumestypes.VolumeOpts) (*volumestypes.Volume, error) {
+	request := &pbvolumes.CreateVolumeRequest{Name: name}
+	if opts != nil {
+		request.Driver = opts.Driver
+		request.Size = opts.Size
+	}
+	pbResponse, err := cl.grpcVolumesClient.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalVolume(pbResponse.Volume), nil
+}
+
+// ListVolumes returns the list of the named volumes.
+func (cl *client) ListVolumes(ctx context.Context) ([]*volumestypes.Volume, error) {
+	pbResponse, err := cl.grpcVolumesClient.List(ctx, &pbvolumes.ListVolumesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalVolumes(pbResponse.Volumes), nil
+}
+
+// GetVolume returns the information for a named volume.
+func (cl *client) GetVolume(ctx context.Context, name string) (*volumestypes.Volume, error) {
+	pbResponse, err := cl.grpcVolumesClient.Get(ctx, &pbvolumes.GetVolumeRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalVolume(pbResponse.Volume), nil
+}
+
+// RemoveVolume removes a named volume that is not used by any container.
+func (cl *client) RemoveVolume(ctx context.Context, name string) error {
+	_, err := cl.grpcVolumesClient.Remove(ctx, &pbvolumes.RemoveVolumeRequest{Name: name})
+	return err
+}
+
+// CreateNetwork creates a new user-defined network.
+func (cl *client) CreateNetwork(ctx context.Context, name string, opts *networktypes.NetworkOpts) (*networktypes.Network, error) {
+	request := &pbnetworks.CreateNetworkRequest{Name: name}
+	if opts != nil {
+		request.Subnet = opts.Subnet
+		request.Gateway = opts.Gateway
+		request.Mtu = int64(opts.MTU)
+		request.DisableIcc = opts.DisableICC
+		request.DisableIpMasquerade = opts.DisableIPMasquerade
+	}
+	pbResponse, err := cl.grpcNetworksClient.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalNetwork(pbResponse.Network), nil
+}
+
+// ListNetworks returns the list of the available networks.
+func (cl *client) ListNetworks(ctx context.Context) ([]*networktypes.Network, error) {
+	pbResponse, err := cl.grpcNetworksClient.List(ctx, &pbnetworks.ListNetworksRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalNetworks(pbResponse.Networks), nil
+}
+
+// GetNetwork returns the information for a network.
+func (cl *client) GetNetwork(ctx context.Context, name string) (*networktypes.Network, error) {
+	pbResponse, err := cl.grpcNetworksClient.Get(ctx, &pbnetworks.GetNetworkRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return protobuf.ToInternalNetwork(pbResponse.Network), nil
+}
+
+// RemoveNetwork removes a user-defined network that is not used by any container.
+func (cl *client) RemoveNetwork(ctx context.Context, name string) error {
+	_, err := cl.grpcNetworksClient.Remove(ctx, &pbnetworks.RemoveNetworkRequest{Name: name})
+	return err
+}
+
 func (cl *client) Dispose() error {
 	return cl.connection.Close()
 }
@@ -167,9 +433,32 @@ func (cl *client) ProjectInfo(ctx context.Context) (sysinfotypes.ProjectInfo, er
 	return protobuf.ToInternalProjectInfo(pbResponse.ProjectInfo), nil
 }
 
+func (cl *client) RuntimeInfo(ctx context.Context) (sysinfotypes.RuntimeInfo, error) {
+	pbResponse, err := cl.grpcSystemInfoClient.RuntimeInfo(ctx, &empty.Empty{})
+	if err != nil {
+		return sysinfotypes.RuntimeInfo{}, err
+	}
+
+	return protobuf.ToInternalRuntimeInfo(pbResponse.RuntimeInfo), nil
+}
+
 // Logs print the logs of a container.
-func (cl *client) Logs(ctx context.Context, id string, tail int32) error {
-	stream, err := cl.grpcContainersClient.Logs(ctx, &pbcontainers.GetLogsRequest{Id: id, Tail: tail})
+func (cl *client) Logs(ctx context.Context, id string, opts *types.LogsOpts) error {
+	request := &pbcontainers.GetLogsRequest{Id: id, Tail: -1}
+	if opts != nil {
+		request.Tail = opts.Tail
+		request.Follow = opts.Follow
+		request.Stdout = opts.Stdout
+		request.Stderr = opts.Stderr
+		request.Timestamps = opts.Timestamps
+		if !opts.Since.IsZero() {
+			request.Since = opts.Since.UTC().Format(time.RFC3339Nano)
+		}
+		if !opts.Until.IsZero() {
+			request.Until = opts.Until.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	stream, err := cl.grpcContainersClient.Logs(ctx, request)
 	if err != nil {
 		return fmt.Errorf("error while opening stream: %s", err)
 	}
@@ -182,7 +471,7 @@ func (cl *client) Logs(ctx context.Context, id string, tail int32) error {
 		if err != nil {
 			return err
 		}
-		fmt.Printf("%s\n", resp.Log)
+		fmt.Print(resp.Log)
 	}
 
 	return nil
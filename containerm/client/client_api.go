@@ -15,14 +15,24 @@ package client
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	sysinfotypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 )
 
 // Filter returns if the container matches the defined filter.
 type Filter func(container *types.Container) bool
 
+// StatsHandler is invoked for each sample of container measurements received. Returning an error stops the stats streaming.
+type StatsHandler func(stats []*types.ContainerStats) error
+
+// EventsHandler is invoked for each container event received. Returning an error stops the events streaming.
+type EventsHandler func(event *types.Event) error
+
 // Client is the client API for gRPC API of the engine.
 type Client interface {
 	// Create a new container.
@@ -46,6 +56,9 @@ type Client interface {
 	// Attach to a container
 	Attach(ctx context.Context, id string, stdin bool) (io.Writer, io.ReadCloser, error)
 
+	// Exec executes a new process inside a running container, streams its IO and returns its exit code
+	Exec(ctx context.Context, id string, execConfig *types.ExecConfig, stdin io.Reader, stdout io.Writer) (int64, error)
+
 	// Restart restart a running container.
 	Restart(ctx context.Context, id string, timeout int64) error
 
@@ -61,10 +74,71 @@ type Client interface {
 	// Remove removes a container, it may be running or stopped and so on.
 	Remove(ctx context.Context, id string, force bool, stopOpts *types.StopOpts) error
 
+	// Checkpoint creates a checkpoint of a running container.
+	Checkpoint(ctx context.Context, id string, checkpointOpts *types.CheckpointOpts) error
+
+	// ListCheckpoints returns the list of the checkpoints stored for a container.
+	ListCheckpoints(ctx context.Context, id string) ([]*types.Checkpoint, error)
+
+	// RemoveCheckpoint removes a stored checkpoint of a container.
+	RemoveCheckpoint(ctx context.Context, id string, checkpointID string) error
+
+	// Restore starts a container restoring its state from a stored checkpoint.
+	Restore(ctx context.Context, id string, checkpointID string) error
+
+	// Metrics returns the current measurements of a container, nil if the container is not running.
+	Metrics(ctx context.Context, id string) (*types.Metrics, error)
+
+	// Stats streams the measurements of the provided containers, or of all running ones if none are provided, on the given interval.
+	Stats(ctx context.Context, interval time.Duration, handler StatsHandler, ids ...string) error
+
+	// Events streams the container events matching the provided filter until the context is done or the filter's until time is reached.
+	Events(ctx context.Context, filter *types.EventsFilter, handler EventsHandler) error
+
+	// ListImages returns the list of the images available locally.
+	ListImages(ctx context.Context) ([]*imagestypes.ImageInfo, error)
+
+	// GetImage returns the information for a locally available image.
+	GetImage(ctx context.Context, imageRef string) (*imagestypes.ImageInfo, error)
+
+	// PullImage downloads an image from its registry.
+	PullImage(ctx context.Context, image types.Image) (*imagestypes.ImageInfo, error)
+
+	// RemoveImage removes a locally available image that is not used by any container.
+	RemoveImage(ctx context.Context, imageRef string) error
+
+	// CreateVolume creates a new named volume.
+	CreateVolume(ctx context.Context, name string, opts *volumestypes.VolumeOpts) (*volumestypes.Volume, error)
+
+	// ListVolumes returns the list of the named volumes.
+	ListVolumes(ctx context.Context) ([]*volumestypes.Volume, error)
+
+	// GetVolume returns the information for a named volume.
+	GetVolume(ctx context.Context, name string) (*volumestypes.Volume, error)
+
+	// RemoveVolume removes a named volume that is not used by any container.
+	RemoveVolume(ctx context.Context, name string) error
+
+	// CreateNetwork creates a new user-defined network.
+	CreateNetwork(ctx context.Context, name string, opts *networktypes.NetworkOpts) (*networktypes.Network, error)
+
+	// ListNetworks returns the list of the available networks.
+	ListNetworks(ctx context.Context) ([]*networktypes.Network, error)
+
+	// GetNetwork returns the information for a network.
+	GetNetwork(ctx context.Context, name string) (*networktypes.Network, error)
+
+	// RemoveNetwork removes a user-defined network that is not used by any container.
+	RemoveNetwork(ctx context.Context, name string) error
+
 	ProjectInfo(ctx context.Context) (sysinfotypes.ProjectInfo, error)
 
-	// Logs prints the logs for a container
-	Logs(ctx context.Context, id string, tail int32) error
+	// RuntimeInfo returns the runtime handlers that the containers can be run with and the name of the default one.
+	RuntimeInfo(ctx context.Context) (sysinfotypes.RuntimeInfo, error)
+
+	// Logs prints the logs for a container according to the provided options.
+	// If following is requested, it blocks until the container exits or the context is cancelled.
+	Logs(ctx context.Context, id string, opts *types.LogsOpts) error
 
 	// Dispose the client instance
 	Dispose() error
@@ -19,7 +19,10 @@ import (
 	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
+	pbimages "github.com/eclipse-kanto/container-management/containerm/api/services/images"
+	pbnetworks "github.com/eclipse-kanto/container-management/containerm/api/services/networks"
 	pbsysinfo "github.com/eclipse-kanto/container-management/containerm/api/services/sysinfo"
+	pbvolumes "github.com/eclipse-kanto/container-management/containerm/api/services/volumes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -51,6 +54,9 @@ func newContainersClient(conn *grpc.ClientConn) (Client, error) {
 		connection:           conn,
 		grpcContainersClient: pbClient,
 		grpcSystemInfoClient: pbVersion,
+		grpcImagesClient:     pbimages.NewImagesClient(conn),
+		grpcVolumesClient:    pbvolumes.NewVolumesClient(conn),
+		grpcNetworksClient:   pbnetworks.NewNetworksClient(conn),
 	}, nil
 }
 
@@ -13,21 +13,36 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
+	pbimages "github.com/eclipse-kanto/container-management/containerm/api/services/images"
+	pbnetworks "github.com/eclipse-kanto/container-management/containerm/api/services/networks"
 	"github.com/eclipse-kanto/container-management/containerm/api/services/sysinfo"
+	pbvolumes "github.com/eclipse-kanto/container-management/containerm/api/services/volumes"
 	"github.com/eclipse-kanto/container-management/containerm/api/types/containers"
+	typesImages "github.com/eclipse-kanto/container-management/containerm/api/types/images"
+	typesNetworks "github.com/eclipse-kanto/container-management/containerm/api/types/networks"
 	typesSysInfo "github.com/eclipse-kanto/container-management/containerm/api/types/sysinfo"
+	typesVolumes "github.com/eclipse-kanto/container-management/containerm/api/types/volumes"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
 	mockscontainerspb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/api/services/containers"
+	mocksimagespb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/api/services/images"
+	mocksnetworkspb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/api/services/networks"
 	mockssysinfopb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/api/services/sysinfo"
+	mocksvolumespb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/api/services/volumes"
 	sysinfotypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/empty"
 )
@@ -39,12 +54,22 @@ const (
 	containerID2      = "test-ctr-2"
 	containerImageID2 = "host/group/image2:tag"
 	containerName2    = "test-ctr-name-2"
+	checkpointID      = "test-checkpoint"
+	checkpointCreated = "2026-01-02T15:04:05Z"
+	volumeName        = "test-volume"
+	networkName       = "test-network"
 )
 
 var (
 	mockContainersClient *mockscontainerspb.MockContainersClient
 	mockAttchClient      *mockscontainerspb.MockContainers_AttachClient
+	mockExecClient       *mockscontainerspb.MockContainers_ExecClient
+	mockStatsClient      *mockscontainerspb.MockContainers_StatsClient
+	mockEventsClient     *mockscontainerspb.MockContainers_EventsClient
 	mockSysInfoClient    *mockssysinfopb.MockSystemInfoClient
+	mockImagesClient     *mocksimagespb.MockImagesClient
+	mockVolumesClient    *mocksvolumespb.MockVolumesClient
+	mockNetworksClient   *mocksnetworkspb.MockNetworksClient
 
 	testClient Client
 
@@ -56,10 +81,19 @@ var (
 func setup(controller *gomock.Controller) {
 	mockContainersClient = mockscontainerspb.NewMockContainersClient(controller)
 	mockAttchClient = mockscontainerspb.NewMockContainers_AttachClient(controller)
+	mockExecClient = mockscontainerspb.NewMockContainers_ExecClient(controller)
+	mockStatsClient = mockscontainerspb.NewMockContainers_StatsClient(controller)
+	mockEventsClient = mockscontainerspb.NewMockContainers_EventsClient(controller)
 	mockSysInfoClient = mockssysinfopb.NewMockSystemInfoClient(controller)
+	mockImagesClient = mocksimagespb.NewMockImagesClient(controller)
+	mockVolumesClient = mocksvolumespb.NewMockVolumesClient(controller)
+	mockNetworksClient = mocksnetworkspb.NewMockNetworksClient(controller)
 	testClient = &client{
 		grpcContainersClient: mockContainersClient,
 		grpcSystemInfoClient: mockSysInfoClient,
+		grpcImagesClient:     mockImagesClient,
+		grpcVolumesClient:    mockVolumesClient,
+		grpcNetworksClient:   mockNetworksClient,
 	}
 	testCtx = context.Background()
 }
@@ -213,6 +247,62 @@ func TestAttach(t *testing.T) {
 	}
 }
 
+type testExecArgs struct {
+	ctx        context.Context
+	id         string
+	execConfig *types.ExecConfig
+}
+type mockExecExec func(args testExecArgs) ([]byte, int64, error)
+
+func TestExec(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	testExecArguments := testExecArgs{
+		ctx:        testCtx,
+		id:         containerID,
+		execConfig: &types.ExecConfig{Cmd: []string{"ls", "-la"}},
+	}
+	tests := map[string]struct {
+		args          testExecArgs
+		mockExecution mockExecExec
+	}{
+		"test_exec_no_errs": {
+			args:          testExecArguments,
+			mockExecution: mockExecExecNoErrors,
+		},
+		"test_exec_err": {
+			args:          testExecArguments,
+			mockExecution: mockExecExecError,
+		},
+		"test_exec_recv_err": {
+			args:          testExecArguments,
+			mockExecution: mockExecExecRecvError,
+		},
+		"test_exec_stream_closed": {
+			args:          testExecArguments,
+			mockExecution: mockExecExecStreamClosed,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedOutput, expectedExitCode, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			output := &bytes.Buffer{}
+			exitCode, resultErr := testClient.Exec(testCase.args.ctx, testCase.args.id, testCase.args.execConfig, nil, output)
+
+			testutil.AssertEqual(t, expectedExitCode, exitCode)
+			testutil.AssertEqual(t, expectedOutput, output.Bytes())
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
 type testListArgs struct {
 	ctx     context.Context
 	filters []Filter
@@ -703,45 +793,32 @@ func TestProjectInfo(t *testing.T) {
 	}
 }
 
-// Tests for client_io_util
-type testWriteArgs struct {
-	data   []byte
-	writer *Writer
+type testRuntimeInfoArgs struct {
+	ctx context.Context
 }
-type mockExecWrite func(args testWriteArgs) (int, error)
+type mockExecRuntimeInfo func(args testRuntimeInfoArgs) (sysinfotypes.RuntimeInfo, error)
 
-func TestWriteNoErr(t *testing.T) {
+func TestRuntimeInfo(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 	setup(controller)
-	writer := &Writer{
-		ctx:         testCtx,
-		writeClient: mockAttchClient,
-		containerID: containerID,
-		stdIn:       false,
-		offset:      0,
-		err:         nil,
-	}
 
 	tests := map[string]struct {
-		args          testWriteArgs
-		mockExecution mockExecWrite
+		args          testRuntimeInfoArgs
+		mockExecution mockExecRuntimeInfo
 	}{
-		"test_write_no_errs": {
-			args: testWriteArgs{
-				data:   testBytes,
-				writer: writer,
+		"test_runtime_info_no_errs": {
+			args: testRuntimeInfoArgs{
+				ctx: testCtx,
 			},
-			mockExecution: mockExecWriteNoErrors,
+			mockExecution: mockExecRuntimeInfoNoErrors,
+		},
+		"test_runtime_info_errs": {
+			args: testRuntimeInfoArgs{
+				ctx: testCtx,
+			},
+			mockExecution: mockExecRuntimeInfoErrors,
 		},
-		// Causes unpredicted test behavior when, as it changes the internal states of the writers
-		/*		"test_write_errs": {
-				args: testWriteArgs{
-					data: testBytes,
-					writer: writer,
-				},
-				mockExecution: mockExecWriteErrors,
-			},*/
 	}
 
 	// execute tests
@@ -749,162 +826,1070 @@ func TestWriteNoErr(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			t.Log(testName)
 
-			expectedWriteResult, expectedRunErr := testCase.mockExecution(testCase.args)
+			expRuntimeInfo, expectedRunErr := testCase.mockExecution(testCase.args)
 
-			writeResult, resultErr := writer.Write(testBytes)
+			resultRuntimeInfo, resultErr := testClient.RuntimeInfo(testCase.args.ctx)
 
-			// assert container
-			testutil.AssertEqual(t, expectedWriteResult, writeResult)
+			testutil.AssertEqual(t, expRuntimeInfo, resultRuntimeInfo)
 
 			testutil.AssertError(t, expectedRunErr, resultErr)
 		})
 	}
 }
 
-// Mock executions -------------------------------------------------------------
-// Create -------------------------------------------------------------
-func mockExecCreateNoErrors(args testCreateArgs) error {
-	pbResponse := &pbcontainers.CreateContainerResponse{}
-	mockContainersClient.EXPECT().Create(args.ctx, gomock.Eq(&pbcontainers.CreateContainerRequest{Container: protobuf.ToProtoContainer(args.ctr)})).Times(1).Return(pbResponse, nil)
-	return nil
+type testCheckpointArgs struct {
+	ctx            context.Context
+	id             string
+	checkpointOpts *types.CheckpointOpts
 }
+type mockExecCheckpoint func(args testCheckpointArgs) error
 
-func mockExecCreateErrors(args testCreateArgs) error {
-	err := errors.New("failed to create container")
-	mockContainersClient.EXPECT().Create(args.ctx, gomock.Eq(&pbcontainers.CreateContainerRequest{Container: protobuf.ToProtoContainer(args.ctr)})).Times(1).Return(nil, err)
-	return err
-}
+func TestCheckpoint(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
 
-// Get -------------------------------------------------------------
-func mockExecGetNoErrors(args testGetArgs) (*types.Container, error) {
-	ctr := types.Container{
-		ID:    containerID,
-		Name:  containerName,
-		Image: types.Image{Name: containerImageID},
+	tests := map[string]struct {
+		args          testCheckpointArgs
+		mockExecution mockExecCheckpoint
+	}{
+		"test_checkpoint_no_errs": {
+			args: testCheckpointArgs{
+				ctx:            testCtx,
+				id:             containerID,
+				checkpointOpts: &types.CheckpointOpts{CheckpointID: checkpointID, Exit: true},
+			},
+			mockExecution: mockExecCheckpointNoErrors,
+		},
+		"test_checkpoint_errs": {
+			args: testCheckpointArgs{
+				ctx:            testCtx,
+				id:             containerID,
+				checkpointOpts: &types.CheckpointOpts{CheckpointID: checkpointID},
+			},
+			mockExecution: mockExecCheckpointErrors,
+		},
 	}
-	pbResponse := &pbcontainers.GetContainerResponse{
-		Container: protobuf.ToProtoContainer(&ctr),
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testClient.Checkpoint(testCase.args.ctx, testCase.args.id, testCase.args.checkpointOpts)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
 	}
-	mockContainersClient.EXPECT().Get(args.ctx, gomock.Eq(&pbcontainers.GetContainerRequest{
-		Id: args.ctrID,
-	})).Times(1).Return(pbResponse, nil)
-	return &ctr, nil
 }
 
-func mockExecGetErrors(args testGetArgs) (*types.Container, error) {
-	err := errors.New("failed to get contianer")
-	mockContainersClient.EXPECT().Get(args.ctx, gomock.Eq(&pbcontainers.GetContainerRequest{
-		Id: args.ctrID,
-	})).Times(1).Return(nil, err)
-	return nil, err
+type testListCheckpointsArgs struct {
+	ctx context.Context
+	id  string
 }
+type mockExecListCheckpoints func(args testListCheckpointsArgs) ([]*types.Checkpoint, error)
 
-// List -------------------------------------------------------------
-func mockExecListNoFilterNoErrors(args testListArgs) ([]*types.Container, error) {
-	ctr := types.Container{
-		ID:    containerID,
-		Name:  containerName,
-		Image: types.Image{Name: containerImageID},
-	}
-	ctr2 := types.Container{
-		ID:    containerID2,
-		Name:  containerName2,
-		Image: types.Image{Name: containerImageID2},
+func TestListCheckpoints(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListCheckpointsArgs
+		mockExecution mockExecListCheckpoints
+	}{
+		"test_list_checkpoints_no_errs": {
+			args: testListCheckpointsArgs{
+				ctx: testCtx,
+				id:  containerID,
+			},
+			mockExecution: mockExecListCheckpointsNoErrors,
+		},
+		"test_list_checkpoints_errs": {
+			args: testListCheckpointsArgs{
+				ctx: testCtx,
+				id:  containerID,
+			},
+			mockExecution: mockExecListCheckpointsErrors,
+		},
 	}
-	pbResponse := &pbcontainers.ListContainersResponse{
-		Containers: []*containers.Container{protobuf.ToProtoContainer(&ctr), protobuf.ToProtoContainer(&ctr2)},
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedCheckpoints, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			checkpoints, resultErr := testClient.ListCheckpoints(testCase.args.ctx, testCase.args.id)
+
+			testutil.AssertEqual(t, expectedCheckpoints, checkpoints)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
 	}
-	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
-	return []*types.Container{&ctr, &ctr2}, nil
 }
 
-func mockExecListFilterNoErrors(args testListArgs) ([]*types.Container, error) {
-	ctr := types.Container{
-		ID:    containerID,
-		Name:  containerName,
-		Image: types.Image{Name: containerImageID},
-	}
-	ctr2 := types.Container{
-		ID:    containerID2,
-		Name:  containerName2,
-		Image: types.Image{Name: containerImageID2},
-	}
-	pbResponse := &pbcontainers.ListContainersResponse{
-		Containers: []*containers.Container{protobuf.ToProtoContainer(&ctr), protobuf.ToProtoContainer(&ctr2)},
-	}
-	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
-	return []*types.Container{&ctr2}, nil
+type testCheckpointIDArgs struct {
+	ctx          context.Context
+	id           string
+	checkpointID string
 }
+type mockExecCheckpointID func(args testCheckpointIDArgs) error
 
-func mockExecListNoContainersNoErrors(args testListArgs) ([]*types.Container, error) {
-	pbResponse := &pbcontainers.ListContainersResponse{
-		Containers: nil,
+func TestRemoveCheckpoint(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCheckpointIDArgs
+		mockExecution mockExecCheckpointID
+	}{
+		"test_remove_checkpoint_no_errs": {
+			args: testCheckpointIDArgs{
+				ctx:          testCtx,
+				id:           containerID,
+				checkpointID: checkpointID,
+			},
+			mockExecution: mockExecRemoveCheckpointNoErrors,
+		},
+		"test_remove_checkpoint_errs": {
+			args: testCheckpointIDArgs{
+				ctx:          testCtx,
+				id:           containerID,
+				checkpointID: checkpointID,
+			},
+			mockExecution: mockExecRemoveCheckpointErrors,
+		},
 	}
-	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
-	return nil, nil
-}
 
-func mockExecListErrors(args testListArgs) ([]*types.Container, error) {
-	err := errors.New("failed to list containers")
-	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(nil, err)
-	return nil, err
-}
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
 
-// Start -------------------------------------------------------------
-func mockExecStartNoErrors(args testStartArgs) error {
-	mockContainersClient.EXPECT().Start(args.ctx, gomock.Eq(&pbcontainers.StartContainerRequest{Id: args.id})).Times(1).Return(nil, nil)
-	return nil
-}
+			expectedRunErr := testCase.mockExecution(testCase.args)
 
-func mockExecStartErrors(args testStartArgs) error {
-	err := errors.New("failed to start")
-	mockContainersClient.EXPECT().Start(args.ctx, gomock.Eq(&pbcontainers.StartContainerRequest{Id: args.id})).Times(1).Return(nil, err)
-	return err
+			resultErr := testClient.RemoveCheckpoint(testCase.args.ctx, testCase.args.id, testCase.args.checkpointID)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
 }
 
-// Stop -------------------------------------------------------------
-func mockExecStopNoErrors(args testStopArgs) error {
-	mockContainersClient.EXPECT().Stop(args.ctx, gomock.Eq(&pbcontainers.StopContainerRequest{
-		Id: args.id,
-		StopOptions: &containers.StopOptions{
-			Timeout: args.stopOpts.Timeout,
-			Force:   args.stopOpts.Force,
+func TestRestore(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCheckpointIDArgs
+		mockExecution mockExecCheckpointID
+	}{
+		"test_restore_no_errs": {
+			args: testCheckpointIDArgs{
+				ctx:          testCtx,
+				id:           containerID,
+				checkpointID: checkpointID,
+			},
+			mockExecution: mockExecRestoreNoErrors,
 		},
-	})).Times(1).Return(nil, nil)
-	return nil
+		"test_restore_errs": {
+			args: testCheckpointIDArgs{
+				ctx:          testCtx,
+				id:           containerID,
+				checkpointID: checkpointID,
+			},
+			mockExecution: mockExecRestoreErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testClient.Restore(testCase.args.ctx, testCase.args.id, testCase.args.checkpointID)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
 }
 
-func mockExecStopErrors(args testStopArgs) error {
-	err := errors.New("failed to stop")
-	mockContainersClient.EXPECT().Stop(args.ctx, gomock.Eq(&pbcontainers.StopContainerRequest{
-		Id: args.id,
-		StopOptions: &containers.StopOptions{
-			Timeout: args.stopOpts.Timeout,
-			Force:   args.stopOpts.Force,
-		},
-	})).Times(1).Return(nil, err)
-	return err
+type testListImagesArgs struct {
+	ctx context.Context
 }
+type mockExecListImages func(args testListImagesArgs) ([]*imagestypes.ImageInfo, error)
 
-// Attach -------------------------------------------------------------
-func mockExecAttachNoErrors(args testAttachArgs) (io.Writer, io.ReadCloser, error) {
-	mockContainersClient.EXPECT().Attach(args.ctx).Times(1).Return(mockAttchClient, nil)
-	mockAttchClient.EXPECT().Send(gomock.Eq(&pbcontainers.AttachContainerRequest{
+func TestListImages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListImagesArgs
+		mockExecution mockExecListImages
+	}{
+		"test_list_images_no_errs": {
+			args:          testListImagesArgs{ctx: testCtx},
+			mockExecution: mockExecListImagesNoErrors,
+		},
+		"test_list_images_errs": {
+			args:          testListImagesArgs{ctx: testCtx},
+			mockExecution: mockExecListImagesErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedImages, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			images, resultErr := testClient.ListImages(testCase.args.ctx)
+
+			testutil.AssertEqual(t, expectedImages, images)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testImageRefArgs struct {
+	ctx      context.Context
+	imageRef string
+}
+type mockExecGetImage func(args testImageRefArgs) (*imagestypes.ImageInfo, error)
+
+func TestGetImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testImageRefArgs
+		mockExecution mockExecGetImage
+	}{
+		"test_get_image_no_errs": {
+			args:          testImageRefArgs{ctx: testCtx, imageRef: containerImageID},
+			mockExecution: mockExecGetImageNoErrors,
+		},
+		"test_get_image_errs": {
+			args:          testImageRefArgs{ctx: testCtx, imageRef: containerImageID},
+			mockExecution: mockExecGetImageErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedImage, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			image, resultErr := testClient.GetImage(testCase.args.ctx, testCase.args.imageRef)
+
+			testutil.AssertEqual(t, expectedImage, image)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testPullImageArgs struct {
+	ctx   context.Context
+	image types.Image
+}
+type mockExecPullImage func(args testPullImageArgs) (*imagestypes.ImageInfo, error)
+
+func TestPullImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testPullImageArgs
+		mockExecution mockExecPullImage
+	}{
+		"test_pull_image_no_errs": {
+			args:          testPullImageArgs{ctx: testCtx, image: types.Image{Name: containerImageID}},
+			mockExecution: mockExecPullImageNoErrors,
+		},
+		"test_pull_image_errs": {
+			args:          testPullImageArgs{ctx: testCtx, image: types.Image{Name: containerImageID}},
+			mockExecution: mockExecPullImageErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedImage, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			image, resultErr := testClient.PullImage(testCase.args.ctx, testCase.args.image)
+
+			testutil.AssertEqual(t, expectedImage, image)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type mockExecRemoveImage func(args testImageRefArgs) error
+
+func TestRemoveImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testImageRefArgs
+		mockExecution mockExecRemoveImage
+	}{
+		"test_remove_image_no_errs": {
+			args:          testImageRefArgs{ctx: testCtx, imageRef: containerImageID},
+			mockExecution: mockExecRemoveImageNoErrors,
+		},
+		"test_remove_image_errs": {
+			args:          testImageRefArgs{ctx: testCtx, imageRef: containerImageID},
+			mockExecution: mockExecRemoveImageErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testClient.RemoveImage(testCase.args.ctx, testCase.args.imageRef)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testCreateVolumeArgs struct {
+	ctx  context.Context
+	name string
+	opts *volumestypes.VolumeOpts
+}
+type mockExecCreateVolume func(args testCreateVolumeArgs) (*volumestypes.Volume, error)
+
+func TestCreateVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCreateVolumeArgs
+		mockExecution mockExecCreateVolume
+	}{
+		"test_create_volume_no_errs": {
+			args:          testCreateVolumeArgs{ctx: testCtx, name: volumeName, opts: &volumestypes.VolumeOpts{Driver: "tmpfs", Size: "64M"}},
+			mockExecution: mockExecCreateVolumeNoErrors,
+		},
+		"test_create_volume_no_opts": {
+			args:          testCreateVolumeArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecCreateVolumeNoErrors,
+		},
+		"test_create_volume_errs": {
+			args:          testCreateVolumeArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecCreateVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedVolume, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			volume, resultErr := testClient.CreateVolume(testCase.args.ctx, testCase.args.name, testCase.args.opts)
+
+			testutil.AssertEqual(t, expectedVolume, volume)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testListVolumesArgs struct {
+	ctx context.Context
+}
+type mockExecListVolumes func(args testListVolumesArgs) ([]*volumestypes.Volume, error)
+
+func TestListVolumes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListVolumesArgs
+		mockExecution mockExecListVolumes
+	}{
+		"test_list_volumes_no_errs": {
+			args:          testListVolumesArgs{ctx: testCtx},
+			mockExecution: mockExecListVolumesNoErrors,
+		},
+		"test_list_volumes_errs": {
+			args:          testListVolumesArgs{ctx: testCtx},
+			mockExecution: mockExecListVolumesErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedVolumes, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			volumes, resultErr := testClient.ListVolumes(testCase.args.ctx)
+
+			testutil.AssertEqual(t, expectedVolumes, volumes)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testVolumeNameArgs struct {
+	ctx  context.Context
+	name string
+}
+type mockExecGetVolume func(args testVolumeNameArgs) (*volumestypes.Volume, error)
+
+func TestGetVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testVolumeNameArgs
+		mockExecution mockExecGetVolume
+	}{
+		"test_get_volume_no_errs": {
+			args:          testVolumeNameArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecGetVolumeNoErrors,
+		},
+		"test_get_volume_errs": {
+			args:          testVolumeNameArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecGetVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedVolume, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			volume, resultErr := testClient.GetVolume(testCase.args.ctx, testCase.args.name)
+
+			testutil.AssertEqual(t, expectedVolume, volume)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type mockExecRemoveVolume func(args testVolumeNameArgs) error
+
+func TestRemoveVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testVolumeNameArgs
+		mockExecution mockExecRemoveVolume
+	}{
+		"test_remove_volume_no_errs": {
+			args:          testVolumeNameArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecRemoveVolumeNoErrors,
+		},
+		"test_remove_volume_errs": {
+			args:          testVolumeNameArgs{ctx: testCtx, name: volumeName},
+			mockExecution: mockExecRemoveVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testClient.RemoveVolume(testCase.args.ctx, testCase.args.name)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testCreateNetworkArgs struct {
+	ctx  context.Context
+	name string
+	opts *networktypes.NetworkOpts
+}
+type mockExecCreateNetwork func(args testCreateNetworkArgs) (*networktypes.Network, error)
+
+func TestCreateNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCreateNetworkArgs
+		mockExecution mockExecCreateNetwork
+	}{
+		"test_create_network_no_errs": {
+			args:          testCreateNetworkArgs{ctx: testCtx, name: networkName, opts: &networktypes.NetworkOpts{Subnet: "10.10.0.0/24", Gateway: "10.10.0.1", MTU: 1400, DisableICC: true, DisableIPMasquerade: true}},
+			mockExecution: mockExecCreateNetworkNoErrors,
+		},
+		"test_create_network_no_opts": {
+			args:          testCreateNetworkArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecCreateNetworkNoErrors,
+		},
+		"test_create_network_errs": {
+			args:          testCreateNetworkArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecCreateNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedNetwork, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			network, resultErr := testClient.CreateNetwork(testCase.args.ctx, testCase.args.name, testCase.args.opts)
+
+			testutil.AssertEqual(t, expectedNetwork, network)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testListNetworksArgs struct {
+	ctx context.Context
+}
+type mockExecListNetworks func(args testListNetworksArgs) ([]*networktypes.Network, error)
+
+func TestListNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListNetworksArgs
+		mockExecution mockExecListNetworks
+	}{
+		"test_list_networks_no_errs": {
+			args:          testListNetworksArgs{ctx: testCtx},
+			mockExecution: mockExecListNetworksNoErrors,
+		},
+		"test_list_networks_errs": {
+			args:          testListNetworksArgs{ctx: testCtx},
+			mockExecution: mockExecListNetworksErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedNetworks, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			networks, resultErr := testClient.ListNetworks(testCase.args.ctx)
+
+			testutil.AssertEqual(t, expectedNetworks, networks)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testNetworkNameArgs struct {
+	ctx  context.Context
+	name string
+}
+type mockExecGetNetwork func(args testNetworkNameArgs) (*networktypes.Network, error)
+
+func TestGetNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testNetworkNameArgs
+		mockExecution mockExecGetNetwork
+	}{
+		"test_get_network_no_errs": {
+			args:          testNetworkNameArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecGetNetworkNoErrors,
+		},
+		"test_get_network_errs": {
+			args:          testNetworkNameArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecGetNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedNetwork, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			network, resultErr := testClient.GetNetwork(testCase.args.ctx, testCase.args.name)
+
+			testutil.AssertEqual(t, expectedNetwork, network)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type mockExecRemoveNetwork func(args testNetworkNameArgs) error
+
+func TestRemoveNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testNetworkNameArgs
+		mockExecution mockExecRemoveNetwork
+	}{
+		"test_remove_network_no_errs": {
+			args:          testNetworkNameArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecRemoveNetworkNoErrors,
+		},
+		"test_remove_network_errs": {
+			args:          testNetworkNameArgs{ctx: testCtx, name: networkName},
+			mockExecution: mockExecRemoveNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testClient.RemoveNetwork(testCase.args.ctx, testCase.args.name)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testMetricsArgs struct {
+	ctx context.Context
+	id  string
+}
+type mockExecMetrics func(args testMetricsArgs) (*types.Metrics, error)
+
+func TestMetrics(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testMetricsArgs
+		mockExecution mockExecMetrics
+	}{
+		"test_metrics_no_errs": {
+			args: testMetricsArgs{
+				ctx: testCtx,
+				id:  containerID,
+			},
+			mockExecution: mockExecMetricsNoErrors,
+		},
+		"test_metrics_not_running": {
+			args: testMetricsArgs{
+				ctx: testCtx,
+				id:  containerID,
+			},
+			mockExecution: mockExecMetricsNotRunning,
+		},
+		"test_metrics_errs": {
+			args: testMetricsArgs{
+				ctx: testCtx,
+				id:  containerID,
+			},
+			mockExecution: mockExecMetricsErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedMetrics, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			metrics, resultErr := testClient.Metrics(testCase.args.ctx, testCase.args.id)
+
+			testutil.AssertEqual(t, expectedMetrics, metrics)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testStatsArgs struct {
+	ctx        context.Context
+	interval   time.Duration
+	handlerErr error
+	ids        []string
+}
+type mockExecStats func(args testStatsArgs) ([][]*types.ContainerStats, error)
+
+func TestStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testStatsArgs
+		mockExecution mockExecStats
+	}{
+		"test_stats_no_errs": {
+			args: testStatsArgs{
+				ctx:      testCtx,
+				interval: 2 * time.Second,
+				ids:      []string{containerID},
+			},
+			mockExecution: mockExecStatsNoErrors,
+		},
+		"test_stats_open_stream_errs": {
+			args: testStatsArgs{
+				ctx:      testCtx,
+				interval: time.Second,
+			},
+			mockExecution: mockExecStatsOpenStreamErrors,
+		},
+		"test_stats_recv_errs": {
+			args: testStatsArgs{
+				ctx:      testCtx,
+				interval: time.Second,
+			},
+			mockExecution: mockExecStatsRecvErrors,
+		},
+		"test_stats_handler_errs": {
+			args: testStatsArgs{
+				ctx:        testCtx,
+				interval:   time.Second,
+				handlerErr: errors.New("handler failed"),
+			},
+			mockExecution: mockExecStatsHandlerErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedSamples, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			var samples [][]*types.ContainerStats
+			resultErr := testClient.Stats(testCase.args.ctx, testCase.args.interval, func(stats []*types.ContainerStats) error {
+				samples = append(samples, stats)
+				return testCase.args.handlerErr
+			}, testCase.args.ids...)
+
+			testutil.AssertEqual(t, expectedSamples, samples)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testEventsArgs struct {
+	ctx        context.Context
+	filter     *types.EventsFilter
+	handlerErr error
+}
+type mockExecEvents func(args testEventsArgs) ([]*types.Event, error)
+
+func TestEvents(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testEventsArgs
+		mockExecution mockExecEvents
+	}{
+		"test_events_no_errs": {
+			args: testEventsArgs{
+				ctx: testCtx,
+				filter: &types.EventsFilter{
+					IDs:     []string{containerID},
+					Names:   []string{containerName},
+					Actions: []types.EventAction{types.EventActionContainersCreated, types.EventActionContainersRemoved},
+					Since:   1767366245,
+					Until:   1767369845,
+				},
+			},
+			mockExecution: mockExecEventsNoErrors,
+		},
+		"test_events_nil_filter": {
+			args: testEventsArgs{
+				ctx: testCtx,
+			},
+			mockExecution: mockExecEventsNilFilter,
+		},
+		"test_events_open_stream_errs": {
+			args: testEventsArgs{
+				ctx: testCtx,
+			},
+			mockExecution: mockExecEventsOpenStreamErrors,
+		},
+		"test_events_recv_errs": {
+			args: testEventsArgs{
+				ctx: testCtx,
+			},
+			mockExecution: mockExecEventsRecvErrors,
+		},
+		"test_events_handler_errs": {
+			args: testEventsArgs{
+				ctx:        testCtx,
+				handlerErr: errors.New("handler failed"),
+			},
+			mockExecution: mockExecEventsHandlerErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedEvents, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			var events []*types.Event
+			resultErr := testClient.Events(testCase.args.ctx, testCase.args.filter, func(event *types.Event) error {
+				events = append(events, event)
+				return testCase.args.handlerErr
+			})
+
+			testutil.AssertEqual(t, expectedEvents, events)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+// Tests for client_io_util
+type testWriteArgs struct {
+	data   []byte
+	writer *Writer
+}
+type mockExecWrite func(args testWriteArgs) (int, error)
+
+func TestWriteNoErr(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+	writer := &Writer{
+		ctx:         testCtx,
+		writeClient: mockAttchClient,
+		containerID: containerID,
+		stdIn:       false,
+		offset:      0,
+		err:         nil,
+	}
+
+	tests := map[string]struct {
+		args          testWriteArgs
+		mockExecution mockExecWrite
+	}{
+		"test_write_no_errs": {
+			args: testWriteArgs{
+				data:   testBytes,
+				writer: writer,
+			},
+			mockExecution: mockExecWriteNoErrors,
+		},
+		// Causes unpredicted test behavior when, as it changes the internal states of the writers
+		/*		"test_write_errs": {
+				args: testWriteArgs{
+					data: testBytes,
+					writer: writer,
+				},
+				mockExecution: mockExecWriteErrors,
+			},*/
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedWriteResult, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			writeResult, resultErr := writer.Write(testBytes)
+
+			// assert container
+			testutil.AssertEqual(t, expectedWriteResult, writeResult)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+// Mock executions -------------------------------------------------------------
+// Create -------------------------------------------------------------
+func mockExecCreateNoErrors(args testCreateArgs) error {
+	pbResponse := &pbcontainers.CreateContainerResponse{}
+	mockContainersClient.EXPECT().Create(args.ctx, gomock.Eq(&pbcontainers.CreateContainerRequest{Container: protobuf.ToProtoContainer(args.ctr)})).Times(1).Return(pbResponse, nil)
+	return nil
+}
+
+func mockExecCreateErrors(args testCreateArgs) error {
+	err := errors.New("failed to create container")
+	mockContainersClient.EXPECT().Create(args.ctx, gomock.Eq(&pbcontainers.CreateContainerRequest{Container: protobuf.ToProtoContainer(args.ctr)})).Times(1).Return(nil, err)
+	return err
+}
+
+// Get -------------------------------------------------------------
+func mockExecGetNoErrors(args testGetArgs) (*types.Container, error) {
+	ctr := types.Container{
+		ID:    containerID,
+		Name:  containerName,
+		Image: types.Image{Name: containerImageID},
+	}
+	pbResponse := &pbcontainers.GetContainerResponse{
+		Container: protobuf.ToProtoContainer(&ctr),
+	}
+	mockContainersClient.EXPECT().Get(args.ctx, gomock.Eq(&pbcontainers.GetContainerRequest{
+		Id: args.ctrID,
+	})).Times(1).Return(pbResponse, nil)
+	return &ctr, nil
+}
+
+func mockExecGetErrors(args testGetArgs) (*types.Container, error) {
+	err := errors.New("failed to get contianer")
+	mockContainersClient.EXPECT().Get(args.ctx, gomock.Eq(&pbcontainers.GetContainerRequest{
+		Id: args.ctrID,
+	})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// List -------------------------------------------------------------
+func mockExecListNoFilterNoErrors(args testListArgs) ([]*types.Container, error) {
+	ctr := types.Container{
+		ID:    containerID,
+		Name:  containerName,
+		Image: types.Image{Name: containerImageID},
+	}
+	ctr2 := types.Container{
+		ID:    containerID2,
+		Name:  containerName2,
+		Image: types.Image{Name: containerImageID2},
+	}
+	pbResponse := &pbcontainers.ListContainersResponse{
+		Containers: []*containers.Container{protobuf.ToProtoContainer(&ctr), protobuf.ToProtoContainer(&ctr2)},
+	}
+	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
+	return []*types.Container{&ctr, &ctr2}, nil
+}
+
+func mockExecListFilterNoErrors(args testListArgs) ([]*types.Container, error) {
+	ctr := types.Container{
+		ID:    containerID,
+		Name:  containerName,
+		Image: types.Image{Name: containerImageID},
+	}
+	ctr2 := types.Container{
+		ID:    containerID2,
+		Name:  containerName2,
+		Image: types.Image{Name: containerImageID2},
+	}
+	pbResponse := &pbcontainers.ListContainersResponse{
+		Containers: []*containers.Container{protobuf.ToProtoContainer(&ctr), protobuf.ToProtoContainer(&ctr2)},
+	}
+	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
+	return []*types.Container{&ctr2}, nil
+}
+
+func mockExecListNoContainersNoErrors(args testListArgs) ([]*types.Container, error) {
+	pbResponse := &pbcontainers.ListContainersResponse{
+		Containers: nil,
+	}
+	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(pbResponse, nil)
+	return nil, nil
+}
+
+func mockExecListErrors(args testListArgs) ([]*types.Container, error) {
+	err := errors.New("failed to list containers")
+	mockContainersClient.EXPECT().List(args.ctx, gomock.Eq(&pbcontainers.ListContainersRequest{})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// Start -------------------------------------------------------------
+func mockExecStartNoErrors(args testStartArgs) error {
+	mockContainersClient.EXPECT().Start(args.ctx, gomock.Eq(&pbcontainers.StartContainerRequest{Id: args.id})).Times(1).Return(nil, nil)
+	return nil
+}
+
+func mockExecStartErrors(args testStartArgs) error {
+	err := errors.New("failed to start")
+	mockContainersClient.EXPECT().Start(args.ctx, gomock.Eq(&pbcontainers.StartContainerRequest{Id: args.id})).Times(1).Return(nil, err)
+	return err
+}
+
+// Stop -------------------------------------------------------------
+func mockExecStopNoErrors(args testStopArgs) error {
+	mockContainersClient.EXPECT().Stop(args.ctx, gomock.Eq(&pbcontainers.StopContainerRequest{
+		Id: args.id,
+		StopOptions: &containers.StopOptions{
+			Timeout: args.stopOpts.Timeout,
+			Force:   args.stopOpts.Force,
+		},
+	})).Times(1).Return(nil, nil)
+	return nil
+}
+
+func mockExecStopErrors(args testStopArgs) error {
+	err := errors.New("failed to stop")
+	mockContainersClient.EXPECT().Stop(args.ctx, gomock.Eq(&pbcontainers.StopContainerRequest{
+		Id: args.id,
+		StopOptions: &containers.StopOptions{
+			Timeout: args.stopOpts.Timeout,
+			Force:   args.stopOpts.Force,
+		},
+	})).Times(1).Return(nil, err)
+	return err
+}
+
+// Attach -------------------------------------------------------------
+func mockExecAttachNoErrors(args testAttachArgs) (io.Writer, io.ReadCloser, error) {
+	mockContainersClient.EXPECT().Attach(args.ctx).Times(1).Return(mockAttchClient, nil)
+	mockAttchClient.EXPECT().Send(gomock.Eq(&pbcontainers.AttachContainerRequest{
 		Id:          args.id,
 		StdIn:       args.stdin,
 		DataToWrite: nil,
 	})).Times(1).Return(nil)
 	return &Writer{
-			ctx:         args.ctx,
-			writeClient: mockAttchClient,
-			containerID: args.id,
-			stdIn:       args.stdin,
-		}, &Reader{
-			ctx:         args.ctx,
-			containerID: args.id,
-			stdIn:       args.stdin,
-			readClient:  mockAttchClient,
-		}, nil
+		ctx:         args.ctx,
+		writeClient: mockAttchClient,
+		containerID: args.id,
+		stdIn:       args.stdin,
+	}, &Reader{
+		ctx:         args.ctx,
+		containerID: args.id,
+		stdIn:       args.stdin,
+		readClient:  mockAttchClient,
+	}, nil
 }
 func mockExecAttachError(args testAttachArgs) (io.Writer, io.ReadCloser, error) {
 	err := errors.New("failed to attach")
@@ -914,6 +1899,46 @@ func mockExecAttachError(args testAttachArgs) (io.Writer, io.ReadCloser, error)
 	return nil, nil, err
 }
 
+// Exec -------------------------------------------------------------
+func mockExecExecStart(args testExecArgs) {
+	mockContainersClient.EXPECT().Exec(args.ctx).Times(1).Return(mockExecClient, nil)
+	mockExecClient.EXPECT().Send(gomock.Eq(&pbcontainers.ExecContainerRequest{
+		Id:         args.id,
+		ExecConfig: protobuf.ToProtoExecConfig(args.execConfig),
+	})).Times(1).Return(nil)
+	mockExecClient.EXPECT().Send(gomock.Eq(&pbcontainers.ExecContainerRequest{FinishWrite: true})).Times(1).Return(nil)
+	mockExecClient.EXPECT().CloseSend().Times(1).Return(nil)
+}
+
+func mockExecExecNoErrors(args testExecArgs) ([]byte, int64, error) {
+	mockExecExecStart(args)
+	gomock.InOrder(
+		mockExecClient.EXPECT().Recv().Times(1).Return(&pbcontainers.ExecContainerResponse{Id: args.id, ReadData: testBytes}, nil),
+		mockExecClient.EXPECT().Recv().Times(1).Return(&pbcontainers.ExecContainerResponse{Id: args.id, Exited: true, ExitCode: 1}, nil),
+	)
+	return testBytes, 1, nil
+}
+
+func mockExecExecError(args testExecArgs) ([]byte, int64, error) {
+	err := errors.New("failed to exec")
+	mockContainersClient.EXPECT().Exec(args.ctx).Times(1).Return(nil, err)
+	mockExecClient.EXPECT().Send(gomock.Any()).Times(0)
+	return nil, -1, err
+}
+
+func mockExecExecRecvError(args testExecArgs) ([]byte, int64, error) {
+	err := errors.New("failed to receive")
+	mockExecExecStart(args)
+	mockExecClient.EXPECT().Recv().Times(1).Return(nil, err)
+	return nil, -1, err
+}
+
+func mockExecExecStreamClosed(args testExecArgs) ([]byte, int64, error) {
+	mockExecExecStart(args)
+	mockExecClient.EXPECT().Recv().Times(1).Return(nil, io.EOF)
+	return nil, -1, fmt.Errorf("the exec stream for container %s was closed before the process exited", args.id)
+}
+
 // Restart -------------------------------------------------------------
 func mockExecRestartNoErrors(args testRestartArgs) error {
 	mockContainersClient.EXPECT().Restart(args.ctx, gomock.Eq(&pbcontainers.RestartContainerRequest{
@@ -1028,6 +2053,378 @@ func mockExecRemoveErrors(args testRemoveArgs) error {
 	return err
 }
 
+// Checkpoint -------------------------------------------------------------
+func mockExecCheckpointNoErrors(args testCheckpointArgs) error {
+	mockContainersClient.EXPECT().Checkpoint(args.ctx, gomock.Eq(&pbcontainers.CheckpointContainerRequest{
+		Id:                args.id,
+		CheckpointOptions: protobuf.ToProtoCheckpointOptions(args.checkpointOpts),
+	})).Times(1).Return(nil, nil)
+	return nil
+}
+
+func mockExecCheckpointErrors(args testCheckpointArgs) error {
+	err := errors.New("failed to checkpoint")
+	mockContainersClient.EXPECT().Checkpoint(args.ctx, gomock.Eq(&pbcontainers.CheckpointContainerRequest{
+		Id:                args.id,
+		CheckpointOptions: protobuf.ToProtoCheckpointOptions(args.checkpointOpts),
+	})).Times(1).Return(nil, err)
+	return err
+}
+
+// ListCheckpoints -------------------------------------------------------------
+func mockExecListCheckpointsNoErrors(args testListCheckpointsArgs) ([]*types.Checkpoint, error) {
+	mockContainersClient.EXPECT().ListCheckpoints(args.ctx, gomock.Eq(&pbcontainers.ListCheckpointsRequest{
+		Id: args.id,
+	})).Times(1).Return(&pbcontainers.ListCheckpointsResponse{
+		Checkpoints: []*containers.Checkpoint{{Id: checkpointID, Created: checkpointCreated}},
+	}, nil)
+	return []*types.Checkpoint{{ID: checkpointID, Created: checkpointCreated}}, nil
+}
+
+func mockExecListCheckpointsErrors(args testListCheckpointsArgs) ([]*types.Checkpoint, error) {
+	err := errors.New("failed to list checkpoints")
+	mockContainersClient.EXPECT().ListCheckpoints(args.ctx, gomock.Eq(&pbcontainers.ListCheckpointsRequest{
+		Id: args.id,
+	})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// RemoveCheckpoint -------------------------------------------------------------
+func mockExecRemoveCheckpointNoErrors(args testCheckpointIDArgs) error {
+	mockContainersClient.EXPECT().RemoveCheckpoint(args.ctx, gomock.Eq(&pbcontainers.RemoveCheckpointRequest{
+		Id:           args.id,
+		CheckpointId: args.checkpointID,
+	})).Times(1).Return(nil, nil)
+	return nil
+}
+
+func mockExecRemoveCheckpointErrors(args testCheckpointIDArgs) error {
+	err := errors.New("failed to remove checkpoint")
+	mockContainersClient.EXPECT().RemoveCheckpoint(args.ctx, gomock.Eq(&pbcontainers.RemoveCheckpointRequest{
+		Id:           args.id,
+		CheckpointId: args.checkpointID,
+	})).Times(1).Return(nil, err)
+	return err
+}
+
+// Restore -------------------------------------------------------------
+func mockExecRestoreNoErrors(args testCheckpointIDArgs) error {
+	mockContainersClient.EXPECT().Restore(args.ctx, gomock.Eq(&pbcontainers.RestoreContainerRequest{
+		Id:           args.id,
+		CheckpointId: args.checkpointID,
+	})).Times(1).Return(nil, nil)
+	return nil
+}
+
+func mockExecRestoreErrors(args testCheckpointIDArgs) error {
+	err := errors.New("failed to restore")
+	mockContainersClient.EXPECT().Restore(args.ctx, gomock.Eq(&pbcontainers.RestoreContainerRequest{
+		Id:           args.id,
+		CheckpointId: args.checkpointID,
+	})).Times(1).Return(nil, err)
+	return err
+}
+
+// Metrics -------------------------------------------------------------
+func mockExecMetricsNoErrors(args testMetricsArgs) (*types.Metrics, error) {
+	mockContainersClient.EXPECT().Metrics(args.ctx, gomock.Eq(&pbcontainers.GetMetricsRequest{
+		Id: args.id,
+	})).Times(1).Return(&pbcontainers.GetMetricsResponse{
+		Metrics: &containers.Metrics{Cpu: &containers.CPUStats{Used: 100, Total: 1000}, Pids: 3},
+	}, nil)
+	return &types.Metrics{CPU: &types.CPUStats{Used: 100, Total: 1000}, PIDs: 3}, nil
+}
+
+func mockExecMetricsNotRunning(args testMetricsArgs) (*types.Metrics, error) {
+	mockContainersClient.EXPECT().Metrics(args.ctx, gomock.Eq(&pbcontainers.GetMetricsRequest{
+		Id: args.id,
+	})).Times(1).Return(&pbcontainers.GetMetricsResponse{}, nil)
+	return nil, nil
+}
+
+func mockExecMetricsErrors(args testMetricsArgs) (*types.Metrics, error) {
+	err := errors.New("failed to get metrics")
+	mockContainersClient.EXPECT().Metrics(args.ctx, gomock.Eq(&pbcontainers.GetMetricsRequest{
+		Id: args.id,
+	})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// Stats -------------------------------------------------------------
+func mockExecStatsOpen(args testStatsArgs) {
+	mockContainersClient.EXPECT().Stats(args.ctx, gomock.Eq(&pbcontainers.StatsRequest{
+		Ids:      args.ids,
+		Interval: int64(args.interval.Seconds()),
+	})).Times(1).Return(mockStatsClient, nil)
+}
+
+func mockExecStatsNoErrors(args testStatsArgs) ([][]*types.ContainerStats, error) {
+	mockExecStatsOpen(args)
+	gomock.InOrder(
+		mockStatsClient.EXPECT().Recv().Times(1).Return(&pbcontainers.StatsResponse{
+			Stats: []*containers.ContainerStats{{Id: containerID, Name: containerName, Metrics: &containers.Metrics{Pids: 3}}},
+		}, nil),
+		mockStatsClient.EXPECT().Recv().Times(1).Return(nil, io.EOF),
+	)
+	return [][]*types.ContainerStats{{{ID: containerID, Name: containerName, Metrics: &types.Metrics{PIDs: 3}}}}, nil
+}
+
+func mockExecStatsOpenStreamErrors(args testStatsArgs) ([][]*types.ContainerStats, error) {
+	err := errors.New("failed to open stream")
+	mockContainersClient.EXPECT().Stats(args.ctx, gomock.Any()).Times(1).Return(nil, err)
+	return nil, fmt.Errorf("error while opening stream: %s", err)
+}
+
+func mockExecStatsRecvErrors(args testStatsArgs) ([][]*types.ContainerStats, error) {
+	err := errors.New("failed to receive")
+	mockExecStatsOpen(args)
+	mockStatsClient.EXPECT().Recv().Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecStatsHandlerErrors(args testStatsArgs) ([][]*types.ContainerStats, error) {
+	mockExecStatsOpen(args)
+	mockStatsClient.EXPECT().Recv().Times(1).Return(&pbcontainers.StatsResponse{}, nil)
+	return [][]*types.ContainerStats{nil}, args.handlerErr
+}
+
+// Events -------------------------------------------------------------
+func mockExecEventsNoErrors(args testEventsArgs) ([]*types.Event, error) {
+	mockContainersClient.EXPECT().Events(args.ctx, gomock.Eq(&pbcontainers.EventsRequest{
+		Ids:     args.filter.IDs,
+		Names:   args.filter.Names,
+		Actions: []string{string(types.EventActionContainersCreated), string(types.EventActionContainersRemoved)},
+		Since:   args.filter.Since,
+		Until:   args.filter.Until,
+	})).Times(1).Return(mockEventsClient, nil)
+	gomock.InOrder(
+		mockEventsClient.EXPECT().Recv().Times(1).Return(&pbcontainers.EventsResponse{
+			Event: &containers.Event{Type: string(types.EventTypeContainers), Action: string(types.EventActionContainersCreated), Source: &containers.Container{Id: containerID, Name: containerName, Image: &containers.Image{Name: containerImageID}}, Time: 1767366245},
+		}, nil),
+		mockEventsClient.EXPECT().Recv().Times(1).Return(nil, io.EOF),
+	)
+	return []*types.Event{{Type: types.EventTypeContainers, Action: types.EventActionContainersCreated, Source: types.Container{ID: containerID, Name: containerName, Image: types.Image{Name: containerImageID}}, Time: 1767366245}}, nil
+}
+
+func mockExecEventsNilFilter(args testEventsArgs) ([]*types.Event, error) {
+	mockContainersClient.EXPECT().Events(args.ctx, gomock.Eq(&pbcontainers.EventsRequest{})).Times(1).Return(mockEventsClient, nil)
+	mockEventsClient.EXPECT().Recv().Times(1).Return(nil, io.EOF)
+	return nil, nil
+}
+
+func mockExecEventsOpenStreamErrors(args testEventsArgs) ([]*types.Event, error) {
+	err := errors.New("failed to open stream")
+	mockContainersClient.EXPECT().Events(args.ctx, gomock.Any()).Times(1).Return(nil, err)
+	return nil, fmt.Errorf("error while opening stream: %s", err)
+}
+
+func mockExecEventsRecvErrors(args testEventsArgs) ([]*types.Event, error) {
+	err := errors.New("failed to receive")
+	mockContainersClient.EXPECT().Events(args.ctx, gomock.Any()).Times(1).Return(mockEventsClient, nil)
+	mockEventsClient.EXPECT().Recv().Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecEventsHandlerErrors(args testEventsArgs) ([]*types.Event, error) {
+	mockContainersClient.EXPECT().Events(args.ctx, gomock.Any()).Times(1).Return(mockEventsClient, nil)
+	mockEventsClient.EXPECT().Recv().Times(1).Return(&pbcontainers.EventsResponse{}, nil)
+	return []*types.Event{nil}, args.handlerErr
+}
+
+// Images -------------------------------------------------------------
+var testPbImageInfo = &typesImages.ImageInfo{
+	Name:       containerImageID,
+	Digest:     "sha256:1f1e11e4b5ecad1c45f3d2f1a7d3e0c9b7f8d4c3b2a1e0f9d8c7b6a5f4e3d2c1",
+	Size:       1024,
+	Platform:   "linux/arm64",
+	Containers: []string{containerID},
+}
+
+func mockExecListImagesNoErrors(args testListImagesArgs) ([]*imagestypes.ImageInfo, error) {
+	mockImagesClient.EXPECT().List(args.ctx, gomock.Eq(&pbimages.ListImagesRequest{})).Times(1).Return(&pbimages.ListImagesResponse{
+		Images: []*typesImages.ImageInfo{testPbImageInfo},
+	}, nil)
+	return []*imagestypes.ImageInfo{protobuf.ToInternalImageInfo(testPbImageInfo)}, nil
+}
+
+func mockExecListImagesErrors(args testListImagesArgs) ([]*imagestypes.ImageInfo, error) {
+	err := errors.New("failed to list images")
+	mockImagesClient.EXPECT().List(args.ctx, gomock.Eq(&pbimages.ListImagesRequest{})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecGetImageNoErrors(args testImageRefArgs) (*imagestypes.ImageInfo, error) {
+	mockImagesClient.EXPECT().Get(args.ctx, gomock.Eq(&pbimages.GetImageRequest{Name: args.imageRef})).Times(1).Return(&pbimages.GetImageResponse{
+		Image: testPbImageInfo,
+	}, nil)
+	return protobuf.ToInternalImageInfo(testPbImageInfo), nil
+}
+
+func mockExecGetImageErrors(args testImageRefArgs) (*imagestypes.ImageInfo, error) {
+	err := errors.New("failed to get image")
+	mockImagesClient.EXPECT().Get(args.ctx, gomock.Eq(&pbimages.GetImageRequest{Name: args.imageRef})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecPullImageNoErrors(args testPullImageArgs) (*imagestypes.ImageInfo, error) {
+	mockImagesClient.EXPECT().Pull(args.ctx, gomock.Eq(&pbimages.PullImageRequest{Image: protobuf.ToProtoImage(&args.image)})).Times(1).Return(&pbimages.PullImageResponse{
+		Image: testPbImageInfo,
+	}, nil)
+	return protobuf.ToInternalImageInfo(testPbImageInfo), nil
+}
+
+func mockExecPullImageErrors(args testPullImageArgs) (*imagestypes.ImageInfo, error) {
+	err := errors.New("failed to pull image")
+	mockImagesClient.EXPECT().Pull(args.ctx, gomock.Eq(&pbimages.PullImageRequest{Image: protobuf.ToProtoImage(&args.image)})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecRemoveImageNoErrors(args testImageRefArgs) error {
+	mockImagesClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbimages.RemoveImageRequest{Name: args.imageRef})).Times(1).Return(&empty.Empty{}, nil)
+	return nil
+}
+
+func mockExecRemoveImageErrors(args testImageRefArgs) error {
+	err := errors.New("failed to remove image")
+	mockImagesClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbimages.RemoveImageRequest{Name: args.imageRef})).Times(1).Return(nil, err)
+	return err
+}
+
+// Volumes -------------------------------------------------------------
+var testPbVolume = &typesVolumes.Volume{
+	Name:       volumeName,
+	Driver:     "tmpfs",
+	Size:       "64M",
+	Mountpoint: "/var/lib/container-management/volumes/test-volume/_data",
+	Containers: []string{containerID},
+}
+
+func mockExecCreateVolumeNoErrors(args testCreateVolumeArgs) (*volumestypes.Volume, error) {
+	request := &pbvolumes.CreateVolumeRequest{Name: args.name}
+	if args.opts != nil {
+		request.Driver = args.opts.Driver
+		request.Size = args.opts.Size
+	}
+	mockVolumesClient.EXPECT().Create(args.ctx, gomock.Eq(request)).Times(1).Return(&pbvolumes.CreateVolumeResponse{
+		Volume: testPbVolume,
+	}, nil)
+	return protobuf.ToInternalVolume(testPbVolume), nil
+}
+
+func mockExecCreateVolumeErrors(args testCreateVolumeArgs) (*volumestypes.Volume, error) {
+	err := errors.New("failed to create volume")
+	mockVolumesClient.EXPECT().Create(args.ctx, gomock.Eq(&pbvolumes.CreateVolumeRequest{Name: args.name})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecListVolumesNoErrors(args testListVolumesArgs) ([]*volumestypes.Volume, error) {
+	mockVolumesClient.EXPECT().List(args.ctx, gomock.Eq(&pbvolumes.ListVolumesRequest{})).Times(1).Return(&pbvolumes.ListVolumesResponse{
+		Volumes: []*typesVolumes.Volume{testPbVolume},
+	}, nil)
+	return []*volumestypes.Volume{protobuf.ToInternalVolume(testPbVolume)}, nil
+}
+
+func mockExecListVolumesErrors(args testListVolumesArgs) ([]*volumestypes.Volume, error) {
+	err := errors.New("failed to list volumes")
+	mockVolumesClient.EXPECT().List(args.ctx, gomock.Eq(&pbvolumes.ListVolumesRequest{})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecGetVolumeNoErrors(args testVolumeNameArgs) (*volumestypes.Volume, error) {
+	mockVolumesClient.EXPECT().Get(args.ctx, gomock.Eq(&pbvolumes.GetVolumeRequest{Name: args.name})).Times(1).Return(&pbvolumes.GetVolumeResponse{
+		Volume: testPbVolume,
+	}, nil)
+	return protobuf.ToInternalVolume(testPbVolume), nil
+}
+
+func mockExecGetVolumeErrors(args testVolumeNameArgs) (*volumestypes.Volume, error) {
+	err := errors.New("failed to get volume")
+	mockVolumesClient.EXPECT().Get(args.ctx, gomock.Eq(&pbvolumes.GetVolumeRequest{Name: args.name})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecRemoveVolumeNoErrors(args testVolumeNameArgs) error {
+	mockVolumesClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbvolumes.RemoveVolumeRequest{Name: args.name})).Times(1).Return(&empty.Empty{}, nil)
+	return nil
+}
+
+func mockExecRemoveVolumeErrors(args testVolumeNameArgs) error {
+	err := errors.New("failed to remove volume")
+	mockVolumesClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbvolumes.RemoveVolumeRequest{Name: args.name})).Times(1).Return(nil, err)
+	return err
+}
+
+// Networks -------------------------------------------------------------
+var testPbNetwork = &typesNetworks.Network{
+	Name:         networkName,
+	Id:           "test-network-id",
+	Driver:       "bridge",
+	Subnet:       "10.10.0.0/24",
+	Gateway:      "10.10.0.1",
+	Mtu:          1400,
+	Icc:          true,
+	IpMasquerade: true,
+	Containers:   []string{containerID},
+}
+
+func mockExecCreateNetworkNoErrors(args testCreateNetworkArgs) (*networktypes.Network, error) {
+	request := &pbnetworks.CreateNetworkRequest{Name: args.name}
+	if args.opts != nil {
+		request.Subnet = args.opts.Subnet
+		request.Gateway = args.opts.Gateway
+		request.Mtu = int64(args.opts.MTU)
+		request.DisableIcc = args.opts.DisableICC
+		request.DisableIpMasquerade = args.opts.DisableIPMasquerade
+	}
+	mockNetworksClient.EXPECT().Create(args.ctx, gomock.Eq(request)).Times(1).Return(&pbnetworks.CreateNetworkResponse{
+		Network: testPbNetwork,
+	}, nil)
+	return protobuf.ToInternalNetwork(testPbNetwork), nil
+}
+
+func mockExecCreateNetworkErrors(args testCreateNetworkArgs) (*networktypes.Network, error) {
+	err := errors.New("failed to create network")
+	mockNetworksClient.EXPECT().Create(args.ctx, gomock.Eq(&pbnetworks.CreateNetworkRequest{Name: args.name})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecListNetworksNoErrors(args testListNetworksArgs) ([]*networktypes.Network, error) {
+	mockNetworksClient.EXPECT().List(args.ctx, gomock.Eq(&pbnetworks.ListNetworksRequest{})).Times(1).Return(&pbnetworks.ListNetworksResponse{
+		Networks: []*typesNetworks.Network{testPbNetwork},
+	}, nil)
+	return []*networktypes.Network{protobuf.ToInternalNetwork(testPbNetwork)}, nil
+}
+
+func mockExecListNetworksErrors(args testListNetworksArgs) ([]*networktypes.Network, error) {
+	err := errors.New("failed to list networks")
+	mockNetworksClient.EXPECT().List(args.ctx, gomock.Eq(&pbnetworks.ListNetworksRequest{})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecGetNetworkNoErrors(args testNetworkNameArgs) (*networktypes.Network, error) {
+	mockNetworksClient.EXPECT().Get(args.ctx, gomock.Eq(&pbnetworks.GetNetworkRequest{Name: args.name})).Times(1).Return(&pbnetworks.GetNetworkResponse{
+		Network: testPbNetwork,
+	}, nil)
+	return protobuf.ToInternalNetwork(testPbNetwork), nil
+}
+
+func mockExecGetNetworkErrors(args testNetworkNameArgs) (*networktypes.Network, error) {
+	err := errors.New("failed to get network")
+	mockNetworksClient.EXPECT().Get(args.ctx, gomock.Eq(&pbnetworks.GetNetworkRequest{Name: args.name})).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecRemoveNetworkNoErrors(args testNetworkNameArgs) error {
+	mockNetworksClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbnetworks.RemoveNetworkRequest{Name: args.name})).Times(1).Return(&empty.Empty{}, nil)
+	return nil
+}
+
+func mockExecRemoveNetworkErrors(args testNetworkNameArgs) error {
+	err := errors.New("failed to remove network")
+	mockNetworksClient.EXPECT().Remove(args.ctx, gomock.Eq(&pbnetworks.RemoveNetworkRequest{Name: args.name})).Times(1).Return(nil, err)
+	return err
+}
+
 // ProjectInfo -------------------------------------------------------------
 func mockExecProjectInfoNoErrors(args testProjectInfoArgs) (sysinfotypes.ProjectInfo, error) {
 	pbresponse := &sysinfo.ProjectInfoResponse{
@@ -1045,6 +2442,27 @@ func mockExecProjectInfoErrors(args testProjectInfoArgs) (sysinfotypes.ProjectIn
 	return sysinfotypes.ProjectInfo{}, err
 }
 
+func mockExecRuntimeInfoNoErrors(args testRuntimeInfoArgs) (sysinfotypes.RuntimeInfo, error) {
+	pbresponse := &sysinfo.RuntimeInfoResponse{
+		RuntimeInfo: &typesSysInfo.RuntimeInfo{
+			DefaultRuntimeHandler: "runc",
+			RuntimeHandlers: []*typesSysInfo.RuntimeHandler{{
+				Name:        "runc",
+				RuntimeType: string(types.RuntimeTypeV2runcV2),
+				Options:     &typesSysInfo.RuntimeOptions{BinaryName: "runc"},
+			}},
+		},
+	}
+	mockSysInfoClient.EXPECT().RuntimeInfo(args.ctx, gomock.Eq(&empty.Empty{})).Times(1).Return(pbresponse, nil)
+	return protobuf.ToInternalRuntimeInfo(pbresponse.RuntimeInfo), nil
+}
+
+func mockExecRuntimeInfoErrors(args testRuntimeInfoArgs) (sysinfotypes.RuntimeInfo, error) {
+	err := errors.New("failed to get runtime info")
+	mockSysInfoClient.EXPECT().RuntimeInfo(args.ctx, gomock.Eq(&empty.Empty{})).Times(1).Return(nil, err)
+	return sysinfotypes.RuntimeInfo{}, err
+}
+
 // Write ------------------------------------------------------------
 
 func mockExecWriteNoErrors(args testWriteArgs) (int, error) {
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// CheckpointOpts represent options for creating a checkpoint of a running container.
+type CheckpointOpts struct {
+
+	// CheckpointID is the unique identifier of the checkpoint among the container's checkpoints.
+	CheckpointID string `json:"checkpoint_id"`
+
+	// Exit determines whether the container will be stopped once the checkpoint is created.
+	Exit bool `json:"exit,omitempty"`
+}
+
+// Checkpoint represents a stored checkpoint of a container's state.
+type Checkpoint struct {
+
+	// ID is the unique identifier of the checkpoint among the container's checkpoints.
+	ID string `json:"id"`
+
+	// Created defines the time when the checkpoint was created
+	Created string `json:"created"`
+}
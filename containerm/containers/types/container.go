@@ -48,6 +48,8 @@ type Container struct {
 	IOConfig *IOConfig `json:"io_config"`
 	// NetworkSettings is the network settings for the container
 	NetworkSettings *NetworkSettings `json:"network_settings"`
+	// HealthCheck is the configuration of the probe that checks the container's health
+	HealthCheck *HealthCheckConfig `json:"health_check,omitempty"`
 	// State is the container's state
 	State *State `json:"state"`
 	// Created is the time of the container's creation
@@ -12,8 +12,13 @@
 
 package types
 
-// ContainerConfiguration holds environment variables for the containers
+// ContainerConfiguration holds the configuration of the container's process and its metadata labels
 type ContainerConfiguration struct {
-	Env []string `json:"env,omitempty"`
-	Cmd []string `json:"cmd,omitempty"`
+	Env        []string          `json:"env,omitempty"`
+	Cmd        []string          `json:"cmd,omitempty"`
+	Entrypoint []string          `json:"entrypoint,omitempty"`
+	WorkingDir string            `json:"working_dir,omitempty"`
+	User       string            `json:"user,omitempty"`
+	Groups     []string          `json:"groups,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
 }
@@ -14,9 +14,11 @@ package types
 
 // EndpointSettings represents an endpoint settings for connecting to a specific network
 type EndpointSettings struct {
-	ID         string `json:"id,omitempty"`
-	Gateway    string `json:"gateway"`
-	IPAddress  string `json:"ip_address"`
-	MacAddress string `json:"mac_address"`
-	NetworkID  string `json:"network_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Gateway     string `json:"gateway"`
+	IPAddress   string `json:"ip_address"`
+	MacAddress  string `json:"mac_address"`
+	NetworkID   string `json:"network_id,omitempty"`
+	IPv6Gateway string `json:"ipv6_gateway,omitempty"`
+	IPv6Address string `json:"ipv6_address,omitempty"`
 }
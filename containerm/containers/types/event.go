@@ -43,6 +43,8 @@ const (
 	EventActionContainersRenamed EventAction = "renamed"
 	// EventActionContainersUpdated is used when a container is updated
 	EventActionContainersUpdated EventAction = "updated"
+	// EventActionContainersHealthChanged is used when a container's health status is changed
+	EventActionContainersHealthChanged EventAction = "health_changed"
 	// EventActionContainersUnknown is used when an unknown action has been performed
 	EventActionContainersUnknown EventAction = "unknown"
 )
@@ -58,3 +60,17 @@ type Event struct {
 	// time
 	Time int64 `json:"time,omitempty"`
 }
+
+// EventsFilter represents the criteria an event must match in order to be received
+type EventsFilter struct {
+	// the IDs of the containers - all containers match if empty
+	IDs []string
+	// the names of the containers - all containers match if empty
+	Names []string
+	// the actions of the events - all actions match if empty
+	Actions []EventAction
+	// the earliest time of the events as Unix time in seconds - not applied if 0
+	Since int64
+	// the latest time of the events as Unix time in seconds - not applied if 0
+	Until int64
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// ExecConfig represents the configuration of a process executed inside a running container.
+type ExecConfig struct {
+
+	// Cmd is the command to be executed along with its arguments.
+	Cmd []string `json:"cmd"`
+
+	// Env holds additional environment variables for the process in the form of VAR=value.
+	Env []string `json:"env,omitempty"`
+
+	// WorkingDir is the working directory of the process. The one of the container's root process is used if not set.
+	WorkingDir string `json:"working_dir,omitempty"`
+
+	// Tty determines whether a TTY is to be allocated for the process.
+	Tty bool `json:"tty,omitempty"`
+
+	// OpenStdin determines whether the process' STDIN is to be kept open.
+	OpenStdin bool `json:"open_stdin,omitempty"`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+import "time"
+
+// HealthCheckType represents the type of probe used for checking a container's health
+type HealthCheckType string
+
+// constants for the supported health check types
+const (
+	// HealthCheckExec checks the container's health by executing a command inside it - the container is healthy if the command exits with 0
+	HealthCheckExec HealthCheckType = "exec"
+	// HealthCheckHTTP checks the container's health by performing an HTTP GET request - the container is healthy if the response status code is 2xx or 3xx
+	HealthCheckHTTP HealthCheckType = "http"
+	// HealthCheckTCP checks the container's health by opening a TCP connection - the container is healthy if the connection is established
+	HealthCheckTCP HealthCheckType = "tcp"
+)
+
+// HealthCheckConfig represents the configuration of the probe that checks a container's health
+type HealthCheckConfig struct {
+	// Type is the type of the probe
+	Type HealthCheckType `json:"type"`
+
+	// Cmd is the command to be executed inside the container along with its arguments - applicable for exec probes only
+	Cmd []string `json:"cmd,omitempty"`
+
+	// Port is the container port to be probed - applicable for HTTP and TCP probes only
+	Port int `json:"port,omitempty"`
+
+	// Path is the path of the HTTP GET request - applicable for HTTP probes only
+	Path string `json:"path,omitempty"`
+
+	// Interval is the time between two consecutive probes
+	Interval time.Duration `json:"interval"`
+
+	// Timeout is the time after which a single probe is considered failed
+	Timeout time.Duration `json:"timeout"`
+
+	// Retries is the number of consecutive failed probes needed to consider the container unhealthy
+	Retries int `json:"retries"`
+
+	// StartPeriod is the initialization time of the container during which failed probes are not counted
+	StartPeriod time.Duration `json:"start_period"`
+}
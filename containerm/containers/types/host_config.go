@@ -18,11 +18,21 @@ type NetworkMode string
 // Runtime represents the runtime for the container
 type Runtime string
 
+// UsernsMode represents the user namespace mode for the container
+type UsernsMode string
+
+// NamespaceMode represents the IPC or PID namespace mode for the container
+type NamespaceMode string
+
 const (
 	// NetworkModeBridge means that the container is connected to the default bridge network interface of the engine and is assigned an IP
 	NetworkModeBridge NetworkMode = "bridge"
 	// NetworkModeHost means that the container shares the network stack of the host
 	NetworkModeHost NetworkMode = "host"
+	// NetworkModeNone means that the container has its own network stack with the loopback interface only
+	NetworkModeNone NetworkMode = "none"
+	// NetworkModeContainerPrefix is the prefix of the network mode container:<name|id> which means that the container shares the network stack of another container
+	NetworkModeContainerPrefix = "container:"
 
 	// RuntimeTypeV1 is the runtime type name for containerd shim interface v1 version.
 	RuntimeTypeV1 Runtime = "io.containerd.runtime.v1.linux"
@@ -34,18 +44,49 @@ const (
 	RuntimeTypeV2runcV1 Runtime = "io.containerd.runc.v1"
 	// RuntimeTypeV2runcV2 is the version 2 runtime type name for runc containerd shim implement the shim v2 api.
 	RuntimeTypeV2runcV2 Runtime = "io.containerd.runc.v2"
+
+	// CapabilityAll is the keyword for all capabilities in the list of dropped capabilities
+	CapabilityAll = "ALL"
+
+	// UsernsModeHost means that the container shares the user namespace of the host, i.e. its root user is the host's root user
+	UsernsModeHost UsernsMode = "host"
+	// UsernsModeRemap means that the container has its own user namespace with its user and group IDs remapped to the subordinate ranges of the engine
+	UsernsModeRemap UsernsMode = "remap"
+
+	// NamespaceModePrivate means that the container has its own namespace, this is the default
+	NamespaceModePrivate NamespaceMode = "private"
+	// NamespaceModeHost means that the container shares the namespace of the host
+	NamespaceModeHost NamespaceMode = "host"
+	// NamespaceModeContainerPrefix is the prefix of the namespace mode container:<name|id> which means that the container shares the namespace of another container
+	NamespaceModeContainerPrefix = "container:"
 )
 
 // HostConfig defines the resources, behavior, etc. that the host must manage on the container
 type HostConfig struct {
-	Devices           []DeviceMapping   `json:"devices"`
-	NetworkMode       NetworkMode       `json:"network_mode"`
-	Privileged        bool              `json:"privileged"`
-	RestartPolicy     *RestartPolicy    `json:"restart_policy"`
-	Runtime           Runtime           `json:"runtime"`
-	ExtraHosts        []string          `json:"extra_hosts"`
-	ExtraCapabilities []string          `json:"extra_capabilities"`
-	PortMappings      []PortMapping     `json:"port_mappings"`
-	LogConfig         *LogConfiguration `json:"log_config"`
-	Resources         *Resources        `json:"resources"`
+	Devices             []DeviceMapping            `json:"devices"`
+	NetworkMode         NetworkMode                `json:"network_mode"`
+	Privileged          bool                       `json:"privileged"`
+	RestartPolicy       *RestartPolicy             `json:"restart_policy"`
+	Runtime             Runtime                    `json:"runtime"`
+	ExtraHosts          []string                   `json:"extra_hosts"`
+	ExtraCapabilities   []string                   `json:"extra_capabilities"`
+	DroppedCapabilities []string                   `json:"dropped_capabilities"`
+	PortMappings        []PortMapping              `json:"port_mappings"`
+	LogConfig           *LogConfiguration          `json:"log_config"`
+	Resources           *Resources                 `json:"resources"`
+	ReadOnlyRootfs      bool                       `json:"read_only_rootfs"`
+	Networks            []string                   `json:"networks"`
+	EndpointsConfig     map[string]*EndpointConfig `json:"endpoints_config"`
+	NetworkPolicy       *NetworkPolicy             `json:"network_policy"`
+	DNS                 []string                   `json:"dns"`
+	DNSSearch           []string                   `json:"dns_search"`
+	DNSOptions          []string                   `json:"dns_options"`
+	SecurityOpts        *SecurityOptions           `json:"security_opts"`
+	UsernsMode          UsernsMode                 `json:"userns_mode"`
+	Ulimits             []Ulimit                   `json:"ulimits"`
+	Sysctls             map[string]string          `json:"sysctls"`
+	ShmSize             string                     `json:"shm_size"`
+	IpcMode             NamespaceMode              `json:"ipc_mode"`
+	PidMode             NamespaceMode              `json:"pid_mode"`
+	RuntimeHandler      string                     `json:"runtime_handler"`
 }
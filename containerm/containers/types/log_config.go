@@ -20,15 +20,41 @@ const (
 	LogConfigDriverNone LogDriver = "none"
 	// LogConfigDriverJSONFile represents a LogDriver type that supports JSON-formatted logging
 	LogConfigDriverJSONFile LogDriver = "json-file" // the default
+	// LogConfigDriverSyslog represents a LogDriver type that forwards the logs to a syslog server
+	LogConfigDriverSyslog LogDriver = "syslog"
+	// LogConfigDriverJournald represents a LogDriver type that writes the logs to the systemd journal
+	LogConfigDriverJournald LogDriver = "journald"
+	// LogConfigDriverLocal represents a LogDriver type that stores the logs as compressed binary records
+	LogConfigDriverLocal LogDriver = "local"
+)
+
+// LogCompression represents the compression of the rotated log files
+type LogCompression string
+
+const (
+	// LogCompressionNone keeps the rotated log files uncompressed
+	LogCompressionNone LogCompression = "none"
+	// LogCompressionGzip compresses the rotated log files using gzip
+	LogCompressionGzip LogCompression = "gzip"
+	// LogCompressionZstd compresses the rotated log files using zstd
+	LogCompressionZstd LogCompression = "zstd"
 )
 
 // LogDriverConfiguration represents a log driver configuration
 type LogDriverConfiguration struct {
 	Type LogDriver `json:"type,omitempty"`
-	// driver config - applicable for json-file only
+	// driver config - applicable for json-file and local only
 	MaxFiles int    `json:"max_files,omitempty"`
 	MaxSize  string `json:"max_size,omitempty"`
 	RootDir  string `json:"root_dir,omitempty"`
+	// driver config - applicable for json-file only, the daemon defaults are used if not set
+	Compress LogCompression `json:"compress,omitempty"`
+	MaxAge   string         `json:"max_age,omitempty"`
+	// driver config - applicable for syslog only
+	Address  string `json:"address,omitempty"`
+	Facility string `json:"facility,omitempty"`
+	// driver config - applicable for syslog and journald only
+	Tag string `json:"tag,omitempty"`
 }
 
 // LogMode indicates available logging modes
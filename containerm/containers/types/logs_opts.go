@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+import "time"
+
+// LogsOpts represent options for retrieving the logs of a container.
+type LogsOpts struct {
+
+	// Tail is the number of the most recent log entries to be returned. All entries are returned if it is negative.
+	Tail int32 `json:"tail,omitempty"`
+
+	// Follow determines whether the new log entries are streamed until the container exits or the request is cancelled.
+	Follow bool `json:"follow,omitempty"`
+
+	// Since filters out the log entries written before this time, if set.
+	Since time.Time `json:"since,omitempty"`
+
+	// Until filters out the log entries written after this time, if set.
+	Until time.Time `json:"until,omitempty"`
+
+	// Stdout determines whether the entries of the container's STDOUT are returned.
+	// Both streams are returned if neither Stdout nor Stderr is set.
+	Stdout bool `json:"stdout,omitempty"`
+
+	// Stderr determines whether the entries of the container's STDERR are returned.
+	// Both streams are returned if neither Stdout nor Stderr is set.
+	Stderr bool `json:"stderr,omitempty"`
+
+	// Timestamps determines whether each log line is prefixed with the time it was written at.
+	Timestamps bool `json:"timestamps,omitempty"`
+}
@@ -48,3 +48,13 @@ type IOStats struct {
 	// Write represents the number of bytes that has been written.
 	Write uint64 `json:"write"`
 }
+
+// ContainerStats represents the measurements of a container along with its identification.
+type ContainerStats struct {
+	// ID is the ID of the container.
+	ID string `json:"id"`
+	// Name is the name of the container.
+	Name string `json:"name"`
+	// Metrics holds the measurements of the container.
+	Metrics *Metrics `json:"metrics,omitempty"`
+}
@@ -27,9 +27,38 @@ const (
 	SlavePropagationMode = "slave"
 )
 
+const (
+	// MountTypeBind represents a mount of a host path in the container.
+	MountTypeBind = "bind"
+	// MountTypeVolume represents a mount of a named volume in the container.
+	MountTypeVolume = "volume"
+)
+
+const (
+	// MountOptionReadOnly represents a read-only mount.
+	MountOptionReadOnly = "ro"
+	// MountOptionReadWrite represents a read-write mount.
+	MountOptionReadWrite = "rw"
+	// MountOptionNoSuid represents a mount that does not honor set-user-ID and set-group-ID bits.
+	MountOptionNoSuid = "nosuid"
+	// MountOptionSuid represents a mount that honors set-user-ID and set-group-ID bits.
+	MountOptionSuid = "suid"
+	// MountOptionNoExec represents a mount that does not permit the execution of binaries.
+	MountOptionNoExec = "noexec"
+	// MountOptionExec represents a mount that permits the execution of binaries.
+	MountOptionExec = "exec"
+	// MountOptionNoDev represents a mount that does not interpret character or block special devices.
+	MountOptionNoDev = "nodev"
+	// MountOptionDev represents a mount that interprets character or block special devices.
+	MountOptionDev = "dev"
+)
+
 // MountPoint specifies a mount point from the host to the container
 type MountPoint struct {
-	Destination     string `json:"destination"`      // path in container
-	Source          string `json:"source"`           // path in host
-	PropagationMode string `json:"propagation_mode"` // propagation mode to use in the spec
+	Destination     string   `json:"destination"`           // path in container
+	Source          string   `json:"source"`                // path in host or the name of the volume
+	PropagationMode string   `json:"propagation_mode"`      // propagation mode to use in the spec
+	Type            string   `json:"type,omitempty"`        // bind (the default) or volume
+	VolumePath      string   `json:"volume_path,omitempty"` // path in host of the volume's data, set by the system
+	Options         []string `json:"options,omitempty"`     // additional mount options - ro, rw, nosuid, suid, noexec, exec, nodev, dev
 }
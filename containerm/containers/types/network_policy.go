@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// EgressAction represents the action applied on the egress traffic of a container
+type EgressAction string
+
+const (
+	// EgressActionAllow means that the traffic is let through
+	EgressActionAllow EgressAction = "allow"
+	// EgressActionDeny means that the traffic is dropped
+	EgressActionDeny EgressAction = "deny"
+)
+
+// NetworkPolicy represents the traffic shaping and egress filtering applied on the network interfaces of the container
+type NetworkPolicy struct {
+
+	// Limit of the rate in bytes per second of the traffic received by the container
+	IngressRate uint64 `json:"ingress_rate,omitempty"`
+
+	// Limit of the rate in bytes per second of the traffic sent by the container
+	EgressRate uint64 `json:"egress_rate,omitempty"`
+
+	// Action for the egress traffic which does not match any of the rules - allow (the default) or deny
+	EgressDefault EgressAction `json:"egress_default,omitempty"`
+
+	// Rules for the egress traffic which are evaluated in order - the first matching one is applied
+	EgressRules []EgressRule `json:"egress_rules,omitempty"`
+}
+
+// EgressRule represents an allow or deny rule for the traffic sent by the container to the given destination
+type EgressRule struct {
+
+	// Action applied on the matching traffic - allow or deny
+	Action EgressAction `json:"action"`
+
+	// Destination subnet in CIDR notation, e.g. 10.0.0.0/8 or 2001:db8::/32
+	CIDR string `json:"cidr"`
+
+	// Protocol of the matching traffic - tcp or udp, all protocols are matched if not set
+	Protocol string `json:"protocol,omitempty"`
+
+	// Destination port or range of ports of the matching traffic, e.g. 443 or 8000-8080, requires the protocol to be set
+	Ports string `json:"ports,omitempty"`
+}
@@ -26,4 +26,47 @@ type Resources struct {
 
 	// Swap + memory usage limit
 	MemorySwap string `json:"memory_swap,omitempty"`
+
+	// CPU CFS (Completely Fair Scheduler) quota in microseconds per CPU period
+	CPUQuota int64 `json:"cpu_quota,omitempty"`
+
+	// CPU CFS (Completely Fair Scheduler) period in microseconds
+	CPUPeriod uint64 `json:"cpu_period,omitempty"`
+
+	// CPU shares - relative weight compared to the other containers
+	CPUShares uint64 `json:"cpu_shares,omitempty"`
+
+	// CPUs in which the container is allowed to execute, e.g. 0-3, 0,1
+	CPUSetCPUs string `json:"cpuset_cpus,omitempty"`
+
+	// Memory nodes in which the container is allowed to execute, e.g. 0-3, 0,1 - effective on NUMA systems only
+	CPUSetMems string `json:"cpuset_mems,omitempty"`
+
+	// Maximum number of processes in the container
+	PidsLimit int64 `json:"pids_limit,omitempty"`
+
+	// Block IO weight - relative weight compared to the other containers in the range of 10 to 1000
+	BlkioWeight uint16 `json:"blkio_weight,omitempty"`
+
+	// Limits of the read rate in bytes per second from devices
+	BlkioDeviceReadBps []ThrottleDevice `json:"blkio_device_read_bps,omitempty"`
+
+	// Limits of the write rate in bytes per second to devices
+	BlkioDeviceWriteBps []ThrottleDevice `json:"blkio_device_write_bps,omitempty"`
+
+	// Limits of the read rate in IO operations per second from devices
+	BlkioDeviceReadIOps []ThrottleDevice `json:"blkio_device_read_iops,omitempty"`
+
+	// Limits of the write rate in IO operations per second to devices
+	BlkioDeviceWriteIOps []ThrottleDevice `json:"blkio_device_write_iops,omitempty"`
+}
+
+// ThrottleDevice represents a block IO rate limit for a device
+type ThrottleDevice struct {
+
+	// Path to the block device on the host, e.g. /dev/sda
+	Path string `json:"path"`
+
+	// Rate limit in bytes or IO operations per second
+	Rate uint64 `json:"rate"`
 }
@@ -35,4 +35,7 @@ type RestartPolicy struct {
 
 	// type
 	Type PolicyType `json:"type"`
+
+	// restart the container when its health check reports it as unhealthy
+	RestartOnUnhealthy bool `json:"restart_on_unhealthy,omitempty"`
 }
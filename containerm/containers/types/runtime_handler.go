@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// RuntimeHandler represents a named configuration of a containerd shim and the OCI runtime that the containers can be run with
+type RuntimeHandler struct {
+
+	// Name of the handler that the containers are configured with
+	Name string `json:"name"`
+
+	// Type of the containerd shim, e.g. io.containerd.runc.v2, io.containerd.runsc.v1 or io.containerd.kata.v2
+	RuntimeType Runtime `json:"runtime_type"`
+
+	// Absolute path to the shim binary, the one resolved by containerd from the runtime type is used if not set
+	BinaryPath string `json:"binary_path,omitempty"`
+
+	// Options passed to the shim, none are passed if not set
+	Options *RuntimeOptions `json:"options,omitempty"`
+}
+
+// RuntimeOptions represents the options of the runc compatible shims
+type RuntimeOptions struct {
+
+	// Name or path of the OCI runtime binary invoked by the shim, e.g. runc or /usr/bin/crun
+	BinaryName string `json:"binary_name,omitempty"`
+
+	// Root directory of the OCI runtime state
+	Root string `json:"root,omitempty"`
+
+	// Whether the container cgroups are managed via systemd
+	SystemdCgroup bool `json:"systemd_cgroup,omitempty"`
+}
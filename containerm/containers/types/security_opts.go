@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+const (
+	// SeccompProfileDefault is the name of the built-in seccomp profile which blocks the syscalls not needed by common workloads
+	SeccompProfileDefault = "default"
+	// SecurityProfileUnconfined means that no seccomp filter or AppArmor profile is applied on the container's process
+	SecurityProfileUnconfined = "unconfined"
+)
+
+// SecurityOptions represents the seccomp filter, Linux security module labels and privileges restrictions of the container's process
+type SecurityOptions struct {
+
+	// Seccomp profile - default for the built-in one, unconfined or an absolute path on the host to a JSON profile in the OCI runtime spec format.
+	// No seccomp filter is applied if not set
+	Seccomp string `json:"seccomp,omitempty"`
+
+	// Name of an AppArmor profile already loaded on the host or unconfined
+	AppArmorProfile string `json:"apparmor_profile,omitempty"`
+
+	// SELinux label of the container's process in the format user:role:type:level
+	SELinuxLabel string `json:"selinux_label,omitempty"`
+
+	// Whether the container's process is prevented from gaining additional privileges, e.g. via setuid or setgid binaries
+	NoNewPrivileges bool `json:"no_new_privileges,omitempty"`
+}
@@ -31,6 +31,34 @@ func (status Status) String() string {
 	return [...]string{"Creating", "Created", "Running", "Stopped", "Paused", "Exited", "Dead", "Unknown"}[status]
 }
 
+// HealthStatus represents a container's health status
+type HealthStatus string
+
+// constants for the supported health statuses
+const (
+	// HealthStarting is the status of a container that is still within its start period and has not passed a health check yet
+	HealthStarting HealthStatus = "starting"
+	// HealthHealthy is the status of a container that has passed its last health check
+	HealthHealthy HealthStatus = "healthy"
+	// HealthUnhealthy is the status of a container that has failed the configured number of consecutive health checks
+	HealthUnhealthy HealthStatus = "unhealthy"
+)
+
+// Health represents a container's health as reported by its health check
+type Health struct {
+	// Status is the current health status of the container
+	Status HealthStatus `json:"status"`
+
+	// FailingStreak is the number of consecutive failed health checks
+	FailingStreak int `json:"failing_streak"`
+
+	// LastCheckAt defines the time of the last performed health check
+	LastCheckAt string `json:"last_check_at,omitempty"`
+
+	// LastOutput holds the output or the error of the last performed health check
+	LastOutput string `json:"last_output,omitempty"`
+}
+
 // State represents a container's state
 type State struct {
 	// Pid represents the container's process's PID
@@ -69,4 +97,7 @@ type State struct {
 
 	// Status represents the status of this container
 	Status Status `json:"status"`
+
+	// Health represents the health of this container - set only for running containers with a configured health check
+	Health *Health `json:"health,omitempty"`
 }
@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// Ulimit represents a resource limit of the container's process
+type Ulimit struct {
+
+	// Name of the resource, e.g. nofile, nproc or memlock
+	Name string `json:"name"`
+
+	// Soft limit of the resource, -1 means unlimited
+	Soft int64 `json:"soft"`
+
+	// Hard limit of the resource, -1 means unlimited
+	Hard int64 `json:"hard"`
+}
@@ -20,4 +20,7 @@ type UpdateOpts struct {
 
 	// Resources of the container.
 	Resources *Resources `json:"resources"`
+
+	// NetworkPolicy of the container.
+	NetworkPolicy *NetworkPolicy `json:"network_policy"`
 }
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
 	"github.com/eclipse-kanto/container-management/containerm/streams"
 )
 
@@ -40,6 +41,12 @@ type ContainerAPIClient interface {
 	// AttachContainer attaches to the container's IO
 	AttachContainer(ctx context.Context, container *types.Container, attachConfig *streams.AttachConfig) error
 
+	// ExecContainer executes a new process inside a running container with the provided IO attached and returns its exit code
+	ExecContainer(ctx context.Context, container *types.Container, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error)
+
+	// CheckpointContainer creates a checkpoint of the running container's state in the provided directory
+	CheckpointContainer(ctx context.Context, container *types.Container, checkpointDir string) error
+
 	// PauseContainer pauses a container
 	PauseContainer(ctx context.Context, container *types.Container) error
 
@@ -66,4 +73,19 @@ type ContainerAPIClient interface {
 
 	//UpdateContainer updates container resource limits
 	UpdateContainer(ctx context.Context, container *types.Container, resources *types.Resources) error
+
+	// ListImages returns information about all locally available images
+	ListImages(ctx context.Context) ([]*imagestypes.ImageInfo, error)
+
+	// GetImage returns information about a locally available image
+	GetImage(ctx context.Context, imageRef string) (*imagestypes.ImageInfo, error)
+
+	// PullImage downloads and unpacks an image if it is not locally available and returns information about it
+	PullImage(ctx context.Context, imageInfo types.Image) (*imagestypes.ImageInfo, error)
+
+	// RemoveImage removes a locally available image if it is not used by any container
+	RemoveImage(ctx context.Context, imageRef string) error
+
+	// ListRuntimeHandlers returns the runtime handlers that the containers can be run with sorted by name and the name of the default one
+	ListRuntimeHandlers(ctx context.Context) ([]*types.RuntimeHandler, string)
 }
@@ -17,25 +17,35 @@ import (
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // ContainerOpts represents container engine client's configuration options.
 type ContainerOpts func(ctrOptions *ctrOpts) error
 
 type ctrOpts struct {
-	namespace           string
-	connectionPath      string
-	registryConfigs     map[string]*RegistryConfig
-	rootExec            string
-	metaPath            string
-	imageDecKeys        []string
-	imageDecRecipients  []string
-	runcRuntime         types.Runtime
-	imageExpiry         time.Duration
-	imageExpiryDisable  bool
-	leaseID             string
-	imageVerifierType   VerifierType
-	imageVerifierConfig map[string]string
+	namespace             string
+	connectionPath        string
+	registryConfigs       map[string]*RegistryConfig
+	rootExec              string
+	metaPath              string
+	imageDecKeys          []string
+	imageDecRecipients    []string
+	runcRuntime           types.Runtime
+	runtimeHandlers       []*types.RuntimeHandler
+	defaultRuntimeHandler string
+	imageExpiry           time.Duration
+	imageExpiryDisable    bool
+	leaseID               string
+	imageVerifierType     VerifierType
+	imageVerifierConfig   map[string]string
+	logCompression        types.LogCompression
+	logMaxAge             time.Duration
+	logDiskBudget         int64
+	usernsRemapUIDs       *specs.LinuxIDMapping
+	usernsRemapGIDs       *specs.LinuxIDMapping
+	usernsRemapDefault    bool
 }
 
 // RegistryConfig represents a single registry's access configuration.
@@ -136,6 +146,22 @@ func WithCtrdRuncRuntime(runcRuntime string) ContainerOpts {
 	}
 }
 
+// WithCtrdRuntimeHandlers sets the runtime handlers that the containers can be run with in addition to the built-in runc one, which can also be redefined.
+func WithCtrdRuntimeHandlers(handlers ...*types.RuntimeHandler) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		ctrOptions.runtimeHandlers = handlers
+		return nil
+	}
+}
+
+// WithCtrdDefaultRuntimeHandler sets the name of the runtime handler that the containers are run with if not configured otherwise per container.
+func WithCtrdDefaultRuntimeHandler(name string) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		ctrOptions.defaultRuntimeHandler = name
+		return nil
+	}
+}
+
 // WithCtrdImageExpiry sets images expiry time.
 func WithCtrdImageExpiry(expiry time.Duration) ContainerOpts {
 	return func(ctrOptions *ctrOpts) error {
@@ -180,3 +206,84 @@ func WithCtrImageVerifierConfig(imageVerifierConfig map[string]string) Container
 		return nil
 	}
 }
+
+// WithCtrdLogCompression sets the default compression of the containers' rotated json-file logs.
+func WithCtrdLogCompression(compression string) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		switch types.LogCompression(compression) {
+		case "", types.LogCompressionNone, types.LogCompressionGzip, types.LogCompressionZstd:
+			ctrOptions.logCompression = types.LogCompression(compression)
+		default:
+			return log.NewErrorf("unexpected log compression = %s", compression)
+		}
+		return nil
+	}
+}
+
+// WithCtrdLogMaxAge sets the default max age of the containers' rotated json-file logs.
+func WithCtrdLogMaxAge(maxAge time.Duration) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		if maxAge < 0 {
+			return log.NewErrorf("unexpected log max age = %s", maxAge)
+		}
+		ctrOptions.logMaxAge = maxAge
+		return nil
+	}
+}
+
+// WithCtrdLogDiskBudget sets the max disk space, e.g. 1G, to be used by the json-file logs of all containers.
+func WithCtrdLogDiskBudget(diskBudget string) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		if diskBudget == "" {
+			ctrOptions.logDiskBudget = 0
+			return nil
+		}
+		size, err := util.SizeToBytes(diskBudget)
+		if err != nil {
+			return log.NewErrorf("unexpected log disk budget = %s", diskBudget)
+		}
+		ctrOptions.logDiskBudget = size
+		return nil
+	}
+}
+
+// WithCtrdUsernsRemapUIDs sets the subordinate range of host user IDs, e.g. 100000:65536, that the user IDs of the containers with a remapped user namespace are mapped to.
+func WithCtrdUsernsRemapUIDs(idRange string) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		if idRange == "" {
+			ctrOptions.usernsRemapUIDs = nil
+			return nil
+		}
+		mapping, err := parseIDMapping(idRange)
+		if err != nil {
+			return err
+		}
+		ctrOptions.usernsRemapUIDs = mapping
+		return nil
+	}
+}
+
+// WithCtrdUsernsRemapGIDs sets the subordinate range of host group IDs, e.g. 100000:65536, that the group IDs of the containers with a remapped user namespace are mapped to.
+// The range of the user IDs is used for the group IDs too if not set.
+func WithCtrdUsernsRemapGIDs(idRange string) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		if idRange == "" {
+			ctrOptions.usernsRemapGIDs = nil
+			return nil
+		}
+		mapping, err := parseIDMapping(idRange)
+		if err != nil {
+			return err
+		}
+		ctrOptions.usernsRemapGIDs = mapping
+		return nil
+	}
+}
+
+// WithCtrdUsernsRemapDefault sets whether the user namespace of the containers is remapped if not configured otherwise per container.
+func WithCtrdUsernsRemapDefault(remapDefault bool) ContainerOpts {
+	return func(ctrOptions *ctrOpts) error {
+		ctrOptions.usernsRemapDefault = remapDefault
+		return nil
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/log"
 
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const (
@@ -33,6 +34,7 @@ const (
 	testImageExpiry        = 31 * 24 * time.Hour
 	testImageExpiryDisable = true
 	testLeaseID            = "test-lease-id"
+	testLogMaxAge          = 72 * time.Hour
 )
 
 var (
@@ -44,22 +46,35 @@ var (
 		},
 		Transport: nil,
 	}
+	testRuntimeHandler = &types.RuntimeHandler{
+		Name:        "crun",
+		RuntimeType: types.RuntimeTypeV2runcV2,
+		Options:     &types.RuntimeOptions{BinaryName: "/usr/bin/crun"},
+	}
 	testVerifierConfig = map[string]string{"testKey": "testValue", "testAnotherKey": "testAnotherValue"}
 
 	testOpt = &ctrOpts{
-		namespace:           testNamespace,
-		connectionPath:      testConnectionPath,
-		registryConfigs:     map[string]*RegistryConfig{testHost: testRegConfig},
-		rootExec:            testRootExec,
-		metaPath:            testMetaPath,
-		imageDecKeys:        testDecKeys,
-		imageDecRecipients:  testDecRecipients,
-		runcRuntime:         types.RuntimeTypeV2runcV2,
-		imageExpiry:         testImageExpiry,
-		imageExpiryDisable:  testImageExpiryDisable,
-		leaseID:             testLeaseID,
-		imageVerifierType:   VerifierNotation,
-		imageVerifierConfig: testVerifierConfig,
+		namespace:             testNamespace,
+		connectionPath:        testConnectionPath,
+		registryConfigs:       map[string]*RegistryConfig{testHost: testRegConfig},
+		rootExec:              testRootExec,
+		metaPath:              testMetaPath,
+		imageDecKeys:          testDecKeys,
+		imageDecRecipients:    testDecRecipients,
+		runcRuntime:           types.RuntimeTypeV2runcV2,
+		runtimeHandlers:       []*types.RuntimeHandler{testRuntimeHandler},
+		defaultRuntimeHandler: testRuntimeHandler.Name,
+		imageExpiry:           testImageExpiry,
+		imageExpiryDisable:    testImageExpiryDisable,
+		leaseID:               testLeaseID,
+		imageVerifierType:     VerifierNotation,
+		imageVerifierConfig:   testVerifierConfig,
+		logCompression:        types.LogCompressionGzip,
+		logMaxAge:             testLogMaxAge,
+		logDiskBudget:         1 << 30,
+		usernsRemapUIDs:       &specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 65536},
+		usernsRemapGIDs:       &specs.LinuxIDMapping{ContainerID: 0, HostID: 200000, Size: 65536},
+		usernsRemapDefault:    true,
 	}
 )
 
@@ -83,6 +98,41 @@ func TestCtrOpts(t *testing.T) {
 			expectedOpts: &ctrOpts{},
 			expectedErr:  log.NewErrorf("unexpected image verifier type = unknown"),
 		},
+		"test_ctr_opts_unexpected_log_compression_error": {
+			opts: []ContainerOpts{
+				WithCtrdLogCompression("unknown"),
+			},
+			expectedOpts: &ctrOpts{},
+			expectedErr:  log.NewErrorf("unexpected log compression = unknown"),
+		},
+		"test_ctr_opts_unexpected_log_max_age_error": {
+			opts: []ContainerOpts{
+				WithCtrdLogMaxAge(-time.Hour),
+			},
+			expectedOpts: &ctrOpts{},
+			expectedErr:  log.NewErrorf("unexpected log max age = -1h0m0s"),
+		},
+		"test_ctr_opts_unexpected_log_disk_budget_error": {
+			opts: []ContainerOpts{
+				WithCtrdLogDiskBudget("unknown"),
+			},
+			expectedOpts: &ctrOpts{},
+			expectedErr:  log.NewErrorf("unexpected log disk budget = unknown"),
+		},
+		"test_ctr_opts_unexpected_userns_remap_uids_error": {
+			opts: []ContainerOpts{
+				WithCtrdUsernsRemapUIDs("unknown"),
+			},
+			expectedOpts: &ctrOpts{},
+			expectedErr:  log.NewErrorf("unexpected ID range = unknown, must be in the form of <first-host-id>:<size>"),
+		},
+		"test_ctr_opts_unexpected_userns_remap_gids_error": {
+			opts: []ContainerOpts{
+				WithCtrdUsernsRemapGIDs("0:65536"),
+			},
+			expectedOpts: &ctrOpts{},
+			expectedErr:  log.NewErrorf("unexpected first host ID = 0, must be a positive number"),
+		},
 		"test_ctr_opts_no_error": {
 			opts: []ContainerOpts{WithCtrdConnectionPath(testConnectionPath),
 				WithCtrdNamespace(testNamespace),
@@ -92,11 +142,19 @@ func TestCtrOpts(t *testing.T) {
 				WithCtrdImageDecryptKeys(testDecKeys...),
 				WithCtrdImageDecryptRecipients(testDecRecipients...),
 				WithCtrdRuncRuntime(string(types.RuntimeTypeV2runcV2)),
+				WithCtrdRuntimeHandlers(testRuntimeHandler),
+				WithCtrdDefaultRuntimeHandler(testRuntimeHandler.Name),
 				WithCtrdImageExpiry(testImageExpiry),
 				WithCtrdImageExpiryDisable(testImageExpiryDisable),
 				WithCtrdLeaseID(testLeaseID),
 				WithCtrImageVerifierType(string(VerifierNotation)),
-				WithCtrImageVerifierConfig(testVerifierConfig)},
+				WithCtrImageVerifierConfig(testVerifierConfig),
+				WithCtrdLogCompression(string(types.LogCompressionGzip)),
+				WithCtrdLogMaxAge(testLogMaxAge),
+				WithCtrdLogDiskBudget("1G"),
+				WithCtrdUsernsRemapUIDs("100000:65536"),
+				WithCtrdUsernsRemapGIDs("200000:65536"),
+				WithCtrdUsernsRemapDefault(true)},
 			expectedOpts: testOpt,
 		},
 	}
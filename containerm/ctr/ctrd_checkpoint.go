@@ -29,6 +29,7 @@ import (
 	"github.com/containerd/containerd/archive"
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/typeurl"
 	"github.com/pkg/errors"
 )
 
@@ -80,6 +81,27 @@ func withCheckpointOpt(checkpoint *containerdtypes.Descriptor) containerd.NewTas
 	}
 }
 
+// withCheckpointRestoreOpt restores the task from the checkpoint image stored in the provided directory.
+// The runtime options of the container are preserved as the task options take precedence over them in the shim.
+func withCheckpointRestoreOpt(ctrdContainer containerd.Container, checkpointDir string) containerd.NewTaskOpts {
+	return func(ctx context.Context, client *containerd.Client, ti *containerd.TaskInfo) error {
+		if ti.Options == nil && containerd.CheckRuntime(ti.Runtime(), "io.containerd.runc") {
+			info, err := ctrdContainer.Info(ctx)
+			if err != nil {
+				return err
+			}
+			if info.Runtime.Options != nil {
+				runtimeOpts, err := typeurl.UnmarshalAny(info.Runtime.Options)
+				if err != nil {
+					return err
+				}
+				ti.Options = runtimeOpts
+			}
+		}
+		return containerd.WithRestoreImagePath(checkpointDir)(ctx, client, ti)
+	}
+}
+
 // getCheckpointDir verifies checkpoint directory for create,remove, list options and checks if checkpoint already exists
 func getCheckpointDir(checkDir, checkpointID, ctrName, ctrID, ctrCheckpointDir string, create bool) (string, error) {
 	var checkpointDir string
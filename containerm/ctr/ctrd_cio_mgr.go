@@ -21,7 +21,7 @@ import (
 )
 
 // execProcessIOCloser is reponsible for closing the STDIN pipes for processed loaded inside a container in an interactive manner
-//type execProcessIOCloser func(containerID, processID string) error
+type execProcessIOCloser func(containerID, processID string) error
 
 // containerIOManager is responsible for handling all IO resources per container
 type containerIOManager interface {
@@ -43,8 +43,8 @@ type containerIOManager interface {
 	ClearIO(id string) error
 	// NewCioCreator creates a new IO set for the provided container id
 	NewCioCreator(withTerminal bool) cio.Creator
-	// NewCioCreator creates a new IO set for the provided id that refers to a process in the provided container id
-	// NewCioCreatorExec(containerID string, withTerminal bool, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser) cio.Creator
+	// NewCioCreatorExec creates a new IO set for the provided id that refers to a process in the provided container id
+	NewCioCreatorExec(containerID string, withTerminal bool, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser) cio.Creator
 	// NewCioAttach creates a new IO set for attaching to an existing root container process
 	NewCioAttach(id string) cio.Attach
 }
@@ -156,11 +156,20 @@ func (mgr *cioMgr) NewCioCreator(withTerminal bool) cio.Creator {
 	}
 }
 
-// keeping it for future interactive exec support
-//func (mgr *cioMgr) NewCioCreatorExec(containerID string, withTerminal bool, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser) cio.Creator {
-//	log.Warn("NewCioCreatorExec is not supported")
-//	return nil
-//}
+func (mgr *cioMgr) NewCioCreatorExec(containerID string, withTerminal bool, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser) cio.Creator {
+	return func(id string) (cio.IO, error) {
+		execIO := mgr.ioCache.Get(id)
+		if execIO == nil {
+			return nil, log.NewErrorf("no IO resources allocated for id = %s", id)
+		}
+		log.Debug("creating cio for process ID = %s in container ID = %s (withStdin=%v, withTerminal=%v)", id, containerID, execIO.UseStdin(), withTerminal)
+		fifoSet, err := mgr.newFIFOSet(id, execIO.UseStdin(), withTerminal)
+		if err != nil {
+			return nil, err
+		}
+		return mgr.createIOExec(fifoSet, containerID, id, closeStdinCh, procIOCloser, execIO)
+	}
+}
 
 func (mgr *cioMgr) NewCioAttach(id string) cio.Attach {
 	return func(fset *cio.FIFOSet) (cio.IO, error) {
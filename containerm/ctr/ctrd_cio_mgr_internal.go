@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/containerd/containerd/cio"
@@ -93,9 +94,7 @@ func (mgr *cioMgr) createIO(fifoSet *cio.FIFOSet, containerIO IO) (cio.IO, error
 	return cntrio, nil
 }
 
-/*
-// keeping the createIO exec handling ready
-func (mgr *cioMgr) createIOExec(fifoSet *cio.FIFOSet, cntrID, procID string, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser, containerIO *IO) (cio.IO, error) {
+func (mgr *cioMgr) createIOExec(fifoSet *cio.FIFOSet, cntrID, procID string, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser, containerIO IO) (cio.IO, error) {
 	cdio, err := cio.NewDirectIO(context.Background(), fifoSet)
 	if err != nil {
 		return nil, err
@@ -138,7 +137,7 @@ func (mgr *cioMgr) createIOExec(fifoSet *cio.FIFOSet, cntrID, procID string, clo
 		return nil, err
 	}
 	return cntrio, nil
-}*/
+}
 
 func (mgr *cioMgr) attachIO(fifoSet *cio.FIFOSet, id string) (cio.IO, error) {
 	ctrIO := mgr.ioCache.Get(id)
@@ -161,6 +161,20 @@ func (mr *MockcontainerIOManagerMockRecorder) NewCioCreator(withTerminal interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCioCreator", reflect.TypeOf((*MockcontainerIOManager)(nil).NewCioCreator), withTerminal)
 }
 
+// NewCioCreatorExec mocks base method.
+func (m *MockcontainerIOManager) NewCioCreatorExec(containerID string, withTerminal bool, closeStdinCh <-chan struct{}, procIOCloser execProcessIOCloser) cio.Creator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCioCreatorExec", containerID, withTerminal, closeStdinCh, procIOCloser)
+	ret0, _ := ret[0].(cio.Creator)
+	return ret0
+}
+
+// NewCioCreatorExec indicates an expected call of NewCioCreatorExec.
+func (mr *MockcontainerIOManagerMockRecorder) NewCioCreatorExec(containerID, withTerminal, closeStdinCh, procIOCloser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCioCreatorExec", reflect.TypeOf((*MockcontainerIOManager)(nil).NewCioCreatorExec), containerID, withTerminal, closeStdinCh, procIOCloser)
+}
+
 // ResetIO mocks base method.
 func (m *MockcontainerIOManager) ResetIO(id string) {
 	m.ctrl.T.Helper()
@@ -22,9 +22,11 @@ import (
 	"github.com/containerd/containerd/errdefs"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/streams"
+	"github.com/google/uuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -43,21 +45,25 @@ func init() {
 
 type containerdClient struct {
 	sync.Mutex
-	rootExec           string
-	metaPath           string
-	registriesResolver containerImageRegistriesResolver
-	ctrdCache          *containerInfoCache
-	ioMgr              containerIOManager
-	logsMgr            containerLogsManager
-	decMgr             containerDecryptMgr
-	verifier           containerVerifier
-	spi                containerdSpi
-	eventsCancel       context.CancelFunc
-	runcRuntime        types.Runtime
-	imageExpiry        time.Duration
-	imageExpiryDisable bool
-	imagesExpiryLock   sync.Mutex
-	imagesWatcher      resourcesWatcher
+	rootExec              string
+	metaPath              string
+	registriesResolver    containerImageRegistriesResolver
+	ctrdCache             *containerInfoCache
+	ioMgr                 containerIOManager
+	logsMgr               containerLogsManager
+	decMgr                containerDecryptMgr
+	verifier              containerVerifier
+	spi                   containerdSpi
+	eventsCancel          context.CancelFunc
+	runtimeHandlers       map[string]*types.RuntimeHandler
+	defaultRuntimeHandler string
+	imageExpiry           time.Duration
+	imageExpiryDisable    bool
+	imagesExpiryLock      sync.Mutex
+	imagesWatcher         resourcesWatcher
+	usernsRemapUIDs       *specs.LinuxIDMapping
+	usernsRemapGIDs       *specs.LinuxIDMapping
+	usernsRemapDefault    bool
 }
 
 // -------------------------------------- ContainerdAPIClient implementation with Containerd -------------------------------------
@@ -65,8 +71,10 @@ type containerdClient struct {
 func (ctrdClient *containerdClient) CreateContainer(ctx context.Context, container *types.Container, checkpointDir string) error {
 	log.Debug("creating container resources in container client")
 	var (
-		err   error
-		image containerd.Image
+		err         error
+		image       containerd.Image
+		uidMappings []specs.LinuxIDMapping
+		gidMappings []specs.LinuxIDMapping
 	)
 	defer func() {
 		if err != nil {
@@ -77,6 +85,13 @@ func (ctrdClient *containerdClient) CreateContainer(ctx context.Context, contain
 			ctrdClient.clearSnapshot(ctx, container.ID)
 		}
 	}()
+	if uidMappings, gidMappings, err = ctrdClient.getIDMappings(container); err != nil {
+		return err
+	}
+	if _, err = ctrdClient.getRuntimeHandler(container); err != nil {
+		return err
+	}
+
 	log.Debug("creating container IOs ")
 	if _, err = ctrdClient.ioMgr.InitIO(container.ID, container.IOConfig.OpenStdin); err != nil {
 		log.ErrorErr(err, "failed to initialise IO for container ID = %s", container.ID)
@@ -96,7 +111,7 @@ func (ctrdClient *containerdClient) CreateContainer(ctx context.Context, contain
 		return err
 	}
 
-	return ctrdClient.createSnapshot(ctx, container.ID, image, container.Image)
+	return ctrdClient.createSnapshot(ctx, container.ID, image, container.Image, uidMappings, gidMappings)
 }
 
 // DestroyContainer kill container and delete it.
@@ -178,11 +193,12 @@ func (ctrdClient *containerdClient) DestroyContainer(ctx context.Context, contai
 // StartContainer starts the underlying container
 func (ctrdClient *containerdClient) StartContainer(ctx context.Context, container *types.Container, checkpointDir string) (int64, error) {
 	var (
-		ctrdContainer containerd.Container
-		createOpts    []containerd.NewContainerOpts
-		image         containerd.Image
-		ctrInfo       *containerInfo
-		err           error
+		ctrdContainer  containerd.Container
+		createOpts     []containerd.NewContainerOpts
+		image          containerd.Image
+		runtimeHandler *types.RuntimeHandler
+		ctrInfo        *containerInfo
+		err            error
 	)
 
 	if ctrdContainer, err = ctrdClient.spi.LoadContainer(ctx, container.ID); err != nil && !errdefs.IsNotFound(err) {
@@ -214,8 +230,10 @@ func (ctrdClient *containerdClient) StartContainer(ctx context.Context, containe
 		return -1, err
 	}
 
-	ctrdClient.configureRuncRuntime(container)
-	createOpts, err = ctrdClient.generateNewContainerOpts(container, image)
+	if runtimeHandler, err = ctrdClient.configureRuntime(container); err != nil {
+		return -1, err
+	}
+	createOpts, err = ctrdClient.generateNewContainerOpts(container, image, runtimeHandler)
 	if err != nil {
 		log.ErrorErr(err, "failed to generate create opts for image ID = %s for container with ID = %s", container.Image.Name, container.ID)
 		return -1, err
@@ -251,7 +269,7 @@ func (ctrdClient *containerdClient) StartContainer(ctx context.Context, containe
 		}
 	}()
 
-	ctrInfo, err = ctrdClient.createTask(ctx, container.IOConfig, container.ID, checkpointDir, ctrdContainer)
+	ctrInfo, err = ctrdClient.createTask(ctx, container.IOConfig, container.ID, checkpointDir, runtimeHandler.BinaryPath, ctrdContainer)
 	if err != nil {
 		log.ErrorErr(err, "error creating task for container ID = %s", container.ID)
 		return -1, err
@@ -310,6 +328,104 @@ func (ctrdClient *containerdClient) AttachContainer(ctx context.Context, contain
 	return <-ctrIO.Stream().Attach(ctx, attachConfig)
 }
 
+// ExecContainer executes a new process inside a running container and returns its exit code.
+func (ctrdClient *containerdClient) ExecContainer(ctx context.Context, container *types.Container, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error) {
+	ctrInfo := ctrdClient.ctrdCache.get(container.ID)
+	if ctrInfo == nil || ctrInfo.getTask() == nil {
+		return -1, log.NewErrorf("missing task for container with ID = %s", container.ID)
+	}
+	spec, err := ctrInfo.container.Spec(ctx)
+	if err != nil {
+		log.ErrorErr(err, "could not get the OCI spec for container ID = %s", container.ID)
+		return -1, err
+	}
+
+	execID := uuid.New().String()
+	execIO, err := ctrdClient.ioMgr.InitIO(execID, execConfig.OpenStdin)
+	if err != nil {
+		log.ErrorErr(err, "failed to initialise IO for process ID = %s in container ID = %s", execID, container.ID)
+		return -1, err
+	}
+	defer func() {
+		if clearErr := ctrdClient.ioMgr.ClearIO(execID); clearErr != nil {
+			log.ErrorErr(clearErr, "error while clearing streams for process ID = %s in container ID = %s", execID, container.ID)
+		}
+	}()
+
+	closeStdinCh := make(chan struct{})
+	process, err := ctrInfo.getTask().Exec(ctx, execID, toExecProcessSpec(spec.Process, execConfig), ctrdClient.ioMgr.NewCioCreatorExec(container.ID, execConfig.Tty, closeStdinCh, ctrdClient.closeStdinIO))
+	if err != nil {
+		log.ErrorErr(err, "failed to create process ID = %s in container ID = %s", execID, container.ID)
+		return -1, err
+	}
+	defer func() {
+		var deleteOpts []containerd.ProcessDeleteOpts
+		if ctx.Err() != nil {
+			// the process is left running when the context is done before it exits so it has to be killed prior to its deletion
+			deleteOpts = append(deleteOpts, containerd.WithProcessKill)
+		}
+		if _, deleteErr := process.Delete(context.Background(), deleteOpts...); deleteErr != nil {
+			log.ErrorErr(deleteErr, "error while deleting process ID = %s in container ID = %s", execID, container.ID)
+		}
+	}()
+
+	// the wait channel must be acquired prior to starting the process to avoid missing its exit
+	statusCh, err := process.Wait(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	attachConfig.Terminal = execConfig.Tty
+	if execConfig.OpenStdin && attachConfig.UseStdin {
+		oldStdin := attachConfig.Stdin
+		pstdinr, pstdinw := io.Pipe()
+		go func() {
+			defer pstdinw.Close()
+			io.Copy(pstdinw, oldStdin)
+		}()
+		attachConfig.Stdin = pstdinr
+		attachConfig.CloseStdin = true
+	} else {
+		attachConfig.UseStdin = false
+	}
+	attachErrCh := execIO.Stream().Attach(ctx, attachConfig)
+
+	if err = process.Start(ctx); err != nil {
+		log.ErrorErr(err, "failed to start process ID = %s in container ID = %s", execID, container.ID)
+		close(closeStdinCh)
+		return -1, err
+	}
+	close(closeStdinCh)
+
+	status := <-statusCh
+	exitCode, _, err := status.Result()
+	if err != nil {
+		return -1, err
+	}
+	// the streams are closed once all of the process output has been copied so that the attached streams are released
+	if clearErr := ctrdClient.ioMgr.ClearIO(execID); clearErr != nil {
+		log.ErrorErr(clearErr, "error while clearing streams for process ID = %s in container ID = %s", execID, container.ID)
+	}
+	if attachErr := <-attachErrCh; attachErr != nil {
+		log.WarnErr(attachErr, "the streams of process ID = %s in container ID = %s were not properly closed", execID, container.ID)
+	}
+	return int64(exitCode), nil
+}
+
+// CheckpointContainer creates a checkpoint of the running container's state in the provided directory.
+func (ctrdClient *containerdClient) CheckpointContainer(ctx context.Context, container *types.Container, checkpointDir string) error {
+	ctrInfo := ctrdClient.ctrdCache.get(container.ID)
+	if ctrInfo == nil || ctrInfo.getTask() == nil {
+		return log.NewErrorf("missing task for container with ID = %s", container.ID)
+	}
+	if _, err := ctrInfo.getTask().Checkpoint(ctx, containerd.WithCheckpointImagePath(checkpointDir)); err != nil {
+		log.ErrorErr(err, "failed to checkpoint container ID = %s", container.ID)
+		return err
+	}
+	log.Debug("successfully created checkpoint for container ID = %s in %s", container.ID, checkpointDir)
+	return nil
+}
+
 // PauseContainer pause container.
 func (ctrdClient *containerdClient) PauseContainer(ctx context.Context, container *types.Container) error {
 	var (
@@ -449,10 +565,18 @@ func (ctrdClient *containerdClient) UpdateContainer(ctx context.Context, contain
 		}
 	}
 
+	blockIO, err := toUpdatedLinuxBlockIO(container.HostConfig.Resources, resources)
+	if err != nil {
+		return err
+	}
+
 	r := &specs.LinuxResources{
 		// Currently, runc update could not change device config and skips it. Add it just in case this changes.
 		Devices: spec.Linux.Resources.Devices,
 		Memory:  lm,
+		CPU:     toUpdatedLinuxCPU(container.HostConfig.Resources, resources),
+		Pids:    toUpdatedLinuxPids(container.HostConfig.Resources, resources),
+		BlockIO: blockIO,
 	}
 	return ctrInfo.getTask().Update(ctx, containerd.WithResources(r))
 }
@@ -471,6 +595,76 @@ func (ctrdClient *containerdClient) GetContainerStats(ctx context.Context, conta
 	return nil, nil, nil, 0, time.Time{}, log.NewErrorf("missing container with ID = %s", container.ID)
 }
 
+// ListImages returns information about all locally available images
+func (ctrdClient *containerdClient) ListImages(ctx context.Context) ([]*imagestypes.ImageInfo, error) {
+	images, err := ctrdClient.spi.ListImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imageInfos := make([]*imagestypes.ImageInfo, len(images))
+	for i, image := range images {
+		imageInfos[i] = ctrdClient.toImageInfo(ctx, image)
+	}
+	return imageInfos, nil
+}
+
+// GetImage returns information about a locally available image
+func (ctrdClient *containerdClient) GetImage(ctx context.Context, imageRef string) (*imagestypes.ImageInfo, error) {
+	image, err := ctrdClient.spi.GetImage(ctx, imageRef)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil, log.NewErrorf(noSuchImageErrorMsg, imageRef)
+		}
+		return nil, err
+	}
+	return ctrdClient.toImageInfo(ctx, image), nil
+}
+
+// PullImage downloads and unpacks an image if it is not locally available and returns information about it
+func (ctrdClient *containerdClient) PullImage(ctx context.Context, imageInfo types.Image) (*imagestypes.ImageInfo, error) {
+	_, getErr := ctrdClient.spi.GetImage(ctx, imageInfo.Name)
+	image, err := ctrdClient.pullImage(ctx, imageInfo)
+	if err != nil {
+		log.ErrorErr(err, "error while pulling image with ID = %s", imageInfo.Name)
+		return nil, err
+	}
+	if errdefs.IsNotFound(getErr) && !ctrdClient.imageExpiryDisable {
+		ctrdClient.imagesExpiryLock.Lock()
+		defer ctrdClient.imagesExpiryLock.Unlock()
+		if expiryErr := ctrdClient.manageImageExpiry(ctx, image); expiryErr != nil {
+			log.WarnErr(expiryErr, "could not schedule expiry management for image = %s", imageInfo.Name)
+		}
+	}
+	return ctrdClient.toImageInfo(ctx, image), nil
+}
+
+// RemoveImage removes a locally available image if it is not used by any container
+func (ctrdClient *containerdClient) RemoveImage(ctx context.Context, imageRef string) error {
+	ctrdClient.imagesExpiryLock.Lock()
+	defer ctrdClient.imagesExpiryLock.Unlock()
+
+	image, err := ctrdClient.spi.GetImage(ctx, imageRef)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return log.NewErrorf(noSuchImageErrorMsg, imageRef)
+		}
+		return err
+	}
+	if err = ctrdClient.removeUnusedImage(ctx, image); err != nil {
+		if err == errImageIsInUse {
+			return log.NewErrorf("image with ID = %s is in use by a container", imageRef)
+		}
+		return err
+	}
+	log.Debug("successfully removed image with ID = %s", imageRef)
+	return nil
+}
+
+// ListRuntimeHandlers returns the runtime handlers that the containers can be run with sorted by name and the name of the default one
+func (ctrdClient *containerdClient) ListRuntimeHandlers(ctx context.Context) ([]*types.RuntimeHandler, string) {
+	return sortedRuntimeHandlers(ctrdClient.runtimeHandlers), ctrdClient.defaultRuntimeHandler
+}
+
 //--------------------------------------EOF ContainerdAPIClient implementation with Containerd -------------------------------------
 
 //----------------------------Disposable-------------------------------------------
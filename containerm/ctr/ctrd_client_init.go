@@ -23,13 +23,29 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 func newContainerdClient(namespace string, socket string, rootExec string, metaPath string, registryConfigs map[string]*RegistryConfig, imageDecKeys, imageDecRecipients []string,
-	runcRuntime types.Runtime, imageExpiry time.Duration, imageExpiryDisable bool, leaseID string, imageVerifierType VerifierType, imageVerifierConfig map[string]string) (ContainerAPIClient, error) {
+	runcRuntime types.Runtime, runtimeHandlers []*types.RuntimeHandler, defaultRuntimeHandler string, imageExpiry time.Duration, imageExpiryDisable bool, leaseID string, imageVerifierType VerifierType, imageVerifierConfig map[string]string,
+	logCompression types.LogCompression, logMaxAge time.Duration, logDiskBudget int64,
+	usernsRemapUIDs, usernsRemapGIDs *specs.LinuxIDMapping, usernsRemapDefault bool) (ContainerAPIClient, error) {
+
+	if usernsRemapUIDs != nil && usernsRemapGIDs == nil {
+		usernsRemapGIDs = usernsRemapUIDs
+	} else if usernsRemapUIDs == nil && usernsRemapGIDs != nil {
+		return nil, log.NewError("the subordinate range of user IDs must be configured together with the one of group IDs")
+	}
+	if defaultRuntimeHandler == "" {
+		defaultRuntimeHandler = RuntimeHandlerRunc
+	}
+	handlers, err := newRuntimeHandlers(runcRuntime, runtimeHandlers, defaultRuntimeHandler)
+	if err != nil {
+		return nil, err
+	}
 
 	//ensure storage
-	err := util.MkDir(rootExec)
+	err = util.MkDir(rootExec)
 	if err != nil {
 		return nil, err
 	}
@@ -53,18 +69,22 @@ func newContainerdClient(namespace string, socket string, rootExec string, metaP
 	}
 
 	ctrdClient := &containerdClient{
-		rootExec:           rootExec,
-		metaPath:           metaPath,
-		ctrdCache:          newContainerInfoCache(),
-		registriesResolver: newContainerImageRegistriesResolver(registryConfigs),
-		spi:                ctrdClientSpi,
-		ioMgr:              newContainerIOManager(filepath.Join(rootExec, "fifo"), newCache()),
-		logsMgr:            newContainerLogsManager(filepath.Join(metaPath, "containers")),
-		decMgr:             decryptMgr,
-		verifier:           verifier,
-		runcRuntime:        runcRuntime,
-		imageExpiry:        imageExpiry,
-		imageExpiryDisable: imageExpiryDisable,
+		rootExec:              rootExec,
+		metaPath:              metaPath,
+		ctrdCache:             newContainerInfoCache(),
+		registriesResolver:    newContainerImageRegistriesResolver(registryConfigs),
+		spi:                   ctrdClientSpi,
+		ioMgr:                 newContainerIOManager(filepath.Join(rootExec, "fifo"), newCache()),
+		logsMgr:               newContainerLogsManager(filepath.Join(metaPath, "containers"), logCompression, logMaxAge, logDiskBudget),
+		decMgr:                decryptMgr,
+		verifier:              verifier,
+		runtimeHandlers:       handlers,
+		defaultRuntimeHandler: defaultRuntimeHandler,
+		imageExpiry:           imageExpiry,
+		imageExpiryDisable:    imageExpiryDisable,
+		usernsRemapUIDs:       usernsRemapUIDs,
+		usernsRemapGIDs:       usernsRemapGIDs,
+		usernsRemapDefault:    usernsRemapDefault,
 	}
 	go ctrdClient.processEvents(namespace)
 	if !ctrdClient.imageExpiryDisable {
@@ -86,5 +106,7 @@ func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, er
 		return nil, err
 	}
 	return newContainerdClient(opts.namespace, opts.connectionPath, opts.rootExec, opts.metaPath, opts.registryConfigs, opts.imageDecKeys, opts.imageDecRecipients,
-		opts.runcRuntime, opts.imageExpiry, opts.imageExpiryDisable, opts.leaseID, opts.imageVerifierType, opts.imageVerifierConfig)
+		opts.runcRuntime, opts.runtimeHandlers, opts.defaultRuntimeHandler, opts.imageExpiry, opts.imageExpiryDisable, opts.leaseID, opts.imageVerifierType, opts.imageVerifierConfig,
+		opts.logCompression, opts.logMaxAge, opts.logDiskBudget,
+		opts.usernsRemapUIDs, opts.usernsRemapGIDs, opts.usernsRemapDefault)
 }
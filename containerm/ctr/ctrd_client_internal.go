@@ -29,6 +29,7 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/util"
 	"github.com/opencontainers/image-spec/identity"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"golang.org/x/sys/unix"
 )
 
@@ -59,12 +60,20 @@ func (ctrdClient *containerdClient) generateUnpackOpts(imageInfo types.Image) ([
 	return unpackOpts, nil
 }
 
-func (ctrdClient *containerdClient) generateNewContainerOpts(container *types.Container, containerImage containerd.Image) ([]containerd.NewContainerOpts, error) {
+func (ctrdClient *containerdClient) generateNewContainerOpts(container *types.Container, containerImage containerd.Image, runtimeHandler *types.RuntimeHandler) ([]containerd.NewContainerOpts, error) {
+	uidMappings, gidMappings, err := ctrdClient.getIDMappings(container)
+	if err != nil {
+		return nil, err
+	}
+	namespaceTargetPids, err := ctrdClient.getNamespaceModeTargetPids(container)
+	if err != nil {
+		return nil, err
+	}
 	createOpts := []containerd.NewContainerOpts{}
 	createOpts = append(createOpts, WithSnapshotOpts(ctrdClient.spi.GetSnapshotID(container.ID), containerd.DefaultSnapshotter)...) // NB! It's very important to apply the snapshot configs prior to the OCI Spec ones as they are dependent
 	createOpts = append(createOpts,
-		WithRuntimeOpts(container, ctrdClient.rootExec),
-		WithSpecOpts(container, containerImage, ctrdClient.rootExec))
+		WithRuntimeOpts(container, runtimeHandler),
+		WithSpecOpts(container, containerImage, ctrdClient.rootExec, isSystemdCgroup(runtimeHandler), uidMappings, gidMappings, namespaceTargetPids))
 
 	decryptCfg, err := ctrdClient.decMgr.GetDecryptConfig(container.Image.DecryptConfig)
 	if err != nil {
@@ -75,17 +84,21 @@ func (ctrdClient *containerdClient) generateNewContainerOpts(container *types.Co
 	return createOpts, nil
 }
 
-func (ctrdClient *containerdClient) configureRuncRuntime(container *types.Container) {
-	if container.HostConfig.Runtime != ctrdClient.runcRuntime {
-		switch container.HostConfig.Runtime {
-		case types.RuntimeTypeV1, types.RuntimeTypeV2runcV1, types.RuntimeTypeV2runcV2:
-			log.Info("container runc runtime is automatically updated from %s to %s for container ID = %s", container.HostConfig.Runtime, ctrdClient.runcRuntime, container.ID)
-			container.HostConfig.Runtime = ctrdClient.runcRuntime
-		default:
-			// non runc runtime, must not change
+// getNamespaceModeTargetPids returns the PIDs of the running containers whose IPC or PID namespaces are shared by the provided one
+func (ctrdClient *containerdClient) getNamespaceModeTargetPids(container *types.Container) (map[specs.LinuxNamespaceType]uint32, error) {
+	pids := map[specs.LinuxNamespaceType]uint32{}
+	for namespaceType, mode := range map[specs.LinuxNamespaceType]types.NamespaceMode{specs.IPCNamespace: container.HostConfig.IpcMode, specs.PIDNamespace: container.HostConfig.PidMode} {
+		targetID := util.GetNamespaceModeTarget(mode)
+		if targetID == "" {
+			continue
 		}
+		targetInfo := ctrdClient.ctrdCache.get(targetID)
+		if targetInfo == nil || targetInfo.getTask() == nil {
+			return nil, log.NewErrorf("container with id = %s is not running - cannot share its %s namespace with container id = %s", targetID, namespaceType, container.ID)
+		}
+		pids[namespaceType] = targetInfo.getTask().Pid()
 	}
-	log.Debug("container runtime = %s for container ID = %s", container.HostConfig.Runtime, container.ID)
+	return pids, nil
 }
 
 func (ctrdClient *containerdClient) getImage(ctx context.Context, imageInfo types.Image) (containerd.Image, error) {
@@ -143,13 +156,13 @@ func (ctrdClient *containerdClient) pullImage(ctx context.Context, imageInfo typ
 	return ctrdImage, err
 }
 
-func (ctrdClient *containerdClient) createSnapshot(ctx context.Context, containerID string, image containerd.Image, imageInfo types.Image) error {
+func (ctrdClient *containerdClient) createSnapshot(ctx context.Context, containerID string, image containerd.Image, imageInfo types.Image, uidMappings, gidMappings []specs.LinuxIDMapping) error {
 	unpackOpts, err := ctrdClient.generateUnpackOpts(imageInfo)
 	if err != nil {
 		log.ErrorErr(err, "error while generating unpack opts for image ID = %s used by container ID = %s", image.Name(), containerID)
 		return err
 	}
-	err = ctrdClient.spi.PrepareSnapshot(ctx, containerID, image, unpackOpts...)
+	err = ctrdClient.spi.PrepareSnapshot(ctx, containerID, image, uidMappings, gidMappings, unpackOpts...)
 	if err != nil {
 		log.ErrorErr(err, "error while trying to create a snapshot for container ID = %s with image ID = %s ", containerID, image.Name())
 		return err
@@ -171,30 +184,19 @@ func (ctrdClient *containerdClient) clearSnapshot(ctx context.Context, container
 	}
 }
 
-func (ctrdClient *containerdClient) createTask(ctx context.Context, ctrIOCfg *types.IOConfig, containerID, checkpointDir string, ctrdContainer containerd.Container) (*containerInfo, error) {
-	/*if checkpointDir != "" {
-		//TODO: add checkpoint support for tasks
-		checkpoint, err = createCheckpointDescriptor(ctx, checkpointDir, ctrdClient)
-		if err != nil {
-			return nil, err
-		}
-
-		defer func() {
-			if checkpoint != nil {
-				// remove the checkpoint blob after task start
-				err := ctrdClient.ContentStore().Delete(context.Background(), checkpoint.Digest)
-				if err != nil {
-					log.Printf("[WARN] failed to delete temporary checkpoint entry: %s", err)
-				}
-			}
-		}()
+func (ctrdClient *containerdClient) createTask(ctx context.Context, ctrIOCfg *types.IOConfig, containerID, checkpointDir, runtimePath string, ctrdContainer containerd.Container) (*containerInfo, error) {
+	var taskOpts []containerd.NewTaskOpts
+	if runtimePath != "" {
+		taskOpts = append(taskOpts, containerd.WithRuntimePath(runtimePath))
+	}
+	if checkpointDir != "" {
+		log.Debug("will restore the task for container ID = %s from checkpoint %s", containerID, checkpointDir)
+		taskOpts = append(taskOpts, withCheckpointRestoreOpt(ctrdContainer, checkpointDir))
 	}
-	// create task
-	 cio.NewCreator(cio.WithStdio) , withCheckpointOpt(checkpoint)*/
 
 	cioCreator := ctrdClient.ioMgr.NewCioCreator(ctrIOCfg.Tty)
 	// create task
-	task, taskErr := ctrdClient.spi.CreateTask(ctx, ctrdContainer, cioCreator)
+	task, taskErr := ctrdClient.spi.CreateTask(ctx, ctrdContainer, cioCreator, taskOpts...)
 	if taskErr != nil {
 		return nil, taskErr
 	}
@@ -275,34 +277,33 @@ func (ctrdClient *containerdClient) loadTask(ctx context.Context, containerID, c
 	return ctrCacheInfo, nil
 }
 
-/* keeping the code needed for interactive exec handling if/when supported
 // closeStdinIO is used to close the write side of fifo in containerd-shim.
 //
-// NOTE: we should use client to make rpc call directly. if we retrieve it from
-// watch, it might return 404 because the pack is saved into cache after Start.
-func (client *containerdClient) closeStdinIO(containerID, processID string) error {
-
-	ctx := client.ctrdWrapper.ensureNamespace(context.Background())
-	wrapperCli := client.ctrdWrapper
-
-	cli := wrapperCli.client
-	cntr, err := cli.LoadContainer(ctx, containerID)
-	if err != nil {
-		return err
+// NOTE: the process is loaded from the container's task directly as the shim holds
+// the other write side of the fifo and the process will not exit until it is closed.
+func (ctrdClient *containerdClient) closeStdinIO(containerID, processID string) error {
+	ctrInfo := ctrdClient.ctrdCache.get(containerID)
+	if ctrInfo == nil || ctrInfo.getTask() == nil {
+		return log.NewErrorf("missing task for container with ID = %s", containerID)
 	}
-
-	t, err := cntr.Task(ctx, nil)
+	ctx := context.Background()
+	p, err := ctrInfo.getTask().LoadProcess(ctx, processID, nil)
 	if err != nil {
 		return err
 	}
+	return p.CloseIO(ctx, containerd.WithStdinCloser)
+}
 
-	p, err := t.LoadProcess(ctx, processID, nil)
-	if err != nil {
-		return err
+func toExecProcessSpec(rootProcess *specs.Process, execConfig *types.ExecConfig) *specs.Process {
+	procSpec := *rootProcess
+	procSpec.Args = execConfig.Cmd
+	procSpec.Terminal = execConfig.Tty
+	procSpec.Env = append(append([]string{}, rootProcess.Env...), execConfig.Env...)
+	if execConfig.WorkingDir != "" {
+		procSpec.Cwd = execConfig.WorkingDir
 	}
-
-	return p.CloseIO(ctx, containerd.WithStdinCloser)
-}*/
+	return &procSpec
+}
 
 func (ctrdClient *containerdClient) initLogDriver(container *types.Container) error {
 	logDriver, err := ctrdClient.logsMgr.GetLogDriver(container)
@@ -47,6 +47,7 @@ import (
 	protoTypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -65,6 +66,11 @@ func TestClientInternalGenerateNewContainerOpts(t *testing.T) {
 		},
 		HostConfig: &types.HostConfig{},
 	}
+	runtimeHandler := &types.RuntimeHandler{
+		Name:        "crun",
+		RuntimeType: types.RuntimeTypeV2runcV2,
+		Options:     &types.RuntimeOptions{BinaryName: "/usr/bin/crun", SystemdCgroup: true},
+	}
 	testCases := map[string]struct {
 		mockExec func(imageMock *mocksContainerd.MockImage, spiMock *mocksCtrd.MockcontainerdSpi, decrytpMgrMock *mocksCtrd.MockcontainerDecryptMgr) ([]containerd.NewContainerOpts, error)
 	}{
@@ -75,8 +81,8 @@ func TestClientInternalGenerateNewContainerOpts(t *testing.T) {
 				decrytpMgrMock.EXPECT().GetDecryptConfig(container.Image.DecryptConfig).Return(dc, nil)
 				res := WithSnapshotOpts(snapshotID, containerd.DefaultSnapshotter) // what these With* return must be tested for each dedicated static func
 				res = append(res,
-					WithRuntimeOpts(container, rootExec),
-					WithSpecOpts(container, imageMock, rootExec),
+					WithRuntimeOpts(container, runtimeHandler),
+					WithSpecOpts(container, imageMock, rootExec, true, nil, nil, map[specs.LinuxNamespaceType]uint32{}),
 					encryption.WithAuthorizationCheck(dc),
 				)
 				return res, nil
@@ -106,59 +112,46 @@ func TestClientInternalGenerateNewContainerOpts(t *testing.T) {
 			}
 			imageMock := mocksContainerd.NewMockImage(ctrl)
 			expectedOpts, expectedErr := testCaseData.mockExec(imageMock, spiMock, decryptMgrMock)
-			actualOpts, actualErr := ctrdClient.generateNewContainerOpts(container, imageMock)
+			actualOpts, actualErr := ctrdClient.generateNewContainerOpts(container, imageMock, runtimeHandler)
 			testutil.AssertError(t, expectedErr, actualErr)
 			testutil.AssertTrue(t, matchers.MatchesNewContainerOpts(expectedOpts...).Matches(actualOpts))
 		})
 	}
 }
 
-func TestConfigureRuncRuntime(t *testing.T) {
-	ctrdClient := &containerdClient{
-		runcRuntime: types.RuntimeTypeV2runcV2,
-	}
+func TestGetNamespaceModeTargetPids(t *testing.T) {
+	const targetID = "sidecar-id"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskMock := mocksContainerd.NewMockTask(ctrl)
+	taskMock.EXPECT().Pid().Return(uint32(1234))
+	ctrdClient := &containerdClient{ctrdCache: newContainerInfoCache()}
+	ctrdClient.ctrdCache.cache[targetID] = &containerInfo{c: &types.Container{ID: targetID}, task: taskMock}
+
 	tests := map[string]struct {
-		container *types.Container
-		runtime   types.Runtime
+		hostConfig   *types.HostConfig
+		expectedPids map[specs.LinuxNamespaceType]uint32
+		expectedErr  error
 	}{
-		"test_RuntimeTypeV1": {
-			container: &types.Container{
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV1,
-				},
-			},
-			runtime: ctrdClient.runcRuntime,
-		},
-		"RuntimeTypeV2runcV1": {
-			container: &types.Container{
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV2runcV1,
-				},
-			},
-			runtime: ctrdClient.runcRuntime,
+		"test_private": {
+			hostConfig:   &types.HostConfig{IpcMode: types.NamespaceModePrivate, PidMode: types.NamespaceModeHost},
+			expectedPids: map[specs.LinuxNamespaceType]uint32{},
 		},
-		"RuntimeTypeV2runcV2": {
-			container: &types.Container{
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV2runcV2,
-				},
-			},
-			runtime: ctrdClient.runcRuntime,
+		"test_container": {
+			hostConfig:   &types.HostConfig{IpcMode: types.NamespaceModeContainerPrefix + targetID},
+			expectedPids: map[specs.LinuxNamespaceType]uint32{specs.IPCNamespace: 1234},
 		},
-		"test_default": {
-			container: &types.Container{
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV2kataV2,
-				},
-			},
-			runtime: types.RuntimeTypeV2kataV2,
+		"test_container_not_running": {
+			hostConfig:  &types.HostConfig{PidMode: types.NamespaceModeContainerPrefix + "other-id"},
+			expectedErr: log.NewErrorf("container with id = other-id is not running - cannot share its pid namespace with container id = test-ctr"),
 		},
 	}
-
-	for name, test := range tests {
-		t.Run(name, func(t *testing.T) {
-			ctrdClient.configureRuncRuntime(test.container)
-			testutil.AssertEqual(t, test.runtime, test.container.HostConfig.Runtime)
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			pids, err := ctrdClient.getNamespaceModeTargetPids(&types.Container{ID: "test-ctr", HostConfig: testCase.hostConfig})
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedPids, pids)
 		})
 	}
 }
@@ -495,7 +488,7 @@ func TestClientInternalCreateSnapshot(t *testing.T) {
 				dc := &config.DecryptConfig{}
 				decryptMgrMock.EXPECT().GetDecryptConfig(testImageInfo.DecryptConfig).Return(dc, nil)
 				err := log.NewError("test error")
-				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(err)
+				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, gomock.Nil(), gomock.Nil(), matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(err)
 				imageMock.EXPECT().Name().Return(containerName)
 				return err
 			},
@@ -504,7 +497,7 @@ func TestClientInternalCreateSnapshot(t *testing.T) {
 			mockExec: func(decryptMgrMock *mocksCtrd.MockcontainerDecryptMgr, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
 				dc := &config.DecryptConfig{}
 				decryptMgrMock.EXPECT().GetDecryptConfig(testImageInfo.DecryptConfig).Return(dc, nil)
-				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
+				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, gomock.Nil(), gomock.Nil(), matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
 				err := log.NewError("test error")
 				spiMock.EXPECT().MountSnapshot(gomock.Any(), containerID, rootFSPathDefault).Return(err)
 				imageMock.EXPECT().Name().Return(containerName)
@@ -515,7 +508,7 @@ func TestClientInternalCreateSnapshot(t *testing.T) {
 			mockExec: func(decryptMgrMock *mocksCtrd.MockcontainerDecryptMgr, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
 				dc := &config.DecryptConfig{}
 				decryptMgrMock.EXPECT().GetDecryptConfig(testImageInfo.DecryptConfig).Return(dc, nil)
-				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
+				spiMock.EXPECT().PrepareSnapshot(gomock.Any(), containerID, imageMock, gomock.Nil(), gomock.Nil(), matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
 				spiMock.EXPECT().MountSnapshot(gomock.Any(), containerID, rootFSPathDefault).Return(nil)
 				return nil
 			},
@@ -535,7 +528,7 @@ func TestClientInternalCreateSnapshot(t *testing.T) {
 				spi:    spiMock,
 			}
 			expectedErr := testCaseData.mockExec(decryptMgrMock, spiMock, imageMock)
-			actualErr := ctrdClient.createSnapshot(context.TODO(), containerID, imageMock, testImageInfo)
+			actualErr := ctrdClient.createSnapshot(context.TODO(), containerID, imageMock, testImageInfo, nil, nil)
 			testutil.AssertError(t, expectedErr, actualErr)
 		})
 	}
@@ -596,6 +589,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 	const (
 		containerID          = "test-container-id"
 		checkpointDir        = "some/dir"
+		runtimePath          = "/usr/local/bin/containerd-shim-runc-v2"
 		taskPid       uint32 = 123
 	)
 	testCioCreator := func(id string) (cio.IO, error) {
@@ -611,7 +605,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 			mockExec: func(spiMock *mocksCtrd.MockcontainerdSpi, ioMgrMock *MockcontainerIOManager, mockContainer *mocksContainerd.MockContainer, ctrl *gomock.Controller) (*containerInfo, error) {
 				ioMgrMock.EXPECT().NewCioCreator(testCtrIOCfg.Tty).Return(testCioCreator)
 				err := log.NewError("test error")
-				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator)).Return(nil, err)
+				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator), gomock.Any()).Return(nil, err)
 				return nil, err
 			},
 		},
@@ -619,7 +613,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 			mockExec: func(spiMock *mocksCtrd.MockcontainerdSpi, ioMgrMock *MockcontainerIOManager, mockContainer *mocksContainerd.MockContainer, ctrl *gomock.Controller) (*containerInfo, error) {
 				ioMgrMock.EXPECT().NewCioCreator(testCtrIOCfg.Tty).Return(testCioCreator)
 				taskMock := mocksContainerd.NewMockTask(ctrl)
-				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator)).Return(taskMock, nil)
+				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator), gomock.Any()).Return(taskMock, nil)
 				err := log.NewError("test error")
 				taskMock.EXPECT().Wait(gomock.Any()).Return(nil, err)
 				taskMock.EXPECT().Delete(gomock.Any()).Return(nil, nil)
@@ -630,7 +624,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 			mockExec: func(spiMock *mocksCtrd.MockcontainerdSpi, ioMgrMock *MockcontainerIOManager, mockContainer *mocksContainerd.MockContainer, ctrl *gomock.Controller) (*containerInfo, error) {
 				ioMgrMock.EXPECT().NewCioCreator(testCtrIOCfg.Tty).Return(testCioCreator)
 				taskMock := mocksContainerd.NewMockTask(ctrl)
-				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator)).Return(taskMock, nil)
+				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator), gomock.Any()).Return(taskMock, nil)
 				err := log.NewError("test error")
 				taskMock.EXPECT().Wait(gomock.Any()).Return(nil, err)
 				taskMock.EXPECT().Delete(gomock.Any()).Return(nil, err)
@@ -641,7 +635,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 			mockExec: func(spiMock *mocksCtrd.MockcontainerdSpi, ioMgrMock *MockcontainerIOManager, mockContainer *mocksContainerd.MockContainer, ctrl *gomock.Controller) (*containerInfo, error) {
 				ioMgrMock.EXPECT().NewCioCreator(testCtrIOCfg.Tty).Return(testCioCreator)
 				taskMock := mocksContainerd.NewMockTask(ctrl)
-				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator)).Return(taskMock, nil)
+				spiMock.EXPECT().CreateTask(gomock.Any(), mockContainer, matchers.MatchesCioCreator(testCioCreator), gomock.Any()).Return(taskMock, nil)
 				taskMock.EXPECT().Wait(gomock.Any()).Return(testStatusChan, nil)
 				taskMock.EXPECT().Pid().Return(taskPid)
 				return &containerInfo{
@@ -667,7 +661,7 @@ func TestClientInternalCreateTask(t *testing.T) {
 			}
 			containerMock := mocksContainerd.NewMockContainer(ctrl)
 			expectedCtrInfo, expectedErr := testCaseData.mockExec(spiMock, ioMgrMock, containerMock, ctrl)
-			actualCtrInfo, actualErr := ctrdClient.createTask(context.TODO(), testCtrIOCfg, containerID, checkpointDir, containerMock)
+			actualCtrInfo, actualErr := ctrdClient.createTask(context.TODO(), testCtrIOCfg, containerID, checkpointDir, runtimePath, containerMock)
 
 			testutil.AssertError(t, expectedErr, actualErr)
 			if expectedCtrInfo != nil {
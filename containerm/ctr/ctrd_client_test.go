@@ -63,10 +63,12 @@ func TestCtrdClientCreateContainer(t *testing.T) {
 	mockImage := containerdMocks.NewMockImage(mockCtrl)
 
 	testClient := &containerdClient{
-		ioMgr:   mockIoMgr,
-		logsMgr: mockLogMgr,
-		decMgr:  mockDecrypctMgr,
-		spi:     mockSpi,
+		ioMgr:                 mockIoMgr,
+		logsMgr:               mockLogMgr,
+		decMgr:                mockDecrypctMgr,
+		spi:                   mockSpi,
+		runtimeHandlers:       testRuntimeHandlers,
+		defaultRuntimeHandler: RuntimeHandlerRunc,
 	}
 
 	testCtr := &types.Container{
@@ -134,7 +136,7 @@ func TestCtrdClientCreateContainer(t *testing.T) {
 				mockDecrypctMgr.EXPECT().GetDecryptConfig(testCtr.Image.DecryptConfig).Times(2).Return(dc, nil)
 				mockSpi.EXPECT().GetImage(ctx, testCtr.Image.Name).Return(mockImage, nil)
 				mockDecrypctMgr.EXPECT().CheckAuthorization(ctx, mockImage, dc).Return(nil)
-				mockSpi.EXPECT().PrepareSnapshot(ctx, testCtr.ID, mockImage, matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
+				mockSpi.EXPECT().PrepareSnapshot(ctx, testCtr.ID, mockImage, gomock.Nil(), gomock.Nil(), matchers.MatchesUnpackOpts(encryption.WithUnpackConfigApplyOpts(encryption.WithDecryptedUnpack(&imgcrypt.Payload{DecryptConfig: *dc})))).Return(nil)
 				mockSpi.EXPECT().MountSnapshot(ctx, testCtr.ID, rootFSPathDefault)
 				return nil
 			},
@@ -292,11 +294,13 @@ func TestCtrdClientStartContainer(t *testing.T) {
 	mockIo := containerdMocks.NewMockIO(mockCtrl)
 
 	testClient := &containerdClient{
-		ctrdCache: newContainerInfoCache(),
-		ioMgr:     mockIoMgr,
-		logsMgr:   mockLogMgr,
-		decMgr:    mockDecMgr,
-		spi:       mockSpi,
+		ctrdCache:             newContainerInfoCache(),
+		ioMgr:                 mockIoMgr,
+		logsMgr:               mockLogMgr,
+		decMgr:                mockDecMgr,
+		spi:                   mockSpi,
+		runtimeHandlers:       testRuntimeHandlers,
+		defaultRuntimeHandler: RuntimeHandlerRunc,
 	}
 	ctx := context.Background()
 
@@ -630,6 +634,171 @@ func TestAttachContainer(t *testing.T) {
 	}
 }
 
+func TestExecContainer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockIoMgr := NewMockcontainerIOManager(mockCtrl)
+	mockTask := containerdMocks.NewMockTask(mockCtrl)
+	mockContainer := containerdMocks.NewMockContainer(mockCtrl)
+	mockProcess := containerdMocks.NewMockProcess(mockCtrl)
+	mockStream := streamsMocks.NewMockStream(mockCtrl)
+	mockIO := NewMockIO(mockCtrl)
+	ctx := context.Background()
+
+	testClient := &containerdClient{
+		ioMgr: mockIoMgr,
+		ctrdCache: &containerInfoCache{
+			cache: map[string]*containerInfo{
+				testContainerID: {
+					c:         &types.Container{ID: testContainerID},
+					container: mockContainer,
+					task:      mockTask,
+				},
+			},
+		},
+	}
+	testExecConfig := &types.ExecConfig{Cmd: []string{"ls", "-la"}, Env: []string{"VAR=1"}, WorkingDir: "/tmp"}
+	testSpec := &specs.Spec{Process: &specs.Process{Args: []string{"sh"}, Env: []string{"PATH=/bin"}, Cwd: "/"}}
+	expectedProcSpec := &specs.Process{Args: testExecConfig.Cmd, Env: []string{"PATH=/bin", "VAR=1"}, Cwd: "/tmp"}
+
+	tests := map[string]struct {
+		ctr      *types.Container
+		mockExec func() (int64, error)
+	}{
+		"test_missing_task": {
+			ctr: &types.Container{ID: "test-container"},
+			mockExec: func() (int64, error) {
+				return -1, log.NewErrorf("missing task for container with ID = %s", "test-container")
+			},
+		},
+		"test_spec_error": {
+			ctr: &types.Container{ID: testContainerID},
+			mockExec: func() (int64, error) {
+				err := log.NewError("test spec error")
+				mockContainer.EXPECT().Spec(ctx).Return(nil, err)
+				return -1, err
+			},
+		},
+		"test_init_io_error": {
+			ctr: &types.Container{ID: testContainerID},
+			mockExec: func() (int64, error) {
+				err := log.NewError("test init IO error")
+				mockContainer.EXPECT().Spec(ctx).Return(testSpec, nil)
+				mockIoMgr.EXPECT().InitIO(gomock.Any(), false).Return(nil, err)
+				return -1, err
+			},
+		},
+		"test_task_exec_error": {
+			ctr: &types.Container{ID: testContainerID},
+			mockExec: func() (int64, error) {
+				err := log.NewError("test exec error")
+				mockContainer.EXPECT().Spec(ctx).Return(testSpec, nil)
+				mockIoMgr.EXPECT().InitIO(gomock.Any(), false).Return(mockIO, nil)
+				mockIoMgr.EXPECT().NewCioCreatorExec(testContainerID, false, gomock.Any(), gomock.Any()).Return(nil)
+				mockTask.EXPECT().Exec(ctx, gomock.Any(), gomock.Eq(expectedProcSpec), gomock.Any()).Return(nil, err)
+				mockIoMgr.EXPECT().ClearIO(gomock.Any()).Return(nil)
+				return -1, err
+			},
+		},
+		"test_process_start_error": {
+			ctr: &types.Container{ID: testContainerID},
+			mockExec: func() (int64, error) {
+				err := log.NewError("test start error")
+				mockContainer.EXPECT().Spec(ctx).Return(testSpec, nil)
+				mockIoMgr.EXPECT().InitIO(gomock.Any(), false).Return(mockIO, nil)
+				mockIoMgr.EXPECT().NewCioCreatorExec(testContainerID, false, gomock.Any(), gomock.Any()).Return(nil)
+				mockTask.EXPECT().Exec(ctx, gomock.Any(), gomock.Eq(expectedProcSpec), gomock.Any()).Return(mockProcess, nil)
+				mockProcess.EXPECT().Wait(ctx).Return(make(chan containerd.ExitStatus), nil)
+				mockIO.EXPECT().Stream().Return(mockStream)
+				mockStream.EXPECT().Attach(ctx, gomock.Any()).Return(make(chan error))
+				mockProcess.EXPECT().Start(ctx).Return(err)
+				mockProcess.EXPECT().Delete(gomock.Any()).Return(nil, nil)
+				mockIoMgr.EXPECT().ClearIO(gomock.Any()).Return(nil)
+				return -1, err
+			},
+		},
+		"test_exec_without_error": {
+			ctr: &types.Container{ID: testContainerID},
+			mockExec: func() (int64, error) {
+				statusCh := make(chan containerd.ExitStatus, 1)
+				statusCh <- *containerd.NewExitStatus(3, time.Now(), nil)
+				attachErrCh := make(chan error, 1)
+				attachErrCh <- nil
+
+				mockContainer.EXPECT().Spec(ctx).Return(testSpec, nil)
+				mockIoMgr.EXPECT().InitIO(gomock.Any(), false).Return(mockIO, nil)
+				mockIoMgr.EXPECT().NewCioCreatorExec(testContainerID, false, gomock.Any(), gomock.Any()).Return(nil)
+				mockTask.EXPECT().Exec(ctx, gomock.Any(), gomock.Eq(expectedProcSpec), gomock.Any()).Return(mockProcess, nil)
+				mockProcess.EXPECT().Wait(ctx).Return(statusCh, nil)
+				mockIO.EXPECT().Stream().Return(mockStream)
+				mockStream.EXPECT().Attach(ctx, gomock.Any()).Return(attachErrCh)
+				mockProcess.EXPECT().Start(ctx).Return(nil)
+				mockProcess.EXPECT().Delete(gomock.Any()).Return(nil, nil)
+				mockIoMgr.EXPECT().ClearIO(gomock.Any()).Return(nil).Times(2)
+				return 3, nil
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			expectedExitCode, expectedErr := testCase.mockExec()
+			exitCode, err := testClient.ExecContainer(ctx, testCase.ctr, testExecConfig, &streams.AttachConfig{UseStdout: true, UseStderr: true})
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expectedExitCode, exitCode)
+		})
+	}
+}
+
+func TestExecContainerContextDone(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockIoMgr := NewMockcontainerIOManager(mockCtrl)
+	mockTask := containerdMocks.NewMockTask(mockCtrl)
+	mockContainer := containerdMocks.NewMockContainer(mockCtrl)
+	mockProcess := containerdMocks.NewMockProcess(mockCtrl)
+	mockStream := streamsMocks.NewMockStream(mockCtrl)
+	mockIO := NewMockIO(mockCtrl)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	testClient := &containerdClient{
+		ioMgr: mockIoMgr,
+		ctrdCache: &containerInfoCache{
+			cache: map[string]*containerInfo{
+				testContainerID: {
+					c:         &types.Container{ID: testContainerID},
+					container: mockContainer,
+					task:      mockTask,
+				},
+			},
+		},
+	}
+	testSpec := &specs.Spec{Process: &specs.Process{Args: []string{"sh"}, Env: []string{"PATH=/bin"}, Cwd: "/"}}
+
+	statusCh := make(chan containerd.ExitStatus, 1)
+	mockContainer.EXPECT().Spec(ctx).Return(testSpec, nil)
+	mockIoMgr.EXPECT().InitIO(gomock.Any(), false).Return(mockIO, nil)
+	mockIoMgr.EXPECT().NewCioCreatorExec(testContainerID, false, gomock.Any(), gomock.Any()).Return(nil)
+	mockTask.EXPECT().Exec(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProcess, nil)
+	mockProcess.EXPECT().Wait(ctx).Return(statusCh, nil)
+	mockIO.EXPECT().Stream().Return(mockStream)
+	mockStream.EXPECT().Attach(ctx, gomock.Any()).Return(make(chan error))
+	mockProcess.EXPECT().Start(ctx).DoAndReturn(func(ctx context.Context) error {
+		// the context is done while the process is still running
+		cancel()
+		statusCh <- *containerd.NewExitStatus(containerd.UnknownExitStatus, time.Time{}, context.Canceled)
+		return nil
+	})
+	mockProcess.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockIoMgr.EXPECT().ClearIO(gomock.Any()).Return(nil)
+
+	exitCode, err := testClient.ExecContainer(ctx, &types.Container{ID: testContainerID}, &types.ExecConfig{Cmd: []string{"sleep", "100"}}, &streams.AttachConfig{UseStdout: true, UseStderr: true})
+	testutil.AssertError(t, context.Canceled, err)
+	testutil.AssertEqual(t, int64(-1), exitCode)
+}
+
 func TestPauseContainer(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -750,6 +919,68 @@ func TestUnpauseContainer(t *testing.T) {
 	}
 }
 
+func TestCheckpointContainer(t *testing.T) {
+	const checkpointDir = "/tmp/checkpoints/test-checkpoint"
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTask := containerdMocks.NewMockTask(mockCtrl)
+
+	testClient := &containerdClient{
+		ctrdCache: &containerInfoCache{
+			cache: map[string]*containerInfo{
+				testContainerID: {
+					c: &types.Container{
+						ID: testContainerID,
+					},
+					task: mockTask,
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		arg      *types.Container
+		mockExec func(context context.Context, mockTask *containerdMocks.MockTask) error
+	}{
+		"test_error_missing_container_to_checkpoint": {
+			arg: &types.Container{
+				ID: "test-container",
+			},
+			mockExec: func(context context.Context, mockTask *containerdMocks.MockTask) error {
+				return log.NewErrorf("missing task for container with ID = test-container")
+			},
+		},
+		"test_checkpoint_container_with_error": {
+			arg: &types.Container{
+				ID: testContainerID,
+			},
+			mockExec: func(context context.Context, mockTask *containerdMocks.MockTask) error {
+				err := log.NewErrorf("test checkpoint task error")
+				mockTask.EXPECT().Checkpoint(context, gomock.Any()).Return(nil, err)
+				return err
+			},
+		},
+		"test_checkpoint_container_without_error": {
+			arg: &types.Container{
+				ID: testContainerID,
+			},
+			mockExec: func(context context.Context, mockTask *containerdMocks.MockTask) error {
+				mockTask.EXPECT().Checkpoint(context, gomock.Any()).Return(nil, nil)
+				return nil
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.mockExec(ctx, mockTask), testClient.CheckpointContainer(ctx, testCase.arg, checkpointDir))
+		})
+	}
+}
+
 func TestListContainers(t *testing.T) {
 	tests := map[string]struct {
 		testClient *containerdClient
@@ -1055,6 +1286,17 @@ func TestCtrdClientUpdateContainer(t *testing.T) {
 	testCtrID := "test-update-id"
 	unlimited := int64(-1)
 
+	defaultGetBlockDeviceNumbers := getBlockDeviceNumbers
+	defer func() {
+		getBlockDeviceNumbers = defaultGetBlockDeviceNumbers
+	}()
+	getBlockDeviceNumbers = func(path string) (int64, int64, error) {
+		if path == "/dev/sda" {
+			return 8, 0, nil
+		}
+		return 0, 0, log.NewErrorf("%s is not a block device", path)
+	}
+
 	var testClient *containerdClient
 	tests := map[string]struct {
 		ctr       *types.Container
@@ -1112,6 +1354,100 @@ func TestCtrdClientUpdateContainer(t *testing.T) {
 				return nil
 			},
 		},
+		"test_with_initially_missing_cpu_pids_blkio_limits": {
+			ctr: &types.Container{
+				ID:         testCtrID,
+				HostConfig: &types.HostConfig{},
+			},
+			resources: &types.Resources{
+				CPUQuota:           50000,
+				CPUShares:          512,
+				CPUSetCPUs:         "0-1",
+				PidsLimit:          100,
+				BlkioWeight:        300,
+				BlkioDeviceReadBps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}},
+			},
+			mockExec: func() error {
+				quota := int64(50000)
+				shares := uint64(512)
+				weight := uint16(300)
+				readBps := specs.LinuxThrottleDevice{Rate: 1024}
+				readBps.Major, readBps.Minor = 8, 0
+				resources := &specs.LinuxResources{
+					Devices: spec.Linux.Resources.Devices,
+					Memory:  &specs.LinuxMemory{},
+					CPU: &specs.LinuxCPU{
+						Quota:  &quota,
+						Shares: &shares,
+						Cpus:   "0-1",
+					},
+					Pids: &specs.LinuxPids{Limit: 100},
+					BlockIO: &specs.LinuxBlockIO{
+						Weight:                &weight,
+						ThrottleReadBpsDevice: []specs.LinuxThrottleDevice{readBps},
+					},
+				}
+				mockContainer.EXPECT().Spec(ctx).Return(spec, nil)
+				mockTask.EXPECT().Update(ctx, matchers.MatchesUpdateTaskOpts(containerd.WithResources(resources))).Return(nil)
+				return nil
+			},
+		},
+		"test_with_removed_cpu_pids_blkio_limits": {
+			ctr: &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					Resources: &types.Resources{
+						CPUQuota:            50000,
+						CPUPeriod:           50000,
+						CPUShares:           512,
+						PidsLimit:           100,
+						BlkioDeviceReadBps:  []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}},
+						BlkioDeviceWriteBps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 2048}},
+					},
+				},
+			},
+			resources: &types.Resources{
+				BlkioDeviceWriteBps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 4096}},
+			},
+			mockExec: func() error {
+				period := uint64(100000)
+				shares := uint64(1024)
+				readBps := specs.LinuxThrottleDevice{}
+				readBps.Major, readBps.Minor = 8, 0
+				writeBps := specs.LinuxThrottleDevice{Rate: 4096}
+				writeBps.Major, writeBps.Minor = 8, 0
+				resources := &specs.LinuxResources{
+					Devices: spec.Linux.Resources.Devices,
+					Memory:  &specs.LinuxMemory{},
+					CPU: &specs.LinuxCPU{
+						Quota:  &unlimited,
+						Period: &period,
+						Shares: &shares,
+					},
+					Pids: &specs.LinuxPids{Limit: -1},
+					BlockIO: &specs.LinuxBlockIO{
+						ThrottleReadBpsDevice:  []specs.LinuxThrottleDevice{readBps},
+						ThrottleWriteBpsDevice: []specs.LinuxThrottleDevice{writeBps},
+					},
+				}
+				mockContainer.EXPECT().Spec(ctx).Return(spec, nil)
+				mockTask.EXPECT().Update(ctx, matchers.MatchesUpdateTaskOpts(containerd.WithResources(resources))).Return(nil)
+				return nil
+			},
+		},
+		"test_throttle_device_err": {
+			ctr: &types.Container{
+				ID:         testCtrID,
+				HostConfig: &types.HostConfig{},
+			},
+			resources: &types.Resources{
+				BlkioDeviceWriteIOps: []types.ThrottleDevice{{Path: "/dev/missing", Rate: 100}},
+			},
+			mockExec: func() error {
+				mockContainer.EXPECT().Spec(ctx).Return(spec, nil)
+				return log.NewErrorf("%s is not a block device", "/dev/missing")
+			},
+		},
 		"test_no_error_nil_resources": {
 			ctr: &types.Container{
 				ID:         testCtrID,
@@ -13,66 +13,53 @@
 package ctr
 
 import (
-	"context"
 	"fmt"
 	"path/filepath"
 
 	"github.com/containerd/containerd"
-	"github.com/containerd/containerd/containers"
 	ctrdoci "github.com/containerd/containerd/oci"
 	"github.com/containerd/containerd/runtime/linux/runctypes"
 	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
-	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const rootFSPathDefault = "rootfs"
 
-var (
-	// CtrdRuntimes contains all runtime type names
-	CtrdRuntimes = map[types.Runtime]string{
-		types.RuntimeTypeV1:        "runc",
-		types.RuntimeTypeV2runscV1: "runhcs",
-		types.RuntimeTypeV2kataV2:  "kata",
-		types.RuntimeTypeV2runcV1:  "runc",
-		types.RuntimeTypeV2runcV2:  "runc",
-	}
-)
-
-// WithRuntimeOpts sets the runtime configuration for the container to be created.
-func WithRuntimeOpts(container *types.Container, runtimeRootPath string) containerd.NewContainerOpts {
+// WithRuntimeOpts sets the runtime configuration of the runtime handler for the container to be created.
+// The runtime options are passed to the shim only if configured for the handler.
+func WithRuntimeOpts(container *types.Container, runtimeHandler *types.RuntimeHandler) containerd.NewContainerOpts {
 	var (
 		options interface{}
 	)
 
-	runtimePath := CtrdRuntimes[container.HostConfig.Runtime]
-	runtimeRootPathFinal := filepath.Join(runtimePath, fmt.Sprintf("runtimes-%s", container.HostConfig.Runtime))
-
-	useSystemd := util.IsRunningSystemd()
-	switch container.HostConfig.Runtime {
-	case types.RuntimeTypeV1, types.RuntimeTypeV2runscV1, types.RuntimeTypeV2kataV2:
-		options = &runctypes.RuncOptions{
-			Runtime:       runtimePath,
-			RuntimeRoot:   runtimeRootPathFinal,
-			SystemdCgroup: useSystemd,
+	if runtimeHandler.Options != nil {
+		binaryName := runtimeHandler.Options.BinaryName
+		if binaryName == "" {
+			binaryName = runcBinaryName
 		}
-	case types.RuntimeTypeV2runcV1, types.RuntimeTypeV2runcV2:
-		options = &runcoptions.Options{
-			BinaryName:    runtimePath,
-			Root:          runtimeRootPathFinal,
-			SystemdCgroup: useSystemd,
+		runtimeRoot := runtimeHandler.Options.Root
+		if runtimeRoot == "" {
+			runtimeRoot = filepath.Join(filepath.Base(binaryName), fmt.Sprintf("runtimes-%s", runtimeHandler.RuntimeType))
 		}
-	default:
-		return func(_ context.Context, client *containerd.Client, _ *containers.Container) error {
-			// do nothing
-			return nil
+		if runtimeHandler.RuntimeType == types.RuntimeTypeV1 {
+			options = &runctypes.RuncOptions{
+				Runtime:       binaryName,
+				RuntimeRoot:   runtimeRoot,
+				SystemdCgroup: runtimeHandler.Options.SystemdCgroup,
+			}
+		} else {
+			options = &runcoptions.Options{
+				BinaryName:    binaryName,
+				Root:          runtimeRoot,
+				SystemdCgroup: runtimeHandler.Options.SystemdCgroup,
+			}
 		}
-
 	}
 
-	log.Info("will create options for runtime with name = %s, for container ID = ", container.HostConfig.Runtime, container.ID)
-	return containerd.WithRuntime(string(container.HostConfig.Runtime), options)
+	log.Info("will create options for runtime handler %s with runtime type = %s, for container ID = %s", runtimeHandler.Name, runtimeHandler.RuntimeType, container.ID)
+	return containerd.WithRuntime(string(runtimeHandler.RuntimeType), options)
 }
 
 // WithSnapshotOpts sets the snapshotting configuration for the container to be created.
@@ -84,7 +71,8 @@ func WithSnapshotOpts(snapshotID string, snapshotterType string) []containerd.Ne
 }
 
 // WithSpecOpts sets the OCI specification configuration options for the container to be created.
-func WithSpecOpts(container *types.Container, image containerd.Image, execRoot string) containerd.NewContainerOpts {
+func WithSpecOpts(container *types.Container, image containerd.Image, execRoot string, systemdCgroup bool, uidMappings, gidMappings []specs.LinuxIDMapping,
+	namespaceTargetPids map[specs.LinuxNamespaceType]uint32) containerd.NewContainerOpts {
 	var args, env []string
 	if container.Config != nil {
 		args = container.Config.Cmd
@@ -93,23 +81,32 @@ func WithSpecOpts(container *types.Container, image containerd.Image, execRoot s
 
 	specOpts := []ctrdoci.SpecOpts{
 		ctrdoci.WithImageConfigArgs(image, args),
+		WithProcessOptions(container),
 		WithCommonOptions(container),
 		ctrdoci.WithEnv(env),
 		WithDevices(container),
 		WithMounts(container),
-		WithNamespaces(container),
+		WithNamespaces(container, uidMappings, gidMappings, namespaceTargetPids),
+		WithShm(container, namespaceTargetPids[specs.IPCNamespace]),
 		WithHooks(container, execRoot),
 		WithResources(container),
-		WithCgroupsPath(container),
+		WithUlimits(container),
+		WithSysctls(container),
+		WithCgroupsPath(container, systemdCgroup),
 		ctrdoci.WithRootFSPath(rootFSPathDefault),
 	}
 
 	if container.HostConfig.Privileged {
 		specOpts = append(specOpts, ctrdoci.WithPrivileged)
 	}
-	if len(container.HostConfig.ExtraCapabilities) > 0 {
-		specOpts = append(specOpts, ctrdoci.WithAddedCapabilities(container.HostConfig.ExtraCapabilities))
+	if len(container.HostConfig.ExtraCapabilities) > 0 || len(container.HostConfig.DroppedCapabilities) > 0 {
+		specOpts = append(specOpts, WithCapabilities(container))
+	}
+	if container.HostConfig.ReadOnlyRootfs {
+		specOpts = append(specOpts, ctrdoci.WithRootFSReadonly())
 	}
+	// must follow the capabilities options as the default seccomp profile depends on them
+	specOpts = append(specOpts, WithSecurityOptions(container))
 
 	return containerd.WithNewSpec(specOpts...)
 }
@@ -23,40 +23,38 @@ import (
 )
 
 func TestWithRuntimeOpts(t *testing.T) {
+	container := &types.Container{
+		ID:         testCtrID1,
+		Name:       testContainerName,
+		HostConfig: &types.HostConfig{},
+	}
 	tests := map[string]struct {
-		container *types.Container
+		runtimeHandler *types.RuntimeHandler
 	}{
 		"test_runtime_type_v1": {
-			&types.Container{
-				ID:   testCtrID1,
-				Name: testContainerName,
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV1,
-				},
+			&types.RuntimeHandler{
+				Name:        "runc-v1",
+				RuntimeType: types.RuntimeTypeV1,
+				Options:     &types.RuntimeOptions{BinaryName: "runc"},
 			},
 		},
 		"test_runtime_type_v2": {
-			&types.Container{
-				ID:   testCtrID1,
-				Name: testContainerName,
-				HostConfig: &types.HostConfig{
-					Runtime: types.RuntimeTypeV2runcV1,
-				},
+			&types.RuntimeHandler{
+				Name:        RuntimeHandlerRunc,
+				RuntimeType: types.RuntimeTypeV2runcV2,
+				Options:     &types.RuntimeOptions{BinaryName: "runc", Root: "/run/runc", SystemdCgroup: true},
 			},
 		},
-		"testing_default": {
-			&types.Container{
-				ID:   testCtrID1,
-				Name: testContainerName,
-				HostConfig: &types.HostConfig{
-					Runtime: "",
-				},
+		"test_no_options": {
+			&types.RuntimeHandler{
+				Name:        "kata",
+				RuntimeType: types.RuntimeTypeV2kataV2,
 			},
 		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			testutil.AssertNotNil(t, WithRuntimeOpts(test.container, ""))
+			testutil.AssertNotNil(t, WithRuntimeOpts(container, test.runtimeHandler))
 		})
 	}
 }
@@ -88,10 +86,17 @@ func TestWithSpecOpts(t *testing.T) {
 				},
 			},
 		},
+		"test_read_only_rootfs": {
+			&types.Container{
+				HostConfig: &types.HostConfig{
+					ReadOnlyRootfs: true,
+				},
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			testutil.AssertNotNil(t, WithSpecOpts(test.container, containerd.NewImage(&containerd.Client{}, images.Image{}), "/tmp/test"))
+			testutil.AssertNotNil(t, WithSpecOpts(test.container, containerd.NewImage(&containerd.Client{}, images.Image{}), "/tmp/test", false, nil, nil, nil))
 		})
 	}
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/platforms"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const noSuchImageErrorMsg = "image with ID = %s does not exist"
+
+func (ctrdClient *containerdClient) toImageInfo(ctx context.Context, image containerd.Image) *imagestypes.ImageInfo {
+	metadata := image.Metadata()
+	imageInfo := &imagestypes.ImageInfo{
+		Name:     image.Name(),
+		Digest:   image.Target().Digest.String(),
+		Platform: getImagePlatform(ctx, image),
+		Created:  metadata.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	size, err := image.Size(ctx)
+	if err != nil {
+		log.DebugErr(err, "could not get the size of image = %s", imageInfo.Name)
+	}
+	imageInfo.Size = size
+	if !ctrdClient.imageExpiryDisable {
+		imageInfo.Expiry = metadata.CreatedAt.Add(ctrdClient.imageExpiry).UTC().Format(time.RFC3339Nano)
+	}
+	return imageInfo
+}
+
+func getImagePlatform(ctx context.Context, image containerd.Image) string {
+	configDesc, err := image.Config(ctx)
+	if err != nil {
+		log.DebugErr(err, "could not get the config descriptor of image = %s", image.Name())
+		return ""
+	}
+	if configDesc.Platform != nil {
+		return platforms.Format(*configDesc.Platform)
+	}
+	configBytes, err := content.ReadBlob(ctx, image.ContentStore(), configDesc)
+	if err != nil {
+		log.DebugErr(err, "could not read the config of image = %s", image.Name())
+		return ""
+	}
+	var imageConfig ocispec.Image
+	if err = json.Unmarshal(configBytes, &imageConfig); err != nil {
+		log.DebugErr(err, "could not parse the config of image = %s", image.Name())
+		return ""
+	}
+	return platforms.Format(imageConfig.Platform)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/containers/ocicrypt/config"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksContainerd "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/containerd"
+	mocksCtrd "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctrd"
+	"github.com/golang/mock/gomock"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const testImageRef = "test.image/ref:latest"
+
+var (
+	testImageDigest  = digest.NewDigest(digest.SHA256, sha256.New())
+	testImageCreated = time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)
+)
+
+func mockImageInfoExec(ctx context.Context, imageMock *mocksContainerd.MockImage) {
+	imageMock.EXPECT().Name().Return(testImageRef).AnyTimes()
+	imageMock.EXPECT().Target().Return(ocispec.Descriptor{Digest: testImageDigest})
+	imageMock.EXPECT().Metadata().Return(images.Image{Name: testImageRef, CreatedAt: testImageCreated})
+	imageMock.EXPECT().Size(ctx).Return(int64(1024), nil)
+	imageMock.EXPECT().Config(ctx).Return(ocispec.Descriptor{Platform: &ocispec.Platform{OS: "linux", Architecture: "arm64"}}, nil)
+}
+
+func newTestImageInfo(expiry string) *imagestypes.ImageInfo {
+	return &imagestypes.ImageInfo{
+		Name:     testImageRef,
+		Digest:   testImageDigest.String(),
+		Size:     1024,
+		Platform: "linux/arm64",
+		Created:  "2026-01-02T15:04:05Z",
+		Expiry:   expiry,
+	}
+}
+
+func TestCtrdClientToImageInfo(t *testing.T) {
+	tests := map[string]struct {
+		expiryDisable bool
+		mockExec      func(ctx context.Context, imageMock *mocksContainerd.MockImage) *imagestypes.ImageInfo
+	}{
+		"test_expiry_enabled": {
+			mockExec: func(ctx context.Context, imageMock *mocksContainerd.MockImage) *imagestypes.ImageInfo {
+				mockImageInfoExec(ctx, imageMock)
+				return newTestImageInfo("2026-01-03T15:04:05Z")
+			},
+		},
+		"test_expiry_disabled": {
+			expiryDisable: true,
+			mockExec: func(ctx context.Context, imageMock *mocksContainerd.MockImage) *imagestypes.ImageInfo {
+				mockImageInfoExec(ctx, imageMock)
+				return newTestImageInfo("")
+			},
+		},
+		"test_size_and_config_errors": {
+			expiryDisable: true,
+			mockExec: func(ctx context.Context, imageMock *mocksContainerd.MockImage) *imagestypes.ImageInfo {
+				imageMock.EXPECT().Name().Return(testImageRef).AnyTimes()
+				imageMock.EXPECT().Target().Return(ocispec.Descriptor{Digest: testImageDigest})
+				imageMock.EXPECT().Metadata().Return(images.Image{Name: testImageRef, CreatedAt: testImageCreated})
+				imageMock.EXPECT().Size(ctx).Return(int64(0), log.NewError("test error"))
+				imageMock.EXPECT().Config(ctx).Return(ocispec.Descriptor{}, log.NewError("test error"))
+				imageInfo := newTestImageInfo("")
+				imageInfo.Size = 0
+				imageInfo.Platform = ""
+				return imageInfo
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			imageMock := mocksContainerd.NewMockImage(ctrl)
+			ctrdClient := &containerdClient{
+				imageExpiry:        24 * time.Hour,
+				imageExpiryDisable: testCase.expiryDisable,
+			}
+
+			expected := testCase.mockExec(ctx, imageMock)
+			testutil.AssertEqual(t, expected, ctrdClient.toImageInfo(ctx, imageMock))
+		})
+	}
+}
+
+func TestCtrdClientListImages(t *testing.T) {
+	tests := map[string]struct {
+		mockExec func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) ([]*imagestypes.ImageInfo, error)
+	}{
+		"test_list_images": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) ([]*imagestypes.ImageInfo, error) {
+				spiMock.EXPECT().ListImages(ctx).Return([]containerd.Image{imageMock}, nil)
+				mockImageInfoExec(ctx, imageMock)
+				return []*imagestypes.ImageInfo{newTestImageInfo("")}, nil
+			},
+		},
+		"test_list_images_error": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) ([]*imagestypes.ImageInfo, error) {
+				err := log.NewError("test error")
+				spiMock.EXPECT().ListImages(ctx).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			spiMock := mocksCtrd.NewMockcontainerdSpi(ctrl)
+			imageMock := mocksContainerd.NewMockImage(ctrl)
+			ctrdClient := &containerdClient{
+				spi:                spiMock,
+				imageExpiryDisable: true,
+			}
+
+			expected, expectedErr := testCase.mockExec(ctx, spiMock, imageMock)
+			actual, err := ctrdClient.ListImages(ctx)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expected, actual)
+		})
+	}
+}
+
+func TestCtrdClientGetImage(t *testing.T) {
+	tests := map[string]struct {
+		mockExec func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error)
+	}{
+		"test_get_image": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error) {
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil)
+				mockImageInfoExec(ctx, imageMock)
+				return newTestImageInfo(""), nil
+			},
+		},
+		"test_get_image_not_found": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error) {
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(nil, errdefs.ErrNotFound)
+				return nil, log.NewErrorf(noSuchImageErrorMsg, testImageRef)
+			},
+		},
+		"test_get_image_error": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error) {
+				err := log.NewError("test error")
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			spiMock := mocksCtrd.NewMockcontainerdSpi(ctrl)
+			imageMock := mocksContainerd.NewMockImage(ctrl)
+			ctrdClient := &containerdClient{
+				spi:                spiMock,
+				imageExpiryDisable: true,
+			}
+
+			expected, expectedErr := testCase.mockExec(ctx, spiMock, imageMock)
+			actual, err := ctrdClient.GetImage(ctx, testImageRef)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expected, actual)
+		})
+	}
+}
+
+func TestCtrdClientPullImage(t *testing.T) {
+	testImage := types.Image{Name: testImageRef}
+	tests := map[string]struct {
+		mockExec func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, decryptMgrMock *mocksCtrd.MockcontainerDecryptMgr, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error)
+	}{
+		"test_pull_image_existing": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, decryptMgrMock *mocksCtrd.MockcontainerDecryptMgr, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error) {
+				dc := &config.DecryptConfig{}
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil).Times(2)
+				decryptMgrMock.EXPECT().GetDecryptConfig(testImage.DecryptConfig).Return(dc, nil)
+				decryptMgrMock.EXPECT().CheckAuthorization(ctx, imageMock, dc).Return(nil)
+				mockImageInfoExec(ctx, imageMock)
+				return newTestImageInfo(""), nil
+			},
+		},
+		"test_pull_image_error": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, decryptMgrMock *mocksCtrd.MockcontainerDecryptMgr, imageMock *mocksContainerd.MockImage) (*imagestypes.ImageInfo, error) {
+				err := log.NewError("test error")
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil)
+				decryptMgrMock.EXPECT().GetDecryptConfig(testImage.DecryptConfig).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			spiMock := mocksCtrd.NewMockcontainerdSpi(ctrl)
+			decryptMgrMock := mocksCtrd.NewMockcontainerDecryptMgr(ctrl)
+			imageMock := mocksContainerd.NewMockImage(ctrl)
+			ctrdClient := &containerdClient{
+				spi:                spiMock,
+				decMgr:             decryptMgrMock,
+				imageExpiryDisable: true,
+			}
+
+			expected, expectedErr := testCase.mockExec(ctx, spiMock, decryptMgrMock, imageMock)
+			actual, err := ctrdClient.PullImage(ctx, testImage)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expected, actual)
+		})
+	}
+}
+
+func TestCtrdClientRemoveImage(t *testing.T) {
+	tests := map[string]struct {
+		mockExec func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error
+	}{
+		"test_remove_image": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil)
+				imageMock.EXPECT().Name().Return(testImageRef).AnyTimes()
+				imageMock.EXPECT().RootFS(ctx).Return([]digest.Digest{testImageDigest}, nil)
+				spiMock.EXPECT().ListSnapshots(ctx, fmt.Sprintf(snapshotsWalkFilterFormat, testImageDigest.String())).Return(nil, nil)
+				spiMock.EXPECT().DeleteImage(ctx, testImageRef).Return(nil)
+				return nil
+			},
+		},
+		"test_remove_image_not_found": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(nil, errdefs.ErrNotFound)
+				return log.NewErrorf(noSuchImageErrorMsg, testImageRef)
+			},
+		},
+		"test_remove_image_get_error": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
+				err := log.NewError("test error")
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(nil, err)
+				return err
+			},
+		},
+		"test_remove_image_in_use": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil)
+				imageMock.EXPECT().Name().Return(testImageRef).AnyTimes()
+				imageMock.EXPECT().RootFS(ctx).Return([]digest.Digest{testImageDigest}, nil)
+				spiMock.EXPECT().ListSnapshots(ctx, fmt.Sprintf(snapshotsWalkFilterFormat, testImageDigest.String())).Return([]snapshots.Info{{}}, nil)
+				return log.NewErrorf("image with ID = %s is in use by a container", testImageRef)
+			},
+		},
+		"test_remove_image_delete_error": {
+			mockExec: func(ctx context.Context, spiMock *mocksCtrd.MockcontainerdSpi, imageMock *mocksContainerd.MockImage) error {
+				err := log.NewError("test error")
+				spiMock.EXPECT().GetImage(ctx, testImageRef).Return(imageMock, nil)
+				imageMock.EXPECT().Name().Return(testImageRef).AnyTimes()
+				imageMock.EXPECT().RootFS(ctx).Return([]digest.Digest{testImageDigest}, nil)
+				spiMock.EXPECT().ListSnapshots(ctx, fmt.Sprintf(snapshotsWalkFilterFormat, testImageDigest.String())).Return(nil, nil)
+				spiMock.EXPECT().DeleteImage(ctx, testImageRef).Return(err)
+				return err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			spiMock := mocksCtrd.NewMockcontainerdSpi(ctrl)
+			imageMock := mocksContainerd.NewMockImage(ctrl)
+			ctrdClient := &containerdClient{
+				spi: spiMock,
+			}
+
+			expectedErr := testCase.mockExec(ctx, spiMock, imageMock)
+			testutil.AssertError(t, expectedErr, ctrdClient.RemoveImage(ctx, testImageRef))
+		})
+	}
+}
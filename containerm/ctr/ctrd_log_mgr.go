@@ -15,11 +15,15 @@ package ctr
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/logger/journald"
 	"github.com/eclipse-kanto/container-management/containerm/logger/jsonfile"
+	"github.com/eclipse-kanto/container-management/containerm/logger/local"
+	"github.com/eclipse-kanto/container-management/containerm/logger/syslog"
 	"github.com/eclipse-kanto/container-management/containerm/util"
 )
 
@@ -27,12 +31,31 @@ type containerLogsManager interface {
 	GetLogDriver(c *types.Container) (logger.LogDriver, error)
 }
 
-func newContainerLogsManager(metaPath string) containerLogsManager {
-	return &ctrLogsMgr{containerLogsDirRoot: metaPath}
+func newContainerLogsManager(metaPath string, logCompression types.LogCompression, logMaxAge time.Duration, logDiskBudget int64) containerLogsManager {
+	mgr := &ctrLogsMgr{
+		containerLogsDirRoot: metaPath,
+		logCompression:       logCompression,
+		logMaxAge:            logMaxAge,
+	}
+	if logDiskBudget > 0 {
+		mgr.diskBudget = jsonfile.NewDiskBudget(logDiskBudget)
+		// the logs of the containers that are not started yet also count towards the disk budget
+		logFiles, _ := filepath.Glob(filepath.Join(metaPath, "*", jsonfile.JSONLogFileName))
+		for _, logFile := range logFiles {
+			mgr.diskBudget.Track(logFile)
+		}
+	}
+	return mgr
 }
 
+type logDriverFactory func(info logger.LogDriverInfo, configOpts ...logger.LogConfigOption) (logger.LogDriver, error)
+
 type ctrLogsMgr struct {
 	containerLogsDirRoot string
+	// the defaults for the json-file log driver
+	logCompression types.LogCompression
+	logMaxAge      time.Duration
+	diskBudget     *jsonfile.DiskBudget
 }
 
 func (mgr *ctrLogsMgr) GetLogDriver(container *types.Container) (logger.LogDriver, error) {
@@ -41,36 +64,98 @@ func (mgr *ctrLogsMgr) GetLogDriver(container *types.Container) (logger.LogDrive
 		return nil, nil
 	}
 
+	// the syslog and journald drivers do not store the logs locally
+	switch cfg.DriverConfig.Type {
+	case types.LogConfigDriverSyslog:
+		return mgr.newLogDriver(container, "", syslog.NewSyslogLog)
+	case types.LogConfigDriverJournald:
+		return mgr.newLogDriver(container, "", journald.NewJournaldLog)
+	}
+
 	ctrLogsRootDir, err := mgr.initContainerLogsRootDir(container)
 	if err != nil {
 		return nil, err
 	}
 
-	if cfg.DriverConfig.Type == types.LogConfigDriverJSONFile {
-		logDriverInfo := mgr.prepareLogDriverInfo(container, ctrLogsRootDir)
-		logDriverCfg, cfgErr := mgr.prepareLogDriverConfig(container.HostConfig.LogConfig.DriverConfig)
-		if cfgErr != nil {
-			log.ErrorErr(cfgErr, "error processing log info for container id = %s", container.ID)
-			return nil, cfgErr
-		}
-		return jsonfile.NewJSONFileLog(logDriverInfo, logDriverCfg...)
+	switch cfg.DriverConfig.Type {
+	case types.LogConfigDriverJSONFile:
+		return mgr.newLogDriver(container, ctrLogsRootDir, jsonfile.NewJSONFileLog)
+	case types.LogConfigDriverLocal:
+		return mgr.newLogDriver(container, ctrLogsRootDir, local.NewLocalLog)
 	}
 
 	log.Warn("unsupported log driver %s", cfg.DriverConfig.Type)
 	return nil, nil
 }
 
+func (mgr *ctrLogsMgr) newLogDriver(container *types.Container, ctrLogsRootDir string, newDriver logDriverFactory) (logger.LogDriver, error) {
+	logDriverInfo := mgr.prepareLogDriverInfo(container, ctrLogsRootDir)
+	logDriverCfg, cfgErr := mgr.prepareLogDriverConfig(container.HostConfig.LogConfig.DriverConfig)
+	if cfgErr != nil {
+		log.ErrorErr(cfgErr, "error processing log info for container id = %s", container.ID)
+		return nil, cfgErr
+	}
+	return newDriver(logDriverInfo, logDriverCfg...)
+}
+
 func (mgr *ctrLogsMgr) prepareLogDriverConfig(driverCfg *types.LogDriverConfiguration) ([]logger.LogConfigOption, error) {
 	var logConfigs []logger.LogConfigOption
+	switch driverCfg.Type {
+	case types.LogConfigDriverSyslog:
+		if driverCfg.Address != "" {
+			logConfigs = append(logConfigs, syslog.WithAddress(driverCfg.Address))
+		}
+		if driverCfg.Facility != "" {
+			logConfigs = append(logConfigs, syslog.WithFacility(driverCfg.Facility))
+		}
+		if driverCfg.Tag != "" {
+			logConfigs = append(logConfigs, syslog.WithTag(driverCfg.Tag))
+		}
+		return logConfigs, nil
+	case types.LogConfigDriverJournald:
+		if driverCfg.Tag != "" {
+			logConfigs = append(logConfigs, journald.WithTag(driverCfg.Tag))
+		}
+		return logConfigs, nil
+	}
+
+	withMaxFiles, withMaxSize := jsonfile.WithMaxFiles, jsonfile.WithMaxSize
+	if driverCfg.Type == types.LogConfigDriverLocal {
+		withMaxFiles, withMaxSize = local.WithMaxFiles, local.WithMaxSize
+	}
 	if driverCfg.MaxFiles != 0 {
-		logConfigs = append(logConfigs, jsonfile.WithMaxFiles(driverCfg.MaxFiles))
+		logConfigs = append(logConfigs, withMaxFiles(driverCfg.MaxFiles))
 	}
 	if driverCfg.MaxSize != "" {
 		bytes, err := util.SizeToBytes(driverCfg.MaxSize)
 		if err != nil {
 			return nil, err
 		}
-		logConfigs = append(logConfigs, jsonfile.WithMaxSize(bytes))
+		logConfigs = append(logConfigs, withMaxSize(bytes))
+	}
+	if driverCfg.Type != types.LogConfigDriverJSONFile {
+		return logConfigs, nil
+	}
+
+	compression := driverCfg.Compress
+	if compression == "" {
+		compression = mgr.logCompression
+	}
+	if compression != "" {
+		logConfigs = append(logConfigs, jsonfile.WithCompression(jsonfile.Compression(compression)))
+	}
+	maxAge := mgr.logMaxAge
+	if driverCfg.MaxAge != "" {
+		var err error
+		if maxAge, err = time.ParseDuration(driverCfg.MaxAge); err != nil {
+			return nil, err
+		}
+	}
+	if maxAge != 0 {
+		logConfigs = append(logConfigs, jsonfile.WithMaxAge(maxAge))
+	}
+	if mgr.diskBudget != nil {
+		logConfigs = append(logConfigs, jsonfile.WithDiskBudget(mgr.diskBudget))
 	}
 	return logConfigs, nil
 }
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
@@ -32,7 +33,7 @@ var (
 )
 
 func TestNewConainerLogsManager(t *testing.T) {
-	containerLogsManager := newContainerLogsManager(testPath)
+	containerLogsManager := newContainerLogsManager(testPath, "", 0, 0)
 	testutil.AssertEqual(t, testPath, containerLogsManager.(*ctrLogsMgr).containerLogsDirRoot)
 }
 
@@ -109,6 +110,54 @@ func TestGetLogDriver(t *testing.T) {
 			expectedDriver: true,
 			expectedError:  nil,
 		},
+		"test_normal_local": {
+			container: &types.Container{
+				ID: testID,
+				HostConfig: &types.HostConfig{
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverLocal,
+							MaxFiles: 2,
+							MaxSize:  "1M",
+						},
+					},
+				},
+			},
+			expectedDriver: true,
+			expectedError:  nil,
+		},
+		"test_normal_syslog": {
+			container: &types.Container{
+				ID:   testID,
+				Name: "test-name",
+				HostConfig: &types.HostConfig{
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:    types.LogConfigDriverSyslog,
+							Address: "udp://127.0.0.1:5514",
+						},
+					},
+				},
+			},
+			expectedDriver: true,
+			expectedError:  nil,
+		},
+		"test_error_in_syslog": {
+			container: &types.Container{
+				ID: testID,
+				HostConfig: &types.HostConfig{
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverSyslog,
+							Address:  "udp://127.0.0.1:5514",
+							Facility: "invalid",
+						},
+					},
+				},
+			},
+			expectedDriver: false,
+			expectedError:  errors.New("unsupported syslog facility invalid"),
+		},
 		"test_not_supported_file_format": {
 			container: &types.Container{
 				HostConfig: &types.HostConfig{
@@ -127,12 +176,13 @@ func TestGetLogDriver(t *testing.T) {
 
 	for testName, testCase := range testCases {
 		t.Run(testName, func(t *testing.T) {
-			containerLogsManager := newContainerLogsManager(testPath)
+			containerLogsManager := newContainerLogsManager(testPath, "", 0, 0)
 			driver, err := containerLogsManager.GetLogDriver(testCase.container)
 
 			testutil.AssertError(t, testCase.expectedError, err)
 			if testCase.expectedDriver {
 				testutil.AssertNotNil(t, driver)
+				testutil.AssertNil(t, driver.Close())
 			} else {
 				testutil.AssertNil(t, driver)
 			}
@@ -200,6 +250,54 @@ func TestPrepareLogDriverConfig(t *testing.T) {
 			expectedOptionSize: 2,
 			expectedError:      nil,
 		},
+		"test_compress_max_age": {
+			driverConfig: &types.LogDriverConfiguration{
+				MaxSize:  "4 m",
+				MaxFiles: 4,
+				Compress: types.LogCompressionZstd,
+				MaxAge:   "72h",
+				Type:     types.LogConfigDriverJSONFile,
+			},
+			expectedOptionSize: 4,
+			expectedError:      nil,
+		},
+		"test_max_age_incorrect": {
+			driverConfig: &types.LogDriverConfiguration{
+				MaxAge: "3 days",
+				Type:   types.LogConfigDriverJSONFile,
+			},
+			expectedOptionSize: 0,
+			expectedError:      errors.New("time: unknown unit \" days\" in duration \"3 days\""),
+		},
+		"test_local_both_max_size_max_file": {
+			driverConfig: &types.LogDriverConfiguration{
+				MaxSize:  "4 m",
+				MaxFiles: 4,
+				Type:     types.LogConfigDriverLocal,
+			},
+			expectedOptionSize: 2,
+			expectedError:      nil,
+		},
+		"test_syslog": {
+			driverConfig: &types.LogDriverConfiguration{
+				MaxSize:  "4 m",
+				Address:  "tcp://localhost",
+				Facility: "local0",
+				Tag:      "tag",
+				Type:     types.LogConfigDriverSyslog,
+			},
+			expectedOptionSize: 3,
+			expectedError:      nil,
+		},
+		"test_journald": {
+			driverConfig: &types.LogDriverConfiguration{
+				Facility: "local0",
+				Tag:      "tag",
+				Type:     types.LogConfigDriverJournald,
+			},
+			expectedOptionSize: 1,
+			expectedError:      nil,
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -213,6 +311,45 @@ func TestPrepareLogDriverConfig(t *testing.T) {
 	}
 }
 
+func TestPrepareLogDriverConfigDefaults(t *testing.T) {
+	containerLogsManager := newContainerLogsManager(testPath, types.LogCompressionGzip, time.Hour, 1<<20)
+
+	testCases := map[string]struct {
+		driverConfig       *types.LogDriverConfiguration
+		expectedOptionSize int
+	}{
+		"test_json_file_defaults": {
+			driverConfig: &types.LogDriverConfiguration{
+				Type: types.LogConfigDriverJSONFile,
+			},
+			expectedOptionSize: 3,
+		},
+		"test_json_file_overridden_defaults": {
+			driverConfig: &types.LogDriverConfiguration{
+				Compress: types.LogCompressionNone,
+				MaxAge:   "0s",
+				Type:     types.LogConfigDriverJSONFile,
+			},
+			expectedOptionSize: 2,
+		},
+		"test_local_no_defaults": {
+			driverConfig: &types.LogDriverConfiguration{
+				Type: types.LogConfigDriverLocal,
+			},
+			expectedOptionSize: 0,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			option, err := containerLogsManager.(*ctrLogsMgr).prepareLogDriverConfig(testCase.driverConfig)
+
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedOptionSize, len(option))
+		})
+	}
+}
+
 func TestInitContainerLogsRootDir(t *testing.T) {
 	testPathAbsolute, _ := filepath.Abs(testPath)
 	testDirAbsolute, _ := filepath.Abs(testDir)
@@ -15,11 +15,14 @@ package ctr
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/contrib/seccomp"
 	"github.com/containerd/containerd/namespaces"
 	crtdoci "github.com/containerd/containerd/oci"
 	"github.com/docker/docker/pkg/stringid"
@@ -33,13 +36,29 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
+const (
+	namespacePathFormat = "/proc/%d/ns/%s"
+	shmPath             = "/dev/shm"
+	shmPathFormat       = "/proc/%d/root/dev/shm"
+)
+
 // WithCommonOptions sets common options:
 // - hostname
+// - annotations from the container labels
 func WithCommonOptions(c *types.Container) crtdoci.SpecOpts {
 	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
 		//setup hostname in spec
 		s.Hostname = c.HostName
 
+		if c.Config != nil && len(c.Config.Labels) > 0 {
+			if s.Annotations == nil {
+				s.Annotations = map[string]string{}
+			}
+			for key, value := range c.Config.Labels {
+				s.Annotations[key] = value
+			}
+		}
+
 		//setup env hostname
 		if s.Process.Env == nil {
 			s.Process.Env = []string{}
@@ -56,6 +75,36 @@ func WithCommonOptions(c *types.Container) crtdoci.SpecOpts {
 	}
 }
 
+// WithProcessOptions overrides the image defaults of the container's process:
+// - entrypoint, the image command is not used if an entrypoint is provided
+// - working directory
+// - user and supplementary groups, the names are resolved using the container's root filesystem
+func WithProcessOptions(c *types.Container) crtdoci.SpecOpts {
+	return func(ctx context.Context, client crtdoci.Client, ctr *containers.Container, s *crtdoci.Spec) error {
+		if c.Config == nil {
+			return nil
+		}
+		if len(c.Config.Entrypoint) > 0 {
+			s.Process.Args = append(append([]string{}, c.Config.Entrypoint...), c.Config.Cmd...)
+		}
+		if c.Config.WorkingDir != "" {
+			s.Process.Cwd = c.Config.WorkingDir
+		}
+		if c.Config.User != "" {
+			if err := crtdoci.WithUser(c.Config.User)(ctx, client, ctr, s); err != nil {
+				return err
+			}
+			if err := crtdoci.WithAdditionalGIDs(fmt.Sprintf("%d", s.Process.User.UID))(ctx, client, ctr, s); err != nil {
+				return err
+			}
+		}
+		if len(c.Config.Groups) > 0 {
+			return crtdoci.WithAppendAdditionalGroups(c.Config.Groups...)(ctx, client, ctr, s)
+		}
+		return nil
+	}
+}
+
 // WithMounts sets the network resolution files generated
 // e.g. c.getRootResourceDir("resolv.conf"), "hostname", "hosts"
 func WithMounts(container *types.Container) crtdoci.SpecOpts {
@@ -66,8 +115,13 @@ func WithMounts(container *types.Container) crtdoci.SpecOpts {
 		opts := []string{"rbind"}
 
 		for _, mnt := range container.Mounts {
-			optsMnt := append(opts, mnt.PropagationMode)
-			s.Mounts = append(s.Mounts, specs.Mount{Destination: mnt.Destination, Source: mnt.Source, Type: "bind", Options: optsMnt})
+			optsMnt := append(append([]string{}, opts...), mnt.PropagationMode)
+			optsMnt = append(optsMnt, mnt.Options...)
+			source := mnt.Source
+			if mnt.Type == types.MountTypeVolume {
+				source = mnt.VolumePath
+			}
+			s.Mounts = append(s.Mounts, specs.Mount{Destination: mnt.Destination, Source: source, Type: "bind", Options: optsMnt})
 		}
 		// ensure network binds
 		optsMnt := append(opts, types.RPrivatePropagationMode)
@@ -92,23 +146,129 @@ func WithMounts(container *types.Container) crtdoci.SpecOpts {
 }
 
 // WithNamespaces sets the enabled and desired namespaces to be used for the container's isolation.
-func WithNamespaces(container *types.Container) crtdoci.SpecOpts {
-	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
+// A new network namespace is created unless the network stack of the host or of another container is shared.
+// New IPC and PID namespaces are created unless the ones of the host or of the running containers with the provided PIDs are shared.
+// A new user namespace with the provided user and group ID mappings is created if any mappings are provided.
+func WithNamespaces(container *types.Container, uidMappings, gidMappings []specs.LinuxIDMapping, namespaceTargetPids map[specs.LinuxNamespaceType]uint32) crtdoci.SpecOpts {
+	return func(ctx context.Context, client crtdoci.Client, ctr *containers.Container, s *crtdoci.Spec) error {
 		networkNamespace := specs.LinuxNamespace{Type: specs.NetworkNamespace}
-		if util.IsContainerNetworkHost(container) {
+		if util.IsContainerNetworkHost(container) || util.IsContainerNetworkContainer(container) {
 			networkNamespace.Path = container.NetworkSettings.SandboxKey
 		}
-		for i, n := range s.Linux.Namespaces {
-			if n.Type == networkNamespace.Type {
-				s.Linux.Namespaces[i] = networkNamespace
+		setNamespace(s, networkNamespace)
+		for namespaceType, mode := range map[specs.LinuxNamespaceType]types.NamespaceMode{specs.IPCNamespace: container.HostConfig.IpcMode, specs.PIDNamespace: container.HostConfig.PidMode} {
+			if mode == types.NamespaceModeHost {
+				if err := crtdoci.WithHostNamespace(namespaceType)(ctx, client, ctr, s); err != nil {
+					return err
+				}
+			} else if pid, ok := namespaceTargetPids[namespaceType]; ok {
+				setNamespace(s, specs.LinuxNamespace{Type: namespaceType, Path: fmt.Sprintf(namespacePathFormat, pid, namespaceType)})
+			}
+		}
+		if len(uidMappings) == 0 && len(gidMappings) == 0 {
+			return nil
+		}
+		setNamespace(s, specs.LinuxNamespace{Type: specs.UserNamespace})
+		s.Linux.UIDMappings = uidMappings
+		s.Linux.GIDMappings = gidMappings
+		// sysfs cannot be mounted in a user namespace which does not own the network namespace, so it is bind mounted from the host instead
+		if networkNamespace.Path != "" {
+			for i, m := range s.Mounts {
+				if m.Destination == "/sys" {
+					s.Mounts[i] = specs.Mount{Destination: "/sys", Type: "bind", Source: "/sys", Options: []string{"rbind", "nosuid", "noexec", "nodev", "ro"}}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// WithShm sets the size of the container's /dev/shm or bind mounts the one of the host or of the running container with the provided PID
+// when their IPC namespace is shared, so that the POSIX shared memory is shared as well
+func WithShm(container *types.Container, ipcTargetPid uint32) crtdoci.SpecOpts {
+	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
+		var source string
+		if container.HostConfig.IpcMode == types.NamespaceModeHost {
+			source = shmPath
+		} else if ipcTargetPid != 0 {
+			source = fmt.Sprintf(shmPathFormat, ipcTargetPid)
+		} else if container.HostConfig.ShmSize == "" {
+			return nil
+		}
+		for i, m := range s.Mounts {
+			if m.Destination != shmPath {
+				continue
+			}
+			if source != "" {
+				s.Mounts[i] = specs.Mount{Destination: shmPath, Type: "bind", Source: source, Options: []string{"rbind", "nosuid", "noexec", "nodev"}}
 				return nil
 			}
+			size, _ := util.SizeToBytes(container.HostConfig.ShmSize) // already validated
+			var options []string
+			for _, option := range m.Options {
+				if !strings.HasPrefix(option, "size=") {
+					options = append(options, option)
+				}
+			}
+			s.Mounts[i].Options = append(options, fmt.Sprintf("size=%d", size))
 		}
-		s.Linux.Namespaces = append(s.Linux.Namespaces, networkNamespace)
 		return nil
 	}
 }
 
+// WithUlimits sets the resource limits of the container's process, the default ones of the same resources are replaced
+func WithUlimits(container *types.Container) crtdoci.SpecOpts {
+	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
+		for _, ulimit := range container.HostConfig.Ulimits {
+			rlimit := specs.POSIXRlimit{Type: "RLIMIT_" + strings.ToUpper(ulimit.Name), Soft: toRlimitValue(ulimit.Soft), Hard: toRlimitValue(ulimit.Hard)}
+			replaced := false
+			for i, r := range s.Process.Rlimits {
+				if r.Type == rlimit.Type {
+					s.Process.Rlimits[i] = rlimit
+					replaced = true
+				}
+			}
+			if !replaced {
+				s.Process.Rlimits = append(s.Process.Rlimits, rlimit)
+			}
+		}
+		return nil
+	}
+}
+
+func toRlimitValue(value int64) uint64 {
+	if value == -1 {
+		return math.MaxUint64 // RLIM_INFINITY
+	}
+	return uint64(value)
+}
+
+// WithSysctls sets the namespaced kernel parameters of the container
+func WithSysctls(container *types.Container) crtdoci.SpecOpts {
+	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
+		if len(container.HostConfig.Sysctls) == 0 {
+			return nil
+		}
+		if s.Linux.Sysctl == nil {
+			s.Linux.Sysctl = map[string]string{}
+		}
+		for key, value := range container.HostConfig.Sysctls {
+			s.Linux.Sysctl[key] = value
+		}
+		return nil
+	}
+}
+
+func setNamespace(s *crtdoci.Spec, namespace specs.LinuxNamespace) {
+	for i, n := range s.Linux.Namespaces {
+		if n.Type == namespace.Type {
+			s.Linux.Namespaces[i] = namespace
+			return
+		}
+	}
+	s.Linux.Namespaces = append(s.Linux.Namespaces, namespace)
+}
+
 // WithHooks sets the desired OCI hooks for the provided container instance.
 func WithHooks(container *types.Container, execRoot string) crtdoci.SpecOpts {
 	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
@@ -230,7 +390,14 @@ func WithResources(c *types.Container) crtdoci.SpecOpts {
 			return nil
 		}
 
+		blockIO, err := toLinuxBlockIO(c.HostConfig.Resources)
+		if err != nil {
+			return err
+		}
 		s.Linux.Resources.Memory = toLinuxMemory(c.HostConfig.Resources)
+		s.Linux.Resources.CPU = toLinuxCPU(c.HostConfig.Resources)
+		s.Linux.Resources.Pids = toLinuxPids(c.HostConfig.Resources)
+		s.Linux.Resources.BlockIO = blockIO
 		return nil
 	}
 }
@@ -258,14 +425,206 @@ func toLinuxMemory(resources *types.Resources) *specs.LinuxMemory {
 	}
 }
 
-// WithCgroupsPath sets the container's cgroup path
-func WithCgroupsPath(container *types.Container) crtdoci.SpecOpts {
+// The CPU, pids and block IO limits are set using the cgroup v1 semantics of the OCI runtime spec.
+// The OCI runtime converts them to the according cgroup v2 controller values, if cgroup v2 is used on the host.
+func toLinuxCPU(resources *types.Resources) *specs.LinuxCPU {
+	if resources == nil || (resources.CPUQuota == 0 && resources.CPUPeriod == 0 && resources.CPUShares == 0 &&
+		resources.CPUSetCPUs == "" && resources.CPUSetMems == "") {
+		return nil
+	}
+	cpu := &specs.LinuxCPU{
+		Cpus: resources.CPUSetCPUs,
+		Mems: resources.CPUSetMems,
+	}
+	if resources.CPUQuota > 0 {
+		quota := resources.CPUQuota
+		cpu.Quota = &quota
+	}
+	if resources.CPUPeriod > 0 {
+		period := resources.CPUPeriod
+		cpu.Period = &period
+	}
+	if resources.CPUShares > 0 {
+		shares := resources.CPUShares
+		cpu.Shares = &shares
+	}
+	return cpu
+}
+
+func toLinuxPids(resources *types.Resources) *specs.LinuxPids {
+	if resources == nil || resources.PidsLimit <= 0 {
+		return nil
+	}
+	return &specs.LinuxPids{Limit: resources.PidsLimit}
+}
+
+func toLinuxBlockIO(resources *types.Resources) (*specs.LinuxBlockIO, error) {
+	if resources == nil {
+		return nil, nil
+	}
+	var (
+		blockIO = &specs.LinuxBlockIO{}
+		err     error
+	)
+	if resources.BlkioWeight > 0 {
+		weight := resources.BlkioWeight
+		blockIO.Weight = &weight
+	}
+	if blockIO.ThrottleReadBpsDevice, err = toLinuxThrottleDevices(resources.BlkioDeviceReadBps); err != nil {
+		return nil, err
+	}
+	if blockIO.ThrottleWriteBpsDevice, err = toLinuxThrottleDevices(resources.BlkioDeviceWriteBps); err != nil {
+		return nil, err
+	}
+	if blockIO.ThrottleReadIOPSDevice, err = toLinuxThrottleDevices(resources.BlkioDeviceReadIOps); err != nil {
+		return nil, err
+	}
+	if blockIO.ThrottleWriteIOPSDevice, err = toLinuxThrottleDevices(resources.BlkioDeviceWriteIOps); err != nil {
+		return nil, err
+	}
+	if blockIO.Weight == nil && blockIO.ThrottleReadBpsDevice == nil && blockIO.ThrottleWriteBpsDevice == nil &&
+		blockIO.ThrottleReadIOPSDevice == nil && blockIO.ThrottleWriteIOPSDevice == nil {
+		return nil, nil
+	}
+	return blockIO, nil
+}
+
+func toLinuxThrottleDevices(throttleDevices []types.ThrottleDevice) ([]specs.LinuxThrottleDevice, error) {
+	var linuxThrottleDevices []specs.LinuxThrottleDevice
+	for _, throttleDevice := range throttleDevices {
+		major, minor, err := getBlockDeviceNumbers(throttleDevice.Path)
+		if err != nil {
+			return nil, err
+		}
+		linuxThrottleDevice := specs.LinuxThrottleDevice{Rate: throttleDevice.Rate}
+		linuxThrottleDevice.Major = major
+		linuxThrottleDevice.Minor = minor
+		linuxThrottleDevices = append(linuxThrottleDevices, linuxThrottleDevice)
+	}
+	return linuxThrottleDevices, nil
+}
+
+const (
+	// the kernel default values used when a CPU limitation is removed from a running container
+	defaultCPUShares = uint64(1024)
+	defaultCPUPeriod = uint64(100000)
+)
+
+// toUpdatedLinuxCPU returns the CPU limitations to be applied on a running container, resetting the removed ones
+// to their defaults. Removed cpuset limitations are applied after the container is restarted.
+func toUpdatedLinuxCPU(current, updated *types.Resources) *specs.LinuxCPU {
+	cpu := toLinuxCPU(updated)
+	if current == nil {
+		return cpu
+	}
+	reset := &specs.LinuxCPU{}
+	if current.CPUQuota > 0 && updated.CPUQuota == 0 {
+		unlimited := int64(-1)
+		reset.Quota = &unlimited
+	}
+	if current.CPUPeriod > 0 && updated.CPUPeriod == 0 {
+		period := defaultCPUPeriod
+		reset.Period = &period
+	}
+	if current.CPUShares > 0 && updated.CPUShares == 0 {
+		shares := defaultCPUShares
+		reset.Shares = &shares
+	}
+	if reset.Quota == nil && reset.Period == nil && reset.Shares == nil {
+		return cpu
+	}
+	if cpu == nil {
+		return reset
+	}
+	if reset.Quota != nil {
+		cpu.Quota = reset.Quota
+	}
+	if reset.Period != nil {
+		cpu.Period = reset.Period
+	}
+	if reset.Shares != nil {
+		cpu.Shares = reset.Shares
+	}
+	return cpu
+}
+
+// toUpdatedLinuxPids returns the pids limitation to be applied on a running container, removing it if no longer set.
+func toUpdatedLinuxPids(current, updated *types.Resources) *specs.LinuxPids {
+	if current != nil && current.PidsLimit > 0 && updated.PidsLimit <= 0 {
+		return &specs.LinuxPids{Limit: -1}
+	}
+	return toLinuxPids(updated)
+}
+
+// toUpdatedLinuxBlockIO returns the block IO limitations to be applied on a running container, removing the rate limits
+// of the devices that are no longer throttled. A removed block IO weight is applied after the container is restarted.
+func toUpdatedLinuxBlockIO(current, updated *types.Resources) (*specs.LinuxBlockIO, error) {
+	blockIO, err := toLinuxBlockIO(updated)
+	if err != nil || current == nil {
+		return blockIO, err
+	}
+	removed := &types.Resources{
+		BlkioDeviceReadBps:   removedThrottleDevices(current.BlkioDeviceReadBps, updated.BlkioDeviceReadBps),
+		BlkioDeviceWriteBps:  removedThrottleDevices(current.BlkioDeviceWriteBps, updated.BlkioDeviceWriteBps),
+		BlkioDeviceReadIOps:  removedThrottleDevices(current.BlkioDeviceReadIOps, updated.BlkioDeviceReadIOps),
+		BlkioDeviceWriteIOps: removedThrottleDevices(current.BlkioDeviceWriteIOps, updated.BlkioDeviceWriteIOps),
+	}
+	removedBlockIO, err := toLinuxBlockIO(removed)
+	if err != nil || removedBlockIO == nil {
+		return blockIO, err
+	}
+	if blockIO == nil {
+		return removedBlockIO, nil
+	}
+	blockIO.ThrottleReadBpsDevice = append(blockIO.ThrottleReadBpsDevice, removedBlockIO.ThrottleReadBpsDevice...)
+	blockIO.ThrottleWriteBpsDevice = append(blockIO.ThrottleWriteBpsDevice, removedBlockIO.ThrottleWriteBpsDevice...)
+	blockIO.ThrottleReadIOPSDevice = append(blockIO.ThrottleReadIOPSDevice, removedBlockIO.ThrottleReadIOPSDevice...)
+	blockIO.ThrottleWriteIOPSDevice = append(blockIO.ThrottleWriteIOPSDevice, removedBlockIO.ThrottleWriteIOPSDevice...)
+	return blockIO, nil
+}
+
+// removedThrottleDevices returns the devices that are no longer throttled with a rate of 0 that removes the limit.
+func removedThrottleDevices(current, updated []types.ThrottleDevice) []types.ThrottleDevice {
+	var removed []types.ThrottleDevice
+	for _, currentDevice := range current {
+		found := false
+		for _, updatedDevice := range updated {
+			if updatedDevice.Path == currentDevice.Path {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, types.ThrottleDevice{Path: currentDevice.Path})
+		}
+	}
+	return removed
+}
+
+// getBlockDeviceNumbers returns the major and minor numbers of the block device at the provided path
+var getBlockDeviceNumbers = func(path string) (int64, int64, error) {
+	resolvedPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	device, err := devices.DeviceFromPath(resolvedPath, "")
+	if err != nil {
+		return 0, 0, err
+	}
+	if device.Type != devices.BlockDevice {
+		return 0, 0, log.NewErrorf("%s is not a block device", path)
+	}
+	return device.Major, device.Minor, nil
+}
+
+// WithCgroupsPath sets the container's cgroup path in the format expected by the cgroup driver of the container's runtime
+func WithCgroupsPath(container *types.Container, systemdCgroup bool) crtdoci.SpecOpts {
 	return func(ctx context.Context, _ crtdoci.Client, _ *containers.Container, s *crtdoci.Spec) error {
 		ns, err := namespaces.NamespaceRequired(ctx)
 		if err != nil {
 			return err
 		}
-		if util.IsRunningSystemd() {
+		if systemdCgroup {
 			s.Linux.CgroupsPath = "system.slice:" + ns + ":" + container.ID
 		} else {
 			s.Linux.CgroupsPath = filepath.Join("/", ns, container.ID)
@@ -273,3 +632,54 @@ func WithCgroupsPath(container *types.Container) crtdoci.SpecOpts {
 		return nil
 	}
 }
+
+// WithCapabilities sets the capabilities of the container's process:
+// - the dropped capabilities are removed from the default ones, all of them are removed if ALL is dropped
+// - the extra capabilities are added afterwards, so they take precedence over the dropped ones
+func WithCapabilities(c *types.Container) crtdoci.SpecOpts {
+	return func(ctx context.Context, client crtdoci.Client, ctr *containers.Container, s *crtdoci.Spec) error {
+		dropOpt := crtdoci.WithDroppedCapabilities(c.HostConfig.DroppedCapabilities)
+		for _, capability := range c.HostConfig.DroppedCapabilities {
+			if capability == types.CapabilityAll {
+				dropOpt = crtdoci.WithCapabilities(nil)
+				break
+			}
+		}
+		if err := dropOpt(ctx, client, ctr, s); err != nil {
+			return err
+		}
+		return crtdoci.WithAddedCapabilities(c.HostConfig.ExtraCapabilities)(ctx, client, ctr, s)
+	}
+}
+
+// WithSecurityOptions sets the security options of the container's process:
+// - seccomp filter, the built-in profile allows syscalls depending on the process capabilities, so it must be applied after them
+// - AppArmor profile
+// - SELinux label
+// - no-new-privileges flag
+func WithSecurityOptions(c *types.Container) crtdoci.SpecOpts {
+	return func(ctx context.Context, client crtdoci.Client, ctr *containers.Container, s *crtdoci.Spec) error {
+		securityOpts := c.HostConfig.SecurityOpts
+		if securityOpts == nil {
+			return nil
+		}
+		switch securityOpts.Seccomp {
+		case "", types.SecurityProfileUnconfined:
+			if s.Linux != nil {
+				s.Linux.Seccomp = nil
+			}
+		case types.SeccompProfileDefault:
+			if err := seccomp.WithDefaultProfile()(ctx, client, ctr, s); err != nil {
+				return err
+			}
+		default:
+			if err := seccomp.WithProfile(securityOpts.Seccomp)(ctx, client, ctr, s); err != nil {
+				return log.NewErrorf("failed to apply the seccomp profile of container id = %s: %v", c.ID, err)
+			}
+		}
+		s.Process.ApparmorProfile = securityOpts.AppArmorProfile
+		s.Process.SelinuxLabel = securityOpts.SELinuxLabel
+		s.Process.NoNewPrivileges = securityOpts.NoNewPrivileges
+		return nil
+	}
+}
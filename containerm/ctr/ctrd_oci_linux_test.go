@@ -0,0 +1,437 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/containers"
+	crtdoci "github.com/containerd/containerd/oci"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestWithMounts(t *testing.T) {
+	container := &types.Container{
+		ResolvConfPath: "/meta/resolv.conf",
+		HostnamePath:   "/meta/hostname",
+		HostsPath:      "/meta/hosts",
+		Mounts: []types.MountPoint{
+			{Source: "/home/data", Destination: "/data", PropagationMode: types.RPrivatePropagationMode},
+			{Source: "/home/config", Destination: "/config", PropagationMode: types.RPrivatePropagationMode, Options: []string{types.MountOptionReadOnly, types.MountOptionNoExec}},
+			{Source: "logs", Destination: "/logs", PropagationMode: types.RSharedPropagationMode, Type: types.MountTypeVolume, VolumePath: "/meta/volumes/logs/_data"},
+		},
+	}
+	spec := &crtdoci.Spec{Mounts: []specs.Mount{{Destination: "/run", Type: "tmpfs", Source: "tmpfs"}}}
+
+	err := WithMounts(container)(context.Background(), nil, nil, spec)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []specs.Mount{
+		{Destination: "/etc/hosts", Source: "/meta/hosts", Type: "bind", Options: []string{"rbind", types.RPrivatePropagationMode}},
+		{Destination: "/data", Source: "/home/data", Type: "bind", Options: []string{"rbind", types.RPrivatePropagationMode}},
+		{Destination: "/config", Source: "/home/config", Type: "bind", Options: []string{"rbind", types.RPrivatePropagationMode, types.MountOptionReadOnly, types.MountOptionNoExec}},
+		{Destination: "/logs", Source: "/meta/volumes/logs/_data", Type: "bind", Options: []string{"rbind", types.RSharedPropagationMode}},
+		{Destination: "/etc/resolv.conf", Source: "/meta/resolv.conf", Type: "bind", Options: []string{"rbind", types.RPrivatePropagationMode}},
+		{Destination: "/etc/hostname", Source: "/meta/hostname", Type: "bind", Options: []string{"rbind", types.RPrivatePropagationMode}},
+	}, spec.Mounts)
+}
+
+func TestWithProcessOptions(t *testing.T) {
+	rootfs := t.TempDir()
+	testutil.AssertNil(t, os.MkdirAll(filepath.Join(rootfs, "etc"), 0755))
+	testutil.AssertNil(t, os.WriteFile(filepath.Join(rootfs, "etc", "passwd"), []byte("root:x:0:0:root:/root:/bin/sh\napp:x:1000:1000::/app:/bin/sh\n"), 0644))
+	testutil.AssertNil(t, os.WriteFile(filepath.Join(rootfs, "etc", "group"), []byte("root:x:0:\naudio:x:29:app\nvideo:x:44:\n"), 0644))
+
+	tests := map[string]struct {
+		config        *types.ContainerConfiguration
+		expectedArgs  []string
+		expectedCwd   string
+		expectedUser  specs.User
+		expectedError bool
+	}{
+		"test_no_config": {
+			expectedArgs: []string{"/bin/image-entrypoint", "image-cmd"},
+			expectedCwd:  "/",
+		},
+		"test_entrypoint": {
+			config:       &types.ContainerConfiguration{Entrypoint: []string{"/bin/app"}},
+			expectedArgs: []string{"/bin/app"},
+			expectedCwd:  "/",
+		},
+		"test_entrypoint_and_cmd": {
+			config:       &types.ContainerConfiguration{Entrypoint: []string{"/bin/app"}, Cmd: []string{"-v"}},
+			expectedArgs: []string{"/bin/app", "-v"},
+			expectedCwd:  "/",
+		},
+		"test_working_dir": {
+			config:       &types.ContainerConfiguration{WorkingDir: "/app"},
+			expectedArgs: []string{"/bin/image-entrypoint", "image-cmd"},
+			expectedCwd:  "/app",
+		},
+		"test_user_and_groups": {
+			config:       &types.ContainerConfiguration{User: "app", Groups: []string{"video", "100"}},
+			expectedArgs: []string{"/bin/image-entrypoint", "image-cmd"},
+			expectedCwd:  "/",
+			expectedUser: specs.User{UID: 1000, GID: 1000, AdditionalGids: []uint32{1000, 29, 44, 100}},
+		},
+		"test_user_ids": {
+			config:       &types.ContainerConfiguration{User: "2000:3000"},
+			expectedArgs: []string{"/bin/image-entrypoint", "image-cmd"},
+			expectedCwd:  "/",
+			expectedUser: specs.User{UID: 2000, GID: 3000, AdditionalGids: []uint32{3000}},
+		},
+		"test_unknown_user": {
+			config:        &types.ContainerConfiguration{User: "unknown"},
+			expectedError: true,
+		},
+		"test_unknown_group": {
+			config:        &types.ContainerConfiguration{Groups: []string{"unknown"}},
+			expectedError: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := &crtdoci.Spec{
+				Root:    &specs.Root{Path: rootfs},
+				Linux:   &specs.Linux{},
+				Process: &specs.Process{Args: []string{"/bin/image-entrypoint", "image-cmd"}, Cwd: "/"},
+			}
+			err := WithProcessOptions(&types.Container{Config: test.config})(context.Background(), nil, &containers.Container{}, spec)
+			if test.expectedError {
+				testutil.AssertNotNil(t, err)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedArgs, spec.Process.Args)
+			testutil.AssertEqual(t, test.expectedCwd, spec.Process.Cwd)
+			testutil.AssertEqual(t, test.expectedUser, spec.Process.User)
+		})
+	}
+}
+
+func TestWithNamespaces(t *testing.T) {
+	const sandboxKey = "/proc/123/ns/net"
+	tests := map[string]struct {
+		networkMode  types.NetworkMode
+		expectedPath string
+	}{
+		"test_bridge": {
+			networkMode: types.NetworkModeBridge,
+		},
+		"test_none": {
+			networkMode: types.NetworkModeNone,
+		},
+		"test_host": {
+			networkMode:  types.NetworkModeHost,
+			expectedPath: sandboxKey,
+		},
+		"test_container": {
+			networkMode:  types.NetworkModeContainerPrefix + "web",
+			expectedPath: sandboxKey,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := &types.Container{
+				HostConfig:      &types.HostConfig{NetworkMode: test.networkMode},
+				NetworkSettings: &types.NetworkSettings{SandboxKey: sandboxKey},
+			}
+			spec := &crtdoci.Spec{Linux: &specs.Linux{Namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace}}}}
+
+			err := WithNamespaces(container, nil, nil, nil)(context.Background(), nil, nil, spec)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace, Path: test.expectedPath}}, spec.Linux.Namespaces)
+		})
+	}
+}
+
+func TestWithNamespacesRemapped(t *testing.T) {
+	const sandboxKey = "/proc/123/ns/net"
+	uidMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	gidMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}}
+	sysfsMount := specs.Mount{Destination: "/sys", Type: "sysfs", Source: "sysfs", Options: []string{"nosuid", "noexec", "nodev", "ro"}}
+	tests := map[string]struct {
+		networkMode        types.NetworkMode
+		expectedNamespaces []specs.LinuxNamespace
+		expectedMounts     []specs.Mount
+	}{
+		"test_bridge": {
+			networkMode:        types.NetworkModeBridge,
+			expectedNamespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace}, {Type: specs.UserNamespace}},
+			expectedMounts:     []specs.Mount{sysfsMount},
+		},
+		"test_host": {
+			networkMode:        types.NetworkModeHost,
+			expectedNamespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace, Path: sandboxKey}, {Type: specs.UserNamespace}},
+			expectedMounts:     []specs.Mount{{Destination: "/sys", Type: "bind", Source: "/sys", Options: []string{"rbind", "nosuid", "noexec", "nodev", "ro"}}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := &types.Container{
+				HostConfig:      &types.HostConfig{NetworkMode: test.networkMode},
+				NetworkSettings: &types.NetworkSettings{SandboxKey: sandboxKey},
+			}
+			spec := &crtdoci.Spec{
+				Mounts: []specs.Mount{sysfsMount},
+				Linux:  &specs.Linux{Namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace}}},
+			}
+
+			err := WithNamespaces(container, uidMappings, gidMappings, nil)(context.Background(), nil, nil, spec)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedNamespaces, spec.Linux.Namespaces)
+			testutil.AssertEqual(t, uidMappings, spec.Linux.UIDMappings)
+			testutil.AssertEqual(t, gidMappings, spec.Linux.GIDMappings)
+			testutil.AssertEqual(t, test.expectedMounts, spec.Mounts)
+		})
+	}
+}
+
+func TestWithNamespacesShared(t *testing.T) {
+	tests := map[string]struct {
+		ipcMode             types.NamespaceMode
+		pidMode             types.NamespaceMode
+		namespaceTargetPids map[specs.LinuxNamespaceType]uint32
+		expectedNamespaces  []specs.LinuxNamespace
+	}{
+		"test_private": {
+			ipcMode:            types.NamespaceModePrivate,
+			expectedNamespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.IPCNamespace}, {Type: specs.NetworkNamespace}},
+		},
+		"test_host": {
+			ipcMode:            types.NamespaceModeHost,
+			pidMode:            types.NamespaceModeHost,
+			expectedNamespaces: []specs.LinuxNamespace{{Type: specs.NetworkNamespace}},
+		},
+		"test_container": {
+			ipcMode:             types.NamespaceModeContainerPrefix + "sidecar-id",
+			pidMode:             types.NamespaceModeContainerPrefix + "sidecar-id",
+			namespaceTargetPids: map[specs.LinuxNamespaceType]uint32{specs.IPCNamespace: 1234, specs.PIDNamespace: 1234},
+			expectedNamespaces: []specs.LinuxNamespace{
+				{Type: specs.PIDNamespace, Path: "/proc/1234/ns/pid"}, {Type: specs.IPCNamespace, Path: "/proc/1234/ns/ipc"}, {Type: specs.NetworkNamespace},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := &types.Container{
+				HostConfig:      &types.HostConfig{NetworkMode: types.NetworkModeBridge, IpcMode: test.ipcMode, PidMode: test.pidMode},
+				NetworkSettings: &types.NetworkSettings{},
+			}
+			spec := &crtdoci.Spec{Linux: &specs.Linux{Namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.IPCNamespace}, {Type: specs.NetworkNamespace}}}}
+
+			err := WithNamespaces(container, nil, nil, test.namespaceTargetPids)(context.Background(), nil, nil, spec)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedNamespaces, spec.Linux.Namespaces)
+		})
+	}
+}
+
+func TestWithShm(t *testing.T) {
+	sysfsMount := specs.Mount{Destination: "/sys", Type: "sysfs", Source: "sysfs", Options: []string{"nosuid", "noexec", "nodev", "ro"}}
+	shmMount := specs.Mount{Destination: "/dev/shm", Type: "tmpfs", Source: "shm", Options: []string{"nosuid", "noexec", "nodev", "mode=1777", "size=65536k"}}
+	tests := map[string]struct {
+		hostConfig     *types.HostConfig
+		ipcTargetPid   uint32
+		expectedMounts []specs.Mount
+	}{
+		"test_not_set": {
+			hostConfig:     &types.HostConfig{},
+			expectedMounts: []specs.Mount{sysfsMount, shmMount},
+		},
+		"test_size": {
+			hostConfig: &types.HostConfig{ShmSize: "256M"},
+			expectedMounts: []specs.Mount{sysfsMount, {
+				Destination: "/dev/shm", Type: "tmpfs", Source: "shm", Options: []string{"nosuid", "noexec", "nodev", "mode=1777", "size=268435456"},
+			}},
+		},
+		"test_ipc_host": {
+			hostConfig:     &types.HostConfig{IpcMode: types.NamespaceModeHost},
+			expectedMounts: []specs.Mount{sysfsMount, {Destination: "/dev/shm", Type: "bind", Source: "/dev/shm", Options: []string{"rbind", "nosuid", "noexec", "nodev"}}},
+		},
+		"test_ipc_container": {
+			hostConfig:     &types.HostConfig{IpcMode: types.NamespaceModeContainerPrefix + "sidecar-id"},
+			ipcTargetPid:   1234,
+			expectedMounts: []specs.Mount{sysfsMount, {Destination: "/dev/shm", Type: "bind", Source: "/proc/1234/root/dev/shm", Options: []string{"rbind", "nosuid", "noexec", "nodev"}}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := &crtdoci.Spec{Mounts: []specs.Mount{sysfsMount, shmMount}}
+			spec.Mounts[1].Options = append([]string{}, shmMount.Options...)
+
+			err := WithShm(&types.Container{HostConfig: test.hostConfig}, test.ipcTargetPid)(context.Background(), nil, nil, spec)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedMounts, spec.Mounts)
+		})
+	}
+}
+
+func TestWithUlimits(t *testing.T) {
+	container := &types.Container{
+		HostConfig: &types.HostConfig{
+			Ulimits: []types.Ulimit{{Name: "nofile", Soft: 4096, Hard: 65536}, {Name: "memlock", Soft: -1, Hard: -1}},
+		},
+	}
+	spec := &crtdoci.Spec{Process: &specs.Process{Rlimits: []specs.POSIXRlimit{{Type: "RLIMIT_NOFILE", Soft: 1024, Hard: 1024}}}}
+
+	testutil.AssertNil(t, WithUlimits(container)(context.Background(), nil, nil, spec))
+	testutil.AssertEqual(t, []specs.POSIXRlimit{
+		{Type: "RLIMIT_NOFILE", Soft: 4096, Hard: 65536},
+		{Type: "RLIMIT_MEMLOCK", Soft: math.MaxUint64, Hard: math.MaxUint64},
+	}, spec.Process.Rlimits)
+}
+
+func TestWithSysctls(t *testing.T) {
+	spec := &crtdoci.Spec{Linux: &specs.Linux{}}
+	testutil.AssertNil(t, WithSysctls(&types.Container{HostConfig: &types.HostConfig{}})(context.Background(), nil, nil, spec))
+	testutil.AssertNil(t, spec.Linux.Sysctl)
+
+	sysctls := map[string]string{"kernel.shmmax": "68719476736", "net.ipv4.tcp_keepalive_time": "60"}
+	testutil.AssertNil(t, WithSysctls(&types.Container{HostConfig: &types.HostConfig{Sysctls: sysctls}})(context.Background(), nil, nil, spec))
+	testutil.AssertEqual(t, sysctls, spec.Linux.Sysctl)
+}
+
+func TestWithCommonOptionsLabels(t *testing.T) {
+	container := &types.Container{
+		HostName: "test-host",
+		IOConfig: &types.IOConfig{},
+		Config:   &types.ContainerConfiguration{Labels: map[string]string{"app": "test"}},
+	}
+	spec := &crtdoci.Spec{Process: &specs.Process{}, Annotations: map[string]string{"existing": "value"}}
+
+	err := WithCommonOptions(container)(context.Background(), nil, nil, spec)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, map[string]string{"existing": "value", "app": "test"}, spec.Annotations)
+}
+
+func TestWithCapabilities(t *testing.T) {
+	tests := map[string]struct {
+		extra        []string
+		dropped      []string
+		expectedCaps []string
+	}{
+		"test_add": {
+			extra:        []string{"CAP_NET_ADMIN"},
+			expectedCaps: []string{"CAP_CHOWN", "CAP_NET_RAW", "CAP_MKNOD", "CAP_NET_ADMIN"},
+		},
+		"test_drop": {
+			dropped:      []string{"CAP_NET_RAW", "CAP_MKNOD"},
+			expectedCaps: []string{"CAP_CHOWN"},
+		},
+		"test_drop_all": {
+			dropped: []string{types.CapabilityAll},
+		},
+		"test_drop_all_add": {
+			extra:        []string{"CAP_NET_BIND_SERVICE"},
+			dropped:      []string{types.CapabilityAll},
+			expectedCaps: []string{"CAP_NET_BIND_SERVICE"},
+		},
+		"test_add_precedence": {
+			extra:        []string{"CAP_NET_RAW"},
+			dropped:      []string{"CAP_NET_RAW"},
+			expectedCaps: []string{"CAP_CHOWN", "CAP_MKNOD", "CAP_NET_RAW"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := &types.Container{HostConfig: &types.HostConfig{ExtraCapabilities: test.extra, DroppedCapabilities: test.dropped}}
+			defaultCaps := []string{"CAP_CHOWN", "CAP_NET_RAW", "CAP_MKNOD"}
+			spec := &crtdoci.Spec{Process: &specs.Process{Capabilities: &specs.LinuxCapabilities{
+				Bounding:  append([]string{}, defaultCaps...),
+				Effective: append([]string{}, defaultCaps...),
+				Permitted: append([]string{}, defaultCaps...),
+			}}}
+
+			err := WithCapabilities(container)(context.Background(), nil, nil, spec)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedCaps, spec.Process.Capabilities.Bounding)
+			testutil.AssertEqual(t, test.expectedCaps, spec.Process.Capabilities.Effective)
+			testutil.AssertEqual(t, test.expectedCaps, spec.Process.Capabilities.Permitted)
+		})
+	}
+}
+
+func TestWithSecurityOptions(t *testing.T) {
+	profilePath := filepath.Join(t.TempDir(), "seccomp.json")
+	testutil.AssertNil(t, os.WriteFile(profilePath, []byte(`{"defaultAction":"SCMP_ACT_ERRNO","syscalls":[{"names":["read","write"],"action":"SCMP_ACT_ALLOW"}]}`), 0644))
+
+	tests := map[string]struct {
+		securityOpts    *types.SecurityOptions
+		expectedSeccomp *specs.LinuxSeccomp
+		expectedProcess *specs.Process
+		expectedErr     bool
+	}{
+		"test_not_set": {
+			expectedProcess: &specs.Process{},
+		},
+		"test_unconfined": {
+			securityOpts:    &types.SecurityOptions{Seccomp: types.SecurityProfileUnconfined, AppArmorProfile: types.SecurityProfileUnconfined},
+			expectedProcess: &specs.Process{ApparmorProfile: types.SecurityProfileUnconfined},
+		},
+		"test_custom_profile": {
+			securityOpts: &types.SecurityOptions{
+				Seccomp:         profilePath,
+				AppArmorProfile: "kanto-vendor",
+				SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+				NoNewPrivileges: true,
+			},
+			expectedSeccomp: &specs.LinuxSeccomp{
+				DefaultAction: specs.ActErrno,
+				Syscalls:      []specs.LinuxSyscall{{Names: []string{"read", "write"}, Action: specs.ActAllow}},
+			},
+			expectedProcess: &specs.Process{
+				ApparmorProfile: "kanto-vendor",
+				SelinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+				NoNewPrivileges: true,
+			},
+		},
+		"test_missing_profile": {
+			securityOpts: &types.SecurityOptions{Seccomp: filepath.Join(t.TempDir(), "missing.json")},
+			expectedErr:  true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := &types.Container{ID: "test-ctr", HostConfig: &types.HostConfig{SecurityOpts: test.securityOpts}}
+			spec := &crtdoci.Spec{Process: &specs.Process{}, Linux: &specs.Linux{}}
+
+			err := WithSecurityOptions(container)(context.Background(), nil, nil, spec)
+			if test.expectedErr {
+				testutil.AssertNotNil(t, err)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, test.expectedSeccomp, spec.Linux.Seccomp)
+			testutil.AssertEqual(t, test.expectedProcess, spec.Process)
+		})
+	}
+}
+
+func TestWithSecurityOptionsDefaultSeccomp(t *testing.T) {
+	container := &types.Container{HostConfig: &types.HostConfig{SecurityOpts: &types.SecurityOptions{Seccomp: types.SeccompProfileDefault}}}
+	spec := &crtdoci.Spec{
+		Process: &specs.Process{Capabilities: &specs.LinuxCapabilities{Bounding: []string{"CAP_CHOWN"}}},
+		Linux:   &specs.Linux{},
+	}
+
+	err := WithSecurityOptions(container)(context.Background(), nil, nil, spec)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotNil(t, spec.Linux.Seccomp)
+	testutil.AssertEqual(t, specs.ActErrno, spec.Linux.Seccomp.DefaultAction)
+}
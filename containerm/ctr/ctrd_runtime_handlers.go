@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+const (
+	// RuntimeHandlerRunc is the name of the built-in runtime handler which runs the containers with runc via the configured runc runtime
+	RuntimeHandlerRunc = "runc"
+
+	runcBinaryName = "runc"
+)
+
+// newRuntimeHandlers returns the built-in runc runtime handler along with the configured ones, which are validated and may also redefine it
+func newRuntimeHandlers(runcRuntime types.Runtime, handlers []*types.RuntimeHandler, defaultHandler string) (map[string]*types.RuntimeHandler, error) {
+	runtimeHandlers := map[string]*types.RuntimeHandler{
+		RuntimeHandlerRunc: {
+			Name:        RuntimeHandlerRunc,
+			RuntimeType: runcRuntime,
+			Options: &types.RuntimeOptions{
+				BinaryName:    runcBinaryName,
+				SystemdCgroup: util.IsRunningSystemd(),
+			},
+		},
+	}
+	for _, handler := range handlers {
+		if handler.Name == "" {
+			return nil, log.NewError("the name of the runtime handler must be provided")
+		}
+		if handler.RuntimeType == "" {
+			return nil, log.NewErrorf("the runtime type of runtime handler %s must be provided", handler.Name)
+		}
+		if handler.BinaryPath != "" && !filepath.IsAbs(handler.BinaryPath) {
+			return nil, log.NewErrorf("the binary path %s of runtime handler %s must be absolute", handler.BinaryPath, handler.Name)
+		}
+		runtimeHandlers[handler.Name] = handler
+	}
+	if _, ok := runtimeHandlers[defaultHandler]; !ok {
+		return nil, log.NewErrorf("the default runtime handler %s is not configured", defaultHandler)
+	}
+	return runtimeHandlers, nil
+}
+
+// getRuntimeHandler returns the runtime handler that the container is run with, the default one is returned if the container is not configured with any
+// unless the container is configured with a runtime other than runc which differs from the runtime type of the default handler
+func (ctrdClient *containerdClient) getRuntimeHandler(container *types.Container) (*types.RuntimeHandler, error) {
+	name := container.HostConfig.RuntimeHandler
+	if name == "" {
+		name = ctrdClient.defaultRuntimeHandler
+		if runtime := container.HostConfig.Runtime; runtime != "" && !isRuncRuntime(runtime) {
+			if defaultHandler, ok := ctrdClient.runtimeHandlers[name]; !ok || defaultHandler.RuntimeType != runtime {
+				return ctrdClient.getRuntimeTypeHandler(container)
+			}
+		}
+	}
+	handler, ok := ctrdClient.runtimeHandlers[name]
+	if !ok {
+		return nil, log.NewErrorf("runtime handler %s of container id = %s is not configured", name, container.ID)
+	}
+	return handler, nil
+}
+
+// getRuntimeTypeHandler returns the first by name of the runtime handlers whose runtime type is the runtime of the container,
+// so that a container configured with a sandboxed runtime such as kata or gVisor is never run with a runtime of another type
+func (ctrdClient *containerdClient) getRuntimeTypeHandler(container *types.Container) (*types.RuntimeHandler, error) {
+	for _, handler := range sortedRuntimeHandlers(ctrdClient.runtimeHandlers) {
+		if handler.RuntimeType == container.HostConfig.Runtime {
+			return handler, nil
+		}
+	}
+	return nil, log.NewErrorf("no runtime handler of runtime type %s of container id = %s is configured", container.HostConfig.Runtime, container.ID)
+}
+
+// configureRuntime sets the runtime of the container to the runtime type of its runtime handler
+func (ctrdClient *containerdClient) configureRuntime(container *types.Container) (*types.RuntimeHandler, error) {
+	handler, err := ctrdClient.getRuntimeHandler(container)
+	if err != nil {
+		return nil, err
+	}
+	if container.HostConfig.Runtime != handler.RuntimeType {
+		log.Info("container runtime is updated from %s to %s of runtime handler %s for container ID = %s", container.HostConfig.Runtime, handler.RuntimeType, handler.Name, container.ID)
+		container.HostConfig.Runtime = handler.RuntimeType
+	}
+	log.Debug("container runtime = %s of runtime handler %s for container ID = %s", container.HostConfig.Runtime, handler.Name, container.ID)
+	return handler, nil
+}
+
+// isRuncRuntime returns whether the runtime runs the containers with runc, the handlers of such runtimes are interchangeable
+func isRuncRuntime(runtime types.Runtime) bool {
+	return runtime == types.RuntimeTypeV1 || runtime == types.RuntimeTypeV2runcV1 || runtime == types.RuntimeTypeV2runcV2
+}
+
+// sortedRuntimeHandlers returns the runtime handlers sorted by name
+func sortedRuntimeHandlers(runtimeHandlers map[string]*types.RuntimeHandler) []*types.RuntimeHandler {
+	handlers := make([]*types.RuntimeHandler, 0, len(runtimeHandlers))
+	for _, handler := range runtimeHandlers {
+		handlers = append(handlers, handler)
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Name < handlers[j].Name
+	})
+	return handlers
+}
+
+// isSystemdCgroup returns whether the cgroups of the containers run with the runtime handler are managed via systemd,
+// the init of the host is checked for the handlers without options
+func isSystemdCgroup(handler *types.RuntimeHandler) bool {
+	if handler.Options == nil {
+		return util.IsRunningSystemd()
+	}
+	return handler.Options.SystemdCgroup
+}
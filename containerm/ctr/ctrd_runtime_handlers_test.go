@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+var (
+	testRuncRuntimeHandler = &types.RuntimeHandler{
+		Name:        RuntimeHandlerRunc,
+		RuntimeType: types.RuntimeTypeV2runcV2,
+		Options: &types.RuntimeOptions{
+			BinaryName:    runcBinaryName,
+			SystemdCgroup: util.IsRunningSystemd(),
+		},
+	}
+	testCrunRuntimeHandler = &types.RuntimeHandler{
+		Name:        "crun",
+		RuntimeType: types.RuntimeTypeV2runcV2,
+		Options: &types.RuntimeOptions{
+			BinaryName: "/usr/bin/crun",
+		},
+	}
+	testKataRuntimeHandler = &types.RuntimeHandler{
+		Name:        "kata",
+		RuntimeType: types.RuntimeTypeV2kataV2,
+		BinaryPath:  "/opt/kata/bin/containerd-shim-kata-v2",
+	}
+	testRuntimeHandlers = map[string]*types.RuntimeHandler{
+		RuntimeHandlerRunc:          testRuncRuntimeHandler,
+		testCrunRuntimeHandler.Name: testCrunRuntimeHandler,
+		testKataRuntimeHandler.Name: testKataRuntimeHandler,
+	}
+)
+
+func TestNewRuntimeHandlers(t *testing.T) {
+	testRedefinedRunc := &types.RuntimeHandler{
+		Name:        RuntimeHandlerRunc,
+		RuntimeType: types.RuntimeTypeV2runcV1,
+	}
+	tests := map[string]struct {
+		handlers         []*types.RuntimeHandler
+		defaultHandler   string
+		expectedHandlers map[string]*types.RuntimeHandler
+		expectedErr      error
+	}{
+		"test_built_in_runc": {
+			defaultHandler:   RuntimeHandlerRunc,
+			expectedHandlers: map[string]*types.RuntimeHandler{RuntimeHandlerRunc: testRuncRuntimeHandler},
+		},
+		"test_configured_handlers": {
+			handlers:         []*types.RuntimeHandler{testCrunRuntimeHandler, testKataRuntimeHandler},
+			defaultHandler:   testCrunRuntimeHandler.Name,
+			expectedHandlers: testRuntimeHandlers,
+		},
+		"test_redefined_runc": {
+			handlers:         []*types.RuntimeHandler{testRedefinedRunc},
+			defaultHandler:   RuntimeHandlerRunc,
+			expectedHandlers: map[string]*types.RuntimeHandler{RuntimeHandlerRunc: testRedefinedRunc},
+		},
+		"test_error_no_name": {
+			handlers:       []*types.RuntimeHandler{{RuntimeType: types.RuntimeTypeV2runcV2}},
+			defaultHandler: RuntimeHandlerRunc,
+			expectedErr:    log.NewError("the name of the runtime handler must be provided"),
+		},
+		"test_error_no_runtime_type": {
+			handlers:       []*types.RuntimeHandler{{Name: "crun"}},
+			defaultHandler: RuntimeHandlerRunc,
+			expectedErr:    log.NewError("the runtime type of runtime handler crun must be provided"),
+		},
+		"test_error_relative_binary_path": {
+			handlers:       []*types.RuntimeHandler{{Name: "kata", RuntimeType: types.RuntimeTypeV2kataV2, BinaryPath: "containerd-shim-kata-v2"}},
+			defaultHandler: RuntimeHandlerRunc,
+			expectedErr:    log.NewError("the binary path containerd-shim-kata-v2 of runtime handler kata must be absolute"),
+		},
+		"test_error_default_not_configured": {
+			handlers:       []*types.RuntimeHandler{testCrunRuntimeHandler},
+			defaultHandler: "kata",
+			expectedErr:    log.NewError("the default runtime handler kata is not configured"),
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			handlers, err := newRuntimeHandlers(types.RuntimeTypeV2runcV2, testCase.handlers, testCase.defaultHandler)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedHandlers, handlers)
+		})
+	}
+}
+
+func TestConfigureRuntime(t *testing.T) {
+	testClient := &containerdClient{
+		runtimeHandlers:       testRuntimeHandlers,
+		defaultRuntimeHandler: RuntimeHandlerRunc,
+	}
+	tests := map[string]struct {
+		runtimeHandler  string
+		runtime         types.Runtime
+		expectedHandler *types.RuntimeHandler
+		expectedRuntime types.Runtime
+		expectedErr     error
+	}{
+		"test_default_handler": {
+			runtime:         types.RuntimeTypeV2runcV1,
+			expectedHandler: testRuncRuntimeHandler,
+			expectedRuntime: types.RuntimeTypeV2runcV2,
+		},
+		"test_configured_handler": {
+			runtimeHandler:  testKataRuntimeHandler.Name,
+			runtime:         types.RuntimeTypeV2runcV2,
+			expectedHandler: testKataRuntimeHandler,
+			expectedRuntime: testKataRuntimeHandler.RuntimeType,
+		},
+		"test_runtime_type_handler": {
+			runtime:         types.RuntimeTypeV2kataV2,
+			expectedHandler: testKataRuntimeHandler,
+			expectedRuntime: types.RuntimeTypeV2kataV2,
+		},
+		"test_error_no_runtime_type_handler": {
+			runtime:         types.RuntimeTypeV2runscV1,
+			expectedRuntime: types.RuntimeTypeV2runscV1,
+			expectedErr:     log.NewErrorf("no runtime handler of runtime type %s of container id = %s is configured", types.RuntimeTypeV2runscV1, testCtrID1),
+		},
+		"test_error_handler_not_configured": {
+			runtimeHandler:  "youki",
+			runtime:         types.RuntimeTypeV2runcV2,
+			expectedRuntime: types.RuntimeTypeV2runcV2,
+			expectedErr:     log.NewErrorf("runtime handler youki of container id = %s is not configured", testCtrID1),
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			container := &types.Container{
+				ID: testCtrID1,
+				HostConfig: &types.HostConfig{
+					Runtime:        testCase.runtime,
+					RuntimeHandler: testCase.runtimeHandler,
+				},
+			}
+			handler, err := testClient.configureRuntime(container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedHandler, handler)
+			testutil.AssertEqual(t, testCase.expectedRuntime, container.HostConfig.Runtime)
+		})
+	}
+}
+
+func TestSortedRuntimeHandlers(t *testing.T) {
+	testutil.AssertEqual(t, []*types.RuntimeHandler{testCrunRuntimeHandler, testKataRuntimeHandler, testRuncRuntimeHandler}, sortedRuntimeHandlers(testRuntimeHandlers))
+}
+
+func TestListRuntimeHandlers(t *testing.T) {
+	testClient := &containerdClient{
+		runtimeHandlers:       testRuntimeHandlers,
+		defaultRuntimeHandler: testCrunRuntimeHandler.Name,
+	}
+	handlers, defaultHandler := testClient.ListRuntimeHandlers(context.Background())
+	testutil.AssertEqual(t, []*types.RuntimeHandler{testCrunRuntimeHandler, testKataRuntimeHandler, testRuncRuntimeHandler}, handlers)
+	testutil.AssertEqual(t, testCrunRuntimeHandler.Name, defaultHandler)
+}
+
+func TestIsSystemdCgroup(t *testing.T) {
+	testutil.AssertFalse(t, isSystemdCgroup(testCrunRuntimeHandler))
+	testutil.AssertTrue(t, isSystemdCgroup(&types.RuntimeHandler{Options: &types.RuntimeOptions{SystemdCgroup: true}}))
+	testutil.AssertEqual(t, util.IsRunningSystemd(), isSystemdCgroup(testKataRuntimeHandler))
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // containerClientWrapper is an interface that abstracts the functional scope of the *containerd.Client instance
@@ -71,8 +73,9 @@ type containerdSpi interface {
 	GetSnapshot(ctx context.Context, containerID string) (snapshots.Info, error)
 	// ListSnapshots collects all snapshots matching the provided filters or all if no filters are provided
 	ListSnapshots(ctx context.Context, filters ...string) ([]snapshots.Info, error)
-	// PrepareSnapshot initializes a new snapshot for the provided container image for the provided container ID
-	PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, opts ...containerd.UnpackOpt) error
+	// PrepareSnapshot initializes a new snapshot for the provided container image for the provided container ID,
+	// the ownership of the snapshot's files is remapped if ID mappings for the container's user namespace are provided
+	PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, uidMappings, gidMappings []specs.LinuxIDMapping, opts ...containerd.UnpackOpt) error
 	// MountSnapshot mounts the provided rootFS to an already existing snapshot for the provided container ID
 	MountSnapshot(ctx context.Context, containerID string, rootFS string) error
 	// RemoveSnapshot removes the snapshot and allocated resources for the provided container ID
@@ -105,6 +108,7 @@ type ctrdSpi struct {
 	metaPath        string
 	snapshotService snapshots.Snapshotter
 	imageService    images.Store
+	remapLocks      *util.LocksCache
 }
 
 const containerdGCExpireLabel = "containerd.io/gc.expire"
@@ -162,6 +166,7 @@ func newContainerdSpi(rpcAddress string, namespace string, snapshotterType strin
 				snapshotterType: snapshotterType,
 				metaPath:        metaPath,
 				snapshotService: ctrdClient.SnapshotService(snapshotterType),
+				remapLocks:      newRemapLocks(),
 			}, nil
 		}
 		log.Debug("deleting expired lease %s", leaseID)
@@ -185,6 +190,7 @@ func newContainerdSpi(rpcAddress string, namespace string, snapshotterType strin
 		snapshotterType: snapshotterType,
 		metaPath:        metaPath,
 		snapshotService: ctrdClient.SnapshotService(snapshotterType),
+		remapLocks:      newRemapLocks(),
 	}, nil
 }
 
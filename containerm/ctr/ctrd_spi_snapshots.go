@@ -14,8 +14,10 @@ package ctr
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
@@ -23,10 +25,15 @@ import (
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
 	"github.com/opencontainers/image-spec/identity"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"golang.org/x/sys/unix"
 )
 
+// remappedSnapshotLabel marks the copies of the images' snapshots with the ownership of their files remapped for a user namespace
+const remappedSnapshotLabel = "org.eclipse.kanto.container-management/userns-remap.parent"
+
 func (spi *ctrdSpi) GetSnapshotID(containerID string) string {
 	return spi.generateSnapshotID(containerID)
 }
@@ -36,7 +43,7 @@ func (spi *ctrdSpi) GetSnapshot(ctx context.Context, containerID string) (snapsh
 	return spi.snapshotService.Stat(ctx, spi.generateSnapshotID(containerID))
 }
 
-func (spi *ctrdSpi) PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, opts ...containerd.UnpackOpt) error {
+func (spi *ctrdSpi) PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, uidMappings, gidMappings []specs.LinuxIDMapping, opts ...containerd.UnpackOpt) error {
 	ctx = spi.setContext(ctx, false)
 	originalCtx := ctx
 	ctx = leases.WithLease(ctx, spi.lease.ID)
@@ -54,7 +61,7 @@ func (spi *ctrdSpi) PrepareSnapshot(ctx context.Context, containerID string, ima
 	// request will fail on preparing snapshot because there is no such
 	// parent snapshotter. Thus, we should skip the not
 	// found error and retry unpacking
-	_, err = spi.snapshotService.Prepare(ctx, snapshotID, parent)
+	err = spi.prepareSnapshot(ctx, snapshotID, parent, uidMappings, gidMappings)
 	if err == nil || !errdefs.IsNotFound(err) {
 		return err
 	}
@@ -80,12 +87,87 @@ func (spi *ctrdSpi) PrepareSnapshot(ctx context.Context, containerID string, ima
 		}
 
 		// retry
-		_, err = spi.snapshotService.Prepare(ctx, snapshotID, parent)
-		return err
+		return spi.prepareSnapshot(ctx, snapshotID, parent, uidMappings, gidMappings)
 	}
 	return nil
 }
 
+// prepareSnapshot prepares the snapshot with the provided ID on top of the provided parent snapshot.
+// As idmapped mounts are not supported by the snapshotters, the snapshot of a container with a remapped user namespace
+// is prepared on top of a copy of the parent snapshot with the ownership of its files changed to the remapped IDs.
+// The copy is shared by all containers of the same image and with the same ID mappings.
+func (spi *ctrdSpi) prepareSnapshot(ctx context.Context, snapshotID string, parent string, uidMappings, gidMappings []specs.LinuxIDMapping) error {
+	if len(uidMappings) == 0 && len(gidMappings) == 0 {
+		_, err := spi.snapshotService.Prepare(ctx, snapshotID, parent)
+		return err
+	}
+	remappedParent := fmt.Sprintf("%s-%d-%d", parent, remapID(0, uidMappings), remapID(0, gidMappings))
+	// the remapped copy is shared, so it is created, used as a parent and removed by one container at a time
+	remapLock := spi.remapLocks.GetLock(remappedParent)
+	remapLock.Lock()
+	defer remapLock.Unlock()
+
+	if _, err := spi.snapshotService.Stat(ctx, remappedParent); err != nil {
+		if !errdefs.IsNotFound(err) {
+			return err
+		}
+		if err = spi.remapSnapshot(ctx, remappedParent, parent, uidMappings, gidMappings); err != nil {
+			return err
+		}
+	}
+	_, err := spi.snapshotService.Prepare(ctx, snapshotID, remappedParent)
+	return err
+}
+
+func (spi *ctrdSpi) remapSnapshot(ctx context.Context, remappedParent string, parent string, uidMappings, gidMappings []specs.LinuxIDMapping) error {
+	remapKey := remappedParent + "-remap"
+	mounts, err := spi.snapshotService.Prepare(ctx, remapKey, parent)
+	if errdefs.IsAlreadyExists(err) {
+		// the remapping is serialized, so the existing snapshot is left over from an interrupted one
+		log.Warn("removing snapshot %s left over from an interrupted remapping", remapKey)
+		if err = spi.snapshotService.Remove(ctx, remapKey); err != nil {
+			return err
+		}
+		mounts, err = spi.snapshotService.Prepare(ctx, remapKey, parent)
+	}
+	if err != nil {
+		return err
+	}
+	if err = mount.WithTempMount(ctx, mounts, func(root string) error {
+		return filepath.Walk(root, remapFileOwnership(uidMappings, gidMappings))
+	}); err != nil {
+		if rmErr := spi.snapshotService.Remove(ctx, remapKey); rmErr != nil {
+			log.WarnErr(rmErr, "error removing snapshot %s after failing to remap it", remapKey)
+		}
+		return err
+	}
+	log.Debug("remapped the ownership of the files of snapshot %s", parent)
+	return spi.snapshotService.Commit(ctx, remappedParent, remapKey, snapshots.WithLabels(map[string]string{remappedSnapshotLabel: parent}))
+}
+
+func newRemapLocks() *util.LocksCache {
+	locks := util.NewLocksCache()
+	return &locks
+}
+
+func remapFileOwnership(uidMappings, gidMappings []specs.LinuxIDMapping) filepath.WalkFunc {
+	return func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		stat := info.Sys().(*syscall.Stat_t)
+		// the symbolic links themselves are changed as not to follow them to the host's files
+		if err = os.Lchown(path, int(remapID(stat.Uid, uidMappings)), int(remapID(stat.Gid, gidMappings))); err != nil {
+			return err
+		}
+		// changing the owner clears the setuid and setgid bits, so they are restored
+		if info.Mode()&os.ModeSymlink == 0 && info.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 {
+			return os.Chmod(path, info.Mode())
+		}
+		return nil
+	}
+}
+
 func (spi *ctrdSpi) MountSnapshot(ctx context.Context, containerID string, rootFS string) error {
 	ctx = spi.setContext(ctx, true)
 	mounts, err := spi.snapshotService.Mounts(ctx, spi.generateSnapshotID(containerID))
@@ -115,11 +197,37 @@ func (spi *ctrdSpi) MountSnapshot(ctx context.Context, containerID string, rootF
 
 func (spi *ctrdSpi) RemoveSnapshot(ctx context.Context, containerID string) error {
 	ctx = spi.setContext(ctx, true)
-	if err := spi.snapshotService.Remove(ctx, spi.generateSnapshotID(containerID)); err != nil && !errdefs.IsNotFound(err) {
+	snapshotID := spi.generateSnapshotID(containerID)
+	info, statErr := spi.snapshotService.Stat(ctx, snapshotID)
+	if err := spi.snapshotService.Remove(ctx, snapshotID); err != nil && !errdefs.IsNotFound(err) {
 		return err
 	}
+	if statErr == nil {
+		spi.removeRemappedSnapshot(ctx, info.Parent)
+	}
 	return nil
 }
+
+// removeRemappedSnapshot removes the provided snapshot if it is a remapped copy of an image's snapshot which is not used by other containers anymore
+func (spi *ctrdSpi) removeRemappedSnapshot(ctx context.Context, snapshotID string) {
+	if snapshotID == "" {
+		return
+	}
+	info, err := spi.snapshotService.Stat(ctx, snapshotID)
+	if err != nil || info.Labels[remappedSnapshotLabel] == "" {
+		return
+	}
+	remapLock := spi.remapLocks.GetLock(snapshotID)
+	remapLock.Lock()
+	defer remapLock.Unlock()
+
+	if err = spi.snapshotService.Remove(ctx, snapshotID); err != nil {
+		// the snapshot cannot be removed while it is a parent of other containers' snapshots
+		log.DebugErr(err, "remapped snapshot %s is not removed", snapshotID)
+		return
+	}
+	log.Debug("removed unused remapped snapshot %s", snapshotID)
+}
 func (spi *ctrdSpi) UnmountSnapshot(ctx context.Context, containerID string, rootFS string) error {
 	ctx = spi.setContext(ctx, true)
 	mountFS := spi.getContainerRootFSDir(containerID, rootFS)
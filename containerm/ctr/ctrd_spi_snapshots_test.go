@@ -19,7 +19,9 @@ import (
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/eclipse-kanto/container-management/containerm/log"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
@@ -27,6 +29,7 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
 	containerdMocks "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/containerd"
 	"github.com/golang/mock/gomock"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 func TestGetSnapshot(t *testing.T) {
@@ -75,6 +78,7 @@ func TestGetSnapshot(t *testing.T) {
 				snapshotService: mockSnapshotter,
 				lease:           &leases.Lease{ID: testLeaseID},
 				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
 			}
 			// mock exec
 			expectedInfo, expectedErr := testData.mockExec(prepareContext(ctx), mockSnapshotter)
@@ -222,16 +226,171 @@ func TestPrepareSnapshot(t *testing.T) {
 				snapshotterType: testType,
 				lease:           &leases.Lease{ID: testLeaseID},
 				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
 			}
 			ctx := context.Background()
 			expectedErr := testData.mockExec(ctx, mockImage, mockSnapshotter)
 
-			actualErr := testSpi.PrepareSnapshot(ctx, testCtrID, mockImage)
+			actualErr := testSpi.PrepareSnapshot(ctx, testCtrID, mockImage, nil, nil)
 			testutil.AssertError(t, expectedErr, actualErr)
 		})
 	}
 }
 
+func TestPrepareSnapshotRemapped(t *testing.T) {
+	const (
+		testType           = "test_type"
+		testCtrID          = "test-container-id"
+		testLeaseID        = "test.lease"
+		testNamespace      = "test-ns"
+		testRemappedParent = "-100000-200000"
+	)
+	testSnapshotID := fmt.Sprintf(snapshotIDTemplate, testCtrID)
+	testUIDMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	testGIDMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}}
+
+	testCases := map[string]struct {
+		mockExec func(context.Context, *containerdMocks.MockSnapshotter) error
+	}{
+		"test_remapped_parent_exists": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{Name: testRemappedParent}, nil)
+				mockSnapshotter.EXPECT().Prepare(ctx, testSnapshotID, testRemappedParent).Return(make([]mount.Mount, 0), nil)
+				return nil
+			},
+		},
+		"test_remapped_parent_stat_error": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				err := errors.New("test stat error")
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{}, err)
+				return err
+			},
+		},
+		"test_remap_prepare_error": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				err := errors.New("test prepare error")
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{}, errdefs.ErrNotFound)
+				mockSnapshotter.EXPECT().Prepare(ctx, testRemappedParent+"-remap", "").Return(nil, err)
+				return err
+			},
+		},
+		"test_remap_leftover_snapshot_removed": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{}, errdefs.ErrNotFound)
+				mockSnapshotter.EXPECT().Prepare(ctx, testRemappedParent+"-remap", "").Return(nil, errdefs.ErrAlreadyExists)
+				mockSnapshotter.EXPECT().Remove(ctx, testRemappedParent+"-remap").Return(nil)
+				mockSnapshotter.EXPECT().Prepare(ctx, testRemappedParent+"-remap", "").Return(make([]mount.Mount, 0), nil)
+				mockSnapshotter.EXPECT().Commit(ctx, testRemappedParent, testRemappedParent+"-remap", gomock.Any()).Return(nil)
+				mockSnapshotter.EXPECT().Prepare(ctx, testSnapshotID, testRemappedParent).Return(make([]mount.Mount, 0), nil)
+				return nil
+			},
+		},
+		"test_remap_commit_error": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				err := errors.New("test commit error")
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{}, errdefs.ErrNotFound)
+				mockSnapshotter.EXPECT().Prepare(ctx, testRemappedParent+"-remap", "").Return(make([]mount.Mount, 0), nil)
+				mockSnapshotter.EXPECT().Commit(ctx, testRemappedParent, testRemappedParent+"-remap", gomock.Any()).Return(err)
+				return err
+			},
+		},
+	}
+	prepareContext := func(ctx context.Context) context.Context {
+		resCtx := namespaces.WithNamespace(ctx, testNamespace)
+		resCtx = leases.WithLease(resCtx, testLeaseID)
+		return resCtx
+	}
+
+	for testName, testData := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockImage := containerdMocks.NewMockImage(mockCtrl)
+			mockSnapshotter := containerdMocks.NewMockSnapshotter(mockCtrl)
+			testSpi := &ctrdSpi{
+				snapshotService: mockSnapshotter,
+				snapshotterType: testType,
+				lease:           &leases.Lease{ID: testLeaseID},
+				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
+			}
+			ctx := context.Background()
+			testCtx := prepareContext(ctx)
+			mockImage.EXPECT().RootFS(testCtx).Return(nil, nil)
+			expectedErr := testData.mockExec(testCtx, mockSnapshotter)
+
+			actualErr := testSpi.PrepareSnapshot(ctx, testCtrID, mockImage, testUIDMappings, testGIDMappings)
+			testutil.AssertError(t, expectedErr, actualErr)
+		})
+	}
+}
+
+func TestPrepareSnapshotRemappedConcurrently(t *testing.T) {
+	const (
+		testType           = "test_type"
+		testLeaseID        = "test.lease"
+		testNamespace      = "test-ns"
+		testRemappedParent = "-100000-200000"
+		testContainers     = 5
+	)
+	testUIDMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	testGIDMappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockImage := containerdMocks.NewMockImage(mockCtrl)
+	mockSnapshotter := containerdMocks.NewMockSnapshotter(mockCtrl)
+	testSpi := &ctrdSpi{
+		snapshotService: mockSnapshotter,
+		snapshotterType: testType,
+		lease:           &leases.Lease{ID: testLeaseID},
+		namespace:       testNamespace,
+		remapLocks:      newRemapLocks(),
+	}
+
+	var (
+		committed     bool
+		committedLock sync.Mutex
+	)
+	mockImage.EXPECT().RootFS(gomock.Any()).Return(nil, nil).Times(testContainers)
+	mockSnapshotter.EXPECT().Stat(gomock.Any(), testRemappedParent).DoAndReturn(func(ctx context.Context, key string) (snapshots.Info, error) {
+		committedLock.Lock()
+		defer committedLock.Unlock()
+		if !committed {
+			return snapshots.Info{}, errdefs.ErrNotFound
+		}
+		return snapshots.Info{Name: testRemappedParent}, nil
+	}).Times(testContainers)
+	// the remapped parent is created only once
+	mockSnapshotter.EXPECT().Prepare(gomock.Any(), testRemappedParent+"-remap", "").DoAndReturn(func(ctx context.Context, key, parent string, opts ...snapshots.Opt) ([]mount.Mount, error) {
+		time.Sleep(10 * time.Millisecond)
+		return make([]mount.Mount, 0), nil
+	}).Times(1)
+	mockSnapshotter.EXPECT().Commit(gomock.Any(), testRemappedParent, testRemappedParent+"-remap", gomock.Any()).DoAndReturn(func(ctx context.Context, name, key string, opts ...snapshots.Opt) error {
+		committedLock.Lock()
+		defer committedLock.Unlock()
+		committed = true
+		return nil
+	}).Times(1)
+	mockSnapshotter.EXPECT().Prepare(gomock.Any(), gomock.Not(testRemappedParent+"-remap"), testRemappedParent).Return(make([]mount.Mount, 0), nil).Times(testContainers)
+
+	var wg sync.WaitGroup
+	errs := make([]error, testContainers)
+	for i := 0; i < testContainers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = testSpi.PrepareSnapshot(context.Background(), fmt.Sprintf("test-container-id-%d", i), mockImage, testUIDMappings, testGIDMappings)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		testutil.AssertNil(t, err)
+	}
+}
+
 func TestMountSnapshot(t *testing.T) {
 	const (
 		testType      = "test_type"
@@ -277,6 +436,7 @@ func TestMountSnapshot(t *testing.T) {
 				snapshotterType: testType,
 				lease:           &leases.Lease{ID: testLeaseID},
 				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
 			}
 			ctx := context.Background()
 
@@ -295,6 +455,8 @@ func TestRemoveSnapshot(t *testing.T) {
 		testCtrID     = "test-container-id"
 		testLeaseID   = "test.lease"
 		testNamespace = "test-ns"
+
+		testRemappedParent = "sha256:parent-100000-100000"
 	)
 	testSnapshotID := fmt.Sprintf(snapshotIDTemplate, testCtrID)
 
@@ -304,19 +466,41 @@ func TestRemoveSnapshot(t *testing.T) {
 		"test_error_remove": {
 			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
 				err := errors.New("test error remove")
+				mockSnapshotter.EXPECT().Stat(ctx, testSnapshotID).Return(snapshots.Info{Name: testSnapshotID}, nil)
 				mockSnapshotter.EXPECT().Remove(ctx, testSnapshotID).Return(err)
 				return err
 			},
 		},
 		"test_error_not_found_remove": {
 			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testSnapshotID).Return(snapshots.Info{}, errdefs.ErrNotFound)
 				mockSnapshotter.EXPECT().Remove(ctx, testSnapshotID).Return(errdefs.ErrNotFound)
 				return nil
 			},
 		},
 		"test_remove_success": {
 			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testSnapshotID).Return(snapshots.Info{Name: testSnapshotID, Parent: "sha256:parent"}, nil)
+				mockSnapshotter.EXPECT().Remove(ctx, testSnapshotID).Return(nil)
+				mockSnapshotter.EXPECT().Stat(ctx, "sha256:parent").Return(snapshots.Info{Name: "sha256:parent"}, nil)
+				return nil
+			},
+		},
+		"test_remove_success_remapped_parent_removed": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testSnapshotID).Return(snapshots.Info{Name: testSnapshotID, Parent: testRemappedParent}, nil)
+				mockSnapshotter.EXPECT().Remove(ctx, testSnapshotID).Return(nil)
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{Name: testRemappedParent, Labels: map[string]string{remappedSnapshotLabel: "sha256:parent"}}, nil)
+				mockSnapshotter.EXPECT().Remove(ctx, testRemappedParent).Return(nil)
+				return nil
+			},
+		},
+		"test_remove_success_remapped_parent_in_use": {
+			mockExec: func(ctx context.Context, mockSnapshotter *containerdMocks.MockSnapshotter) error {
+				mockSnapshotter.EXPECT().Stat(ctx, testSnapshotID).Return(snapshots.Info{Name: testSnapshotID, Parent: testRemappedParent}, nil)
 				mockSnapshotter.EXPECT().Remove(ctx, testSnapshotID).Return(nil)
+				mockSnapshotter.EXPECT().Stat(ctx, testRemappedParent).Return(snapshots.Info{Name: testRemappedParent, Labels: map[string]string{remappedSnapshotLabel: "sha256:parent"}}, nil)
+				mockSnapshotter.EXPECT().Remove(ctx, testRemappedParent).Return(errdefs.ErrFailedPrecondition)
 				return nil
 			},
 		},
@@ -339,6 +523,7 @@ func TestRemoveSnapshot(t *testing.T) {
 				snapshotterType: testType,
 				lease:           &leases.Lease{ID: testLeaseID},
 				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
 			}
 			ctx := context.Background()
 
@@ -397,6 +582,7 @@ func TestListSnapshots(t *testing.T) {
 				snapshotterType: testType,
 				lease:           &leases.Lease{ID: testLeaseID},
 				namespace:       testNamespace,
+				remapLocks:      newRemapLocks(),
 			}
 			ctx := context.Background()
 
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// parseIDMapping parses a subordinate range of host IDs in the form of <first-host-id>:<size> to
+// a mapping of the IDs starting from 0 in the container's user namespace
+func parseIDMapping(idRange string) (*specs.LinuxIDMapping, error) {
+	parts := strings.Split(idRange, ":")
+	if len(parts) != 2 {
+		return nil, log.NewErrorf("unexpected ID range = %s, must be in the form of <first-host-id>:<size>", idRange)
+	}
+	hostID, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil || hostID == 0 {
+		return nil, log.NewErrorf("unexpected first host ID = %s, must be a positive number", parts[0])
+	}
+	size, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil || size == 0 || hostID+size-1 > math.MaxUint32 {
+		return nil, log.NewErrorf("unexpected ID range size = %s, must be a positive number not exceeding the max ID", parts[1])
+	}
+	return &specs.LinuxIDMapping{ContainerID: 0, HostID: uint32(hostID), Size: uint32(size)}, nil
+}
+
+// getIDMappings returns the user and group ID mappings of the container's user namespace or nil ones if the container is not remapped
+func (ctrdClient *containerdClient) getIDMappings(container *types.Container) ([]specs.LinuxIDMapping, []specs.LinuxIDMapping, error) {
+	switch container.HostConfig.UsernsMode {
+	case types.UsernsModeHost:
+		return nil, nil, nil
+	case types.UsernsModeRemap:
+		if ctrdClient.usernsRemapUIDs == nil {
+			return nil, nil, log.NewErrorf("cannot remap the user namespace of container id = %s as no subordinate ID ranges are configured", container.ID)
+		}
+	default:
+		// the privileged containers are never remapped by default as they are expected to have the full host privileges,
+		// neither are the ones sharing IPC or PID namespaces as these are owned by another user namespace
+		if !ctrdClient.usernsRemapDefault || ctrdClient.usernsRemapUIDs == nil || container.HostConfig.Privileged ||
+			util.IsNamespaceModeShared(container.HostConfig.IpcMode) || util.IsNamespaceModeShared(container.HostConfig.PidMode) {
+			return nil, nil, nil
+		}
+	}
+	return []specs.LinuxIDMapping{*ctrdClient.usernsRemapUIDs}, []specs.LinuxIDMapping{*ctrdClient.usernsRemapGIDs}, nil
+}
+
+// remapID returns the host ID that the provided ID in the container's user namespace is mapped to,
+// the IDs which are not mapped are returned as they are
+func remapID(id uint32, mappings []specs.LinuxIDMapping) uint32 {
+	for _, m := range mappings {
+		if id >= m.ContainerID && id-m.ContainerID < m.Size {
+			return m.HostID + id - m.ContainerID
+		}
+	}
+	return id
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package ctr
+
+import (
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestParseIDMapping(t *testing.T) {
+	tests := map[string]struct {
+		idRange         string
+		expectedMapping *specs.LinuxIDMapping
+		expectedErr     error
+	}{
+		"test_valid": {
+			idRange:         "100000:65536",
+			expectedMapping: &specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 65536},
+		},
+		"test_no_size": {
+			idRange:     "100000",
+			expectedErr: log.NewErrorf("unexpected ID range = 100000, must be in the form of <first-host-id>:<size>"),
+		},
+		"test_host_root": {
+			idRange:     "0:65536",
+			expectedErr: log.NewErrorf("unexpected first host ID = 0, must be a positive number"),
+		},
+		"test_invalid_host_id": {
+			idRange:     "-1:65536",
+			expectedErr: log.NewErrorf("unexpected first host ID = -1, must be a positive number"),
+		},
+		"test_zero_size": {
+			idRange:     "100000:0",
+			expectedErr: log.NewErrorf("unexpected ID range size = 0, must be a positive number not exceeding the max ID"),
+		},
+		"test_size_overflow": {
+			idRange:     "4294967295:2",
+			expectedErr: log.NewErrorf("unexpected ID range size = 2, must be a positive number not exceeding the max ID"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mapping, err := parseIDMapping(testCase.idRange)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedMapping, mapping)
+		})
+	}
+}
+
+func TestGetIDMappings(t *testing.T) {
+	uidMapping := &specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 65536}
+	gidMapping := &specs.LinuxIDMapping{ContainerID: 0, HostID: 200000, Size: 65536}
+
+	tests := map[string]struct {
+		client              *containerdClient
+		hostConfig          *types.HostConfig
+		expectedUIDMappings []specs.LinuxIDMapping
+		expectedGIDMappings []specs.LinuxIDMapping
+		expectedErr         error
+	}{
+		"test_not_configured": {
+			client:     &containerdClient{},
+			hostConfig: &types.HostConfig{},
+		},
+		"test_not_configured_remap": {
+			client:      &containerdClient{},
+			hostConfig:  &types.HostConfig{UsernsMode: types.UsernsModeRemap},
+			expectedErr: log.NewErrorf("cannot remap the user namespace of container id = test-ctr as no subordinate ID ranges are configured"),
+		},
+		"test_remap": {
+			client:              &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping},
+			hostConfig:          &types.HostConfig{UsernsMode: types.UsernsModeRemap},
+			expectedUIDMappings: []specs.LinuxIDMapping{*uidMapping},
+			expectedGIDMappings: []specs.LinuxIDMapping{*gidMapping},
+		},
+		"test_default_not_remapped": {
+			client:     &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping},
+			hostConfig: &types.HostConfig{},
+		},
+		"test_default_remapped": {
+			client:              &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping, usernsRemapDefault: true},
+			hostConfig:          &types.HostConfig{},
+			expectedUIDMappings: []specs.LinuxIDMapping{*uidMapping},
+			expectedGIDMappings: []specs.LinuxIDMapping{*gidMapping},
+		},
+		"test_default_remapped_privileged": {
+			client:     &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping, usernsRemapDefault: true},
+			hostConfig: &types.HostConfig{Privileged: true},
+		},
+		"test_default_remapped_shared_ipc": {
+			client:     &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping, usernsRemapDefault: true},
+			hostConfig: &types.HostConfig{IpcMode: types.NamespaceModeContainerPrefix + "sidecar"},
+		},
+		"test_default_remapped_host": {
+			client:     &containerdClient{usernsRemapUIDs: uidMapping, usernsRemapGIDs: gidMapping, usernsRemapDefault: true},
+			hostConfig: &types.HostConfig{UsernsMode: types.UsernsModeHost},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			uidMappings, gidMappings, err := testCase.client.getIDMappings(&types.Container{ID: "test-ctr", HostConfig: testCase.hostConfig})
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedUIDMappings, uidMappings)
+			testutil.AssertEqual(t, testCase.expectedGIDMappings, gidMappings)
+		})
+	}
+}
+
+func TestRemapID(t *testing.T) {
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 1000}, {ContainerID: 1000, HostID: 300000, Size: 10}}
+	testutil.AssertEqual(t, uint32(100000), remapID(0, mappings))
+	testutil.AssertEqual(t, uint32(100999), remapID(999, mappings))
+	testutil.AssertEqual(t, uint32(300005), remapID(1005, mappings))
+	testutil.AssertEqual(t, uint32(1010), remapID(1010, mappings))
+	testutil.AssertEqual(t, uint32(5), remapID(5, nil))
+}
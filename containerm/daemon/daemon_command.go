@@ -52,16 +52,24 @@ func setupCommandFlags(cmd *cobra.Command) {
 	flagSet.StringSliceVar(&cfg.ContainerClientConfig.CtrImageDecKeys, "ccl-image-dec-keys", cfg.ContainerClientConfig.CtrImageDecKeys, "Specify a list of private keys filenames (GPG private key ring, JWE and PKCS7 private key). Each entry can include an optional password separated by a colon after the filename.")
 	flagSet.StringSliceVar(&cfg.ContainerClientConfig.CtrImageDecRecipients, "ccl-image-dec-recipients", cfg.ContainerClientConfig.CtrImageDecRecipients, "Specify a recipients certificates list of the image (used only for PKCS7 and must be an x509)")
 	flagSet.StringVar(&cfg.ContainerClientConfig.CtrRuncRuntime, "ccl-runc-runtime", cfg.ContainerClientConfig.CtrRuncRuntime, "Specify a default global runc runtime - possible values are io.containerd.runtime.v1.linux, io.containerd.runc.v1 and io.containerd.runc.v2. ")
+	flagSet.StringVar(&cfg.ContainerClientConfig.CtrDefaultRuntimeHandler, "ccl-default-runtime-handler", cfg.ContainerClientConfig.CtrDefaultRuntimeHandler, "Specify the name of the runtime handler that the containers are run with if not configured otherwise per container - the built-in runc one, which uses the global runc runtime, or any of the configured runtime handlers")
 	flagSet.DurationVar(&cfg.ContainerClientConfig.CtrImageExpiry, "ccl-image-expiry", cfg.ContainerClientConfig.CtrImageExpiry, "Specify the time period for the cached images and content to be kept in the form of e.g. 72h3m0.5s")
 	flagSet.BoolVar(&cfg.ContainerClientConfig.CtrImageExpiryDisable, "ccl-image-expiry-disable", cfg.ContainerClientConfig.CtrImageExpiryDisable, "Disables expiry management of cached images and content - must be used with caution as it may lead to large memory volumes being persistently allocated")
 	flagSet.StringVar(&cfg.ContainerClientConfig.CtrLeaseID, "ccl-lease-id", cfg.ContainerClientConfig.CtrLeaseID, "Specify the lease identifier to be used for container resources persistence")
 	flagSet.StringVar(&cfg.ContainerClientConfig.CtrImageVerifierType, "ccl-image-verifier-type", cfg.ContainerClientConfig.CtrImageVerifierType, "Specify the image verifier type - possible values are none and notation, when set to none image signatures wil not be verified.")
 	flagSet.Var(&cfg.ContainerClientConfig.CtrImageVerifierConfig, "ccl-image-verifier-config", "Specify the configuration of the image verifier, as comma separated {key}={value} pairs - possible keys for notation verifier are configDir and libexecDir, for more info https://notaryproject.dev/docs/user-guides/how-to/directory-structure/#user-level")
+	flagSet.StringVar(&cfg.ContainerClientConfig.CtrLogCompress, "ccl-log-compress", cfg.ContainerClientConfig.CtrLogCompress, "Specify the default compression of the rotated json-file container logs - possible values are none, gzip and zstd")
+	flagSet.DurationVar(&cfg.ContainerClientConfig.CtrLogMaxAge, "ccl-log-max-age", cfg.ContainerClientConfig.CtrLogMaxAge, "Specify the default max age of the rotated json-file container logs in the form of e.g. 72h3m0.5s, the older ones are removed - 0 disables the age based retention")
+	flagSet.StringVar(&cfg.ContainerClientConfig.CtrLogDiskBudget, "ccl-log-disk-budget", cfg.ContainerClientConfig.CtrLogDiskBudget, "Specify the max disk space to be used by the json-file logs of all containers, e.g. 1G, the oldest rotated log files are removed first when it is exceeded - not limited if not set")
+	flagSet.StringVar(&cfg.ContainerClientConfig.CtrUsernsRemapUIDs, "ccl-userns-remap-uids", cfg.ContainerClientConfig.CtrUsernsRemapUIDs, "Specify the subordinate range of host user IDs in the form of <first-host-id>:<size>, e.g. 100000:65536, that the user IDs of the containers with a remapped user namespace are mapped to - user namespace remapping is not available if not set")
+	flagSet.StringVar(&cfg.ContainerClientConfig.CtrUsernsRemapGIDs, "ccl-userns-remap-gids", cfg.ContainerClientConfig.CtrUsernsRemapGIDs, "Specify the subordinate range of host group IDs in the form of <first-host-id>:<size>, e.g. 100000:65536, that the group IDs of the containers with a remapped user namespace are mapped to - the range of the user IDs is used if not set")
+	flagSet.BoolVar(&cfg.ContainerClientConfig.CtrUsernsRemapDefault, "ccl-userns-remap-default", cfg.ContainerClientConfig.CtrUsernsRemapDefault, "Remaps the user namespace of all non-privileged containers which do not explicitly opt out with the host user namespace mode")
 
 	// init network manager flags
 	flagSet.StringVar(&cfg.NetworkConfig.NetType, "net-type", cfg.NetworkConfig.NetType, "Specify the default network management type for containers")
 	flagSet.StringVar(&cfg.NetworkConfig.NetMetaPath, "net-home-dir", cfg.NetworkConfig.NetMetaPath, "Specify the home directory for containers network management data handling")
 	flagSet.StringVar(&cfg.NetworkConfig.NetExecRoot, "net-exec-root-dir", cfg.NetworkConfig.NetExecRoot, "Specify the exec root for the network management operations")
+	flagSet.BoolVar(&cfg.NetworkConfig.NetDNSDisable, "net-dns-disable", cfg.NetworkConfig.NetDNSDisable, "Disable the embedded DNS resolvers of the bridge networks which resolve the container names")
 
 	// init default bridge network flags
 	flagSet.BoolVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeDisableBridge, "net-tbr-disable", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeDisableBridge, "Disables the default container management bridge network")
@@ -70,6 +78,8 @@ func setupCommandFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv4, "net-br-fcidr4", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv4, "The fixed container ids range for the default bridge network interface used with IP v4")
 	flagSet.StringVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv4, "net-br-gwip4", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv4, "The IP v4 of the gateway to be configured for the default bridge network interface")
 	flagSet.BoolVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeEnableIPv6, "net-br-enable-ip6", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeEnableIPv6, "Specifies whether IP v6 must be enabled for the default bridge network interface")
+	flagSet.StringVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv6, "net-br-fcidr6", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv6, "The fixed IP v6 subnet (ULA or global prefix) from which the containers connected to the default bridge network interface are assigned IP v6 addresses")
+	flagSet.StringVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv6, "net-br-gwip6", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv6, "The IP v6 of the gateway to be configured for the default bridge network interface")
 	flagSet.IntVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeMtu, "net-br-mtu", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeMtu, "Specifies the MTU for the default bridge network interface")
 	flagSet.BoolVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIcc, "net-br-icc", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIcc, "Enable inter-container communication on the default bridge network interface")
 	flagSet.BoolVar(&cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIPTables, "net-br-ipt", cfg.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIPTables, "Enable Ip Tables management on the default bridge network interface")
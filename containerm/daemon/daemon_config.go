@@ -80,20 +80,28 @@ func (mc *managerConfig) UnmarshalJSON(data []byte) error {
 
 // container client config- e.g. containerd
 type containerRuntimeConfig struct {
-	CtrNamespace           string                     `json:"default_ns,omitempty"`
-	CtrAddressPath         string                     `json:"address_path,omitempty"`
-	CtrRegistryConfigs     map[string]*registryConfig `json:"registry_configurations,omitempty"`
-	CtrInsecureRegistries  []string                   `json:"insecure_registries,omitempty"`
-	CtrRootExec            string                     `json:"exec_root_dir,omitempty"`
-	CtrMetaPath            string                     `json:"home_dir,omitempty"`
-	CtrImageDecKeys        []string                   `json:"image_dec_keys,omitempty"`
-	CtrImageDecRecipients  []string                   `json:"image_dec_recipients,omitempty"`
-	CtrRuncRuntime         string                     `json:"runc_runtime,omitempty"`
-	CtrImageExpiry         time.Duration              `json:"image_expiry,omitempty"`
-	CtrImageExpiryDisable  bool                       `json:"image_expiry_disable,omitempty"`
-	CtrLeaseID             string                     `json:"lease_id,omitempty"`
-	CtrImageVerifierType   string                     `json:"image_verifier_type,omitempty"`
-	CtrImageVerifierConfig verifierConfig             `json:"image_verifier_config,omitempty"`
+	CtrNamespace             string                           `json:"default_ns,omitempty"`
+	CtrAddressPath           string                           `json:"address_path,omitempty"`
+	CtrRegistryConfigs       map[string]*registryConfig       `json:"registry_configurations,omitempty"`
+	CtrInsecureRegistries    []string                         `json:"insecure_registries,omitempty"`
+	CtrRootExec              string                           `json:"exec_root_dir,omitempty"`
+	CtrMetaPath              string                           `json:"home_dir,omitempty"`
+	CtrImageDecKeys          []string                         `json:"image_dec_keys,omitempty"`
+	CtrImageDecRecipients    []string                         `json:"image_dec_recipients,omitempty"`
+	CtrRuncRuntime           string                           `json:"runc_runtime,omitempty"`
+	CtrRuntimeHandlers       map[string]*runtimeHandlerConfig `json:"runtime_handlers,omitempty"`
+	CtrDefaultRuntimeHandler string                           `json:"default_runtime_handler,omitempty"`
+	CtrImageExpiry           time.Duration                    `json:"image_expiry,omitempty"`
+	CtrImageExpiryDisable    bool                             `json:"image_expiry_disable,omitempty"`
+	CtrLeaseID               string                           `json:"lease_id,omitempty"`
+	CtrImageVerifierType     string                           `json:"image_verifier_type,omitempty"`
+	CtrImageVerifierConfig   verifierConfig                   `json:"image_verifier_config,omitempty"`
+	CtrLogCompress           string                           `json:"log_compress,omitempty"`
+	CtrLogMaxAge             time.Duration                    `json:"log_max_age,omitempty"`
+	CtrLogDiskBudget         string                           `json:"log_disk_budget,omitempty"`
+	CtrUsernsRemapUIDs       string                           `json:"userns_remap_uids,omitempty"`
+	CtrUsernsRemapGIDs       string                           `json:"userns_remap_gids,omitempty"`
+	CtrUsernsRemapDefault    bool                             `json:"userns_remap_default,omitempty"`
 }
 
 // deployment manager config
@@ -109,6 +117,7 @@ func (cfg *containerRuntimeConfig) UnmarshalJSON(data []byte) error {
 
 	tmp := struct {
 		CtrImageExpiry string `json:"image_expiry,omitempty"`
+		CtrLogMaxAge   string `json:"log_max_age,omitempty"`
 		*containerRuntimeConfigPlain
 	}{
 		containerRuntimeConfigPlain: (*containerRuntimeConfigPlain)(cfg),
@@ -123,6 +132,12 @@ func (cfg *containerRuntimeConfig) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if tmp.CtrLogMaxAge != "" {
+		cfg.CtrLogMaxAge, err = time.ParseDuration(tmp.CtrLogMaxAge)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -138,6 +153,20 @@ type authCredentials struct {
 	Password string `json:"password,omitempty"`
 }
 
+// runtime handler config - the shim type, the shim binary and the options of the OCI runtime which the containers are run with
+type runtimeHandlerConfig struct {
+	RuntimeType string                `json:"runtime_type"`
+	BinaryPath  string                `json:"binary_path,omitempty"`
+	Options     *runtimeOptionsConfig `json:"options,omitempty"`
+}
+
+// runc compatible OCI runtime options config
+type runtimeOptionsConfig struct {
+	BinaryName    string `json:"binary_name,omitempty"`
+	Root          string `json:"root,omitempty"`
+	SystemdCgroup *bool  `json:"systemd_cgroup,omitempty"`
+}
+
 // tls-secured communication config
 type tlsConfig struct {
 	RootCA     string `json:"root_ca"`
@@ -150,6 +179,7 @@ type networkConfig struct {
 	NetType                    string               `json:"type,omitempty"`
 	NetMetaPath                string               `json:"home_dir,omitempty"`
 	NetExecRoot                string               `json:"exec_root_dir,omitempty"`
+	NetDNSDisable              bool                 `json:"dns_disable,omitempty"`
 	DefaultBridgeNetworkConfig *bridgeNetworkConfig `json:"default_bridge,omitempty"`
 }
 
@@ -161,6 +191,8 @@ type bridgeNetworkConfig struct {
 	NetBridgeFixedCIDRv4   string `json:"fcidr4,omitempty"`
 	NetBridgeGatewayIPv4   string `json:"gwip4,omitempty"`
 	NetBridgeEnableIPv6    bool   `json:"enable_ip6,omitempty"`
+	NetBridgeFixedCIDRv6   string `json:"fcidr6,omitempty"`
+	NetBridgeGatewayIPv6   string `json:"gwip6,omitempty"`
 
 	NetBridgeMtu           int  `json:"mtu,omitempty"`
 	NetBridgeIcc           bool `json:"icc,omitempty"`
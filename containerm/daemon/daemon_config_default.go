@@ -43,20 +43,23 @@ const (
 	managerContainerStopTimeoutDefault     = "30s"
 
 	// default container client config
-	containerClientNamespaceDefault   = "kanto-cm"
-	containerClientAddressPathDefault = "/run/containerd/containerd.sock"
-	containerClientExecRootDefault    = managerExecRootPathDefault
-	containerClientMetaPathDefault    = managerMetaPathDefault
-	containerClientRuncRuntimeDefault = string(types.RuntimeTypeV2runcV2)
-	containerClientImageExpiry        = 31 * 24 * time.Hour // 31 days
-	containerClientImageExpiryDisable = false
-	containerClientLeaseIDDefault     = "kanto-cm.lease"
-	containerClientImageVerifierType  = string(ctr.VerifierNone)
+	containerClientNamespaceDefault      = "kanto-cm"
+	containerClientAddressPathDefault    = "/run/containerd/containerd.sock"
+	containerClientExecRootDefault       = managerExecRootPathDefault
+	containerClientMetaPathDefault       = managerMetaPathDefault
+	containerClientRuncRuntimeDefault    = string(types.RuntimeTypeV2runcV2)
+	containerClientRuntimeHandlerDefault = ctr.RuntimeHandlerRunc
+	containerClientImageExpiry           = 31 * 24 * time.Hour // 31 days
+	containerClientImageExpiryDisable    = false
+	containerClientLeaseIDDefault        = "kanto-cm.lease"
+	containerClientImageVerifierType     = string(ctr.VerifierNone)
+	containerClientLogCompress           = string(types.LogCompressionNone)
 
 	// default network manager config
 	networkManagerNetTypeDefault  = string(types.NetworkModeBridge)
 	networkManagerMetaPathDefault = managerMetaPathDefault
 	networkManagerExecRootDefault = managerExecRootPathDefault
+	networkManagerDNSDisable      = false
 
 	// default bridge network config
 	networkBridgeDisableDefault       = false
@@ -65,6 +68,8 @@ const (
 	networkBridgeFixedCIDRv4Default   = ""
 	networkBridgeGatewayIPV4Default   = ""
 	networkBridgeEnableIPV6Default    = false
+	networkBridgeFixedCIDRv6Default   = ""
+	networkBridgeGatewayIPV6Default   = ""
 	networkBridgeMtuDefault           = 1500
 	networkBridgeIccDefault           = true
 	networkBridgeIPTablesDefault      = true
@@ -129,21 +134,24 @@ func getDefaultInstance() *config {
 			MgrDefaultCtrsStopTimeout: managerContainerStopTimeoutDefault,
 		},
 		ContainerClientConfig: &containerRuntimeConfig{
-			CtrNamespace:          containerClientNamespaceDefault,
-			CtrAddressPath:        containerClientAddressPathDefault,
-			CtrInsecureRegistries: containerClientInsecureRegistriesDefault,
-			CtrRootExec:           containerClientExecRootDefault,
-			CtrMetaPath:           containerClientMetaPathDefault,
-			CtrRuncRuntime:        containerClientRuncRuntimeDefault,
-			CtrImageExpiry:        containerClientImageExpiry,
-			CtrImageExpiryDisable: containerClientImageExpiryDisable,
-			CtrLeaseID:            containerClientLeaseIDDefault,
-			CtrImageVerifierType:  containerClientImageVerifierType,
+			CtrNamespace:             containerClientNamespaceDefault,
+			CtrAddressPath:           containerClientAddressPathDefault,
+			CtrInsecureRegistries:    containerClientInsecureRegistriesDefault,
+			CtrRootExec:              containerClientExecRootDefault,
+			CtrMetaPath:              containerClientMetaPathDefault,
+			CtrRuncRuntime:           containerClientRuncRuntimeDefault,
+			CtrDefaultRuntimeHandler: containerClientRuntimeHandlerDefault,
+			CtrImageExpiry:           containerClientImageExpiry,
+			CtrImageExpiryDisable:    containerClientImageExpiryDisable,
+			CtrLeaseID:               containerClientLeaseIDDefault,
+			CtrImageVerifierType:     containerClientImageVerifierType,
+			CtrLogCompress:           containerClientLogCompress,
 		},
 		NetworkConfig: &networkConfig{
-			NetType:     networkManagerNetTypeDefault,
-			NetMetaPath: networkManagerMetaPathDefault,
-			NetExecRoot: networkManagerExecRootDefault,
+			NetType:       networkManagerNetTypeDefault,
+			NetMetaPath:   networkManagerMetaPathDefault,
+			NetExecRoot:   networkManagerExecRootDefault,
+			NetDNSDisable: networkManagerDNSDisable,
 			DefaultBridgeNetworkConfig: &bridgeNetworkConfig{
 				NetBridgeDisableBridge: networkBridgeDisableDefault,
 				NetBridgeName:          networkBridgeNameDefault,
@@ -151,6 +159,8 @@ func getDefaultInstance() *config {
 				NetBridgeFixedCIDRv4:   networkBridgeFixedCIDRv4Default,
 				NetBridgeGatewayIPv4:   networkBridgeGatewayIPV4Default,
 				NetBridgeEnableIPv6:    networkBridgeEnableIPV6Default,
+				NetBridgeFixedCIDRv6:   networkBridgeFixedCIDRv6Default,
+				NetBridgeGatewayIPv6:   networkBridgeGatewayIPV6Default,
 				NetBridgeMtu:           networkBridgeMtuDefault,
 				NetBridgeIcc:           networkBridgeIccDefault,
 				NetBridgeIPTables:      networkBridgeIPTablesDefault,
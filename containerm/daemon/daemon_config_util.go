@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strconv"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/server"
 	"github.com/eclipse-kanto/container-management/containerm/things"
 	"github.com/eclipse-kanto/container-management/containerm/updateagent"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/eclipse-kanto/container-management/containerm/volumes"
 	"github.com/spf13/pflag"
 )
 
@@ -45,11 +48,19 @@ func extractCtrClientConfigOptions(daemonConfig *config) []ctr.ContainerOpts {
 		ctr.WithCtrdImageDecryptKeys(daemonConfig.ContainerClientConfig.CtrImageDecKeys...),
 		ctr.WithCtrdImageDecryptRecipients(daemonConfig.ContainerClientConfig.CtrImageDecRecipients...),
 		ctr.WithCtrdRuncRuntime(daemonConfig.ContainerClientConfig.CtrRuncRuntime),
+		ctr.WithCtrdRuntimeHandlers(parseRuntimeHandlers(daemonConfig.ContainerClientConfig.CtrRuntimeHandlers)...),
+		ctr.WithCtrdDefaultRuntimeHandler(daemonConfig.ContainerClientConfig.CtrDefaultRuntimeHandler),
 		ctr.WithCtrdImageExpiry(daemonConfig.ContainerClientConfig.CtrImageExpiry),
 		ctr.WithCtrdImageExpiryDisable(daemonConfig.ContainerClientConfig.CtrImageExpiryDisable),
 		ctr.WithCtrdLeaseID(daemonConfig.ContainerClientConfig.CtrLeaseID),
 		ctr.WithCtrImageVerifierType(daemonConfig.ContainerClientConfig.CtrImageVerifierType),
 		ctr.WithCtrImageVerifierConfig(daemonConfig.ContainerClientConfig.CtrImageVerifierConfig),
+		ctr.WithCtrdLogCompression(daemonConfig.ContainerClientConfig.CtrLogCompress),
+		ctr.WithCtrdLogMaxAge(daemonConfig.ContainerClientConfig.CtrLogMaxAge),
+		ctr.WithCtrdLogDiskBudget(daemonConfig.ContainerClientConfig.CtrLogDiskBudget),
+		ctr.WithCtrdUsernsRemapUIDs(daemonConfig.ContainerClientConfig.CtrUsernsRemapUIDs),
+		ctr.WithCtrdUsernsRemapGIDs(daemonConfig.ContainerClientConfig.CtrUsernsRemapGIDs),
+		ctr.WithCtrdUsernsRemapDefault(daemonConfig.ContainerClientConfig.CtrUsernsRemapDefault),
 	)
 	return ctrOpts
 }
@@ -60,12 +71,15 @@ func extractNetManagerConfigOptions(daemonConfig *config) []network.NetOpt {
 		network.WithLibNetType(daemonConfig.NetworkConfig.NetType),
 		network.WithLibNetMetaPath(daemonConfig.NetworkConfig.NetMetaPath),
 		network.WithLibNetExecRoot(daemonConfig.NetworkConfig.NetExecRoot),
+		network.WithLibNetDisableDNS(daemonConfig.NetworkConfig.NetDNSDisable),
 		network.WithLibNetDisableBridge(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeDisableBridge),
 		network.WithLibNetName(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeName),
 		network.WithLibNetIPV4(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIPV4),
 		network.WithLibNetFixedCIDRv4(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv4),
 		network.WithLibNetGatewayIPv4(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv4),
 		network.WithLibNetEnableIPv6(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeEnableIPv6),
+		network.WithLibNetFixedCIDRv6(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv6),
+		network.WithLibNetGatewayIPv6(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv6),
 		network.WithLibNetMtu(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeMtu),
 		network.WithLibNetIcc(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIcc),
 		network.WithLibNetIPTables(daemonConfig.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIPTables),
@@ -91,6 +105,14 @@ func extractContainerManagerOptions(daemonConfig *config) []mgr.ContainerManager
 	return mgrOpts
 }
 
+func extractVolumesManagerOptions(daemonConfig *config) []volumes.VolumeManagerOpt {
+	volumesOpts := []volumes.VolumeManagerOpt{}
+	volumesOpts = append(volumesOpts,
+		volumes.WithVolumesMetaPath(daemonConfig.ManagerConfig.MgrMetaPath),
+	)
+	return volumesOpts
+}
+
 func extractGrpcOptions(daemonConfig *config) []server.GrpcServerOpt {
 	grpcServerOpts := []server.GrpcServerOpt{}
 	grpcServerOpts = append(grpcServerOpts,
@@ -269,11 +291,21 @@ func dumpContClient(configInstance *config) {
 		if r == types.RuntimeTypeV1 || r == types.RuntimeTypeV2runcV1 {
 			log.Warn("runtime %s is deprecated since containerd v1.4, consider using %s", r, types.RuntimeTypeV2runcV2)
 		}
+		for _, handler := range parseRuntimeHandlers(configInstance.ContainerClientConfig.CtrRuntimeHandlers) {
+			log.Debug("[daemon_cfg][ccl-runtime-handlers] : %s - %s", handler.Name, handler.RuntimeType)
+		}
+		log.Debug("[daemon_cfg][ccl-default-runtime-handler] : %s", configInstance.ContainerClientConfig.CtrDefaultRuntimeHandler)
 		log.Debug("[daemon_cfg][ccl-image-expiry] : %s", configInstance.ContainerClientConfig.CtrImageExpiry)
 		log.Debug("[daemon_cfg][ccl-image-expiry-disable] : %v", configInstance.ContainerClientConfig.CtrImageExpiryDisable)
 		log.Debug("[daemon_cfg][ccl-lease-id] : %s", configInstance.ContainerClientConfig.CtrLeaseID)
 		log.Debug("[daemon_cfg][ccl-image-verifier-type] : %v", configInstance.ContainerClientConfig.CtrImageVerifierType)
 		log.Debug("[daemon_cfg][ccl-image-verifier-config] : %v", configInstance.ContainerClientConfig.CtrImageVerifierConfig.String())
+		log.Debug("[daemon_cfg][ccl-log-compress] : %s", configInstance.ContainerClientConfig.CtrLogCompress)
+		log.Debug("[daemon_cfg][ccl-log-max-age] : %s", configInstance.ContainerClientConfig.CtrLogMaxAge)
+		log.Debug("[daemon_cfg][ccl-log-disk-budget] : %s", configInstance.ContainerClientConfig.CtrLogDiskBudget)
+		log.Debug("[daemon_cfg][ccl-userns-remap-uids] : %s", configInstance.ContainerClientConfig.CtrUsernsRemapUIDs)
+		log.Debug("[daemon_cfg][ccl-userns-remap-gids] : %s", configInstance.ContainerClientConfig.CtrUsernsRemapGIDs)
+		log.Debug("[daemon_cfg][ccl-userns-remap-default] : %v", configInstance.ContainerClientConfig.CtrUsernsRemapDefault)
 	}
 }
 
@@ -282,6 +314,7 @@ func dumpNetworkManager(configInstance *config) {
 		log.Debug("[daemon_cfg][net-type] : %s", configInstance.NetworkConfig.NetType)
 		log.Debug("[daemon_cfg][net-home-dir] : %s", configInstance.NetworkConfig.NetMetaPath)
 		log.Debug("[daemon_cfg][net-exec-root-dir] : %s", configInstance.NetworkConfig.NetExecRoot)
+		log.Debug("[daemon_cfg][net-dns-disable] : %v", configInstance.NetworkConfig.NetDNSDisable)
 
 		// dump default bridge network config
 		if configInstance.NetworkConfig.DefaultBridgeNetworkConfig != nil {
@@ -298,6 +331,12 @@ func dumpNetworkManager(configInstance *config) {
 					log.Debug("[daemon_cfg][net-br-gwip4] : %s", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv4)
 				}
 				log.Debug("[daemon_cfg][net-br-enable-ip6] : %v", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeEnableIPv6)
+				if configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv6 != "" {
+					log.Debug("[daemon_cfg][net-br-fcidr6] : %s", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeFixedCIDRv6)
+				}
+				if configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv6 != "" {
+					log.Debug("[daemon_cfg][net-br-gwip6] : %s", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeGatewayIPv6)
+				}
 				log.Debug("[daemon_cfg][net-br-mtu] : %d", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeMtu)
 				log.Debug("[daemon_cfg][net-br-icc] : %v", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIcc)
 				log.Debug("[daemon_cfg][et-br-ipt] : %v", configInstance.NetworkConfig.DefaultBridgeNetworkConfig.NetBridgeIPTables)
@@ -439,3 +478,33 @@ func parseDuration(duration, defaultDuration string) time.Duration {
 	}
 	return d
 }
+
+func parseRuntimeHandlers(configs map[string]*runtimeHandlerConfig) []*types.RuntimeHandler {
+	var handlers []*types.RuntimeHandler
+	for name, conf := range configs {
+		if conf == nil {
+			log.Warn("[daemon_cfg] runtime handler %s is not configured and will not be added to the container-management configuration", name)
+			continue
+		}
+		handler := &types.RuntimeHandler{
+			Name:        name,
+			RuntimeType: types.Runtime(conf.RuntimeType),
+			BinaryPath:  conf.BinaryPath,
+		}
+		if conf.Options != nil {
+			handler.Options = &types.RuntimeOptions{
+				BinaryName:    conf.Options.BinaryName,
+				Root:          conf.Options.Root,
+				SystemdCgroup: util.IsRunningSystemd(),
+			}
+			if conf.Options.SystemdCgroup != nil {
+				handler.Options.SystemdCgroup = *conf.Options.SystemdCgroup
+			}
+		}
+		handlers = append(handlers, handler)
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Name < handlers[j].Name
+	})
+	return handlers
+}
@@ -34,18 +34,24 @@ func (d *daemon) init() {
 	//init events manager services
 	initService(ctx, d, registrationsMap, registry.EventsManagerService)
 
-	//init system info manager services
-	initService(ctx, d, registrationsMap, registry.SystemInfoService)
-
 	//init container client services
 	initService(ctx, d, registrationsMap, registry.ContainerClientService)
 
+	//init system info manager services
+	initService(ctx, d, registrationsMap, registry.SystemInfoService)
+
 	//init network manager services
 	initService(ctx, d, registrationsMap, registry.NetworkManagerService)
 
+	//init volumes manager service
+	initService(ctx, d, registrationsMap, registry.VolumesManagerService)
+
 	//init container manager service
 	initService(ctx, d, registrationsMap, registry.ContainerManagerService)
 
+	//init images manager service
+	initService(ctx, d, registrationsMap, registry.ImagesManagerService)
+
 	//init Things container manager service
 	if daemonConfig.ThingsConfig.ThingsEnable {
 		initService(ctx, d, registrationsMap, registry.ThingsContainerManagerService)
@@ -87,6 +93,9 @@ func initService(ctx context.Context, d *daemon, registrationsMap map[registry.T
 		case registry.NetworkManagerService:
 			config = extractNetManagerConfigOptions(d.config)
 			break
+		case registry.VolumesManagerService:
+			config = extractVolumesManagerOptions(d.config)
+			break
 		case registry.ContainerManagerService:
 			config = extractContainerManagerOptions(d.config)
 			break
@@ -20,9 +20,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
 	"github.com/eclipse-kanto/container-management/containerm/things"
+	"github.com/eclipse-kanto/container-management/containerm/util"
 	"github.com/spf13/cobra"
 )
 
@@ -126,6 +128,12 @@ func TestExtractOpts(t *testing.T) {
 		}
 		testutil.AssertEqual(t, "10s", config.ManagerConfig.MgrDefaultCtrsStopTimeout)
 	})
+	t.Run("test_extract_volumes_mgr_opts", func(t *testing.T) {
+		opts := extractVolumesManagerOptions(cfg)
+		if len(opts) == 0 {
+			t.Error("no volumes mgr opts after extraction")
+		}
+	})
 	t.Run("test_extract_grpc_opts", func(t *testing.T) {
 		opts := extractGrpcOptions(cfg)
 		if len(opts) == 0 {
@@ -264,6 +272,10 @@ func TestSetCommandFlags(t *testing.T) {
 			flag:         "ccl-runc-runtime",
 			expectedType: reflect.String.String(),
 		},
+		"test_flags_ccl-default-runtime-handler": {
+			flag:         "ccl-default-runtime-handler",
+			expectedType: reflect.String.String(),
+		},
 		"test_flags_ccl-image-expiry": {
 			flag:         "ccl-image-expiry",
 			expectedType: "duration",
@@ -284,6 +296,30 @@ func TestSetCommandFlags(t *testing.T) {
 			flag:         "ccl-image-verifier-config",
 			expectedType: "stringSlice",
 		},
+		"test_flags_ccl-log-compress": {
+			flag:         "ccl-log-compress",
+			expectedType: reflect.String.String(),
+		},
+		"test_flags_ccl-log-max-age": {
+			flag:         "ccl-log-max-age",
+			expectedType: "duration",
+		},
+		"test_flags_ccl-log-disk-budget": {
+			flag:         "ccl-log-disk-budget",
+			expectedType: reflect.String.String(),
+		},
+		"test_flags_ccl-userns-remap-uids": {
+			flag:         "ccl-userns-remap-uids",
+			expectedType: reflect.String.String(),
+		},
+		"test_flags_ccl-userns-remap-gids": {
+			flag:         "ccl-userns-remap-gids",
+			expectedType: reflect.String.String(),
+		},
+		"test_flags_ccl-userns-remap-default": {
+			flag:         "ccl-userns-remap-default",
+			expectedType: reflect.Bool.String(),
+		},
 		"test_flags_net-type": {
 			flag:         "net-type",
 			expectedType: reflect.String.String(),
@@ -320,6 +356,14 @@ func TestSetCommandFlags(t *testing.T) {
 			flag:         "net-br-enable-ip6",
 			expectedType: reflect.Bool.String(),
 		},
+		"test_flags_net-br-fcidr6": {
+			flag:         "net-br-fcidr6",
+			expectedType: reflect.String.String(),
+		},
+		"test_flags_net-br-gwip6": {
+			flag:         "net-br-gwip6",
+			expectedType: reflect.String.String(),
+		},
 		"test_flags_net-br-mtu": {
 			flag:         "net-br-mtu",
 			expectedType: reflect.Int.String(),
@@ -627,6 +671,30 @@ func TestRunLock(t *testing.T) {
 	})
 }
 
+func TestParseRuntimeHandlers(t *testing.T) {
+	local := &config{}
+	_ = loadLocalConfig("../pkg/testutil/config/daemon-config-runtime-handlers.json", local)
+	testutil.AssertEqual(t, "crun", local.ContainerClientConfig.CtrDefaultRuntimeHandler)
+	testutil.AssertEqual(t, []*types.RuntimeHandler{
+		{
+			Name:        "crun",
+			RuntimeType: types.RuntimeTypeV2runcV2,
+			Options:     &types.RuntimeOptions{BinaryName: "/usr/bin/crun", Root: "/run/crun", SystemdCgroup: true},
+		},
+		{
+			Name:        "kata",
+			RuntimeType: types.RuntimeTypeV2kataV2,
+			BinaryPath:  "/opt/kata/bin/containerd-shim-kata-v2",
+			Options:     &types.RuntimeOptions{BinaryName: "kata-runtime", SystemdCgroup: util.IsRunningSystemd()},
+		},
+		{
+			Name:        "runsc",
+			RuntimeType: types.RuntimeTypeV2runscV1,
+		},
+	}, parseRuntimeHandlers(local.ContainerClientConfig.CtrRuntimeHandlers))
+	testutil.AssertNil(t, parseRuntimeHandlers(map[string]*runtimeHandlerConfig{"crun": nil}))
+}
+
 func TestImageVerifierConfig(t *testing.T) {
 	local := &config{}
 	_ = loadLocalConfig("../pkg/testutil/config/daemon-config-image-verifier.json", local)
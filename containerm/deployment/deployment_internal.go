@@ -123,6 +123,7 @@ func updateContainer(ctx context.Context, ctrMgr mgr.ContainerManager, current *
 	updateOpts := &types.UpdateOpts{
 		RestartPolicy: desired.HostConfig.RestartPolicy,
 		Resources:     desired.HostConfig.Resources,
+		NetworkPolicy: desired.HostConfig.NetworkPolicy,
 	}
 	if updateErr := ctrMgr.Update(ctx, current.ID, updateOpts); updateErr != nil {
 		log.WarnErr(updateErr, "could not update container with ID = %s, name = %s and image name = %s", current.ID, current.Name, current.Image.Name)
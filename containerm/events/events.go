@@ -34,9 +34,13 @@ func init() {
 	})
 }
 
+// maxEventsHistorySize is the number of the most recent events kept for replaying them to the subscribers for past events
+const maxEventsHistorySize = 256
+
 type eventsMgr struct {
 	broadcaster  *eventsSinkDispatcher
 	publishMutex sync.Mutex
+	history      []*types.Event
 }
 
 func (eMgr *eventsMgr) Publish(ctx context.Context, eventType types.EventType, eventAction types.EventAction, source *types.Container) error {
@@ -55,12 +59,36 @@ func (eMgr *eventsMgr) Publish(ctx context.Context, eventType types.EventType, e
 	err := eMgr.broadcaster.write(msg)
 	if err != nil {
 		log.ErrorErr(err, "could not publish event: %+v", msg)
+	} else {
+		if len(eMgr.history) == maxEventsHistorySize {
+			eMgr.history = eMgr.history[1:]
+		}
+		eMgr.history = append(eMgr.history, msg)
 	}
 	log.Debug("published event %+v", msg)
 	return err
 }
 
 func (eMgr *eventsMgr) Subscribe(ctx context.Context) (<-chan *types.Event, <-chan error) {
+	return eMgr.subscribe(ctx, nil)
+}
+
+func (eMgr *eventsMgr) SubscribeSince(ctx context.Context, since int64) (<-chan *types.Event, <-chan error) {
+	// the history is read and the subscriber is added while no events are published so that no event is missed or received twice
+	eMgr.publishMutex.Lock()
+	defer eMgr.publishMutex.Unlock()
+
+	var replayed []*types.Event
+	for _, event := range eMgr.history {
+		if event.Time >= since {
+			replayed = append(replayed, event)
+		}
+	}
+	return eMgr.subscribe(ctx, replayed)
+}
+
+// subscribe adds a new subscriber which receives the provided events before the published ones
+func (eMgr *eventsMgr) subscribe(ctx context.Context, replayed []*types.Event) (<-chan *types.Event, <-chan error) {
 	var (
 		eventsEmitter               = make(chan *types.Event)
 		errorsEmitter               = make(chan error, 1)
@@ -82,6 +110,15 @@ func (eMgr *eventsMgr) Subscribe(ctx context.Context) (<-chan *types.Event, <-ch
 		defer clearResources()
 
 		var err error
+	replayLoop:
+		for _, event := range replayed {
+			select {
+			case eventsEmitter <- event:
+				log.Debug("sent past event to subscriber %+v", event)
+			case <-ctx.Done():
+				break replayLoop
+			}
+		}
 	eventsLoop:
 		for {
 			select {
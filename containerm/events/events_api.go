@@ -24,4 +24,7 @@ type ContainerEventsManager interface {
 	Publish(ctx context.Context, eventType types.EventType, eventAction types.EventAction, source *types.Container) error
 	// Subscribe provides two channels where the according events and errors can be received via the subscriber context provided
 	Subscribe(ctx context.Context) (<-chan *types.Event, <-chan error)
+	// SubscribeSince subscribes like Subscribe but the events published at or after the provided Unix time are received first
+	// as long as they are still among the most recent ones which are kept in a bounded history
+	SubscribeSince(ctx context.Context, since int64) (<-chan *types.Event, <-chan error)
 }
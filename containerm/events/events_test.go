@@ -151,6 +151,32 @@ assertSubscribe:
 	}
 }
 
+func TestSubscribeSince(t *testing.T) {
+	evMgr := newEventsManager()
+	ctx := context.Background()
+
+	pastCtr := &types.Container{ID: "test-ctr-past", State: &types.State{Status: types.Stopped}}
+	liveCtr := &types.Container{ID: "test-ctr-live", State: &types.State{Status: types.Running}}
+	testutil.AssertNil(t, evMgr.Publish(ctx, types.EventTypeContainers, types.EventActionContainersStopped, pastCtr))
+
+	subscribeCtx, subscribeCtxCancelFunc := context.WithCancel(ctx)
+	t.Cleanup(subscribeCtxCancelFunc)
+	eventsChan, _ := evMgr.SubscribeSince(subscribeCtx, time.Now().Add(-time.Minute).Unix())
+
+	testutil.AssertEqual(t, pastCtr, &(<-eventsChan).Source)
+	testutil.AssertNil(t, evMgr.Publish(ctx, types.EventTypeContainers, types.EventActionContainersRunning, liveCtr))
+	testutil.AssertEqual(t, liveCtr, &(<-eventsChan).Source)
+}
+
+func TestPublishHistoryBounded(t *testing.T) {
+	evMgr := &eventsMgr{broadcaster: newEventSinksDispatcher()}
+	ctr := &types.Container{ID: "test-ctr", State: &types.State{Status: types.Running}}
+	for i := 0; i <= maxEventsHistorySize; i++ {
+		testutil.AssertNil(t, evMgr.Publish(context.Background(), types.EventTypeContainers, types.EventActionContainersRunning, ctr))
+	}
+	testutil.AssertEqual(t, maxEventsHistorySize, len(evMgr.history))
+}
+
 func TestSubscribeContextError(t *testing.T) {
 	evMgr := newEventsManager()
 	subscribeCtx, subscribeCtxCancelFunc := context.WithDeadline(context.Background(), time.Now().UTC())
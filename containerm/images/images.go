@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package images
+
+import (
+	"context"
+	"strings"
+
+	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/ctr"
+	"github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/mgr"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+type imagesMgr struct {
+	ctrClient ctr.ContainerAPIClient
+	ctrMgr    mgr.ContainerManager
+}
+
+func newImagesMgr(ctrClient ctr.ContainerAPIClient, ctrMgr mgr.ContainerManager) *imagesMgr {
+	return &imagesMgr{ctrClient: ctrClient, ctrMgr: ctrMgr}
+}
+
+func (imgMgr *imagesMgr) List(ctx context.Context) ([]*types.ImageInfo, error) {
+	imageInfos, err := imgMgr.ctrClient.ListImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := imgMgr.getImagesUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, imageInfo := range imageInfos {
+		imageInfo.Containers = usages[imageInfo.Name]
+	}
+	return imageInfos, nil
+}
+
+func (imgMgr *imagesMgr) Get(ctx context.Context, imageRef string) (*types.ImageInfo, error) {
+	imageInfo, err := imgMgr.ctrClient.GetImage(ctx, imageRef)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := imgMgr.getImagesUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imageInfo.Containers = usages[imageInfo.Name]
+	return imageInfo, nil
+}
+
+func (imgMgr *imagesMgr) Pull(ctx context.Context, image ctrtypes.Image) (*types.ImageInfo, error) {
+	if err := util.ValidateImage(image); err != nil {
+		return nil, err
+	}
+	imageInfo, err := imgMgr.ctrClient.PullImage(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := imgMgr.getImagesUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imageInfo.Containers = usages[imageInfo.Name]
+	return imageInfo, nil
+}
+
+func (imgMgr *imagesMgr) Remove(ctx context.Context, imageRef string) error {
+	usages, err := imgMgr.getImagesUsage(ctx)
+	if err != nil {
+		return err
+	}
+	if ctrIDs, used := usages[imageRef]; used {
+		return log.NewErrorf("image with ID = %s is in use by containers [%s]", imageRef, strings.Join(ctrIDs, ", "))
+	}
+	return imgMgr.ctrClient.RemoveImage(ctx, imageRef)
+}
+
+// getImagesUsage returns the IDs of the containers using each image mapped by the image reference
+func (imgMgr *imagesMgr) getImagesUsage(ctx context.Context) (map[string][]string, error) {
+	ctrs, err := imgMgr.ctrMgr.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usages := make(map[string][]string)
+	for _, container := range ctrs {
+		usages[container.Image.Name] = append(usages[container.Image.Name], container.ID)
+	}
+	return usages, nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package images
+
+import (
+	"context"
+
+	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/images/types"
+)
+
+// ImageManager provides management of the locally available container images
+type ImageManager interface {
+	// List returns information about all locally available images
+	List(ctx context.Context) ([]*types.ImageInfo, error)
+
+	// Get returns information about a locally available image
+	Get(ctx context.Context, imageRef string) (*types.ImageInfo, error)
+
+	// Pull downloads and unpacks an image if it is not locally available and returns information about it
+	Pull(ctx context.Context, image ctrtypes.Image) (*types.ImageInfo, error)
+
+	// Remove removes a locally available image if it is not used by any container
+	Remove(ctx context.Context, imageRef string) error
+}
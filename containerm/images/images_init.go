@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package images
+
+import (
+	"github.com/eclipse-kanto/container-management/containerm/ctr"
+	"github.com/eclipse-kanto/container-management/containerm/mgr"
+	"github.com/eclipse-kanto/container-management/containerm/registry"
+)
+
+const (
+	// ImagesManagerServiceLocalID is the ID of the local images manager service
+	ImagesManagerServiceLocalID = "container-management.service.local.v1.service-images-manager"
+)
+
+func init() {
+	registry.Register(&registry.Registration{
+		ID:       ImagesManagerServiceLocalID,
+		Type:     registry.ImagesManagerService,
+		InitFunc: registryInit,
+	})
+}
+
+func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+	ctrClientService, err := registryCtx.Get(registry.ContainerClientService)
+	if err != nil {
+		return nil, err
+	}
+	mgrService, err := registryCtx.Get(registry.ContainerManagerService)
+	if err != nil {
+		return nil, err
+	}
+	return newImagesMgr(ctrClientService.(ctr.ContainerAPIClient), mgrService.(mgr.ContainerManager)), nil
+}
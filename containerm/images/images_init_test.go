@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package images
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksctr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
+	mocksmgr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	"github.com/eclipse-kanto/container-management/containerm/registry"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRegistryInit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+	mockCtrMgr := mocksmgr.NewMockContainerManager(controller)
+
+	newServiceInfo := func(serviceType registry.Type, instance interface{}) *registry.ServiceInfo {
+		return (&registry.Registration{
+			ID:   string(serviceType),
+			Type: serviceType,
+			InitFunc: func(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+				return instance, nil
+			},
+		}).Init(&registry.ServiceRegistryContext{})
+	}
+
+	ctrClientOnlySet := registry.NewServiceInfoSet()
+	ctrClientOnlySet.Add(newServiceInfo(registry.ContainerClientService, mockCtrClient))
+
+	fullSet := registry.NewServiceInfoSet()
+	fullSet.Add(newServiceInfo(registry.ContainerClientService, mockCtrClient))
+	fullSet.Add(newServiceInfo(registry.ContainerManagerService, mockCtrMgr))
+
+	tests := map[string]struct {
+		services    *registry.Set
+		expected    interface{}
+		expectedErr error
+	}{
+		"test_registry_init": {
+			services: fullSet,
+			expected: newImagesMgr(mockCtrClient, mockCtrMgr),
+		},
+		"test_registry_init_no_ctr_client": {
+			services:    registry.NewServiceInfoSet(),
+			expectedErr: fmt.Errorf("no services registered for %s", registry.ContainerClientService),
+		},
+		"test_registry_init_no_ctr_mgr": {
+			services:    ctrClientOnlySet,
+			expectedErr: fmt.Errorf("no services registered for %s", registry.ContainerManagerService),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			imagesMgr, err := registryInit(registry.NewContext(context.Background(), nil, nil, testCase.services))
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if testCase.expectedErr == nil {
+				testutil.AssertEqual(t, testCase.expected, imagesMgr)
+			}
+		})
+	}
+}
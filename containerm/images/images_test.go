@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package images
+
+import (
+	"context"
+	"testing"
+
+	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksctr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
+	mocksmgr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	testImageName  = "host/group/image:tag"
+	testImageName2 = "host/group/image2:tag"
+)
+
+var (
+	testCtx  = context.Background()
+	testCtrs = []*ctrtypes.Container{
+		{ID: "test-ctr-1", Image: ctrtypes.Image{Name: testImageName}},
+		{ID: "test-ctr-2", Image: ctrtypes.Image{Name: testImageName}},
+		{ID: "test-ctr-3", Image: ctrtypes.Image{Name: "host/group/other:tag"}},
+	}
+)
+
+func TestList(t *testing.T) {
+	tests := map[string]struct {
+		mockExec func(*mocksctr.MockContainerAPIClient, *mocksmgr.MockContainerManager) ([]*types.ImageInfo, error)
+	}{
+		"test_list": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) ([]*types.ImageInfo, error) {
+				ctrClient.EXPECT().ListImages(testCtx).Return([]*types.ImageInfo{{Name: testImageName}, {Name: testImageName2}}, nil)
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				return []*types.ImageInfo{{Name: testImageName, Containers: []string{"test-ctr-1", "test-ctr-2"}}, {Name: testImageName2}}, nil
+			},
+		},
+		"test_list_images_error": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) ([]*types.ImageInfo, error) {
+				err := log.NewError("test error")
+				ctrClient.EXPECT().ListImages(testCtx).Return(nil, err)
+				return nil, err
+			},
+		},
+		"test_list_containers_error": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) ([]*types.ImageInfo, error) {
+				err := log.NewError("test error")
+				ctrClient.EXPECT().ListImages(testCtx).Return([]*types.ImageInfo{{Name: testImageName}}, nil)
+				ctrMgr.EXPECT().List(testCtx).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+			mockCtrMgr := mocksmgr.NewMockContainerManager(controller)
+			expectedImages, expectedErr := testCase.mockExec(mockCtrClient, mockCtrMgr)
+
+			images, err := newImagesMgr(mockCtrClient, mockCtrMgr).List(testCtx)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expectedImages, images)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	tests := map[string]struct {
+		mockExec func(*mocksctr.MockContainerAPIClient, *mocksmgr.MockContainerManager) (*types.ImageInfo, error)
+	}{
+		"test_get": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				ctrClient.EXPECT().GetImage(testCtx, testImageName).Return(&types.ImageInfo{Name: testImageName}, nil)
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				return &types.ImageInfo{Name: testImageName, Containers: []string{"test-ctr-1", "test-ctr-2"}}, nil
+			},
+		},
+		"test_get_image_error": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				err := log.NewErrorf("image with ID = %s does not exist", testImageName)
+				ctrClient.EXPECT().GetImage(testCtx, testImageName).Return(nil, err)
+				return nil, err
+			},
+		},
+		"test_get_containers_error": {
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				err := log.NewError("test error")
+				ctrClient.EXPECT().GetImage(testCtx, testImageName).Return(&types.ImageInfo{Name: testImageName}, nil)
+				ctrMgr.EXPECT().List(testCtx).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+			mockCtrMgr := mocksmgr.NewMockContainerManager(controller)
+			expectedImage, expectedErr := testCase.mockExec(mockCtrClient, mockCtrMgr)
+
+			image, err := newImagesMgr(mockCtrClient, mockCtrMgr).Get(testCtx, testImageName)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expectedImage, image)
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	tests := map[string]struct {
+		image    ctrtypes.Image
+		mockExec func(*mocksctr.MockContainerAPIClient, *mocksmgr.MockContainerManager) (*types.ImageInfo, error)
+	}{
+		"test_pull": {
+			image: ctrtypes.Image{Name: testImageName2},
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				ctrClient.EXPECT().PullImage(testCtx, ctrtypes.Image{Name: testImageName2}).Return(&types.ImageInfo{Name: testImageName2}, nil)
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				return &types.ImageInfo{Name: testImageName2}, nil
+			},
+		},
+		"test_pull_invalid_image": {
+			image: ctrtypes.Image{},
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				ctrClient.EXPECT().PullImage(gomock.Any(), gomock.Any()).Times(0)
+				return nil, log.NewError("image is not provided")
+			},
+		},
+		"test_pull_error": {
+			image: ctrtypes.Image{Name: testImageName2},
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) (*types.ImageInfo, error) {
+				err := log.NewError("test error")
+				ctrClient.EXPECT().PullImage(testCtx, ctrtypes.Image{Name: testImageName2}).Return(nil, err)
+				return nil, err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+			mockCtrMgr := mocksmgr.NewMockContainerManager(controller)
+			expectedImage, expectedErr := testCase.mockExec(mockCtrClient, mockCtrMgr)
+
+			image, err := newImagesMgr(mockCtrClient, mockCtrMgr).Pull(testCtx, testCase.image)
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expectedImage, image)
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	tests := map[string]struct {
+		imageRef string
+		mockExec func(*mocksctr.MockContainerAPIClient, *mocksmgr.MockContainerManager) error
+	}{
+		"test_remove": {
+			imageRef: testImageName2,
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) error {
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				ctrClient.EXPECT().RemoveImage(testCtx, testImageName2).Return(nil)
+				return nil
+			},
+		},
+		"test_remove_image_in_use": {
+			imageRef: testImageName,
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) error {
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				ctrClient.EXPECT().RemoveImage(gomock.Any(), gomock.Any()).Times(0)
+				return log.NewErrorf("image with ID = %s is in use by containers [test-ctr-1, test-ctr-2]", testImageName)
+			},
+		},
+		"test_remove_containers_error": {
+			imageRef: testImageName2,
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) error {
+				err := log.NewError("test error")
+				ctrMgr.EXPECT().List(testCtx).Return(nil, err)
+				return err
+			},
+		},
+		"test_remove_error": {
+			imageRef: testImageName2,
+			mockExec: func(ctrClient *mocksctr.MockContainerAPIClient, ctrMgr *mocksmgr.MockContainerManager) error {
+				err := log.NewError("test error")
+				ctrMgr.EXPECT().List(testCtx).Return(testCtrs, nil)
+				ctrClient.EXPECT().RemoveImage(testCtx, testImageName2).Return(err)
+				return err
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+			mockCtrMgr := mocksmgr.NewMockContainerManager(controller)
+			expectedErr := testCase.mockExec(mockCtrClient, mockCtrMgr)
+
+			testutil.AssertError(t, expectedErr, newImagesMgr(mockCtrClient, mockCtrMgr).Remove(testCtx, testCase.imageRef))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+// ImageInfo contains the main information about a locally available container image
+type ImageInfo struct {
+	// Name is the fully qualified reference of the image
+	Name string `json:"name"`
+	// Digest is the digest of the image's target content - e.g. manifest or index
+	Digest string `json:"digest"`
+	// Size is the total size of the image's packed resources in bytes
+	Size int64 `json:"size"`
+	// Platform is the platform the image is built for in the format os/architecture[/variant]
+	Platform string `json:"platform,omitempty"`
+	// Created is the time when the image has been stored locally
+	Created string `json:"created"`
+	// Expiry is the time after which the image will be removed if not used by any container. Empty if the images expiry management is disabled
+	Expiry string `json:"expiry,omitempty"`
+	// Containers are the IDs of the containers that are using the image
+	Containers []string `json:"containers,omitempty"`
+}
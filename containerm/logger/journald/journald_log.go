@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package journald
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+// constants for journald log driver
+const (
+	JournaldLogDriverName logger.LogDriverType = "journald"
+
+	shortIDLength  = 12
+	priorityError  = 3
+	priorityInfo   = 6
+	tempFilePrefix = "kanto-journal-"
+
+	sharedMemoryDir = "/dev/shm"
+)
+
+// journalSocket is the socket of the journal native protocol
+var journalSocket = "/run/systemd/journal/socket"
+
+// journaldLogDriver is used to write the container's stdout and stderr to the systemd journal via its native protocol.
+type journaldLogDriver struct {
+	journalMux sync.Mutex
+
+	conn     *net.UnixConn
+	fields   []journalField
+	isClosed bool
+}
+
+type journalField struct {
+	name  string
+	value string
+}
+
+// NewJournaldLog creates a new LogDriver instance that writes the logs to the systemd journal.
+func NewJournaldLog(info logger.LogDriverInfo, configOpts ...logger.LogConfigOption) (logger.LogDriver, error) {
+	logCfg := &journaldLogOpts{}
+	if err := applyJournaldLoggerOpts(logCfg, configOpts...); err != nil {
+		log.ErrorErr(err, "invalid config provided for log driver %s", JournaldLogDriverName)
+		return nil, err
+	}
+	if logCfg.tag == "" {
+		logCfg.tag = info.ContainerName
+	}
+	if logCfg.tag == "" {
+		logCfg.tag = shortID(info.ContainerID)
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journalSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, log.NewErrorf("journald is not available: %v", err)
+	}
+	return &journaldLogDriver{
+		conn: conn,
+		fields: []journalField{
+			{name: "CONTAINER_ID", value: shortID(info.ContainerID)},
+			{name: "CONTAINER_ID_FULL", value: info.ContainerID},
+			{name: "CONTAINER_NAME", value: info.ContainerName},
+			{name: "CONTAINER_TAG", value: logCfg.tag},
+			{name: "IMAGE_NAME", value: info.ContainerImageID},
+			{name: "SYSLOG_IDENTIFIER", value: logCfg.tag},
+		},
+	}, nil
+}
+
+func (journalDriver *journaldLogDriver) Type() logger.LogDriverType {
+	return JournaldLogDriverName
+}
+
+func (journalDriver *journaldLogDriver) WriteLogMessage(msg *logger.LogMessage) error {
+	data := journalDriver.serialize(msg)
+
+	journalDriver.journalMux.Lock()
+	defer journalDriver.journalMux.Unlock()
+
+	if journalDriver.isClosed {
+		return log.NewError("the journald log driver is closed")
+	}
+	_, err := journalDriver.conn.Write(data)
+	if err == nil || !isMessageTooLarge(err) {
+		return err
+	}
+	return journalDriver.writeViaFile(data)
+}
+
+func (journalDriver *journaldLogDriver) Close() error {
+	journalDriver.journalMux.Lock()
+	defer journalDriver.journalMux.Unlock()
+
+	if journalDriver.isClosed {
+		return nil
+	}
+	journalDriver.isClosed = true
+	return journalDriver.conn.Close()
+}
+
+// serialize encodes the log message and the container fields in the journal native protocol format.
+func (journalDriver *journaldLogDriver) serialize(msg *logger.LogMessage) []byte {
+	buffer := &bytes.Buffer{}
+	line := bytes.TrimSuffix(msg.Line, []byte{'\n'})
+	writeField(buffer, "MESSAGE", string(line))
+	priority := priorityInfo
+	if msg.Source == "stderr" {
+		priority = priorityError
+	}
+	writeField(buffer, "PRIORITY", strconv.Itoa(priority))
+	if len(line) == len(msg.Line) {
+		writeField(buffer, "CONTAINER_PARTIAL_MESSAGE", "true")
+	}
+	for _, field := range journalDriver.fields {
+		if field.value != "" {
+			writeField(buffer, field.name, field.value)
+		}
+	}
+	return buffer.Bytes()
+}
+
+// writeViaFile passes the messages that do not fit in a datagram to the journal as a descriptor of an unlinked temporary file.
+func (journalDriver *journaldLogDriver) writeViaFile(data []byte) error {
+	file, err := os.CreateTemp(sharedMemoryDir, tempFilePrefix)
+	if err != nil {
+		if file, err = os.CreateTemp("", tempFilePrefix); err != nil {
+			return err
+		}
+	}
+	defer file.Close()
+	if err = os.Remove(file.Name()); err != nil {
+		return err
+	}
+	if _, err = file.Write(data); err != nil {
+		return err
+	}
+	_, _, err = journalDriver.conn.WriteMsgUnix(nil, syscall.UnixRights(int(file.Fd())), nil)
+	return err
+}
+
+// writeField writes a single field using the binary safe form for the values with new lines.
+func writeField(buffer *bytes.Buffer, name, value string) {
+	buffer.WriteString(name)
+	if !strings.ContainsRune(value, '\n') {
+		buffer.WriteByte('=')
+		buffer.WriteString(value)
+		buffer.WriteByte('\n')
+		return
+	}
+	buffer.WriteByte('\n')
+	_ = binary.Write(buffer, binary.LittleEndian, uint64(len(value)))
+	buffer.WriteString(value)
+	buffer.WriteByte('\n')
+}
+
+func isMessageTooLarge(err error) bool {
+	return errors.Is(err, syscall.EMSGSIZE) || errors.Is(err, syscall.ENOBUFS)
+}
+
+func shortID(id string) string {
+	if len(id) > shortIDLength {
+		return id[:shortIDLength]
+	}
+	return id
+}
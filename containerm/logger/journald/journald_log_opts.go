@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package journald
+
+import (
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+type journaldLogOpts struct {
+	tag string
+}
+
+func applyJournaldLoggerOpts(journaldOpts *journaldLogOpts, opts ...logger.LogConfigOption) error {
+	for _, o := range opts {
+		if err := o(journaldOpts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithTag sets the tag that is used as a syslog identifier of the journal entries.
+func WithTag(tag string) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		if tag == "" {
+			return log.NewError("tag logger config cannot be empty")
+		}
+		specificConfigOpts.(*journaldLogOpts).tag = tag
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package journald
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func TestJournaldLog(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "journal.sock")
+	listener, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	testutil.AssertNil(t, err)
+	defer listener.Close()
+
+	defaultSocket := journalSocket
+	journalSocket = socket
+	defer func() {
+		journalSocket = defaultSocket
+	}()
+
+	info := logger.LogDriverInfo{ContainerID: "0123456789abcdef", ContainerName: "test-name", ContainerImageID: "test-image"}
+	driver, err := NewJournaldLog(info, WithTag("app"))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+	testutil.AssertEqual(t, JournaldLogDriverName, driver.Type())
+
+	testCases := map[string]struct {
+		msg      *logger.LogMessage
+		expected string
+	}{
+		"test_stdout": {
+			msg: &logger.LogMessage{Source: "stdout", Line: []byte("test output\n")},
+			expected: "MESSAGE=test output\nPRIORITY=6\nCONTAINER_ID=0123456789ab\nCONTAINER_ID_FULL=0123456789abcdef\n" +
+				"CONTAINER_NAME=test-name\nCONTAINER_TAG=app\nIMAGE_NAME=test-image\nSYSLOG_IDENTIFIER=app\n",
+		},
+		"test_stderr_partial_multiline": {
+			msg: &logger.LogMessage{Source: "stderr", Line: []byte("test\nerror")},
+			expected: "MESSAGE\n" + string(binary.LittleEndian.AppendUint64(nil, 10)) + "test\nerror\nPRIORITY=3\nCONTAINER_PARTIAL_MESSAGE=true\n" +
+				"CONTAINER_ID=0123456789ab\nCONTAINER_ID_FULL=0123456789abcdef\nCONTAINER_NAME=test-name\nCONTAINER_TAG=app\nIMAGE_NAME=test-image\nSYSLOG_IDENTIFIER=app\n",
+		},
+	}
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertNil(t, driver.WriteLogMessage(testCase.msg))
+			buffer := make([]byte, 4096)
+			listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+			n, err := listener.Read(buffer)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expected, string(buffer[:n]))
+		})
+	}
+
+	testutil.AssertNil(t, driver.Close())
+	testutil.AssertError(t, errors.New("the journald log driver is closed"), driver.WriteLogMessage(&logger.LogMessage{Line: []byte("test")}))
+}
+
+func TestJournaldLogNotAvailable(t *testing.T) {
+	defaultSocket := journalSocket
+	journalSocket = filepath.Join(t.TempDir(), "missing.sock")
+	defer func() {
+		journalSocket = defaultSocket
+	}()
+
+	_, err := NewJournaldLog(logger.LogDriverInfo{ContainerID: "test-id"})
+	testutil.AssertNotNil(t, err)
+}
+
+func TestJournaldLogInvalidTag(t *testing.T) {
+	_, err := NewJournaldLog(logger.LogDriverInfo{ContainerID: "test-id"}, WithTag(""))
+	testutil.AssertError(t, errors.New("tag logger config cannot be empty"), err)
+}
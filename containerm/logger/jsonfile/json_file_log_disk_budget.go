@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package jsonfile
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+)
+
+// DiskBudget limits the disk space used by the json-file logs of all containers.
+// When the limit is exceeded, the oldest rotated log files are removed first. The current log files are never removed.
+type DiskBudget struct {
+	mutex    sync.Mutex
+	limit    int64
+	logFiles map[string]struct{}
+}
+
+type rotatedLogFile struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// NewDiskBudget creates a disk budget limiting the logs of all containers to the provided size in bytes
+func NewDiskBudget(limit int64) *DiskBudget {
+	return &DiskBudget{limit: limit, logFiles: map[string]struct{}{}}
+}
+
+// Track adds the provided current log file and its rotated log files to the disk budget
+func (budget *DiskBudget) Track(logFileName string) {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	budget.logFiles[logFileName] = struct{}{}
+}
+
+// Enforce removes the oldest rotated log files of all tracked containers until their logs fit the disk budget
+func (budget *DiskBudget) Enforce() error {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+
+	var (
+		total        int64
+		rotatedFiles []rotatedLogFile
+	)
+	for logFileName := range budget.logFiles {
+		if _, err := os.Stat(filepath.Dir(logFileName)); os.IsNotExist(err) {
+			// the container has been removed together with its logs
+			delete(budget.logFiles, logFileName)
+			continue
+		}
+		if stat, err := os.Stat(logFileName); err == nil {
+			total += stat.Size()
+		}
+		names, err := RotatedLogFiles(logFileName)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			stat, err := os.Stat(name)
+			if err != nil {
+				continue
+			}
+			total += stat.Size()
+			rotatedFiles = append(rotatedFiles, rotatedLogFile{name: name, size: stat.Size(), modTime: stat.ModTime()})
+		}
+	}
+	if total <= budget.limit {
+		return nil
+	}
+
+	sort.Slice(rotatedFiles, func(i, j int) bool {
+		return rotatedFiles[i].modTime.Before(rotatedFiles[j].modTime)
+	})
+	for _, rotatedFile := range rotatedFiles {
+		if total <= budget.limit {
+			return nil
+		}
+		if err := os.Remove(rotatedFile.name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		log.Debug("removed rotated log file %s to fit the logs disk budget", rotatedFile.name)
+		total -= rotatedFile.size
+	}
+	if total > budget.limit {
+		log.Warn("the current log files exceed the logs disk budget of %d bytes", budget.limit)
+	}
+	return nil
+}
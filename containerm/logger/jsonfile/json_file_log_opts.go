@@ -13,13 +13,18 @@
 package jsonfile
 
 import (
+	"time"
+
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/logger"
 )
 
 type jsonLogFileOpts struct {
-	maxFiles int
-	maxSize  int64
+	maxFiles    int
+	maxSize     int64
+	compression Compression
+	maxAge      time.Duration
+	diskBudget  *DiskBudget
 }
 
 func applyJSONLoggerOpts(jsonLogOpts *jsonLogFileOpts, opts ...logger.LogConfigOption) error {
@@ -49,3 +54,35 @@ func WithMaxSize(maxSize int64) logger.LogConfigOption {
 		return nil
 	}
 }
+
+// WithCompression sets the compression of the rotated log files.
+func WithCompression(compression Compression) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		switch compression {
+		case CompressionNone, CompressionGzip, CompressionZstd:
+		default:
+			return log.NewErrorf("unsupported compression %s of the rotated log files", compression)
+		}
+		specificConfigOpts.(*jsonLogFileOpts).compression = compression
+		return nil
+	}
+}
+
+// WithMaxAge sets the max age of the rotated log files - the older ones are removed.
+func WithMaxAge(maxAge time.Duration) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		if maxAge < 0 {
+			return log.NewError("maxAge logger config cannot be negative")
+		}
+		specificConfigOpts.(*jsonLogFileOpts).maxAge = maxAge
+		return nil
+	}
+}
+
+// WithDiskBudget sets the disk budget shared by the log files of all containers.
+func WithDiskBudget(diskBudget *DiskBudget) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		specificConfigOpts.(*jsonLogFileOpts).diskBudget = diskBudget
+		return nil
+	}
+}
@@ -13,24 +13,240 @@
 package jsonfile
 
 import (
+	"compress/gzip"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression represents the compression of the rotated log files
+type Compression string
+
+// supported compressions of the rotated log files
+const (
+	CompressionNone Compression = "none"
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+
+	gzipFileExt = ".gz"
+	zstdFileExt = ".zst"
+	tmpFileExt  = ".tmp"
 )
 
-func rotate(logFileName string, maxFiles int) error {
+var compressedFileExts = []string{gzipFileExt, zstdFileExt}
+
+func (compression Compression) fileExt() string {
+	switch compression {
+	case CompressionGzip:
+		return gzipFileExt
+	case CompressionZstd:
+		return zstdFileExt
+	}
+	return ""
+}
+
+func rotate(logFileName string, maxFiles int, compression Compression) error {
 	if maxFiles < 2 {
 		return nil
 	}
+	// the rotated log files can be compressed differently, so all variants of an index are shifted
+	if err := removeRotatedLogFile(logFileName, maxFiles-1); err != nil {
+		return err
+	}
 	for i := maxFiles - 1; i > 1; i-- {
-		newFileName := logFileName + "." + strconv.Itoa(i)
-		oldFileName := logFileName + "." + strconv.Itoa(i-1)
-		if err := os.Rename(oldFileName, newFileName); err != nil && !os.IsNotExist(err) {
+		for _, ext := range append([]string{""}, compressedFileExts...) {
+			newFileName := logFileName + "." + strconv.Itoa(i) + ext
+			oldFileName := logFileName + "." + strconv.Itoa(i-1) + ext
+			if err := os.Rename(oldFileName, newFileName); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	if err := os.Rename(logFileName, logFileName+".1"); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return compressLogFile(logFileName+".1", compression)
+}
+
+func removeRotatedLogFile(logFileName string, index int) error {
+	for _, ext := range append([]string{""}, compressedFileExts...) {
+		if err := os.Remove(logFileName + "." + strconv.Itoa(index) + ext); err != nil && !os.IsNotExist(err) {
 			return err
 		}
 	}
+	return nil
+}
+
+// compressLogFile replaces the rotated log file with its compressed version.
+// The compressed log file is written under a temporary name first, so that it is never read partially.
+func compressLogFile(fileName string, compression Compression) error {
+	ext := compression.fileExt()
+	if ext == "" {
+		return nil
+	}
+	src, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	stat, err := src.Stat()
+	if err != nil {
+		return err
+	}
 
-	if err := os.Rename(logFileName, logFileName+".1"); err != nil && !os.IsNotExist(err) {
+	tmpFileName := fileName + ext + tmpFileExt
+	dst, err := os.OpenFile(tmpFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, jsonLogFilePerms)
+	if err != nil {
+		return err
+	}
+	if err = compress(dst, src, compression); err != nil {
+		dst.Close()
+		os.Remove(tmpFileName)
+		return err
+	}
+	if err = dst.Close(); err != nil {
+		os.Remove(tmpFileName)
+		return err
+	}
+	// keep the modification time of the rotated log file for the age based retention
+	if err = os.Chtimes(tmpFileName, stat.ModTime(), stat.ModTime()); err != nil {
+		os.Remove(tmpFileName)
+		return err
+	}
+	if err = os.Rename(tmpFileName, fileName+ext); err != nil {
+		os.Remove(tmpFileName)
 		return err
 	}
+	return os.Remove(fileName)
+}
+
+func compress(w io.Writer, r io.Reader, compression Compression) error {
+	var cw io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		cw = gzip.NewWriter(w)
+	case CompressionZstd:
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		cw = zw
+	}
+	if _, err := io.Copy(cw, r); err != nil {
+		cw.Close()
+		return err
+	}
+	return cw.Close()
+}
+
+// removeExpiredLogFiles removes the rotated log files that have not been modified within the max age
+func removeExpiredLogFiles(logFileName string, maxAge time.Duration) error {
+	if maxAge <= 0 {
+		return nil
+	}
+	rotatedFiles, err := RotatedLogFiles(logFileName)
+	if err != nil {
+		return err
+	}
+	expiry := time.Now().Add(-maxAge)
+	for _, rotatedFile := range rotatedFiles {
+		stat, err := os.Stat(rotatedFile)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if stat.ModTime().Before(expiry) {
+			if err := os.Remove(rotatedFile); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
 	return nil
 }
+
+// RotatedLogFiles returns the rotated log files of the provided log file sorted from the oldest to the most recent one.
+// If a rotated log file is present both uncompressed and compressed, i.e. while it is being compressed, the compressed one is returned.
+func RotatedLogFiles(logFileName string) ([]string, error) {
+	matches, err := filepath.Glob(logFileName + ".*")
+	if err != nil {
+		return nil, err
+	}
+	rotated := map[int]string{}
+	var indexes []int
+	for _, match := range matches {
+		suffix := strings.TrimPrefix(match, logFileName+".")
+		compressed := false
+		for _, ext := range compressedFileExts {
+			if strings.HasSuffix(suffix, ext) {
+				suffix = strings.TrimSuffix(suffix, ext)
+				compressed = true
+				break
+			}
+		}
+		index, err := strconv.Atoi(suffix)
+		if err != nil || index < 1 {
+			continue
+		}
+		if _, ok := rotated[index]; !ok {
+			indexes = append(indexes, index)
+		} else if !compressed {
+			continue
+		}
+		rotated[index] = match
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(indexes)))
+	rotatedFiles := make([]string, len(indexes))
+	for i, index := range indexes {
+		rotatedFiles[i] = rotated[index]
+	}
+	return rotatedFiles, nil
+}
+
+// OpenLogFile opens the provided current or rotated log file for reading.
+// The compressed rotated log files are decompressed transparently.
+func OpenLogFile(fileName string) (io.ReadCloser, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(fileName) {
+	case gzipFileExt:
+		gr, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		return &decompressedLogFile{Reader: gr, file: file, closeReader: func() { gr.Close() }}, nil
+	case zstdFileExt:
+		zr, err := zstd.NewReader(file, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		return &decompressedLogFile{Reader: zr, file: file, closeReader: zr.Close}, nil
+	}
+	return file, nil
+}
+
+type decompressedLogFile struct {
+	io.Reader
+	file        *os.File
+	closeReader func()
+}
+
+func (logFile *decompressedLogFile) Close() error {
+	logFile.closeReader()
+	return logFile.file.Close()
+}
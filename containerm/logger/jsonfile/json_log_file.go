@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/logger"
@@ -44,6 +45,10 @@ type jsonFileLogDriver struct {
 	maxSize            int64
 	currentSize        int64
 	maxFile            int
+	compression        Compression
+	maxAge             time.Duration
+	lastExpiryCheck    time.Time
+	diskBudget         *DiskBudget
 }
 
 // NewJSONFileLog creates a new LogDriver instance that produces JSON-formatted logs.
@@ -72,7 +77,7 @@ func NewJSONFileLog(info logger.LogDriverInfo, configOpts ...logger.LogConfigOpt
 		}
 		currentSize = size
 	}
-	return &jsonFileLogDriver{
+	jsLogDriver := &jsonFileLogDriver{
 		jsLogFile:   f,
 		permissions: jsonLogFilePerms,
 		isClosed:    false,
@@ -82,7 +87,16 @@ func NewJSONFileLog(info logger.LogDriverInfo, configOpts ...logger.LogConfigOpt
 		maxSize:     logCfg.maxSize,
 		currentSize: currentSize,
 		maxFile:     logCfg.maxFiles,
-	}, nil
+		compression: logCfg.compression,
+		maxAge:      logCfg.maxAge,
+		diskBudget:  logCfg.diskBudget,
+	}
+	jsLogDriver.checkExpiry()
+	if jsLogDriver.diskBudget != nil {
+		jsLogDriver.diskBudget.Track(logPath)
+		jsLogDriver.enforceDiskBudget()
+	}
+	return jsLogDriver, nil
 }
 
 func (jsLogDriver *jsonFileLogDriver) Type() logger.LogDriverType {
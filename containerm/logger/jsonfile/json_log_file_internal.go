@@ -14,9 +14,16 @@ package jsonfile
 
 import (
 	"os"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
 )
 
+// expiryCheckInterval is the min interval between two checks for expired rotated log files
+const expiryCheckInterval = time.Minute
+
 func (jsLogDriver *jsonFileLogDriver) checkRotate() error {
+	jsLogDriver.checkExpiry()
 	if jsLogDriver.maxSize == 0 || jsLogDriver.currentSize < jsLogDriver.maxSize {
 		// do not rotate
 		return nil
@@ -27,7 +34,7 @@ func (jsLogDriver *jsonFileLogDriver) checkRotate() error {
 		return err
 	}
 	// TODO: after rotating logs, a notice should be made to the log reader
-	if err := rotate(logName, jsLogDriver.maxFile); err != nil {
+	if err := rotate(logName, jsLogDriver.maxFile, jsLogDriver.compression); err != nil {
 		return err
 	}
 	newFile, err := os.OpenFile(logName, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
@@ -36,10 +43,29 @@ func (jsLogDriver *jsonFileLogDriver) checkRotate() error {
 	}
 	jsLogDriver.jsLogFile = newFile
 	jsLogDriver.currentSize = 0
-
+	if jsLogDriver.diskBudget != nil {
+		jsLogDriver.enforceDiskBudget()
+	}
 	return nil
 }
 
+// checkExpiry removes the expired rotated log files, if a max age is configured and the last check is not too recent
+func (jsLogDriver *jsonFileLogDriver) checkExpiry() {
+	if jsLogDriver.maxAge == 0 || time.Since(jsLogDriver.lastExpiryCheck) < expiryCheckInterval {
+		return
+	}
+	jsLogDriver.lastExpiryCheck = time.Now()
+	if err := removeExpiredLogFiles(jsLogDriver.jsLogFile.Name(), jsLogDriver.maxAge); err != nil {
+		log.WarnErr(err, "could not remove the expired rotated log files of %s", jsLogDriver.jsLogFile.Name())
+	}
+}
+
+func (jsLogDriver *jsonFileLogDriver) enforceDiskBudget() {
+	if err := jsLogDriver.diskBudget.Enforce(); err != nil {
+		log.WarnErr(err, "could not enforce the logs disk budget")
+	}
+}
+
 // // readMessages reads the log messages and returns LogReaderForwarder
 // func (jsLogDriver *jsonFileLogDriver) readMessages(cfg *logger.ReadConfig) *logger.LogReaderForwarder {
 // 	watcher := logger.NewLogReaderForwarder()
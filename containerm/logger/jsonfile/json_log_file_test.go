@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package jsonfile
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func writeTestFile(t *testing.T, name string, size int, modTime time.Time) {
+	testutil.AssertNil(t, os.WriteFile(name, []byte(strings.Repeat("x", size)), 0644))
+	testutil.AssertNil(t, os.Chtimes(name, modTime, modTime))
+}
+
+func readTestLogFile(t *testing.T, name string) string {
+	f, err := OpenLogFile(name)
+	testutil.AssertNil(t, err)
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	testutil.AssertNil(t, err)
+	return string(data)
+}
+
+func TestJSONFileLogRotateCompressed(t *testing.T) {
+	for compression, ext := range map[Compression]string{CompressionGzip: gzipFileExt, CompressionZstd: zstdFileExt} {
+		t.Run(string(compression), func(t *testing.T) {
+			dir := t.TempDir()
+			driver, err := NewJSONFileLog(logger.LogDriverInfo{ContainerID: "test-id", ContainerRootDir: dir},
+				WithMaxFiles(3), WithMaxSize(10), WithCompression(compression))
+			testutil.AssertNil(t, err)
+			defer driver.Close()
+
+			for _, line := range []string{"first line\n", "second line\n", "third line\n", "fourth line\n"} {
+				testutil.AssertNil(t, driver.WriteLogMessage(&logger.LogMessage{Source: "stdout", Line: []byte(line), Timestamp: time.Now()}))
+			}
+
+			logFile := filepath.Join(dir, JSONLogFileName)
+			rotatedFiles, err := RotatedLogFiles(logFile)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, []string{logFile + ".2" + ext, logFile + ".1" + ext}, rotatedFiles)
+			testutil.AssertTrue(t, strings.Contains(readTestLogFile(t, rotatedFiles[0]), "second line"))
+			testutil.AssertTrue(t, strings.Contains(readTestLogFile(t, rotatedFiles[1]), "third line"))
+			testutil.AssertTrue(t, strings.Contains(readTestLogFile(t, logFile), "fourth line"))
+
+			_, err = os.Stat(logFile + ".1")
+			testutil.AssertTrue(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestRotatedLogFiles(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), JSONLogFileName)
+	for _, suffix := range []string{"", ".1", ".1.gz", ".1.gz.tmp", ".2.zst", ".10", ".x", ".0"} {
+		writeTestFile(t, logFile+suffix, 1, time.Now())
+	}
+
+	rotatedFiles, err := RotatedLogFiles(logFile)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []string{logFile + ".10", logFile + ".2.zst", logFile + ".1.gz"}, rotatedFiles)
+}
+
+func TestJSONFileLogMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, JSONLogFileName)
+	writeTestFile(t, logFile+".1", 1, time.Now())
+	writeTestFile(t, logFile+".2.gz", 1, time.Now().Add(-2*time.Hour))
+
+	driver, err := NewJSONFileLog(logger.LogDriverInfo{ContainerID: "test-id", ContainerRootDir: dir}, WithMaxAge(time.Hour))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+
+	rotatedFiles, err := RotatedLogFiles(logFile)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []string{logFile + ".1"}, rotatedFiles)
+}
+
+func TestDiskBudget(t *testing.T) {
+	now := time.Now()
+	firstLogFile := filepath.Join(t.TempDir(), JSONLogFileName)
+	writeTestFile(t, firstLogFile, 10, now)
+	writeTestFile(t, firstLogFile+".1.gz", 10, now.Add(-time.Hour))
+	writeTestFile(t, firstLogFile+".2.gz", 10, now.Add(-3*time.Hour))
+	secondLogFile := filepath.Join(t.TempDir(), JSONLogFileName)
+	writeTestFile(t, secondLogFile, 10, now)
+	writeTestFile(t, secondLogFile+".1", 10, now.Add(-2*time.Hour))
+	removedLogFile := filepath.Join(t.TempDir(), "removed", JSONLogFileName)
+
+	budget := NewDiskBudget(35)
+	budget.Track(firstLogFile)
+	budget.Track(secondLogFile)
+	budget.Track(removedLogFile)
+	testutil.AssertNil(t, budget.Enforce())
+
+	firstRotatedFiles, err := RotatedLogFiles(firstLogFile)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []string{firstLogFile + ".1.gz"}, firstRotatedFiles)
+	secondRotatedFiles, err := RotatedLogFiles(secondLogFile)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 0, len(secondRotatedFiles))
+	testutil.AssertEqual(t, 2, len(budget.logFiles))
+}
+
+func TestJSONFileLogInvalidOpts(t *testing.T) {
+	testCases := map[string]struct {
+		opt         logger.LogConfigOption
+		expectedErr error
+	}{
+		"test_unsupported_compression": {
+			opt:         WithCompression("lz4"),
+			expectedErr: log.NewError("unsupported compression lz4 of the rotated log files"),
+		},
+		"test_negative_max_age": {
+			opt:         WithMaxAge(-time.Hour),
+			expectedErr: log.NewError("maxAge logger config cannot be negative"),
+		},
+	}
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := NewJSONFileLog(logger.LogDriverInfo{ContainerID: "test-id", ContainerRootDir: t.TempDir()}, testCase.opt)
+			testutil.AssertError(t, testCase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package local
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+// constants for local log file
+const (
+	LocalLogFileName              = "container.log"
+	localLogFilePerms os.FileMode = 0644
+
+	LocalLogDriverName logger.LogDriverType = "local"
+)
+
+// localLogDriver is used to store the container's stdout and stderr as compressed binary records.
+type localLogDriver struct {
+	localLogMux sync.Mutex
+
+	logFile     *os.File
+	encoder     *recordEncoder
+	isClosed    bool
+	maxSize     int64
+	currentSize int64
+	maxFiles    int
+}
+
+// NewLocalLog creates a new LogDriver instance that produces compact binary logs.
+// The stored log messages can be read via ReadLogMessage.
+func NewLocalLog(info logger.LogDriverInfo, configOpts ...logger.LogConfigOption) (logger.LogDriver, error) {
+	logCfg := &localLogFileOpts{}
+	if err := applyLocalLoggerOpts(logCfg, configOpts...); err != nil {
+		log.ErrorErr(err, "invalid config provided for log driver %s", LocalLogDriverName)
+		return nil, err
+	}
+
+	if _, err := os.Stat(info.ContainerRootDir); err != nil {
+		return nil, err
+	}
+	logPath := filepath.Join(info.ContainerRootDir, LocalLogFileName)
+
+	f, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, localLogFilePerms)
+	if err != nil {
+		return nil, err
+	}
+	currentSize, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &localLogDriver{
+		logFile:     f,
+		encoder:     newRecordEncoder(),
+		maxSize:     logCfg.maxSize,
+		currentSize: currentSize,
+		maxFiles:    logCfg.maxFiles,
+	}, nil
+}
+
+func (localDriver *localLogDriver) Type() logger.LogDriverType {
+	return LocalLogDriverName
+}
+
+func (localDriver *localLogDriver) WriteLogMessage(msg *logger.LogMessage) error {
+	localDriver.localLogMux.Lock()
+	defer localDriver.localLogMux.Unlock()
+
+	if localDriver.isClosed {
+		return log.NewError("the local log driver is closed")
+	}
+	record, err := localDriver.encoder.encode(msg)
+	if err != nil {
+		return err
+	}
+	if err = localDriver.checkRotate(); err != nil {
+		return err
+	}
+
+	n, err := localDriver.logFile.Write(record)
+	if err == nil {
+		localDriver.currentSize += int64(n)
+	}
+	return err
+}
+
+func (localDriver *localLogDriver) Close() error {
+	localDriver.localLogMux.Lock()
+	defer localDriver.localLogMux.Unlock()
+
+	if localDriver.isClosed {
+		return nil
+	}
+
+	if err := localDriver.logFile.Close(); err != nil {
+		return err
+	}
+	localDriver.isClosed = true
+	return nil
+}
+
+func (localDriver *localLogDriver) checkRotate() error {
+	if localDriver.maxSize == 0 || localDriver.currentSize < localDriver.maxSize {
+		// do not rotate
+		return nil
+	}
+
+	logName := localDriver.logFile.Name()
+	if err := localDriver.logFile.Close(); err != nil {
+		return err
+	}
+	if err := rotate(logName, localDriver.maxFiles); err != nil {
+		return err
+	}
+	newFile, err := os.OpenFile(logName, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, localLogFilePerms)
+	if err != nil {
+		return err
+	}
+	localDriver.logFile = newFile
+	localDriver.currentSize = 0
+	return nil
+}
+
+func rotate(logFileName string, maxFiles int) error {
+	if maxFiles < 2 {
+		return nil
+	}
+	for i := maxFiles - 1; i > 1; i-- {
+		newFileName := logFileName + "." + strconv.Itoa(i)
+		oldFileName := logFileName + "." + strconv.Itoa(i-1)
+		if err := os.Rename(oldFileName, newFileName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.Rename(logFileName, logFileName+".1"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package local
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+// Each log message is stored as a record that is prefixed and suffixed with the length of its body,
+// so that the records can be read both forwards and backwards:
+//
+//	| body length (uint32) | body | body length (uint32) |
+//
+// The body consists of a flags byte followed by the payload, which is compressed with DEFLATE when this makes it smaller:
+//
+//	| flags (uint8) | timestamp in Unix nanoseconds (int64) | source length (uint8) | source | line |
+const (
+	recordLengthSize = 4
+	maxRecordSize    = 16 * 1024 * 1024
+	timestampSize    = 8
+	maxSourceLength  = 255
+
+	flagCompressed byte = 1
+)
+
+type recordEncoder struct {
+	payload    bytes.Buffer
+	compressed bytes.Buffer
+	compressor *flate.Writer
+	record     []byte
+}
+
+func newRecordEncoder() *recordEncoder {
+	compressor, _ := flate.NewWriter(nil, flate.BestSpeed)
+	return &recordEncoder{compressor: compressor}
+}
+
+// encode returns the record of the provided message, the returned slice is valid until the next call
+func (encoder *recordEncoder) encode(msg *logger.LogMessage) ([]byte, error) {
+	if len(msg.Source) > maxSourceLength {
+		return nil, log.NewErrorf("the log message source %s is too long", msg.Source)
+	}
+	encoder.payload.Reset()
+	_ = binary.Write(&encoder.payload, binary.BigEndian, msg.Timestamp.UnixNano())
+	encoder.payload.WriteByte(byte(len(msg.Source)))
+	encoder.payload.WriteString(msg.Source)
+	encoder.payload.Write(msg.Line)
+
+	encoder.compressed.Reset()
+	encoder.compressor.Reset(&encoder.compressed)
+	if _, err := encoder.compressor.Write(encoder.payload.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := encoder.compressor.Close(); err != nil {
+		return nil, err
+	}
+	flags, body := flagCompressed, encoder.compressed.Bytes()
+	if len(body) >= encoder.payload.Len() {
+		// the short lines are not worth compressing
+		flags, body = 0, encoder.payload.Bytes()
+	}
+
+	bodyLength := uint32(len(body) + 1)
+	record := binary.BigEndian.AppendUint32(encoder.record[:0], bodyLength)
+	record = append(record, flags)
+	record = append(record, body...)
+	record = binary.BigEndian.AppendUint32(record, bodyLength)
+	encoder.record = record
+	return record, nil
+}
+
+// ReadLogMessage reads and decodes the next log message record from the provided reader.
+// It returns io.EOF if there are no more records.
+func ReadLogMessage(r io.Reader) (*logger.LogMessage, error) {
+	header := make([]byte, recordLengthSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	bodyLength := binary.BigEndian.Uint32(header)
+	if bodyLength < 1 || bodyLength > maxRecordSize {
+		return nil, log.NewErrorf("invalid log record length %d", bodyLength)
+	}
+	record := make([]byte, bodyLength+recordLengthSize)
+	if _, err := io.ReadFull(r, record); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if binary.BigEndian.Uint32(record[bodyLength:]) != bodyLength {
+		return nil, log.NewError("corrupted log record")
+	}
+
+	payload := record[1:bodyLength]
+	if record[0]&flagCompressed != 0 {
+		var err error
+		if payload, err = io.ReadAll(flate.NewReader(bytes.NewReader(payload))); err != nil {
+			return nil, log.NewErrorf("failed to decompress log record: %v", err)
+		}
+	}
+	if len(payload) <= timestampSize {
+		return nil, log.NewError("corrupted log record")
+	}
+	sourceEnd := timestampSize + 1 + int(payload[timestampSize])
+	if len(payload) < sourceEnd {
+		return nil, log.NewError("corrupted log record")
+	}
+	return &logger.LogMessage{
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(payload))).UTC(),
+		Source:    string(payload[timestampSize+1 : sourceEnd]),
+		Line:      payload[sourceEnd:],
+	}, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
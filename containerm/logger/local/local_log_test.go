@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package local
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func TestLocalLog(t *testing.T) {
+	dir := t.TempDir()
+	driver, err := NewLocalLog(logger.LogDriverInfo{ContainerID: "test-id", ContainerRootDir: dir})
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, LocalLogDriverName, driver.Type())
+
+	timestamp := time.Date(2023, 5, 10, 10, 0, 0, 123456789, time.UTC)
+	messages := []*logger.LogMessage{
+		{Source: "stdout", Line: []byte("short\n"), Timestamp: timestamp},
+		{Source: "stderr", Line: []byte(strings.Repeat("compressible ", 100) + "\n"), Timestamp: timestamp.Add(time.Second)},
+		{Source: "stdout", Line: []byte{}, Timestamp: timestamp.Add(2 * time.Second)},
+	}
+	for _, msg := range messages {
+		testutil.AssertNil(t, driver.WriteLogMessage(msg))
+	}
+	testutil.AssertNil(t, driver.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, LocalLogFileName))
+	testutil.AssertNil(t, err)
+	testutil.AssertTrue(t, len(data) < len(messages[1].Line))
+
+	reader := bytes.NewReader(data)
+	for _, expected := range messages {
+		msg, err := ReadLogMessage(reader)
+		testutil.AssertNil(t, err)
+		testutil.AssertEqual(t, expected.Source, msg.Source)
+		testutil.AssertEqual(t, string(expected.Line), string(msg.Line))
+		testutil.AssertEqual(t, expected.Timestamp, msg.Timestamp)
+	}
+	_, err = ReadLogMessage(reader)
+	testutil.AssertEqual(t, io.EOF, err)
+}
+
+func TestLocalLogRotate(t *testing.T) {
+	dir := t.TempDir()
+	driver, err := NewLocalLog(logger.LogDriverInfo{ContainerID: "test-id", ContainerRootDir: dir}, WithMaxFiles(2), WithMaxSize(10))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+
+	for _, line := range []string{"first line\n", "second line\n", "third line\n"} {
+		testutil.AssertNil(t, driver.WriteLogMessage(&logger.LogMessage{Source: "stdout", Line: []byte(line), Timestamp: time.Now()}))
+	}
+
+	for fileName, expectedLine := range map[string]string{LocalLogFileName: "third line\n", LocalLogFileName + ".1": "second line\n"} {
+		f, err := os.Open(filepath.Join(dir, fileName))
+		testutil.AssertNil(t, err)
+		msg, err := ReadLogMessage(f)
+		f.Close()
+		testutil.AssertNil(t, err)
+		testutil.AssertEqual(t, expectedLine, string(msg.Line))
+	}
+	_, err = os.Stat(filepath.Join(dir, LocalLogFileName+".2"))
+	testutil.AssertTrue(t, os.IsNotExist(err))
+}
+
+func TestReadLogMessageCorrupted(t *testing.T) {
+	testCases := map[string][]byte{
+		"test_zero_length":      {0, 0, 0, 0},
+		"test_truncated":        {0, 0, 0, 10, 0, 1},
+		"test_length_mismatch":  {0, 0, 0, 1, 0, 0, 0, 0, 2},
+		"test_invalid_compress": {0, 0, 0, 2, 1, 0xff, 0, 0, 0, 2},
+	}
+	for testName, data := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := ReadLogMessage(bytes.NewReader(data))
+			testutil.AssertNotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+// constants for syslog log driver
+const (
+	SyslogLogDriverName logger.LogDriverType = "syslog"
+
+	localSyslogSocket = "/dev/log"
+	dialTimeout       = 5 * time.Second
+
+	// RFC 5424 allows up to 6 digits for the fractions of the second
+	rfc5424TimeLayout = "2006-01-02T15:04:05.000000Z07:00"
+	nilValue          = "-"
+
+	severityError = 3
+	severityInfo  = 6
+)
+
+// syslogLogDriver is used to forward the container's stdout and stderr to a syslog server as RFC 5424 messages.
+type syslogLogDriver struct {
+	syslogMux sync.Mutex
+
+	network     string
+	address     string
+	conn        net.Conn
+	connNetwork string
+	facility    int
+	hostname    string
+	tag         string
+	isClosed    bool
+}
+
+// NewSyslogLog creates a new LogDriver instance that forwards the logs to a syslog server.
+func NewSyslogLog(info logger.LogDriverInfo, configOpts ...logger.LogConfigOption) (logger.LogDriver, error) {
+	logCfg := &syslogLogOpts{facility: facilities[defaultFacility]}
+	if err := applySyslogLoggerOpts(logCfg, configOpts...); err != nil {
+		log.ErrorErr(err, "invalid config provided for log driver %s", SyslogLogDriverName)
+		return nil, err
+	}
+	if logCfg.tag == "" {
+		logCfg.tag = defaultTag(info)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = nilValue
+	}
+
+	syslogDriver := &syslogLogDriver{
+		network:  logCfg.network,
+		address:  logCfg.address,
+		facility: logCfg.facility,
+		hostname: hostname,
+		tag:      logCfg.tag,
+	}
+	if err := syslogDriver.connect(); err != nil {
+		return nil, err
+	}
+	return syslogDriver, nil
+}
+
+func (syslogDriver *syslogLogDriver) Type() logger.LogDriverType {
+	return SyslogLogDriverName
+}
+
+func (syslogDriver *syslogLogDriver) WriteLogMessage(msg *logger.LogMessage) error {
+	syslogDriver.syslogMux.Lock()
+	defer syslogDriver.syslogMux.Unlock()
+
+	if syslogDriver.isClosed {
+		return log.NewError("the syslog log driver is closed")
+	}
+	// reconnect once as the syslog server could have been restarted
+	for attempt := 0; ; attempt++ {
+		if syslogDriver.conn == nil {
+			if err := syslogDriver.connect(); err != nil {
+				return err
+			}
+		}
+		_, err := syslogDriver.conn.Write(syslogDriver.format(msg))
+		if err == nil || attempt > 0 {
+			return err
+		}
+		syslogDriver.conn.Close()
+		syslogDriver.conn = nil
+	}
+}
+
+func (syslogDriver *syslogLogDriver) Close() error {
+	syslogDriver.syslogMux.Lock()
+	defer syslogDriver.syslogMux.Unlock()
+
+	if syslogDriver.isClosed {
+		return nil
+	}
+	syslogDriver.isClosed = true
+	if syslogDriver.conn == nil {
+		return nil
+	}
+	return syslogDriver.conn.Close()
+}
+
+func (syslogDriver *syslogLogDriver) connect() error {
+	var (
+		conn    net.Conn
+		err     error
+		network = syslogDriver.network
+	)
+	if network == "" {
+		// the local syslog socket could be either a datagram or a stream one
+		for _, network = range []string{networkUnixgram, networkUnix} {
+			if conn, err = net.DialTimeout(network, localSyslogSocket, dialTimeout); err == nil {
+				break
+			}
+		}
+	} else {
+		conn, err = net.DialTimeout(network, syslogDriver.address, dialTimeout)
+	}
+	if err != nil {
+		return log.NewErrorf("failed to connect to syslog: %v", err)
+	}
+	syslogDriver.conn = conn
+	syslogDriver.connNetwork = network
+	return nil
+}
+
+// format builds a RFC 5424 message with the tag as an application name and the stream as a message ID.
+// The stream connections use octet counting framing as defined in RFC 6587.
+func (syslogDriver *syslogLogDriver) format(msg *logger.LogMessage) []byte {
+	severity := severityInfo
+	if msg.Source == "stderr" {
+		severity = severityError
+	}
+	msgID := msg.Source
+	if msgID == "" {
+		msgID = nilValue
+	}
+	timestamp := msg.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	line := bytes.TrimSuffix(msg.Line, []byte{'\n'})
+
+	message := fmt.Sprintf("<%d>1 %s %s %s %s %s %s %s", syslogDriver.facility*8+severity, timestamp.UTC().Format(rfc5424TimeLayout),
+		syslogDriver.hostname, syslogDriver.tag, nilValue, msgID, nilValue, line)
+	if syslogDriver.connNetwork == networkTCP || syslogDriver.connNetwork == networkUnix {
+		return []byte(fmt.Sprintf("%d %s", len(message), message))
+	}
+	return []byte(message)
+}
+
+func defaultTag(info logger.LogDriverInfo) string {
+	tag := info.ContainerName
+	if tag == "" {
+		tag = info.ContainerID
+	}
+	if len(tag) > maxTagLength {
+		tag = tag[:maxTagLength]
+	}
+	return tag
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package syslog
+
+import (
+	"net"
+	"net/url"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+)
+
+const (
+	networkUnix     = "unix"
+	networkUnixgram = "unixgram"
+	networkTCP      = "tcp"
+	networkUDP      = "udp"
+
+	defaultPort     = "514"
+	defaultFacility = "daemon"
+
+	maxTagLength = 48
+)
+
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+type syslogLogOpts struct {
+	network  string
+	address  string
+	facility int
+	tag      string
+}
+
+func applySyslogLoggerOpts(syslogOpts *syslogLogOpts, opts ...logger.LogConfigOption) error {
+	for _, o := range opts {
+		if err := o(syslogOpts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithAddress sets the address of the syslog server in the form of [unix|unixgram|tcp|udp]://<address>.
+// If not set, the local syslog socket is used.
+func WithAddress(address string) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		network, addr, err := ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		specificConfigOpts.(*syslogLogOpts).network = network
+		specificConfigOpts.(*syslogLogOpts).address = addr
+		return nil
+	}
+}
+
+// WithFacility sets the syslog facility of the log messages.
+func WithFacility(facility string) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		code, err := ParseFacility(facility)
+		if err != nil {
+			return err
+		}
+		specificConfigOpts.(*syslogLogOpts).facility = code
+		return nil
+	}
+}
+
+// WithTag sets the tag that is used as an application name of the log messages.
+func WithTag(tag string) logger.LogConfigOption {
+	return func(specificConfigOpts interface{}) error {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+		specificConfigOpts.(*syslogLogOpts).tag = tag
+		return nil
+	}
+}
+
+// ParseAddress parses a syslog server address in the form of [unix|unixgram|tcp|udp]://<address> to its network and address.
+// The default syslog port is used for tcp and udp addresses without a port. An empty address is parsed to empty network and address.
+func ParseAddress(address string) (string, string, error) {
+	if address == "" {
+		return "", "", nil
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", log.NewErrorf("invalid syslog address %s", address)
+	}
+	switch u.Scheme {
+	case networkUnix, networkUnixgram:
+		if u.Path == "" {
+			return "", "", log.NewErrorf("missing socket path in syslog address %s", address)
+		}
+		return u.Scheme, u.Path, nil
+	case networkTCP, networkUDP:
+		if u.Host == "" {
+			return "", "", log.NewErrorf("missing host in syslog address %s", address)
+		}
+		host := u.Host
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), defaultPort)
+		}
+		return u.Scheme, host, nil
+	default:
+		return "", "", log.NewErrorf("unsupported network in syslog address %s - use one of %s, %s, %s or %s", address, networkUnix, networkUnixgram, networkTCP, networkUDP)
+	}
+}
+
+// ParseFacility returns the code of a syslog facility provided by its name.
+func ParseFacility(facility string) (int, error) {
+	code, ok := facilities[facility]
+	if !ok {
+		return 0, log.NewErrorf("unsupported syslog facility %s", facility)
+	}
+	return code, nil
+}
+
+// ValidateTag checks if the provided tag is a valid syslog application name - up to 48 printable ASCII characters without spaces.
+func ValidateTag(tag string) error {
+	if len(tag) > maxTagLength {
+		return log.NewErrorf("syslog tag %s is longer than %d characters", tag, maxTagLength)
+	}
+	for i := 0; i < len(tag); i++ {
+		if tag[i] < '!' || tag[i] > '~' {
+			return log.NewErrorf("syslog tag %s must contain only printable ASCII characters without spaces", tag)
+		}
+	}
+	return nil
+}
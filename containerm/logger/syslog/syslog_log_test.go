@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package syslog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+var (
+	testInfo      = logger.LogDriverInfo{ContainerID: "test-id", ContainerName: "test-name"}
+	testTimestamp = time.Date(2023, 5, 10, 10, 0, 0, 123456789, time.UTC)
+)
+
+func TestParseAddress(t *testing.T) {
+	testCases := map[string]struct {
+		address         string
+		expectedNetwork string
+		expectedAddress string
+		expectedErr     error
+	}{
+		"test_empty": {},
+		"test_udp_default_port": {
+			address:         "udp://localhost",
+			expectedNetwork: networkUDP,
+			expectedAddress: "localhost:514",
+		},
+		"test_tcp": {
+			address:         "tcp://10.0.0.1:6514",
+			expectedNetwork: networkTCP,
+			expectedAddress: "10.0.0.1:6514",
+		},
+		"test_unixgram": {
+			address:         "unixgram:///dev/log",
+			expectedNetwork: networkUnixgram,
+			expectedAddress: "/dev/log",
+		},
+		"test_unix_missing_path": {
+			address:     "unix://",
+			expectedErr: errors.New("missing socket path in syslog address unix://"),
+		},
+		"test_tcp_missing_host": {
+			address:     "tcp://",
+			expectedErr: errors.New("missing host in syslog address tcp://"),
+		},
+		"test_unsupported_network": {
+			address:     "localhost:514",
+			expectedErr: errors.New("unsupported network in syslog address localhost:514 - use one of unix, unixgram, tcp or udp"),
+		},
+	}
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			network, address, err := ParseAddress(testCase.address)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedNetwork, network)
+			testutil.AssertEqual(t, testCase.expectedAddress, address)
+		})
+	}
+}
+
+func TestValidateTag(t *testing.T) {
+	testutil.AssertNil(t, ValidateTag("my-app.1"))
+	testutil.AssertError(t, errors.New("syslog tag my app must contain only printable ASCII characters without spaces"), ValidateTag("my app"))
+	longTag := strings.Repeat("a", maxTagLength+1)
+	testutil.AssertError(t, fmt.Errorf("syslog tag %s is longer than %d characters", longTag, maxTagLength), ValidateTag(longTag))
+}
+
+func TestSyslogLogUDP(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	testutil.AssertNil(t, err)
+	defer listener.Close()
+
+	driver, err := NewSyslogLog(testInfo, WithAddress("udp://"+listener.LocalAddr().String()), WithFacility("local0"))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+	testutil.AssertEqual(t, SyslogLogDriverName, driver.Type())
+
+	testutil.AssertNil(t, driver.WriteLogMessage(&logger.LogMessage{Source: "stderr", Line: []byte("test error\n"), Timestamp: testTimestamp}))
+
+	buffer := make([]byte, 1024)
+	listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := listener.ReadFrom(buffer)
+	testutil.AssertNil(t, err)
+	hostname, _ := os.Hostname()
+	testutil.AssertEqual(t, fmt.Sprintf("<131>1 2023-05-10T10:00:00.123456Z %s test-name - stderr - test error", hostname), string(buffer[:n]))
+}
+
+func TestSyslogLogUnixStream(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "syslog.sock")
+	listener, err := net.Listen("unix", socket)
+	testutil.AssertNil(t, err)
+	defer listener.Close()
+
+	driver, err := NewSyslogLog(logger.LogDriverInfo{ContainerID: "test-id"}, WithAddress("unix://"+socket), WithTag("app"))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+
+	conn, err := listener.Accept()
+	testutil.AssertNil(t, err)
+	defer conn.Close()
+
+	testutil.AssertNil(t, driver.WriteLogMessage(&logger.LogMessage{Source: "stdout", Line: []byte("test output\n"), Timestamp: testTimestamp}))
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	var length int
+	_, err = fmt.Fscanf(reader, "%d ", &length)
+	testutil.AssertNil(t, err)
+	message := make([]byte, length)
+	_, err = io.ReadFull(reader, message)
+	testutil.AssertNil(t, err)
+	hostname, _ := os.Hostname()
+	testutil.AssertEqual(t, fmt.Sprintf("<30>1 2023-05-10T10:00:00.123456Z %s app - stdout - test output", hostname), string(message))
+
+	testutil.AssertNil(t, driver.Close())
+	testutil.AssertError(t, errors.New("the syslog log driver is closed"), driver.WriteLogMessage(&logger.LogMessage{Line: []byte("test")}))
+}
+
+func TestSyslogLogConnectError(t *testing.T) {
+	_, err := NewSyslogLog(testInfo, WithAddress("unix://"+filepath.Join(t.TempDir(), "missing.sock")))
+	testutil.AssertNotNil(t, err)
+}
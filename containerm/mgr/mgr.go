@@ -29,6 +29,7 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/streams"
 	"github.com/eclipse-kanto/container-management/containerm/util"
 	errorUtil "github.com/eclipse-kanto/container-management/containerm/util/error"
+	"github.com/eclipse-kanto/container-management/containerm/volumes"
 )
 
 const (
@@ -53,11 +54,13 @@ type containerMgr struct {
 	ctrClient              ctr.ContainerAPIClient
 	netMgr                 network.ContainerNetworkManager
 	eventsMgr              events.ContainerEventsManager
+	volumeMgr              volumes.VolumeManager
 
 	containers     map[string]*types.Container
 	containersLock sync.RWMutex
 
 	restartCtrsMgrCache *restartMgrCache
+	healthMonitorsCache *healthMonitorCache
 	containerRepository containerRepository
 }
 
@@ -132,6 +135,10 @@ func (mgr *containerMgr) Restore(ctx context.Context) error {
 			}
 			mgr.cancelContainerRestartManager(ctr)
 			if err = mgr.ctrClient.RestoreContainer(ctx, ctr); err == nil {
+				if ctr.HealthCheck != nil {
+					ctr.State.Health = &types.Health{Status: types.HealthStarting}
+				}
+				mgr.startContainerHealthMonitor(ctr)
 				mgr.containerRepository.Save(ctr)
 				continue
 			}
@@ -177,6 +184,14 @@ func (mgr *containerMgr) Create(ctx context.Context, container *types.Container)
 		log.ErrorErr(err, "configuration for container id = %s is invalid", container.ID)
 		return nil, err
 	}
+	if err := mgr.checkContainerNetworks(ctx, container); err != nil {
+		log.ErrorErr(err, "the networks of container id = %s are not available", container.ID)
+		return nil, err
+	}
+	if err := mgr.checkNamespaceModeTargets(container); err != nil {
+		log.ErrorErr(err, "the namespaces shared by container id = %s are not available", container.ID)
+		return nil, err
+	}
 
 	container.State = &types.State{
 		Status: types.Creating,
@@ -199,7 +214,18 @@ func (mgr *containerMgr) Create(ctx context.Context, container *types.Container)
 		util.MkDir(pth)
 	}
 
+	if err = mgr.acquireContainerVolumes(ctx, container); err != nil {
+		return nil, err
+	}
+
+	if err = mgr.netMgr.AcquireNetworks(ctx, container); err != nil {
+		mgr.releaseContainerVolumes(ctx, container)
+		return nil, err
+	}
+
 	if err = mgr.ctrClient.CreateContainer(ctx, container, ""); err != nil {
+		mgr.releaseContainerNetworks(ctx, container)
+		mgr.releaseContainerVolumes(ctx, container)
 		return nil, err
 	}
 
@@ -236,7 +262,7 @@ func (mgr *containerMgr) List(ctx context.Context) ([]*types.Container, error) {
 
 // Start a container.
 func (mgr *containerMgr) Start(ctx context.Context, id string) error {
-	return mgr.processStartContainer(ctx, id, true)
+	return mgr.processStartContainer(ctx, id, "", true)
 }
 
 // Attach attaches the container's IO
@@ -248,6 +274,25 @@ func (mgr *containerMgr) Attach(ctx context.Context, id string, attachConfig *st
 	return mgr.ctrClient.AttachContainer(ctx, container, attachConfig)
 }
 
+// Exec executes a new process inside a running container.
+func (mgr *containerMgr) Exec(ctx context.Context, id string, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error) {
+	container := mgr.getContainerFromCache(id)
+	if container == nil {
+		return -1, log.NewErrorf(noSuchContainerErrorMsg, id)
+	}
+	if err := util.ValidateExecConfig(execConfig); err != nil {
+		log.ErrorErr(err, "invalid exec configuration for container id = %s", container.ID)
+		return -1, err
+	}
+	container.Lock()
+	if !container.State.Running || container.State.Paused {
+		container.Unlock()
+		return -1, log.NewErrorf("container with id = %s is not running - current status is %s", container.ID, container.State.Status.String())
+	}
+	container.Unlock()
+	return mgr.ctrClient.ExecContainer(ctx, container, execConfig, attachConfig)
+}
+
 // Stop a container.
 func (mgr *containerMgr) Stop(ctx context.Context, id string, stopOpts *types.StopOpts) error {
 	container := mgr.getContainerFromCache(id)
@@ -297,6 +342,15 @@ func (mgr *containerMgr) Update(ctx context.Context, id string, updateOpts *type
 	container.Lock()
 	defer container.Unlock()
 
+	if updateOpts.NetworkPolicy != nil {
+		hostConfig := *container.HostConfig
+		hostConfig.NetworkPolicy = updateOpts.NetworkPolicy
+		if err := util.ValidateNetworking(&hostConfig); err != nil {
+			log.ErrorErr(err, "will not update container id = %s invalid network policy", container.ID)
+			return err
+		}
+	}
+
 	var changesMade bool
 	if updateOpts.Resources != nil && !reflect.DeepEqual(updateOpts.Resources, container.HostConfig.Resources) {
 		if util.IsContainerRunningOrPaused(container) {
@@ -304,7 +358,7 @@ func (mgr *containerMgr) Update(ctx context.Context, id string, updateOpts *type
 				return err
 			}
 		}
-		if (*updateOpts.Resources == types.Resources{}) { // empty, no limits
+		if reflect.DeepEqual(*updateOpts.Resources, types.Resources{}) { // empty, no limits
 			container.HostConfig.Resources = nil
 		} else {
 			container.HostConfig.Resources = updateOpts.Resources
@@ -312,6 +366,20 @@ func (mgr *containerMgr) Update(ctx context.Context, id string, updateOpts *type
 		changesMade = true
 	}
 
+	if updateOpts.NetworkPolicy != nil && !reflect.DeepEqual(updateOpts.NetworkPolicy, container.HostConfig.NetworkPolicy) {
+		if util.IsContainerRunningOrPaused(container) {
+			if err := mgr.netMgr.UpdateNetworkPolicy(ctx, container, updateOpts.NetworkPolicy); err != nil {
+				return err
+			}
+		}
+		if util.IsNetworkPolicySet(updateOpts.NetworkPolicy) {
+			container.HostConfig.NetworkPolicy = updateOpts.NetworkPolicy
+		} else { // empty, no policy
+			container.HostConfig.NetworkPolicy = nil
+		}
+		changesMade = true
+	}
+
 	var rpChanged bool
 	if updateOpts.RestartPolicy != nil && !reflect.DeepEqual(updateOpts.RestartPolicy, container.HostConfig.RestartPolicy) {
 		mgr.resetContainerRestartManager(container, false)
@@ -466,6 +534,7 @@ func (mgr *containerMgr) Remove(ctx context.Context, id string, force bool, stop
 	}
 
 	util.SetContainerStatusDead(container)
+	mgr.stopNetworkModeDependents(container)
 	// publish event
 	if pubErr := mgr.publishContainerStateChangedEvent(ctx, types.EventActionContainersRemoved, container); pubErr != nil {
 		log.ErrorErr(pubErr, "failed to publish event for container %+v", container)
@@ -476,6 +545,9 @@ func (mgr *containerMgr) Remove(ctx context.Context, id string, force bool, stop
 
 	err := mgr.containerRepository.Delete(id)
 
+	mgr.releaseContainerVolumes(ctx, container)
+	mgr.releaseContainerNetworks(ctx, container)
+	mgr.stopContainerHealthMonitor(container)
 	mgr.removeContainerRestartManager(container)
 	mgr.removeContainerFromCache(id)
 
@@ -486,6 +558,82 @@ func (mgr *containerMgr) Remove(ctx context.Context, id string, force bool, stop
 	return nil
 }
 
+// Checkpoint creates a checkpoint of a running container.
+func (mgr *containerMgr) Checkpoint(ctx context.Context, id string, checkpointOpts *types.CheckpointOpts) error {
+	container := mgr.getContainerFromCache(id)
+	if container == nil {
+		return log.NewErrorf(noSuchContainerErrorMsg, id)
+	}
+	if err := util.ValidateCheckpointOpts(checkpointOpts); err != nil {
+		log.ErrorErr(err, "invalid checkpoint options for container id = %s", container.ID)
+		return err
+	}
+	if err := mgr.checkpointContainer(ctx, container, checkpointOpts.CheckpointID); err != nil {
+		return err
+	}
+	if checkpointOpts.Exit {
+		return mgr.Stop(ctx, container.ID, nil)
+	}
+	return nil
+}
+
+// ListCheckpoints returns the list of the checkpoints stored for a container.
+func (mgr *containerMgr) ListCheckpoints(ctx context.Context, id string) ([]*types.Checkpoint, error) {
+	container := mgr.getContainerFromCache(id)
+	if container == nil {
+		return nil, log.NewErrorf(noSuchContainerErrorMsg, id)
+	}
+	entries, err := os.ReadDir(mgr.getContainerCheckpointsPath(container.ID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*types.Checkpoint{}, nil
+		}
+		return nil, err
+	}
+	checkpoints := []*types.Checkpoint{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			log.WarnErr(infoErr, "could not get info for checkpoint %s of container id = %s", entry.Name(), container.ID)
+			continue
+		}
+		checkpoints = append(checkpoints, &types.Checkpoint{
+			ID:      entry.Name(),
+			Created: info.ModTime().UTC().Format(time.RFC3339Nano),
+		})
+	}
+	return checkpoints, nil
+}
+
+// RemoveCheckpoint removes a stored checkpoint of a container.
+func (mgr *containerMgr) RemoveCheckpoint(ctx context.Context, id string, checkpointID string) error {
+	container := mgr.getContainerFromCache(id)
+	if container == nil {
+		return log.NewErrorf(noSuchContainerErrorMsg, id)
+	}
+	checkpointDir, err := mgr.getExistingCheckpointPath(container.ID, checkpointID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(checkpointDir)
+}
+
+// RestoreFromCheckpoint starts a container that has been stopped or created restoring its state from a stored checkpoint.
+func (mgr *containerMgr) RestoreFromCheckpoint(ctx context.Context, id string, checkpointID string) error {
+	container := mgr.getContainerFromCache(id)
+	if container == nil {
+		return log.NewErrorf(noSuchContainerErrorMsg, id)
+	}
+	checkpointDir, err := mgr.getExistingCheckpointPath(container.ID, checkpointID)
+	if err != nil {
+		return err
+	}
+	return mgr.processStartContainer(ctx, container.ID, checkpointDir, true)
+}
+
 func (mgr *containerMgr) Metrics(ctx context.Context, id string) (*types.Metrics, error) {
 	container := mgr.getContainerFromCache(id)
 	if container == nil {
@@ -43,6 +43,9 @@ type ContainerManager interface {
 	// Attach attaches the container's IO
 	Attach(ctx context.Context, id string, attachConfig *streams.AttachConfig) error
 
+	// Exec executes a new process inside a running container and returns its exit code
+	Exec(ctx context.Context, id string, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error)
+
 	// Stop stops a running container
 	Stop(ctx context.Context, id string, stopOpts *types.StopOpts) error
 
@@ -64,6 +67,18 @@ type ContainerManager interface {
 	// Remove removes a container, it may be running or stopped and so on
 	Remove(ctx context.Context, id string, force bool, stopOpts *types.StopOpts) error
 
+	// Checkpoint creates a checkpoint of a running container's state
+	Checkpoint(ctx context.Context, id string, checkpointOpts *types.CheckpointOpts) error
+
+	// ListCheckpoints returns the list of the checkpoints stored for a container
+	ListCheckpoints(ctx context.Context, id string) ([]*types.Checkpoint, error)
+
+	// RemoveCheckpoint removes a stored checkpoint of a container
+	RemoveCheckpoint(ctx context.Context, id string, checkpointID string) error
+
+	// RestoreFromCheckpoint starts a container that has been stopped or created restoring its state from a stored checkpoint
+	RestoreFromCheckpoint(ctx context.Context, id string, checkpointID string) error
+
 	// Metrics retrieves metrics data about a container
 	Metrics(ctx context.Context, id string) (*types.Metrics, error)
 
@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/streams"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+const (
+	healthCheckLocalhost     = "127.0.0.1"
+	healthCheckMaxOutputSize = 4096
+)
+
+type healthMonitor struct {
+	sync.Once
+	mgr         *containerMgr
+	container   *types.Container
+	healthCheck *types.HealthCheckConfig
+	startedAt   time.Time
+	cancelChan  chan struct{}
+}
+
+func newHealthMonitor(mgr *containerMgr, container *types.Container) *healthMonitor {
+	return &healthMonitor{
+		mgr:         mgr,
+		container:   container,
+		healthCheck: container.HealthCheck,
+		startedAt:   time.Now(),
+		cancelChan:  make(chan struct{}),
+	}
+}
+
+func (hm *healthMonitor) start() {
+	go func() {
+		ticker := time.NewTicker(hm.healthCheck.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-hm.cancelChan:
+				log.Debug("health monitor for container id = %s is stopped", hm.container.ID)
+				return
+			case <-ticker.C:
+				hm.check()
+			}
+		}
+	}()
+}
+
+func (hm *healthMonitor) stop() {
+	hm.Do(func() {
+		close(hm.cancelChan)
+	})
+}
+
+func (hm *healthMonitor) isStopped() bool {
+	select {
+	case <-hm.cancelChan:
+		return true
+	default:
+		return false
+	}
+}
+
+func (hm *healthMonitor) check() {
+	hm.container.Lock()
+	if hm.isStopped() || !hm.container.State.Running || hm.container.State.Paused || hm.container.State.Health == nil {
+		hm.container.Unlock()
+		return
+	}
+	address := hm.probeAddress()
+	hm.container.Unlock()
+
+	output, probeErr := hm.probe(address)
+
+	hm.container.Lock()
+	defer hm.container.Unlock()
+	if hm.isStopped() || hm.container.State.Health == nil {
+		return
+	}
+	if hm.updateHealth(output, probeErr) {
+		hm.mgr.onContainerHealthChanged(hm.container)
+	}
+}
+
+// the container's lock must be held when calling this method
+func (hm *healthMonitor) probeAddress() string {
+	if hm.healthCheck.Type == types.HealthCheckExec {
+		return ""
+	}
+	ip := healthCheckLocalhost
+	if !util.IsContainerNetworkHost(hm.container) && hm.container.NetworkSettings != nil {
+		for _, endpoint := range hm.container.NetworkSettings.Networks {
+			if endpoint != nil && endpoint.IPAddress != "" {
+				ip = endpoint.IPAddress
+				break
+			}
+		}
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(hm.healthCheck.Port))
+}
+
+func (hm *healthMonitor) probe(address string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), hm.healthCheck.Timeout)
+	defer cancel()
+
+	switch hm.healthCheck.Type {
+	case types.HealthCheckExec:
+		return hm.probeExec(ctx)
+	case types.HealthCheckHTTP:
+		return hm.probeHTTP(ctx, address)
+	case types.HealthCheckTCP:
+		return hm.probeTCP(ctx, address)
+	default:
+		return "", log.NewErrorf("unsupported health check type %s", hm.healthCheck.Type)
+	}
+}
+
+func (hm *healthMonitor) probeExec(ctx context.Context) (string, error) {
+	output := &bytes.Buffer{}
+	attachConfig := &streams.AttachConfig{
+		UseStdout: true,
+		UseStderr: true,
+		Stdout:    output,
+		Stderr:    output,
+	}
+	exitCode, err := hm.mgr.ctrClient.ExecContainer(ctx, hm.container, &types.ExecConfig{Cmd: hm.healthCheck.Cmd}, attachConfig)
+	if err != nil {
+		return "", err
+	}
+	if exitCode != 0 {
+		return output.String(), log.NewErrorf("health check command exited with code %d", exitCode)
+	}
+	return output.String(), nil
+}
+
+func (hm *healthMonitor) probeHTTP(ctx context.Context, address string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", address, hm.healthCheck.Path), nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusBadRequest {
+		return response.Status, log.NewErrorf("health check HTTP request returned status %s", response.Status)
+	}
+	return response.Status, nil
+}
+
+func (hm *healthMonitor) probeTCP(ctx context.Context, address string) (string, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	conn.Close()
+	return fmt.Sprintf("connected to %s", address), nil
+}
+
+// updateHealth applies the probe result to the container's health and returns true if the health status has changed.
+// The container's lock must be held when calling this method.
+func (hm *healthMonitor) updateHealth(output string, probeErr error) bool {
+	health := hm.container.State.Health
+	previousStatus := health.Status
+
+	health.LastCheckAt = time.Now().UTC().Format(time.RFC3339Nano)
+	if probeErr != nil && output == "" {
+		output = probeErr.Error()
+	}
+	if len(output) > healthCheckMaxOutputSize {
+		output = output[:healthCheckMaxOutputSize]
+	}
+	health.LastOutput = output
+
+	if probeErr == nil {
+		health.FailingStreak = 0
+		health.Status = types.HealthHealthy
+	} else if health.Status == types.HealthStarting && time.Since(hm.startedAt) < hm.healthCheck.StartPeriod {
+		log.Debug("health check for container id = %s failed within its start period - will not be counted", hm.container.ID)
+	} else {
+		health.FailingStreak++
+		if health.FailingStreak >= hm.healthCheck.Retries {
+			health.Status = types.HealthUnhealthy
+		}
+	}
+	return health.Status != previousStatus
+}
+
+func (mgr *containerMgr) onContainerHealthChanged(container *types.Container) {
+	log.Debug("health status of container id = %s changed to %s", container.ID, container.State.Health.Status)
+	if pubErr := mgr.publishContainerStateChangedEvent(context.Background(), types.EventActionContainersHealthChanged, container); pubErr != nil {
+		log.ErrorErr(pubErr, "failed to publish event for container %+v", container)
+	}
+	if _, errMeta := mgr.containerRepository.Save(container); errMeta != nil {
+		log.ErrorErr(errMeta, failedConfigStoringErrorMsg)
+	}
+	if container.State.Health.Status == types.HealthUnhealthy && mgr.getContainerRestartManager(container).shouldRestartOnUnhealthy() {
+		go mgr.restartUnhealthyContainer(container)
+	}
+}
+
+func (mgr *containerMgr) restartUnhealthyContainer(container *types.Container) {
+	ctx := context.Background()
+	log.Debug("restarting unhealthy container id = %s", container.ID)
+	if err := mgr.stopContainer(ctx, container, mgr.getContainerStopOptions(true), false); err != nil {
+		log.ErrorErr(err, "failed to stop unhealthy container id = %s", container.ID)
+		return
+	}
+	container.Lock()
+	container.RestartCount++
+	container.Unlock()
+	if err := mgr.processStartContainer(ctx, container.ID, "", false); err != nil {
+		log.ErrorErr(err, "failed to restart unhealthy container id = %s", container.ID)
+	}
+}
+
+// the container's lock must be held when calling this method
+func (mgr *containerMgr) startContainerHealthMonitor(container *types.Container) {
+	mgr.stopContainerHealthMonitor(container)
+	if container.HealthCheck == nil || container.State.Health == nil {
+		return
+	}
+	log.Debug("starting health monitor for container id = %s", container.ID)
+	monitor := newHealthMonitor(mgr, container)
+	mgr.healthMonitorsCache.put(container.ID, monitor)
+	monitor.start()
+}
+
+func (mgr *containerMgr) stopContainerHealthMonitor(container *types.Container) {
+	if monitor := mgr.healthMonitorsCache.get(container.ID); monitor != nil {
+		log.Debug("stopping health monitor for container id = %s", container.ID)
+		monitor.stop()
+		mgr.healthMonitorsCache.remove(container.ID)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"sync"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+)
+
+// the cache saves all container' health monitors.
+type healthMonitorCache struct {
+	m *sync.Map
+}
+
+// newHealthMonitorCache creates a container's health monitors storage.
+func newHealthMonitorCache() *healthMonitorCache {
+	return &healthMonitorCache{
+		m: &sync.Map{},
+	}
+}
+
+// put writes a container's health monitor into storage.
+func (c *healthMonitorCache) put(id string, monitor *healthMonitor) {
+	c.m.Store(id, monitor)
+	log.Debug("added health monitor for container id = %s", id)
+}
+
+// get reads a container's health monitor by id.
+func (c *healthMonitorCache) get(id string) *healthMonitor {
+	obj, ok := c.m.Load(id)
+	if !ok {
+		return nil
+	}
+	if monitor, ok := obj.(*healthMonitor); ok {
+		return monitor
+	}
+	return nil
+}
+
+// remove removes the container's health monitor.
+func (c *healthMonitorCache) remove(id string) {
+	c.m.Delete(id)
+	log.Debug("removed health monitor for container id = %s", id)
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	ctrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
+	eventsMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
+	mgrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	"github.com/eclipse-kanto/container-management/containerm/streams"
+	"github.com/golang/mock/gomock"
+)
+
+const testHealthCheckCtrID = "test-health-check-ctr-id"
+
+func newTestHealthCheckContainer(healthCheck *types.HealthCheckConfig) *types.Container {
+	return &types.Container{
+		ID:          testHealthCheckCtrID,
+		HealthCheck: healthCheck,
+		HostConfig: &types.HostConfig{
+			NetworkMode:   types.NetworkModeHost,
+			RestartPolicy: &types.RestartPolicy{Type: types.No},
+		},
+		State: &types.State{
+			Status:  types.Running,
+			Running: true,
+			Health:  &types.Health{Status: types.HealthStarting},
+		},
+	}
+}
+
+func TestHealthMonitorUpdateHealth(t *testing.T) {
+	probeErr := log.NewError("probe failed")
+	tests := map[string]struct {
+		startPeriod     time.Duration
+		initialHealth   types.Health
+		probeErrs       []error
+		expectedHealth  types.HealthStatus
+		expectedStreak  int
+		expectedChanged bool
+	}{
+		"test_starting_to_healthy": {
+			initialHealth:   types.Health{Status: types.HealthStarting},
+			probeErrs:       []error{nil},
+			expectedHealth:  types.HealthHealthy,
+			expectedChanged: true,
+		},
+		"test_failures_below_retries": {
+			initialHealth:  types.Health{Status: types.HealthHealthy},
+			probeErrs:      []error{probeErr, probeErr},
+			expectedHealth: types.HealthHealthy,
+			expectedStreak: 2,
+		},
+		"test_healthy_to_unhealthy": {
+			initialHealth:   types.Health{Status: types.HealthHealthy},
+			probeErrs:       []error{probeErr, probeErr, probeErr},
+			expectedHealth:  types.HealthUnhealthy,
+			expectedStreak:  3,
+			expectedChanged: true,
+		},
+		"test_unhealthy_to_healthy": {
+			initialHealth:   types.Health{Status: types.HealthUnhealthy, FailingStreak: 5},
+			probeErrs:       []error{nil},
+			expectedHealth:  types.HealthHealthy,
+			expectedChanged: true,
+		},
+		"test_failures_within_start_period": {
+			startPeriod:    time.Hour,
+			initialHealth:  types.Health{Status: types.HealthStarting},
+			probeErrs:      []error{probeErr, probeErr, probeErr},
+			expectedHealth: types.HealthStarting,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctr := newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Retries: 3, StartPeriod: testCase.startPeriod})
+			health := testCase.initialHealth
+			ctr.State.Health = &health
+			monitor := newHealthMonitor(&containerMgr{}, ctr)
+
+			changed := false
+			for _, err := range testCase.probeErrs {
+				changed = monitor.updateHealth("", err) || changed
+			}
+			testutil.AssertEqual(t, testCase.expectedHealth, ctr.State.Health.Status)
+			testutil.AssertEqual(t, testCase.expectedStreak, ctr.State.Health.FailingStreak)
+			testutil.AssertEqual(t, testCase.expectedChanged, changed)
+			testutil.AssertNotEqual(t, "", ctr.State.Health.LastCheckAt)
+		})
+	}
+}
+
+func TestHealthMonitorProbeExec(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCtrClient := ctrMock.NewMockContainerAPIClient(controller)
+	healthCheck := &types.HealthCheckConfig{Type: types.HealthCheckExec, Cmd: []string{"healthcheck"}, Timeout: time.Second}
+	ctr := newTestHealthCheckContainer(healthCheck)
+	monitor := newHealthMonitor(&containerMgr{ctrClient: mockCtrClient}, ctr)
+
+	tests := map[string]struct {
+		exitCode    int64
+		execErr     error
+		expectedErr error
+	}{
+		"test_exit_code_zero": {},
+		"test_exit_code_non_zero": {
+			exitCode:    1,
+			expectedErr: log.NewError("health check command exited with code 1"),
+		},
+		"test_exec_error": {
+			exitCode:    -1,
+			execErr:     log.NewError("test error"),
+			expectedErr: log.NewError("test error"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrClient.EXPECT().ExecContainer(gomock.Any(), ctr, &types.ExecConfig{Cmd: healthCheck.Cmd}, gomock.AssignableToTypeOf(&streams.AttachConfig{})).Return(testCase.exitCode, testCase.execErr)
+			_, err := monitor.probe("")
+			testutil.AssertError(t, testCase.expectedErr, err)
+		})
+	}
+}
+
+func TestHealthMonitorProbeHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	address := server.Listener.Addr().String()
+
+	monitor := newHealthMonitor(&containerMgr{}, newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckHTTP, Path: "/health", Timeout: time.Second}))
+	_, err := monitor.probe(address)
+	testutil.AssertNil(t, err)
+
+	monitor.healthCheck.Path = "/"
+	_, err = monitor.probe(address)
+	testutil.AssertNotNil(t, err)
+}
+
+func TestHealthMonitorProbeTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.AssertNil(t, err)
+	address := listener.Addr().String()
+
+	ctr := newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: listener.Addr().(*net.TCPAddr).Port, Timeout: time.Second})
+	monitor := newHealthMonitor(&containerMgr{}, ctr)
+	testutil.AssertEqual(t, address, monitor.probeAddress())
+
+	_, err = monitor.probe(address)
+	testutil.AssertNil(t, err)
+
+	listener.Close()
+	_, err = monitor.probe(address)
+	testutil.AssertNotNil(t, err)
+}
+
+func TestHealthMonitorProbeAddressBridge(t *testing.T) {
+	ctr := newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckHTTP, Port: 8080})
+	ctr.HostConfig.NetworkMode = types.NetworkModeBridge
+	ctr.NetworkSettings = &types.NetworkSettings{
+		Networks: map[string]*types.EndpointSettings{
+			"default": {IPAddress: "172.17.0.2"},
+		},
+	}
+	monitor := newHealthMonitor(&containerMgr{}, ctr)
+	testutil.AssertEqual(t, net.JoinHostPort("172.17.0.2", strconv.Itoa(8080)), monitor.probeAddress())
+}
+
+func TestHealthMonitorCheck(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCtrClient := ctrMock.NewMockContainerAPIClient(controller)
+	mockEventsManager := eventsMock.NewMockContainerEventsManager(controller)
+	mockRepository := mgrMock.NewMockcontainerRepository(controller)
+
+	ctr := newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckExec, Cmd: []string{"healthcheck"}, Timeout: time.Second, Retries: 1})
+	testMgr := createContainerManagerWithCustomMocks(testMetaPath, mockCtrClient, nil, mockEventsManager, mockRepository, map[string]*types.Container{ctr.ID: ctr})
+	monitor := newHealthMonitor(&testMgr, ctr)
+
+	mockCtrClient.EXPECT().ExecContainer(gomock.Any(), ctr, gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersHealthChanged, ctr).Return(nil)
+	mockRepository.EXPECT().Save(ctr).Return(ctr, nil)
+	monitor.check()
+	testutil.AssertEqual(t, types.HealthHealthy, ctr.State.Health.Status)
+
+	// no events are published while the health status remains the same
+	mockCtrClient.EXPECT().ExecContainer(gomock.Any(), ctr, gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	monitor.check()
+
+	// no probes are performed while the container is paused
+	ctr.State.Paused = true
+	monitor.check()
+	ctr.State.Paused = false
+
+	// no probes are performed once the monitor is stopped
+	monitor.stop()
+	monitor.check()
+}
+
+func TestRestartManagerShouldRestartOnUnhealthy(t *testing.T) {
+	tests := map[string]struct {
+		policy   *types.RestartPolicy
+		canceled bool
+		expected bool
+	}{
+		"test_policy_no": {
+			policy: &types.RestartPolicy{Type: types.No, RestartOnUnhealthy: true},
+		},
+		"test_not_enabled": {
+			policy: &types.RestartPolicy{Type: types.Always},
+		},
+		"test_enabled": {
+			policy:   &types.RestartPolicy{Type: types.UnlessStopped, RestartOnUnhealthy: true},
+			expected: true,
+		},
+		"test_canceled": {
+			policy:   &types.RestartPolicy{Type: types.Always, RestartOnUnhealthy: true},
+			canceled: true,
+		},
+		"test_max_retry_count_reached": {
+			policy: &types.RestartPolicy{Type: types.OnFailure, MaximumRetryCount: 1, RestartOnUnhealthy: true},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			resMan := newRestartManager(testCase.policy, 1)
+			if testCase.canceled {
+				resMan.cancel()
+			}
+			testutil.AssertEqual(t, testCase.expected, resMan.shouldRestartOnUnhealthy())
+		})
+	}
+}
+
+func TestStartStopContainerHealthMonitor(t *testing.T) {
+	testMgr := &containerMgr{healthMonitorsCache: newHealthMonitorCache()}
+
+	ctr := newTestHealthCheckContainer(nil)
+	ctr.State.Health = nil
+	testMgr.startContainerHealthMonitor(ctr)
+	testutil.AssertNil(t, testMgr.healthMonitorsCache.get(ctr.ID))
+
+	ctr = newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Interval: time.Hour})
+	testMgr.startContainerHealthMonitor(ctr)
+	monitor := testMgr.healthMonitorsCache.get(ctr.ID)
+	testutil.AssertNotNil(t, monitor)
+
+	testMgr.stopContainerHealthMonitor(ctr)
+	testutil.AssertNil(t, testMgr.healthMonitorsCache.get(ctr.ID))
+	testutil.AssertTrue(t, monitor.isStopped())
+}
+
+func TestHealthMonitorProbeTimeout(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCtrClient := ctrMock.NewMockContainerAPIClient(controller)
+	ctr := newTestHealthCheckContainer(&types.HealthCheckConfig{Type: types.HealthCheckExec, Cmd: []string{"healthcheck"}, Timeout: time.Millisecond})
+	monitor := newHealthMonitor(&containerMgr{ctrClient: mockCtrClient}, ctr)
+
+	mockCtrClient.EXPECT().ExecContainer(gomock.Any(), ctr, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, container *types.Container, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error) {
+			<-ctx.Done()
+			return -1, ctx.Err()
+		})
+	_, err := monitor.probe("")
+	testutil.AssertError(t, context.DeadlineExceeded, err)
+}
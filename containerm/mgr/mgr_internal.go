@@ -14,8 +14,11 @@ package mgr
 
 import (
 	"context"
+	"net"
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
@@ -27,13 +30,57 @@ import (
 )
 
 const (
-	sigterm = "SIGTERM"
+	sigterm            = "SIGTERM"
+	checkpointsRootDir = "checkpoints"
 )
 
 func (mgr *containerMgr) getContainerMetaPath(containerID string) string {
 	return filepath.Join(mgr.metaPath, containersRootDir, containerID)
 }
 
+func (mgr *containerMgr) getContainerCheckpointsPath(containerID string) string {
+	return filepath.Join(mgr.getContainerMetaPath(containerID), checkpointsRootDir)
+}
+
+func (mgr *containerMgr) getContainerCheckpointPath(containerID, checkpointID string) string {
+	return filepath.Join(mgr.getContainerCheckpointsPath(containerID), checkpointID)
+}
+
+func (mgr *containerMgr) getExistingCheckpointPath(containerID, checkpointID string) (string, error) {
+	if err := util.ValidateCheckpointID(checkpointID); err != nil {
+		return "", err
+	}
+	checkpointDir := mgr.getContainerCheckpointPath(containerID, checkpointID)
+	if stat, err := os.Stat(checkpointDir); err != nil || !stat.IsDir() {
+		return "", log.NewErrorf("checkpoint with id = %s does not exist for container with id = %s", checkpointID, containerID)
+	}
+	return checkpointDir, nil
+}
+
+func (mgr *containerMgr) checkpointContainer(ctx context.Context, container *types.Container, checkpointID string) error {
+	container.Lock()
+	defer container.Unlock()
+
+	if !util.IsContainerRunningOrPaused(container) {
+		return log.NewErrorf("container with id = %s is not running - current status is %s", container.ID, container.State.Status.String())
+	}
+	checkpointDir := mgr.getContainerCheckpointPath(container.ID, checkpointID)
+	if _, err := os.Stat(checkpointDir); err == nil {
+		return log.NewErrorf("checkpoint with id = %s already exists for container with id = %s", checkpointID, container.ID)
+	}
+	if err := os.MkdirAll(checkpointDir, 0700); err != nil {
+		return err
+	}
+	if err := mgr.ctrClient.CheckpointContainer(ctx, container, checkpointDir); err != nil {
+		log.ErrorErr(err, "error creating checkpoint %s for container id = %s", checkpointID, container.ID)
+		if cleanupErr := os.RemoveAll(checkpointDir); cleanupErr != nil {
+			log.WarnErr(cleanupErr, "could not remove checkpoint directory %s", checkpointDir)
+		}
+		return err
+	}
+	return nil
+}
+
 func (mgr *containerMgr) fillCurrentDefaults(ctrs []*types.Container) {
 	if ctrs != nil && len(ctrs) > 0 {
 		for _, ctr := range ctrs {
@@ -91,7 +138,7 @@ func (mgr *containerMgr) applyRestartPolicy(ctx context.Context, container *type
 		go func() {
 			err := <-wait
 			if err == nil {
-				if err = mgr.processStartContainer(ctx, container.ID, false); err != nil {
+				if err = mgr.processStartContainer(ctx, container.ID, "", false); err != nil {
 					log.DebugErr(err, "failed to restart container id = %s", container.ID)
 				}
 			}
@@ -118,6 +165,106 @@ func (mgr *containerMgr) releaseContainerResources(container *types.Container) e
 	return nil
 }
 
+// acquireContainerVolumes marks the named volumes mounted in the container as used by it and sets the paths to their data.
+func (mgr *containerMgr) acquireContainerVolumes(ctx context.Context, container *types.Container) error {
+	for idx, mount := range container.Mounts {
+		if mount.Type != types.MountTypeVolume {
+			continue
+		}
+		volume, err := mgr.volumeMgr.Acquire(ctx, mount.Source, container.ID)
+		if err != nil {
+			log.ErrorErr(err, "could not acquire volume with name = %s for container id = %s", mount.Source, container.ID)
+			mgr.releaseContainerVolumes(ctx, &types.Container{ID: container.ID, Mounts: container.Mounts[:idx]})
+			return err
+		}
+		container.Mounts[idx].VolumePath = volume.Mountpoint
+	}
+	return nil
+}
+
+// releaseContainerVolumes marks the named volumes mounted in the container as no longer used by it
+func (mgr *containerMgr) releaseContainerVolumes(ctx context.Context, container *types.Container) {
+	for _, mount := range container.Mounts {
+		if mount.Type != types.MountTypeVolume {
+			continue
+		}
+		if err := mgr.volumeMgr.Release(ctx, mount.Source, container.ID); err != nil {
+			log.WarnErr(err, "could not release volume with name = %s for container id = %s", mount.Source, container.ID)
+		}
+	}
+}
+
+// releaseContainerNetworks marks the networks configured for the container as no longer used by it
+func (mgr *containerMgr) releaseContainerNetworks(ctx context.Context, container *types.Container) {
+	if err := mgr.netMgr.ReleaseNetworks(ctx, container); err != nil {
+		log.WarnErr(err, "could not release the networks for container id = %s", container.ID)
+	}
+}
+
+// checkContainerNetworks checks that the user-defined networks the container is connected to exist
+// or that the container whose network stack is shared exists
+func (mgr *containerMgr) checkContainerNetworks(ctx context.Context, container *types.Container) error {
+	if util.IsContainerNetworkContainer(container) {
+		target, err := mgr.getNetworkModeTarget(container)
+		if err != nil {
+			return err
+		}
+		// the container is referenced by its ID as the names can be changed
+		container.HostConfig.NetworkMode = types.NetworkMode(types.NetworkModeContainerPrefix + target.ID)
+		return nil
+	}
+	var networks []string
+	if util.IsContainerNetworkBridge(container) && container.HostConfig.NetworkMode != types.NetworkModeBridge {
+		networks = append(networks, string(container.HostConfig.NetworkMode))
+	}
+	for _, network := range append(networks, container.HostConfig.Networks...) {
+		if _, err := mgr.netMgr.GetNetwork(ctx, network); err != nil {
+			return err
+		}
+	}
+	return mgr.checkContainerStaticAddresses(ctx, container)
+}
+
+// checkContainerStaticAddresses checks that the static IPv4 addresses of the container are within the subnets of their networks
+// and that none of the static addresses is already requested by another container in the same network
+func (mgr *containerMgr) checkContainerStaticAddresses(ctx context.Context, container *types.Container) error {
+	for netName, epConfig := range container.HostConfig.EndpointsConfig {
+		if epConfig.IPv4Address != "" {
+			network, err := mgr.netMgr.GetNetwork(ctx, netName)
+			if err != nil {
+				return err
+			}
+			if _, subnet, err := net.ParseCIDR(network.Subnet); err == nil && !subnet.Contains(net.ParseIP(epConfig.IPv4Address)) {
+				return log.NewErrorf("the IPv4 address %s is not within the subnet %s of network %s", epConfig.IPv4Address, network.Subnet, netName)
+			}
+		}
+	}
+
+	mgr.containersLock.RLock()
+	defer mgr.containersLock.RUnlock()
+	for _, ctr := range mgr.containers {
+		if ctr.ID == container.ID || ctr.HostConfig == nil {
+			continue
+		}
+		for netName, epConfig := range container.HostConfig.EndpointsConfig {
+			ctrEpConfig := ctr.HostConfig.EndpointsConfig[netName]
+			if ctrEpConfig == nil {
+				continue
+			}
+			if epConfig.IPv4Address != "" && net.ParseIP(epConfig.IPv4Address).Equal(net.ParseIP(ctrEpConfig.IPv4Address)) {
+				return log.NewErrorf("the IPv4 address %s in network %s is already assigned to container id = %s", epConfig.IPv4Address, netName, ctr.ID)
+			}
+			if epConfig.IPv6Address != "" && net.ParseIP(epConfig.IPv6Address).Equal(net.ParseIP(ctrEpConfig.IPv6Address)) {
+				return log.NewErrorf("the IPv6 address %s in network %s is already assigned to container id = %s", epConfig.IPv6Address, netName, ctr.ID)
+			}
+			if epConfig.MacAddress != "" && strings.EqualFold(epConfig.MacAddress, ctrEpConfig.MacAddress) {
+				return log.NewErrorf("the MAC address %s in network %s is already assigned to container id = %s", epConfig.MacAddress, netName, ctr.ID)
+			}
+		}
+	}
+	return nil
+}
+
 // the mgr.containersLock must be used when calling this method
 func (mgr *containerMgr) containersToArray() []*types.Container {
 	if mgr.containers == nil || len(mgr.containers) == 0 {
@@ -143,7 +290,9 @@ func (mgr *containerMgr) updateConfigToStopped(ctx context.Context, c *types.Con
 	if err != nil {
 		errMsg = err.Error()
 	}
+	mgr.stopContainerHealthMonitor(c)
 	util.SetContainerStatusStopped(c, code, errMsg)
+	mgr.stopNetworkModeDependents(c)
 	// publish event
 	if pubErr := mgr.publishContainerStateChangedEvent(ctx, types.EventActionContainersStopped, c); pubErr != nil {
 		log.ErrorErr(pubErr, "failed to publish event for container %+v", c)
@@ -172,7 +321,9 @@ func (mgr *containerMgr) updateConfigToExited(ctx context.Context, c *types.Cont
 	if err != nil {
 		errMsg = err.Error()
 	}
+	mgr.stopContainerHealthMonitor(c)
 	util.SetContainerStatusExited(c, code, errMsg, oomKilled)
+	mgr.stopNetworkModeDependents(c)
 	// publish event
 	if pubErr := mgr.publishContainerStateChangedEvent(ctx, types.EventActionContainersExited, c); pubErr != nil {
 		log.ErrorErr(pubErr, "failed to publish event for container %+v", c)
@@ -246,7 +397,7 @@ func (mgr *containerMgr) cancelContainerRestartManager(container *types.Containe
 	mgr.getContainerRestartManager(container).cancel()
 }
 
-func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, resetResMan bool) error {
+func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, checkpointDir string, resetResMan bool) error {
 	container := mgr.getContainerFromCache(id)
 	if container == nil {
 		return log.NewErrorf(noSuchContainerErrorMsg, id)
@@ -290,6 +441,12 @@ func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, r
 		return err
 	}
 
+	if util.IsContainerNetworkContainer(container) {
+		if err = mgr.joinNetworkModeTarget(container); err != nil {
+			return err
+		}
+	}
+
 	if _, errMeta := mgr.containerRepository.Save(container); errMeta != nil {
 		log.ErrorErr(errMeta, failedConfigStoringErrorMsg)
 	}
@@ -299,7 +456,7 @@ func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, r
 		container.ManuallyStopped = false
 	}
 
-	pid, err = mgr.ctrClient.StartContainer(ctx, container, "")
+	pid, err = mgr.ctrClient.StartContainer(ctx, container, checkpointDir)
 	if err != nil {
 		_ = mgr.updateConfigToStopped(ctx, container, -1, err, true)
 		return err
@@ -308,6 +465,8 @@ func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, r
 	container.StartedSuccessfullyBefore = true
 
 	util.SetContainerStatusRunning(container, pid)
+	mgr.startContainerHealthMonitor(container)
+	mgr.startNetworkModeDependents(container)
 	// publish event
 	if pubErr := mgr.publishContainerStateChangedEvent(ctx, types.EventActionContainersRunning, container); pubErr != nil {
 		log.ErrorErr(pubErr, "failed to publish event for container %+v", container)
@@ -320,16 +479,26 @@ func (mgr *containerMgr) processStartContainer(ctx context.Context, id string, r
 
 func (mgr *containerMgr) startRestoredContainers(ctx context.Context, containers []*types.Container) {
 	ctrsToRestart := make(map[*types.Container]chan struct{})
+	// the containers sharing the network stack of another container are started after all others
+	networkModeDependentsToRestart := make(map[*types.Container]chan struct{})
 	for _, ctr := range containers {
 		if !util.IsContainerDead(ctr) && !util.IsContainerRunningOrPaused(ctr) {
 			mgr.resetContainerRestartManager(ctr, false)
 			if res, _, _ := mgr.getContainerRestartManager(ctr).shouldRestart(uint32(ctr.State.ExitCode), ctr.ManuallyStopped, util.CalculateUptime(ctr)); res && ctr.StartedSuccessfullyBefore {
-				ctrsToRestart[ctr] = make(chan struct{})
+				if util.IsContainerNetworkContainer(ctr) {
+					networkModeDependentsToRestart[ctr] = make(chan struct{})
+				} else {
+					ctrsToRestart[ctr] = make(chan struct{})
+				}
 			}
 		}
 	}
 	parallelLimit := util.CalculateParallelLimit(len(containers), 128*runtime.NumCPU())
+	mgr.startContainersInParallel(ctx, ctrsToRestart, parallelLimit)
+	mgr.startContainersInParallel(ctx, networkModeDependentsToRestart, parallelLimit)
+}
 
+func (mgr *containerMgr) startContainersInParallel(ctx context.Context, ctrsToRestart map[*types.Container]chan struct{}, parallelLimit int) {
 	// Re-used for all parallel startup jobs.
 	var group sync.WaitGroup
 	sem := semaphore.NewWeighted(int64(parallelLimit))
@@ -339,7 +508,7 @@ func (mgr *containerMgr) startRestoredContainers(ctx context.Context, containers
 		go func(c *types.Container, chNotify chan struct{}) {
 			_ = sem.Acquire(context.Background(), 1)
 			log.Debug("Starting container %s", c.ID)
-			if err := mgr.processStartContainer(ctx, c.ID, true); err != nil {
+			if err := mgr.processStartContainer(ctx, c.ID, "", true); err != nil {
 				log.ErrorErr(err, "failed to start container %s", c.ID)
 			}
 			close(chNotify)
@@ -22,9 +22,10 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/network"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/eclipse-kanto/container-management/containerm/volumes"
 )
 
-func newContainerMgr(metaPath string, execPath string, defaultCtrsStopTimeout time.Duration, ctrClient ctr.ContainerAPIClient, netMgr network.ContainerNetworkManager, eventsMgr events.ContainerEventsManager) (ContainerManager, error) {
+func newContainerMgr(metaPath string, execPath string, defaultCtrsStopTimeout time.Duration, ctrClient ctr.ContainerAPIClient, netMgr network.ContainerNetworkManager, eventsMgr events.ContainerEventsManager, volumeMgr volumes.VolumeManager) (ContainerManager, error) {
 	if err := util.MkDir(execPath); err != nil {
 		return nil, err
 	}
@@ -43,8 +44,10 @@ func newContainerMgr(metaPath string, execPath string, defaultCtrsStopTimeout ti
 		ctrClient:              ctrClient,
 		netMgr:                 netMgr,
 		eventsMgr:              eventsMgr,
+		volumeMgr:              volumeMgr,
 		containers:             make(map[string]*types.Container),
 		restartCtrsMgrCache:    newRestartMgrCache(),
+		healthMonitorsCache:    newHealthMonitorCache(),
 		containerRepository:    &ctrRepository,
 	}
 	ctrClient.SetContainerExitHooks(manager.exitedAndRelease)
@@ -66,6 +69,11 @@ func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, er
 		return nil, errEvtsMgr
 	}
 
+	volumesManagerService, errVolumesMgr := registryCtx.Get(registry.VolumesManagerService)
+	if errVolumesMgr != nil {
+		return nil, errVolumesMgr
+	}
+
 	allServices, err = registryCtx.GetByType(registry.ContainerClientService)
 	if err != nil {
 		return nil, err
@@ -96,6 +104,6 @@ func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, er
 	}
 
 	//initialize the manager local service
-	return newContainerMgr(mgrOpts.metaPath, mgrOpts.rootExec, mgrOpts.defaultCtrsStopTimeout, ctrClientService.(ctr.ContainerAPIClient), netMgrService.(network.ContainerNetworkManager), eventsManagerService.(events.ContainerEventsManager))
+	return newContainerMgr(mgrOpts.metaPath, mgrOpts.rootExec, mgrOpts.defaultCtrsStopTimeout, ctrClientService.(ctr.ContainerAPIClient), netMgrService.(network.ContainerNetworkManager), eventsManagerService.(events.ContainerEventsManager), volumesManagerService.(volumes.VolumeManager))
 
 }
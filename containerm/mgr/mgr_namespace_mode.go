@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"fmt"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+// checkNamespaceModeTargets checks that the containers whose IPC or PID namespaces are shared by the provided one exist
+// and references them by their IDs as the names can be changed
+func (mgr *containerMgr) checkNamespaceModeTargets(container *types.Container) error {
+	var err error
+	if container.HostConfig.IpcMode, err = mgr.resolveNamespaceModeTarget(container, "IPC", container.HostConfig.IpcMode); err != nil {
+		return err
+	}
+	container.HostConfig.PidMode, err = mgr.resolveNamespaceModeTarget(container, "PID", container.HostConfig.PidMode)
+	return err
+}
+
+func (mgr *containerMgr) resolveNamespaceModeTarget(container *types.Container, namespace string, mode types.NamespaceMode) (types.NamespaceMode, error) {
+	ref := util.GetNamespaceModeTarget(mode)
+	if ref == "" {
+		return mode, nil
+	}
+	target, err := mgr.getModeTarget(ref, fmt.Sprintf("%s namespace mode %s", namespace, mode))
+	if err != nil {
+		return mode, err
+	}
+	if target.ID == container.ID {
+		return mode, log.NewErrorf("container with id = %s cannot share its own %s namespace", container.ID, namespace)
+	}
+	return types.NamespaceMode(types.NamespaceModeContainerPrefix + target.ID), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	ctrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
+	eventsMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
+	mgrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	networkMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateContainerWithNamespaceModeContainer(t *testing.T) {
+	tests := map[string]struct {
+		ipcMode         types.NamespaceMode
+		pidMode         types.NamespaceMode
+		containers      []*types.Container
+		expectedIpcMode types.NamespaceMode
+		expectedPidMode types.NamespaceMode
+		expectedErr     error
+	}{
+		"test_target_by_name": {
+			ipcMode:         types.NamespaceModeContainerPrefix + testTargetCtrName,
+			pidMode:         types.NamespaceModeHost,
+			containers:      []*types.Container{newTestNetworkModeTarget(true)},
+			expectedIpcMode: types.NamespaceModeContainerPrefix + testTargetCtrID,
+			expectedPidMode: types.NamespaceModeHost,
+		},
+		"test_target_by_id": {
+			ipcMode:         types.NamespaceModeContainerPrefix + testTargetCtrID,
+			pidMode:         types.NamespaceModeContainerPrefix + testTargetCtrName,
+			containers:      []*types.Container{newTestNetworkModeTarget(false)},
+			expectedIpcMode: types.NamespaceModeContainerPrefix + testTargetCtrID,
+			expectedPidMode: types.NamespaceModeContainerPrefix + testTargetCtrID,
+		},
+		"test_target_missing": {
+			pidMode:     types.NamespaceModeContainerPrefix + testTargetCtrName,
+			expectedErr: log.NewErrorf("no container with name or ID = %s exists for PID namespace mode %s%s", testTargetCtrName, types.NamespaceModeContainerPrefix, testTargetCtrName),
+		},
+		"test_target_name_ambiguous": {
+			ipcMode:     types.NamespaceModeContainerPrefix + testTargetCtrName,
+			containers:  []*types.Container{newTestNetworkModeTarget(true), {ID: "other-id", Name: testTargetCtrName}},
+			expectedErr: log.NewErrorf("more than one container with name = %s exists - use the container's ID for IPC namespace mode %s%s", testTargetCtrName, types.NamespaceModeContainerPrefix, testTargetCtrName),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+			mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+
+			_, container := getDefaultContainer()
+			container.HostConfig.IpcMode = testCase.ipcMode
+			container.HostConfig.PidMode = testCase.pidMode
+
+			mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+			if testCase.expectedErr == nil {
+				mockNetworkManager.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				mockCtrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(nil)
+				mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersCreated, gomock.Any()).Return(nil)
+				mockRepository.EXPECT().Save(container).Times(1)
+			}
+
+			cache := map[string]*types.Container{}
+			for _, ctr := range testCase.containers {
+				cache[ctr.ID] = ctr
+			}
+			unitUnderTest := createContainerManagerWithCustomMocks(
+				"../pkg/testutil/metapath/empty",
+				mockCtrClient,
+				mockNetworkManager,
+				mockEventsManager,
+				mockRepository,
+				cache)
+
+			_, err := unitUnderTest.Create(context.Background(), container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if err == nil {
+				testutil.AssertEqual(t, testCase.expectedIpcMode, container.HostConfig.IpcMode)
+				testutil.AssertEqual(t, testCase.expectedPidMode, container.HostConfig.PidMode)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+)
+
+const networkNamespacePathFormat = "/proc/%d/ns/net"
+
+// getModeTarget returns the container with the provided name or ID which is referenced in the provided network or namespace mode
+func (mgr *containerMgr) getModeTarget(ref string, mode string) (*types.Container, error) {
+	target := mgr.getContainerFromCache(ref)
+	if target == nil {
+		mgr.containersLock.RLock()
+		for _, ctr := range mgr.containers {
+			if ctr.Name != ref {
+				continue
+			}
+			if target != nil {
+				mgr.containersLock.RUnlock()
+				return nil, log.NewErrorf("more than one container with name = %s exists - use the container's ID for %s", ref, mode)
+			}
+			target = ctr
+		}
+		mgr.containersLock.RUnlock()
+	}
+	if target == nil {
+		return nil, log.NewErrorf("no container with name or ID = %s exists for %s", ref, mode)
+	}
+	return target, nil
+}
+
+// getNetworkModeTarget returns the container whose network stack is shared by the provided one in network mode container:<name|id>
+func (mgr *containerMgr) getNetworkModeTarget(container *types.Container) (*types.Container, error) {
+	target, err := mgr.getModeTarget(util.GetContainerNetworkModeTarget(container), fmt.Sprintf("network mode %s", container.HostConfig.NetworkMode))
+	if err != nil {
+		return nil, err
+	}
+	if target.ID == container.ID {
+		return nil, log.NewErrorf("container with id = %s cannot share its own network stack", container.ID)
+	}
+	if util.IsContainerNetworkContainer(target) {
+		return nil, log.NewErrorf("container with id = %s shares the network stack of another container and its network stack cannot be shared", target.ID)
+	}
+	return target, nil
+}
+
+// getNetworkModeDependents returns the containers that share the network stack of the container with the provided ID
+func (mgr *containerMgr) getNetworkModeDependents(targetID string) []*types.Container {
+	mgr.containersLock.RLock()
+	defer mgr.containersLock.RUnlock()
+	var dependents []*types.Container
+	for _, ctr := range mgr.containers {
+		if util.GetContainerNetworkModeTarget(ctr) == targetID {
+			dependents = append(dependents, ctr)
+		}
+	}
+	return dependents
+}
+
+// joinNetworkModeTarget sets the network namespace and the network related files of the running container whose network stack is shared
+// the container's lock must be held when calling this method
+func (mgr *containerMgr) joinNetworkModeTarget(container *types.Container) error {
+	target, err := mgr.getNetworkModeTarget(container)
+	if err != nil {
+		return err
+	}
+	target.Lock()
+	defer target.Unlock()
+
+	if !target.State.Running {
+		return log.NewErrorf("container with id = %s is not running - cannot share its network stack with container id = %s", target.ID, container.ID)
+	}
+	for source, dest := range map[string]string{target.HostsPath: container.HostsPath, target.ResolvConfPath: container.ResolvConfPath} {
+		data, err := ioutil.ReadFile(source)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(dest, data, 0644); err != nil {
+			return err
+		}
+	}
+	container.NetworkSettings = &types.NetworkSettings{SandboxKey: fmt.Sprintf(networkNamespacePathFormat, target.State.Pid)}
+	log.Debug("container id = %s will share the network stack of container id = %s", container.ID, target.ID)
+	return nil
+}
+
+// stopNetworkModeDependents stops the containers that share the network stack of the provided container as it is no longer available.
+// The dependents are resolved asynchronously as this method can be called while the mgr.containersLock is held.
+func (mgr *containerMgr) stopNetworkModeDependents(target *types.Container) {
+	go func() {
+		for _, dependent := range mgr.getNetworkModeDependents(target.ID) {
+			if err := mgr.stopContainer(context.Background(), dependent, mgr.getContainerStopOptions(false), false); err != nil {
+				log.DebugErr(err, "did not stop container id = %s sharing the network stack of container id = %s", dependent.ID, target.ID)
+				continue
+			}
+			log.Debug("stopped container id = %s as the network stack of container id = %s is no longer available", dependent.ID, target.ID)
+		}
+	}()
+}
+
+// startNetworkModeDependents starts the containers that share the network stack of the provided container and were stopped when it was no longer available.
+// The dependents are resolved asynchronously as this method can be called while the mgr.containersLock is held.
+func (mgr *containerMgr) startNetworkModeDependents(target *types.Container) {
+	go func() {
+		for _, dependent := range mgr.getNetworkModeDependents(target.ID) {
+			dependent.Lock()
+			start := dependent.State.Status == types.Stopped && !dependent.ManuallyStopped && dependent.StartedSuccessfullyBefore
+			dependent.Unlock()
+			if !start {
+				continue
+			}
+			if err := mgr.processStartContainer(context.Background(), dependent.ID, "", false); err != nil {
+				log.ErrorErr(err, "failed to start container id = %s sharing the network stack of container id = %s", dependent.ID, target.ID)
+			}
+		}
+	}()
+}
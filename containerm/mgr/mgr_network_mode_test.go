@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	ctrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
+	eventsMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
+	mgrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	networkMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
+
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	testTargetCtrID    = "target-ctr-id"
+	testTargetCtrName  = "target"
+	testTargetCtrPid   = 1234
+	testDependentCtrID = "dependent-ctr-id"
+)
+
+func newTestNetworkModeTarget(running bool) *types.Container {
+	return &types.Container{
+		ID:         testTargetCtrID,
+		Name:       testTargetCtrName,
+		HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+		State:      &types.State{Running: running, Pid: testTargetCtrPid},
+	}
+}
+
+func TestCreateContainerWithNetworkModeContainer(t *testing.T) {
+	tests := map[string]struct {
+		networkMode         types.NetworkMode
+		containers          []*types.Container
+		expectedNetworkMode types.NetworkMode
+		expectedErr         error
+	}{
+		"test_target_by_name": {
+			networkMode:         types.NetworkModeContainerPrefix + testTargetCtrName,
+			containers:          []*types.Container{newTestNetworkModeTarget(true)},
+			expectedNetworkMode: types.NetworkModeContainerPrefix + testTargetCtrID,
+		},
+		"test_target_by_id": {
+			networkMode:         types.NetworkModeContainerPrefix + testTargetCtrID,
+			containers:          []*types.Container{newTestNetworkModeTarget(false)},
+			expectedNetworkMode: types.NetworkModeContainerPrefix + testTargetCtrID,
+		},
+		"test_target_missing": {
+			networkMode: types.NetworkModeContainerPrefix + testTargetCtrName,
+			expectedErr: log.NewErrorf("no container with name or ID = %s exists for network mode %s%s", testTargetCtrName, types.NetworkModeContainerPrefix, testTargetCtrName),
+		},
+		"test_target_name_ambiguous": {
+			networkMode: types.NetworkModeContainerPrefix + testTargetCtrName,
+			containers:  []*types.Container{newTestNetworkModeTarget(true), {ID: "other-id", Name: testTargetCtrName}},
+			expectedErr: log.NewErrorf("more than one container with name = %s exists - use the container's ID for network mode %s%s", testTargetCtrName, types.NetworkModeContainerPrefix, testTargetCtrName),
+		},
+		"test_target_network_mode_container": {
+			networkMode: types.NetworkModeContainerPrefix + testTargetCtrID,
+			containers: []*types.Container{{
+				ID:         testTargetCtrID,
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeContainerPrefix + "other-id"},
+			}},
+			expectedErr: log.NewErrorf("container with id = %s shares the network stack of another container and its network stack cannot be shared", testTargetCtrID),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+			mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+
+			_, container := getDefaultContainer()
+			container.HostConfig.NetworkMode = testCase.networkMode
+			container.HostConfig.PortMappings = nil
+			container.HostConfig.ExtraHosts = nil
+
+			mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+			if testCase.expectedErr == nil {
+				mockNetworkManager.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				mockCtrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(nil)
+				mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersCreated, gomock.Any()).Return(nil)
+				mockRepository.EXPECT().Save(container).Times(1)
+			}
+
+			cache := map[string]*types.Container{}
+			for _, ctr := range testCase.containers {
+				cache[ctr.ID] = ctr
+			}
+			unitUnderTest := createContainerManagerWithCustomMocks(
+				"../pkg/testutil/metapath/empty",
+				mockCtrClient,
+				mockNetworkManager,
+				mockEventsManager,
+				mockRepository,
+				cache)
+
+			_, err := unitUnderTest.Create(context.Background(), container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if err == nil {
+				testutil.AssertEqual(t, testCase.expectedNetworkMode, container.HostConfig.NetworkMode)
+			}
+		})
+	}
+}
+
+func TestJoinNetworkModeTarget(t *testing.T) {
+	tests := map[string]struct {
+		target      *types.Container
+		expectedErr error
+	}{
+		"test_join_running_target": {
+			target: newTestNetworkModeTarget(true),
+		},
+		"test_join_not_running_target": {
+			target:      newTestNetworkModeTarget(false),
+			expectedErr: log.NewErrorf("container with id = %s is not running - cannot share its network stack with container id = %s", testTargetCtrID, testDependentCtrID),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dir := t.TempDir()
+			target := testCase.target
+			target.HostsPath = filepath.Join(dir, "target-hosts")
+			target.ResolvConfPath = filepath.Join(dir, "target-resolv.conf")
+			testutil.AssertNil(t, ioutil.WriteFile(target.HostsPath, []byte("127.0.0.1 localhost\n"), 0644))
+			testutil.AssertNil(t, ioutil.WriteFile(target.ResolvConfPath, []byte("nameserver 172.17.0.1\n"), 0644))
+
+			container := &types.Container{
+				ID:             testDependentCtrID,
+				HostConfig:     &types.HostConfig{NetworkMode: types.NetworkModeContainerPrefix + testTargetCtrID},
+				HostsPath:      filepath.Join(dir, "hosts"),
+				ResolvConfPath: filepath.Join(dir, "resolv.conf"),
+			}
+			unitUnderTest := &containerMgr{containers: map[string]*types.Container{target.ID: target}}
+
+			err := unitUnderTest.joinNetworkModeTarget(container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if err != nil {
+				testutil.AssertNil(t, container.NetworkSettings)
+				return
+			}
+			testutil.AssertEqual(t, fmt.Sprintf("/proc/%d/ns/net", testTargetCtrPid), container.NetworkSettings.SandboxKey)
+			for source, dest := range map[string]string{target.HostsPath: container.HostsPath, target.ResolvConfPath: container.ResolvConfPath} {
+				expected, _ := ioutil.ReadFile(source)
+				actual, err := ioutil.ReadFile(dest)
+				testutil.AssertNil(t, err)
+				testutil.AssertEqual(t, expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetNetworkModeDependents(t *testing.T) {
+	dependent := &types.Container{ID: testDependentCtrID, HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeContainerPrefix + testTargetCtrID}}
+	other := &types.Container{ID: "other-id", HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeContainerPrefix + "other-target-id"}}
+	target := newTestNetworkModeTarget(true)
+	unitUnderTest := &containerMgr{containers: map[string]*types.Container{target.ID: target, dependent.ID: dependent, other.ID: other}}
+
+	testutil.AssertEqual(t, []*types.Container{dependent}, unitUnderTest.getNetworkModeDependents(testTargetCtrID))
+	testutil.AssertNil(t, unitUnderTest.getNetworkModeDependents(testDependentCtrID))
+}
+
+func TestStopManagerServiceWithRunningContainers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+	mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+	mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+	mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+
+	target := newTestNetworkModeTarget(true)
+	target.State.Status = types.Running
+	target.HostConfig.RestartPolicy = &types.RestartPolicy{Type: types.No}
+	mockCtrClient.EXPECT().DestroyContainer(gomock.Any(), target, gomock.Any(), false).Return(int64(0), time.Now(), nil)
+	mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersStopped, gomock.Any()).Return(nil)
+	mockCtrClient.EXPECT().ReleaseContainerResources(gomock.Any(), target).Return(nil)
+	mockNetworkManager.EXPECT().ReleaseNetworkResources(gomock.Any(), target).Return(nil)
+	mockRepository.EXPECT().Save(target)
+
+	unitUnderTest := createContainerManagerWithCustomMocks(
+		"../pkg/testutil/metapath/empty",
+		mockCtrClient,
+		mockNetworkManager,
+		mockEventsManager,
+		mockRepository,
+		map[string]*types.Container{target.ID: target})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- unitUnderTest.stopManagerService(context.Background())
+	}()
+	select {
+	case err := <-done:
+		testutil.AssertNil(t, err)
+		testutil.AssertEqual(t, types.Stopped, target.State.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stopping the container manager service with running containers did not finish")
+	}
+}
@@ -132,6 +132,27 @@ func (rm *restartManager) shouldRestart(exitCode uint32, hasBeenManuallyStopped
 	return true, ch, nil
 }
 
+func (rm *restartManager) shouldRestartOnUnhealthy() bool {
+	if util.IsRestartPolicyNone(rm.restartPolicy) || !rm.restartPolicy.RestartOnUnhealthy {
+		return false
+	}
+	rm.Lock()
+	defer rm.Unlock()
+
+	if rm.isCanceled || rm.isActive {
+		return false
+	}
+	if util.IsRestartPolicyOnFailure(rm.restartPolicy) {
+		if max := rm.restartPolicy.MaximumRetryCount; max != 0 && rm.restartsPerformed >= max {
+			log.Debug("restart manager retry count %d reached the policy's max retry count - will not restart the unhealthy container", rm.restartsPerformed)
+			return false
+		}
+	}
+	rm.restartsPerformed++
+	log.Debug("incremented restart manager retry count to %d", rm.restartsPerformed)
+	return true
+}
+
 func (rm *restartManager) cancel() error {
 	rm.Do(func() {
 		rm.Lock()
@@ -14,6 +14,7 @@ package mgr
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -24,14 +25,17 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/events"
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/network"
+	networkTypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil/matchers"
 	ctrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
 	eventsMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
 	mgrMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
 	networkMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
+	volumesMock "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/volumes"
 	"github.com/eclipse-kanto/container-management/containerm/streams"
 	errorUtil "github.com/eclipse-kanto/container-management/containerm/util/error"
+	volumeTypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -243,6 +247,63 @@ func TestUpdateRunningContainer(t *testing.T) {
 	testutil.AssertNil(t, err)
 }
 
+func TestUpdateRunningContainerNetworkPolicy(t *testing.T) {
+	// Set UP
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+	mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+	mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+	mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+	ctx := context.Background()
+
+	policy := &types.NetworkPolicy{
+		EgressRate:    1048576,
+		EgressDefault: types.EgressActionDeny,
+		EgressRules:   []types.EgressRule{{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "443"}},
+	}
+	ctrID, container := getDefaultContainer()
+	metapath := "../pkg/testutil/metapath/valid"
+	cache := map[string]*types.Container{}
+
+	mockRepository.EXPECT().Prune().Times(1)
+	mockRepository.EXPECT().
+		ReadAll().
+		Return([]*types.Container{container}, nil)
+
+	unitUnderTest := createContainerManagerWithCustomMocks(
+		metapath, mockCtrClient,
+		mockNetworkManager, mockEventsManager,
+		mockRepository, cache)
+	unitUnderTest.Load(ctx)
+
+	t.Run("test_update_network_policy", func(t *testing.T) {
+		mockNetworkManager.EXPECT().UpdateNetworkPolicy(gomock.Any(), container, policy).Return(nil)
+		mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersUpdated, gomock.Any()).Times(1)
+		mockRepository.EXPECT().Save(gomock.Any()).Times(1)
+
+		testutil.AssertNil(t, unitUnderTest.Update(ctx, ctrID, &types.UpdateOpts{NetworkPolicy: policy}))
+		testutil.AssertEqual(t, policy, container.HostConfig.NetworkPolicy)
+	})
+	t.Run("test_update_network_policy_error", func(t *testing.T) {
+		updateErr := log.NewError("test error")
+		newPolicy := &types.NetworkPolicy{IngressRate: 1024}
+		mockNetworkManager.EXPECT().UpdateNetworkPolicy(gomock.Any(), container, newPolicy).Return(updateErr)
+
+		testutil.AssertError(t, updateErr, unitUnderTest.Update(ctx, ctrID, &types.UpdateOpts{NetworkPolicy: newPolicy}))
+		testutil.AssertEqual(t, policy, container.HostConfig.NetworkPolicy)
+	})
+	t.Run("test_remove_network_policy", func(t *testing.T) {
+		mockNetworkManager.EXPECT().UpdateNetworkPolicy(gomock.Any(), container, &types.NetworkPolicy{}).Return(nil)
+		mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersUpdated, gomock.Any()).Times(1)
+		mockRepository.EXPECT().Save(gomock.Any()).Times(1)
+
+		testutil.AssertNil(t, unitUnderTest.Update(ctx, ctrID, &types.UpdateOpts{NetworkPolicy: &types.NetworkPolicy{}}))
+		testutil.AssertNil(t, container.HostConfig.NetworkPolicy)
+	})
+}
+
 func TestUpdateContainerWithInvalidOpts(t *testing.T) {
 	// Set UP
 	mockCtrl := gomock.NewController(t)
@@ -288,6 +349,12 @@ func TestUpdateContainerWithInvalidOpts(t *testing.T) {
 			},
 			expectedErr: log.NewErrorf("invalid format of memory - %s", invalidMemory),
 		},
+		"test_with_invalid_network_policy": {
+			opts: &types.UpdateOpts{
+				NetworkPolicy: &types.NetworkPolicy{EgressDefault: "invalid"},
+			},
+			expectedErr: log.NewError("unsupported default egress action invalid"),
+		},
 		"test_with_nil_opts": {
 			opts:        nil,
 			expectedErr: nil,
@@ -358,6 +425,11 @@ func TestCreateValidContainer(t *testing.T) {
 		Save(container).
 		Times(1)
 
+	mockNetworkManager.
+		EXPECT().
+		AcquireNetworks(gomock.Any(), gomock.Eq(container)).
+		Return(nil)
+
 	mockCtrClient.
 		EXPECT().
 		CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).
@@ -454,6 +526,207 @@ func TestValidateWrongContainerName(t *testing.T) {
 	testutil.AssertError(t, expectedErr, err)
 }
 
+func TestCreateContainerWithVolumes(t *testing.T) {
+	const testVolumeMountpoint = "/var/lib/container-management/volumes/test-volume/_data"
+	testErr := log.NewError("test error")
+
+	tests := map[string]struct {
+		mockExec    func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, volumeMgr *volumesMock.MockVolumeManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager)
+		expectedErr error
+	}{
+		"test_volumes_acquired": {
+			mockExec: func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, volumeMgr *volumesMock.MockVolumeManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager) {
+				volumeMgr.EXPECT().Acquire(gomock.Any(), "test-volume", container.ID).Return(&volumeTypes.Volume{Name: "test-volume", Mountpoint: testVolumeMountpoint}, nil)
+				netMgr.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				ctrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(nil)
+				eventsMgr.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersCreated, gomock.Any()).Return(nil)
+				repository.EXPECT().Save(container).Times(1)
+			},
+		},
+		"test_acquire_volume_error": {
+			mockExec: func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, volumeMgr *volumesMock.MockVolumeManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager) {
+				volumeMgr.EXPECT().Acquire(gomock.Any(), "test-volume", container.ID).Return(nil, testErr)
+				ctrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: testErr,
+		},
+		"test_create_container_error_releases_volumes": {
+			mockExec: func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, volumeMgr *volumesMock.MockVolumeManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager) {
+				volumeMgr.EXPECT().Acquire(gomock.Any(), "test-volume", container.ID).Return(&volumeTypes.Volume{Name: "test-volume", Mountpoint: testVolumeMountpoint}, nil)
+				netMgr.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				ctrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(testErr)
+				netMgr.EXPECT().ReleaseNetworks(gomock.Any(), container).Return(nil)
+				volumeMgr.EXPECT().Release(gomock.Any(), "test-volume", container.ID).Return(nil)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+			mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+			mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+			mockVolumeManager := volumesMock.NewMockVolumeManager(mockCtrl)
+
+			_, container := getDefaultContainer()
+			container.Mounts = []types.MountPoint{
+				{Source: "/host/path", Destination: "/container/path", PropagationMode: types.RPrivatePropagationMode},
+				{Type: types.MountTypeVolume, Source: "test-volume", Destination: "/data", PropagationMode: types.RPrivatePropagationMode},
+			}
+
+			testCase.mockExec(container, mockCtrClient, mockNetworkManager, mockVolumeManager, mockRepository, mockEventsManager)
+
+			unitUnderTest := createContainerManagerWithCustomMocks(
+				"../pkg/testutil/metapath/empty",
+				mockCtrClient,
+				mockNetworkManager,
+				mockEventsManager,
+				mockRepository,
+				map[string]*types.Container{})
+			unitUnderTest.volumeMgr = mockVolumeManager
+
+			_, err := unitUnderTest.Create(context.Background(), container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if testCase.expectedErr == nil {
+				testutil.AssertEqual(t, "", container.Mounts[0].VolumePath)
+				testutil.AssertEqual(t, testVolumeMountpoint, container.Mounts[1].VolumePath)
+			}
+		})
+	}
+}
+
+func TestCreateContainerWithNetworks(t *testing.T) {
+	testErr := log.NewError("no such network with name = monitoring exists")
+
+	tests := map[string]struct {
+		mockExec    func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager)
+		expectedErr error
+	}{
+		"test_networks_available": {
+			mockExec: func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager) {
+				netMgr.EXPECT().GetNetwork(gomock.Any(), "backend").Return(&networkTypes.Network{Name: "backend"}, nil)
+				netMgr.EXPECT().GetNetwork(gomock.Any(), "monitoring").Return(&networkTypes.Network{Name: "monitoring"}, nil)
+				netMgr.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				ctrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(nil)
+				eventsMgr.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersCreated, gomock.Any()).Return(nil)
+				repository.EXPECT().Save(container).Times(1)
+			},
+		},
+		"test_network_missing": {
+			mockExec: func(container *types.Container, ctrClient *ctrMock.MockContainerAPIClient, netMgr *networkMock.MockContainerNetworkManager, repository *mgrMock.MockcontainerRepository, eventsMgr *eventsMock.MockContainerEventsManager) {
+				netMgr.EXPECT().GetNetwork(gomock.Any(), "backend").Return(&networkTypes.Network{Name: "backend"}, nil)
+				netMgr.EXPECT().GetNetwork(gomock.Any(), "monitoring").Return(nil, testErr)
+				ctrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+			mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+			mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+
+			_, container := getDefaultContainer()
+			container.HostConfig.NetworkMode = "backend"
+			container.HostConfig.Networks = []string{"monitoring"}
+
+			testCase.mockExec(container, mockCtrClient, mockNetworkManager, mockRepository, mockEventsManager)
+
+			unitUnderTest := createContainerManagerWithCustomMocks(
+				"../pkg/testutil/metapath/empty",
+				mockCtrClient,
+				mockNetworkManager,
+				mockEventsManager,
+				mockRepository,
+				map[string]*types.Container{})
+
+			_, err := unitUnderTest.Create(context.Background(), container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+		})
+	}
+}
+
+func TestCreateContainerWithStaticAddresses(t *testing.T) {
+	existing := &types.Container{
+		ID: "existing-ctr-id",
+		HostConfig: &types.HostConfig{
+			NetworkMode: "backend",
+			EndpointsConfig: map[string]*types.EndpointConfig{
+				"backend": {IPv4Address: "172.20.0.10", MacAddress: "02:42:ac:14:00:0a"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		epConfig    *types.EndpointConfig
+		expectedErr error
+	}{
+		"test_static_addresses_available": {
+			epConfig: &types.EndpointConfig{IPv4Address: "172.20.0.11", MacAddress: "02:42:ac:14:00:0b"},
+		},
+		"test_static_ipv4_not_in_subnet": {
+			epConfig:    &types.EndpointConfig{IPv4Address: "172.21.0.11"},
+			expectedErr: log.NewError("the IPv4 address 172.21.0.11 is not within the subnet 172.20.0.0/16 of network backend"),
+		},
+		"test_static_ipv4_conflict": {
+			epConfig:    &types.EndpointConfig{IPv4Address: "172.20.0.10"},
+			expectedErr: log.NewError("the IPv4 address 172.20.0.10 in network backend is already assigned to container id = existing-ctr-id"),
+		},
+		"test_static_mac_conflict": {
+			epConfig:    &types.EndpointConfig{MacAddress: "02:42:AC:14:00:0A"},
+			expectedErr: log.NewError("the MAC address 02:42:AC:14:00:0A in network backend is already assigned to container id = existing-ctr-id"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			mockNetworkManager := networkMock.NewMockContainerNetworkManager(mockCtrl)
+			mockEventsManager := eventsMock.NewMockContainerEventsManager(mockCtrl)
+			mockRepository := mgrMock.NewMockcontainerRepository(mockCtrl)
+
+			_, container := getDefaultContainer()
+			container.HostConfig.NetworkMode = "backend"
+			container.HostConfig.EndpointsConfig = map[string]*types.EndpointConfig{"backend": testCase.epConfig}
+
+			mockNetworkManager.EXPECT().GetNetwork(gomock.Any(), "backend").Return(&networkTypes.Network{Name: "backend", Subnet: "172.20.0.0/16"}, nil).AnyTimes()
+			if testCase.expectedErr == nil {
+				mockNetworkManager.EXPECT().AcquireNetworks(gomock.Any(), container).Return(nil)
+				mockCtrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Eq(container), gomock.Any()).Return(nil)
+				mockEventsManager.EXPECT().Publish(gomock.Any(), types.EventTypeContainers, types.EventActionContainersCreated, gomock.Any()).Return(nil)
+				mockRepository.EXPECT().Save(container).Times(1)
+			} else {
+				mockCtrClient.EXPECT().CreateContainer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			unitUnderTest := createContainerManagerWithCustomMocks(
+				"../pkg/testutil/metapath/empty",
+				mockCtrClient,
+				mockNetworkManager,
+				mockEventsManager,
+				mockRepository,
+				map[string]*types.Container{existing.ID: existing})
+
+			_, err := unitUnderTest.Create(context.Background(), container)
+			testutil.AssertError(t, testCase.expectedErr, err)
+		})
+	}
+}
+
 func TestDeleteContainerFromManager(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -494,6 +767,10 @@ func TestDeleteContainerFromManager(t *testing.T) {
 		ReleaseNetworkResources(gomock.Any(), gomock.Eq(container)).
 		Times(1)
 
+	mockNetworkManager.EXPECT().
+		ReleaseNetworks(gomock.Any(), gomock.Eq(container)).
+		Times(1)
+
 	mockEventsManager.EXPECT().Publish(
 		gomock.Any(),
 		types.EventTypeContainers,
@@ -1217,6 +1494,275 @@ func TestMetrics(t *testing.T) {
 	}
 }
 
+func TestExec(t *testing.T) {
+	const testCtrID = "test-ctr-id"
+	testExecConfig := &types.ExecConfig{Cmd: []string{"ls", "-la"}}
+
+	tests := map[string]struct {
+		ctr           *types.Container
+		execConfig    *types.ExecConfig
+		addCtrToCache bool
+		mockExec      func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error)
+	}{
+		"test_missing_in_cache": {
+			ctr:        &types.Container{ID: testCtrID},
+			execConfig: testExecConfig,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				return -1, log.NewErrorf(noSuchContainerErrorMsg, testCtrID)
+			},
+		},
+		"test_invalid_exec_config": {
+			ctr:           &types.Container{ID: testCtrID, State: &types.State{Running: true}},
+			execConfig:    &types.ExecConfig{},
+			addCtrToCache: true,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				return -1, log.NewError("the command to be executed must be provided")
+			},
+		},
+		"test_not_running": {
+			ctr:           &types.Container{ID: testCtrID, State: &types.State{Exited: true, Status: types.Exited}},
+			execConfig:    testExecConfig,
+			addCtrToCache: true,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				return -1, log.NewErrorf("container with id = %s is not running - current status is %s", testCtrID, types.Exited.String())
+			},
+		},
+		"test_paused": {
+			ctr:           &types.Container{ID: testCtrID, State: &types.State{Running: true, Paused: true, Status: types.Paused}},
+			execConfig:    testExecConfig,
+			addCtrToCache: true,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				return -1, log.NewErrorf("container with id = %s is not running - current status is %s", testCtrID, types.Paused.String())
+			},
+		},
+		"test_exec_error": {
+			ctr:           &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			execConfig:    testExecConfig,
+			addCtrToCache: true,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				err := log.NewError("test error")
+				client.EXPECT().ExecContainer(ctx, ctr, testExecConfig, attachConfig).Return(int64(-1), err)
+				return -1, err
+			},
+		},
+		"test_exec": {
+			ctr:           &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			execConfig:    testExecConfig,
+			addCtrToCache: true,
+			mockExec: func(ctx context.Context, ctr *types.Container, attachConfig *streams.AttachConfig, client *ctrMock.MockContainerAPIClient) (int64, error) {
+				client.EXPECT().ExecContainer(ctx, ctr, testExecConfig, attachConfig).Return(int64(2), nil)
+				return 2, nil
+			},
+		},
+	}
+	// run tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			testMgr := &containerMgr{
+				ctrClient:  mockCtrClient,
+				containers: make(map[string]*types.Container),
+			}
+			if testCase.addCtrToCache {
+				testMgr.containers[testCase.ctr.ID] = testCase.ctr
+			}
+			ctx := context.Background()
+			attachConfig := &streams.AttachConfig{}
+			expectedExitCode, expectedErr := testCase.mockExec(ctx, testCase.ctr, attachConfig, mockCtrClient)
+			exitCode, err := testMgr.Exec(ctx, testCase.ctr.ID, testCase.execConfig, attachConfig)
+
+			testutil.AssertError(t, expectedErr, err)
+			testutil.AssertEqual(t, expectedExitCode, exitCode)
+		})
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	const (
+		testCtrID        = "test-ctr-id"
+		testCheckpointID = "test-checkpoint"
+	)
+
+	tests := map[string]struct {
+		ctr            *types.Container
+		checkpointOpts *types.CheckpointOpts
+		addCtrToCache  bool
+		existing       bool
+		mockExec       func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error
+	}{
+		"test_missing_in_cache": {
+			ctr:            &types.Container{ID: testCtrID},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				return log.NewErrorf(noSuchContainerErrorMsg, testCtrID)
+			},
+		},
+		"test_invalid_checkpoint_opts": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			checkpointOpts: &types.CheckpointOpts{},
+			addCtrToCache:  true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				return log.NewError("the checkpoint ID must be provided")
+			},
+		},
+		"test_not_running": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Exited: true, Status: types.Exited}},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			addCtrToCache:  true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				return log.NewErrorf("container with id = %s is not running - current status is %s", testCtrID, types.Exited.String())
+			},
+		},
+		"test_already_existing": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			addCtrToCache:  true,
+			existing:       true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				return log.NewErrorf("checkpoint with id = %s already exists for container with id = %s", testCheckpointID, testCtrID)
+			},
+		},
+		"test_checkpoint_error": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			addCtrToCache:  true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				err := log.NewError("test error")
+				client.EXPECT().CheckpointContainer(ctx, ctr, checkpointDir).Return(err)
+				return err
+			},
+		},
+		"test_checkpoint": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Running: true, Status: types.Running}},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			addCtrToCache:  true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				client.EXPECT().CheckpointContainer(ctx, ctr, checkpointDir).Return(nil)
+				return nil
+			},
+		},
+		"test_checkpoint_paused": {
+			ctr:            &types.Container{ID: testCtrID, State: &types.State{Running: true, Paused: true, Status: types.Paused}},
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: testCheckpointID},
+			addCtrToCache:  true,
+			mockExec: func(ctx context.Context, ctr *types.Container, checkpointDir string, client *ctrMock.MockContainerAPIClient) error {
+				client.EXPECT().CheckpointContainer(ctx, ctr, checkpointDir).Return(nil)
+				return nil
+			},
+		},
+	}
+	// run tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCtrClient := ctrMock.NewMockContainerAPIClient(mockCtrl)
+			testMgr := &containerMgr{
+				metaPath:   t.TempDir(),
+				ctrClient:  mockCtrClient,
+				containers: make(map[string]*types.Container),
+			}
+			if testCase.addCtrToCache {
+				testMgr.containers[testCase.ctr.ID] = testCase.ctr
+			}
+			checkpointDir := testMgr.getContainerCheckpointPath(testCtrID, testCheckpointID)
+			if testCase.existing {
+				testutil.AssertNil(t, os.MkdirAll(checkpointDir, 0700))
+			}
+			ctx := context.Background()
+			expectedErr := testCase.mockExec(ctx, testCase.ctr, checkpointDir, mockCtrClient)
+			err := testMgr.Checkpoint(ctx, testCase.ctr.ID, testCase.checkpointOpts)
+
+			testutil.AssertError(t, expectedErr, err)
+			_, statErr := os.Stat(checkpointDir)
+			testutil.AssertEqual(t, expectedErr == nil || testCase.existing, statErr == nil)
+		})
+	}
+}
+
+func TestListCheckpoints(t *testing.T) {
+	const testCtrID = "test-ctr-id"
+
+	testMgr := &containerMgr{
+		metaPath:   t.TempDir(),
+		containers: map[string]*types.Container{testCtrID: {ID: testCtrID}},
+	}
+	ctx := context.Background()
+
+	_, err := testMgr.ListCheckpoints(ctx, "missing-ctr-id")
+	testutil.AssertError(t, log.NewErrorf(noSuchContainerErrorMsg, "missing-ctr-id"), err)
+
+	checkpoints, err := testMgr.ListCheckpoints(ctx, testCtrID)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 0, len(checkpoints))
+
+	testutil.AssertNil(t, os.MkdirAll(testMgr.getContainerCheckpointPath(testCtrID, "checkpoint-1"), 0700))
+	testutil.AssertNil(t, os.MkdirAll(testMgr.getContainerCheckpointPath(testCtrID, "checkpoint-2"), 0700))
+
+	checkpoints, err = testMgr.ListCheckpoints(ctx, testCtrID)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 2, len(checkpoints))
+	testutil.AssertEqual(t, "checkpoint-1", checkpoints[0].ID)
+	testutil.AssertEqual(t, "checkpoint-2", checkpoints[1].ID)
+	_, err = time.Parse(time.RFC3339Nano, checkpoints[0].Created)
+	testutil.AssertNil(t, err)
+}
+
+func TestRemoveCheckpoint(t *testing.T) {
+	const (
+		testCtrID        = "test-ctr-id"
+		testCheckpointID = "test-checkpoint"
+	)
+
+	testMgr := &containerMgr{
+		metaPath:   t.TempDir(),
+		containers: map[string]*types.Container{testCtrID: {ID: testCtrID}},
+	}
+	ctx := context.Background()
+	checkpointDir := testMgr.getContainerCheckpointPath(testCtrID, testCheckpointID)
+
+	err := testMgr.RemoveCheckpoint(ctx, "missing-ctr-id", testCheckpointID)
+	testutil.AssertError(t, log.NewErrorf(noSuchContainerErrorMsg, "missing-ctr-id"), err)
+
+	err = testMgr.RemoveCheckpoint(ctx, testCtrID, "")
+	testutil.AssertError(t, log.NewError("the checkpoint ID must be provided"), err)
+
+	err = testMgr.RemoveCheckpoint(ctx, testCtrID, testCheckpointID)
+	testutil.AssertError(t, log.NewErrorf("checkpoint with id = %s does not exist for container with id = %s", testCheckpointID, testCtrID), err)
+
+	testutil.AssertNil(t, os.MkdirAll(checkpointDir, 0700))
+	testutil.AssertNil(t, testMgr.RemoveCheckpoint(ctx, testCtrID, testCheckpointID))
+	_, err = os.Stat(checkpointDir)
+	testutil.AssertTrue(t, os.IsNotExist(err))
+}
+
+func TestRestoreFromMissingCheckpoint(t *testing.T) {
+	const (
+		testCtrID        = "test-ctr-id"
+		testCheckpointID = "test-checkpoint"
+	)
+
+	testMgr := &containerMgr{
+		metaPath:   t.TempDir(),
+		containers: map[string]*types.Container{testCtrID: {ID: testCtrID}},
+	}
+	ctx := context.Background()
+
+	err := testMgr.RestoreFromCheckpoint(ctx, "missing-ctr-id", testCheckpointID)
+	testutil.AssertError(t, log.NewErrorf(noSuchContainerErrorMsg, "missing-ctr-id"), err)
+
+	err = testMgr.RestoreFromCheckpoint(ctx, testCtrID, testCheckpointID)
+	testutil.AssertError(t, log.NewErrorf("checkpoint with id = %s does not exist for container with id = %s", testCheckpointID, testCtrID), err)
+}
+
 func getDeadContainer() (string, *types.Container) {
 	containerID := "dead-container"
 	pathToContatiner := filepath.Join("../pkg/testutil/metapath/valid/containers/", containerID, "/config.json")
@@ -1267,6 +1813,7 @@ func createContainerManagerWithCustomMocks(
 		containers:             containersCache,
 		containersLock:         sync.RWMutex{},
 		restartCtrsMgrCache:    newRestartMgrCache(),
+		healthMonitorsCache:    newHealthMonitorCache(),
 		containerRepository:    mockRepository,
 	}
 }
@@ -18,6 +18,7 @@ import (
 	"sync"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/events"
 	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/util"
@@ -44,12 +45,24 @@ type libnetworkMgr struct {
 	netController                 libnetwork.NetworkController //internal libnetwork controller fields
 	bridgeConnectedContainers     map[string]*types.Container
 	bridgeConnectedContainersLock sync.RWMutex
+	networksLock                  sync.Mutex
+	endpointsLock                 sync.Mutex
+	configuredContainers          map[string]*types.Container
+	eventsMgr                     events.ContainerEventsManager
+	cancelEventsHandler           context.CancelFunc
+	dnsRecords                    *dnsRecords
+	dnsResolvers                  map[string]*dnsResolver
+	dnsResolversLock              sync.RWMutex
 }
 
 func (netMgr *libnetworkMgr) Manage(ctx context.Context, container *types.Container) error {
 	if netMgr.netController == nil {
 		return log.NewErrorf("no network controller to connect to default network")
 	}
+	if util.IsContainerNetworkContainer(container) {
+		log.Debug("container ID = %s shares the network stack of container %s - only its hostname file will be built", container.ID, util.GetContainerNetworkModeTarget(container))
+		return netMgr.setupNetworkingRelatedPaths(container)
+	}
 
 	var (
 		sb  libnetwork.Sandbox
@@ -88,29 +101,42 @@ func (netMgr *libnetworkMgr) Manage(ctx context.Context, container *types.Contai
 
 func (netMgr *libnetworkMgr) Connect(ctx context.Context, container *types.Container) error {
 	var (
-		sb          libnetwork.Sandbox
-		ep          libnetwork.Endpoint
-		network     libnetwork.Network
-		cEpSettings *types.EndpointSettings
-		err         error
+		sb       libnetwork.Sandbox
+		ep       libnetwork.Endpoint
+		networks []libnetwork.Network
+		eps      []libnetwork.Endpoint
+		err      error
 	)
 
-	ctrNetworkName := string(container.HostConfig.NetworkMode)
+	if util.IsContainerNetworkContainer(container) {
+		return nil
+	}
+	// the container is connected to the network set as network mode and to all additional user-defined ones
+	// and has only the loopback interface in its sandbox if the network mode is none
+	var ctrNetworkNames []string
+	if !util.IsContainerNetworkNone(container) {
+		ctrNetworkNames = append([]string{string(container.HostConfig.NetworkMode)}, container.HostConfig.Networks...)
+	}
 	defer func() {
 		if err != nil {
-			if ep != nil {
+			for _, ep := range eps {
 				ep.Delete(true)
 			}
 			if container.NetworkSettings != nil && container.NetworkSettings.Networks != nil {
-				delete(container.NetworkSettings.Networks, ctrNetworkName)
+				for _, ctrNetworkName := range ctrNetworkNames {
+					delete(container.NetworkSettings.Networks, ctrNetworkName)
+				}
 			}
 		}
 	}()
-	// get network
-	network, err = netMgr.netController.NetworkByName(ctrNetworkName)
-	if err != nil {
-		err = log.NewErrorf("no network [%s] found while connecting container %s ", ctrNetworkName, container.ID)
-		return err
+	// get networks
+	for _, ctrNetworkName := range ctrNetworkNames {
+		network, netErr := netMgr.netController.NetworkByName(ctrNetworkName)
+		if netErr != nil {
+			err = log.NewErrorf("no network [%s] found while connecting container %s ", ctrNetworkName, container.ID)
+			return err
+		}
+		networks = append(networks, network)
 	}
 
 	// get sandbox
@@ -120,19 +146,23 @@ func (netMgr *libnetworkMgr) Connect(ctx context.Context, container *types.Conta
 		return err
 	}
 
-	//init endpoint
-	ep, err = netMgr.setupContainerNetworkEndpoint(network, container)
-	if err != nil {
-		return err
-	}
+	cEpSettings := make(map[string]*types.EndpointSettings)
+	for i, network := range networks {
+		//init endpoint
+		ep, err = netMgr.setupContainerNetworkEndpoint(network, container)
+		if err != nil {
+			return err
+		}
+		eps = append(eps, ep)
 
-	//joint the network sandbox
-	if err = ep.Join(sb); err != nil {
-		return err
-	}
+		//joint the network sandbox
+		if err = ep.Join(sb); err != nil {
+			return err
+		}
 
-	//update container network config
-	cEpSettings = mapToContainerEndpointSettings(network, ep)
+		//update container network config
+		cEpSettings[ctrNetworkNames[i]] = mapToContainerEndpointSettings(network, ep)
+	}
 
 	if container.NetworkSettings == nil {
 		container.NetworkSettings = &types.NetworkSettings{}
@@ -145,7 +175,13 @@ func (netMgr *libnetworkMgr) Connect(ctx context.Context, container *types.Conta
 		container.NetworkSettings.Networks = make(map[string]*types.EndpointSettings)
 	}
 
-	container.NetworkSettings.Networks[ctrNetworkName] = cEpSettings
+	for ctrNetworkName, epSettings := range cEpSettings {
+		container.NetworkSettings.Networks[ctrNetworkName] = epSettings
+	}
+
+	if err = applyNetworkPolicy(container, container.HostConfig.NetworkPolicy); err != nil {
+		return err
+	}
 
 	if util.IsContainerNetworkBridge(container) {
 		netMgr.bridgeConnectedContainersLock.Lock()
@@ -163,6 +199,7 @@ func (netMgr *libnetworkMgr) Restore(ctx context.Context, containers []*types.Co
 		netController libnetwork.NetworkController
 		netOptions    []libnetcfg.Option
 	)
+	mismatchedCtrs := make(map[*types.Container][]string)
 	if containers != nil {
 		//restore active containers sandboxes
 		if netMgr.config.activeSandboxes == nil {
@@ -171,7 +208,11 @@ func (netMgr *libnetworkMgr) Restore(ctx context.Context, containers []*types.Co
 		netMgr.bridgeConnectedContainersLock.Lock()
 		defer netMgr.bridgeConnectedContainersLock.Unlock()
 
+		netMgr.restoreConfiguredContainers(containers)
 		for _, ctr := range containers {
+			if util.IsContainerNetworkContainer(ctr) {
+				continue
+			}
 			if ctr.NetworkSettings == nil || ctr.NetworkSettings.SandboxID == "" {
 				log.Warn("no network settings are restored for container id = %s", ctr.ID)
 				continue
@@ -182,6 +223,10 @@ func (netMgr *libnetworkMgr) Restore(ctx context.Context, containers []*types.Co
 				continue
 			}
 
+			if mismatched := getMismatchedStaticEndpoints(ctr); len(mismatched) > 0 {
+				mismatchedCtrs[ctr] = mismatched
+			}
+
 			netMgr.config.activeSandboxes[ctr.NetworkSettings.SandboxID] = sbOpts
 			log.Debug("added network sandbox config for container id = %s with sandbox id = %s", ctr.ID, ctr.NetworkSettings.SandboxID)
 
@@ -208,6 +253,11 @@ func (netMgr *libnetworkMgr) Restore(ctx context.Context, containers []*types.Co
 	}
 	netMgr.netController = netController
 
+	for ctr, networkNames := range mismatchedCtrs {
+		if err := netMgr.reapplyStaticAddresses(ctr, networkNames); err != nil {
+			log.ErrorErr(err, "could not re-apply the static addresses in networks %v for restored container id = %s", networkNames, ctr.ID)
+		}
+	}
 	return nil
 }
 
@@ -225,12 +275,25 @@ func (netMgr *libnetworkMgr) ReleaseNetworkResources(ctx context.Context, contai
 		container.NetworkSettings = nil
 	}()
 
+	if util.IsNetworkPolicySet(container.HostConfig.NetworkPolicy) {
+		if err := removeNetworkPolicy(container); err != nil {
+			log.WarnErr(err, "error removing the network policy for container ID = %s", container.ID)
+		}
+	}
+
 	for netName, endpointSettings := range container.NetworkSettings.Networks {
 		if err := netMgr.removeEndpoint(context.Background(), container.NetworkSettings.SandboxID, endpointSettings); err != nil {
 			log.ErrorErr(err, "error removing endpoint for network %s for container ID = %s", netName, container.ID)
 			return err
 		}
 	}
+	if util.IsContainerNetworkNone(container) {
+		// there are no endpoints to remove the sandbox together with the last of them
+		if err := netMgr.netController.SandboxDestroy(container.ID); err != nil {
+			log.ErrorErr(err, "error removing the network sandbox for container ID = %s", container.ID)
+			return err
+		}
+	}
 	if util.IsContainerNetworkBridge(container) {
 		netMgr.bridgeConnectedContainersLock.Lock()
 		defer netMgr.bridgeConnectedContainersLock.Unlock()
@@ -240,7 +303,19 @@ func (netMgr *libnetworkMgr) ReleaseNetworkResources(ctx context.Context, contai
 	return nil
 }
 
+func (netMgr *libnetworkMgr) UpdateNetworkPolicy(ctx context.Context, container *types.Container, policy *types.NetworkPolicy) error {
+	if container.NetworkSettings == nil || container.NetworkSettings.SandboxKey == "" {
+		// the policy is applied when the container is connected
+		return nil
+	}
+	if err := removeNetworkPolicy(container); err != nil {
+		return err
+	}
+	return applyNetworkPolicy(container, policy)
+}
+
 func (netMgr *libnetworkMgr) Dispose(ctx context.Context) error {
+	netMgr.disposeDNS()
 	netMgr.netController.Stop()
 	return nil
 }
@@ -263,20 +338,28 @@ func (netMgr *libnetworkMgr) Initialize(ctx context.Context) error {
 			return err
 		}
 		log.Debug("successfully initialized existing bridge network %s", defaultBridgeNetwork.Name())
-		return nil
+	} else {
+		log.Debug("there are no active sandboxes - the default bridge network will be initialized")
+		//init default bridge network
+		brNet, err := initializeDefaultBridgeNetwork(netMgr.netController, netMgr.config)
+		if err != nil {
+			return err
+		}
+		log.Debug("successfully created and initialized the new default bridge network [%s] from scratch ", brNet.Name())
 	}
-	log.Debug("there are no active sandboxes - the default bridge network will be initialized")
-	//init default bridge network
-	brNet, err := initializeDefaultBridgeNetwork(netMgr.netController, netMgr.config)
-	if err != nil {
+
+	if err = netMgr.restoreNetworks(); err != nil {
 		return err
 	}
-	log.Debug("successfully created and initialized the new default bridge network [%s] from scratch ", brNet.Name())
-
+	netMgr.initializeDNS(ctx)
 	return nil
 }
 
 func (netMgr *libnetworkMgr) Stats(ctx context.Context, container *types.Container) (*types.IOStats, error) {
+	if util.IsContainerNetworkContainer(container) {
+		// the network stack and its statistics are owned by the other container
+		return nil, nil
+	}
 	sb := getNetworkSandbox(netMgr.netController, container.ID)
 	if sb == nil {
 		return nil, log.NewErrorf("no network sandbox for container %s ", container.ID)
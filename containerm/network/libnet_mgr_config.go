@@ -22,6 +22,9 @@ type config struct {
 	// bridge config
 	bridgeConfig bridgeConfig
 
+	// disables the embedded DNS resolvers of the bridge networks
+	disableDNS bool
+
 	activeSandboxes map[string]interface{}
 }
 
@@ -33,6 +36,8 @@ type bridgeConfig struct {
 	fixedCIDRv4   string
 	gatewayIPv4   string
 	enableIPv6    bool
+	fixedCIDRv6   string
+	gatewayIPv6   string
 
 	mtu           int
 	icc           bool
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"context"
+	"net"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+
+	"github.com/docker/docker/libnetwork"
+)
+
+// initializeDNS starts the embedded DNS resolvers of all bridge networks and keeps their records up to date via the container events
+func (netMgr *libnetworkMgr) initializeDNS(ctx context.Context) {
+	if netMgr.config.disableDNS {
+		log.Debug("the embedded DNS resolvers are disabled - the containers will use the host's resolvers")
+		return
+	}
+	for _, network := range netMgr.netController.Networks() {
+		netMgr.startDNSResolver(network)
+	}
+
+	netMgr.bridgeConnectedContainersLock.RLock()
+	for _, ctr := range netMgr.bridgeConnectedContainers {
+		netMgr.updateDNSRecords(ctr)
+	}
+	netMgr.bridgeConnectedContainersLock.RUnlock()
+
+	if netMgr.eventsMgr != nil {
+		netMgr.handleContainerEvents(ctx)
+	}
+}
+
+// disposeDNS stops the container events handling and all embedded DNS resolvers
+func (netMgr *libnetworkMgr) disposeDNS() {
+	if netMgr.cancelEventsHandler != nil {
+		netMgr.cancelEventsHandler()
+	}
+	netMgr.dnsResolversLock.Lock()
+	defer netMgr.dnsResolversLock.Unlock()
+	for name, resolver := range netMgr.dnsResolvers {
+		resolver.stop()
+		delete(netMgr.dnsResolvers, name)
+	}
+}
+
+// startDNSResolver starts the embedded DNS resolver of a bridge network on the network's gateway.
+// If the resolver cannot be started, the containers in the network use the host's resolvers.
+func (netMgr *libnetworkMgr) startDNSResolver(network libnetwork.Network) {
+	if netMgr.config.disableDNS || network.Type() != networktypes.NetworkDriverBridge {
+		return
+	}
+	gateway := getNetworkGateway(network)
+	if gateway == nil {
+		log.Warn("no gateway for network %s - its DNS resolver will not be started", network.Name())
+		return
+	}
+	resolver := newDNSResolver(network.Name(), gateway, dnsPort, netMgr.dnsRecords, defaultHostResolvConfPath)
+	if err := resolver.start(); err != nil {
+		log.ErrorErr(err, "could not start the DNS resolver for network %s - the containers in it will use the host's resolvers", network.Name())
+		return
+	}
+	netMgr.dnsResolversLock.Lock()
+	defer netMgr.dnsResolversLock.Unlock()
+	netMgr.dnsResolvers[network.Name()] = resolver
+}
+
+func (netMgr *libnetworkMgr) stopDNSResolver(networkName string) {
+	netMgr.dnsResolversLock.Lock()
+	defer netMgr.dnsResolversLock.Unlock()
+	if resolver, ok := netMgr.dnsResolvers[networkName]; ok {
+		resolver.stop()
+		delete(netMgr.dnsResolvers, networkName)
+	}
+}
+
+// getDNSResolver returns the running DNS resolver of a network or nil if there is no such
+func (netMgr *libnetworkMgr) getDNSResolver(networkName string) *dnsResolver {
+	netMgr.dnsResolversLock.RLock()
+	defer netMgr.dnsResolversLock.RUnlock()
+	return netMgr.dnsResolvers[networkName]
+}
+
+// dnsSandboxOptions sets the DNS resolver of the container's network as the only nameserver in the container's resolv.conf.
+// If there is no such resolver, the DNS servers of the container are set as nameservers instead.
+// Otherwise, the queries which are not for containers are forwarded by the resolver to the DNS servers of the container.
+func (netMgr *libnetworkMgr) dnsSandboxOptions(container *types.Container) []libnetwork.SandboxOption {
+	if util.IsContainerNetworkBridge(container) {
+		if resolver := netMgr.getDNSResolver(string(container.HostConfig.NetworkMode)); resolver != nil {
+			return []libnetwork.SandboxOption{libnetwork.OptionDNS(resolver.listenIP.String())}
+		}
+	}
+	var sboxOptions []libnetwork.SandboxOption
+	for _, server := range container.HostConfig.DNS {
+		sboxOptions = append(sboxOptions, libnetwork.OptionDNS(server))
+	}
+	return sboxOptions
+}
+
+// dnsEndpointOptions disables the libnetwork's resolver for the endpoints in networks with a running embedded DNS resolver
+func (netMgr *libnetworkMgr) dnsEndpointOptions(network libnetwork.Network) []libnetwork.EndpointOption {
+	netMgr.dnsResolversLock.RLock()
+	defer netMgr.dnsResolversLock.RUnlock()
+	if len(netMgr.dnsResolvers) == 0 {
+		return nil
+	}
+	if _, ok := netMgr.dnsResolvers[network.Name()]; ok {
+		return []libnetwork.EndpointOption{libnetwork.CreateOptionDisableResolution()}
+	}
+	return nil
+}
+
+func (netMgr *libnetworkMgr) handleContainerEvents(ctx context.Context) {
+	subscribeCtx, subscribeCtxCancelFunc := context.WithCancel(ctx)
+	netMgr.cancelEventsHandler = subscribeCtxCancelFunc
+	eventsChannel, errorChannel := netMgr.eventsMgr.Subscribe(subscribeCtx)
+	go func(ctx context.Context) {
+		for {
+			select {
+			case ctrEvent := <-eventsChannel:
+				if ctrEvent.Type == types.EventTypeContainers {
+					netMgr.handleContainerEvent(ctrEvent)
+				}
+			case err := <-errorChannel:
+				log.ErrorErr(err, "received error from the container events subscription")
+			case <-ctx.Done():
+				log.Debug("subscribe context is done - exiting the DNS records events loop")
+				return
+			}
+		}
+	}(subscribeCtx)
+}
+
+func (netMgr *libnetworkMgr) handleContainerEvent(ctrEvent *types.Event) {
+	switch ctrEvent.Action {
+	case types.EventActionContainersRunning, types.EventActionContainersRenamed, types.EventActionContainersUpdated:
+		if ctrEvent.Source.State != nil && !ctrEvent.Source.State.Running {
+			netMgr.dnsRecords.remove(ctrEvent.Source.ID)
+			return
+		}
+		netMgr.updateDNSRecords(&ctrEvent.Source)
+	case types.EventActionContainersStopped, types.EventActionContainersExited, types.EventActionContainersRemoved:
+		netMgr.dnsRecords.remove(ctrEvent.Source.ID)
+	}
+}
+
+// updateDNSRecords sets the DNS records of a container to its name and aliases - the host name and the fully qualified domain name
+func (netMgr *libnetworkMgr) updateDNSRecords(container *types.Container) {
+	if !util.IsContainerNetworkBridge(container) || container.NetworkSettings == nil {
+		netMgr.dnsRecords.remove(container.ID)
+		return
+	}
+	ips := make(map[string]net.IP)
+	for networkName, epSettings := range container.NetworkSettings.Networks {
+		if ip := net.ParseIP(epSettings.IPAddress); ip != nil {
+			ips[networkName] = ip
+		}
+	}
+	var names []string
+	for _, name := range []string{container.Name, container.HostName} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	if container.HostName != "" && container.DomainName != "" {
+		names = append(names, container.HostName+"."+container.DomainName)
+	}
+	netMgr.dnsRecords.set(container.ID, names, ips, container.HostConfig.DNS)
+	log.Debug("updated the DNS records for container ID = %s with names %v", container.ID, names)
+}
+
+func getNetworkGateway(network libnetwork.Network) net.IP {
+	if ipamV4Info, _ := network.Info().IpamInfo(); len(ipamV4Info) > 0 && ipamV4Info[0].Gateway != nil {
+		return ipamV4Info[0].Gateway.IP
+	}
+	return nil
+}
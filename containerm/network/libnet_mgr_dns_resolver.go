@@ -0,0 +1,363 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/miekg/dns"
+)
+
+const (
+	dnsPort           = 53
+	dnsRecordTTL      = 10
+	dnsForwardTimeout = 4 * time.Second
+)
+
+// dnsContainerRecords are the names of a container, its IPs in the bridge networks it is connected to
+// and the DNS servers the queries of the container are forwarded to instead of the host's resolvers
+type dnsContainerRecords struct {
+	names   []string
+	ips     map[string]net.IP
+	servers []string
+}
+
+// dnsRecords keeps the DNS records of all containers connected to bridge networks.
+// The records are shared by the resolvers of all bridge networks as a container can be connected to more than one network.
+type dnsRecords struct {
+	sync.RWMutex
+	containers map[string]*dnsContainerRecords
+}
+
+func newDNSRecords() *dnsRecords {
+	return &dnsRecords{containers: make(map[string]*dnsContainerRecords)}
+}
+
+func (records *dnsRecords) set(containerID string, names []string, ips map[string]net.IP, servers []string) {
+	records.Lock()
+	defer records.Unlock()
+	if len(names) == 0 || len(ips) == 0 {
+		delete(records.containers, containerID)
+		return
+	}
+	fqdnNames := make([]string, len(names))
+	for i, name := range names {
+		fqdnNames[i] = dns.Fqdn(strings.ToLower(name))
+	}
+	records.containers[containerID] = &dnsContainerRecords{names: fqdnNames, ips: ips, servers: servers}
+}
+
+func (records *dnsRecords) remove(containerID string) {
+	records.Lock()
+	defer records.Unlock()
+	delete(records.containers, containerID)
+}
+
+// visibleNetworks returns the networks whose containers can be resolved by the client with the provided IP in the provided network.
+// Unknown clients can resolve only the containers in the network they have sent the query from.
+func (records *dnsRecords) visibleNetworks(network string, clientIP net.IP) []string {
+	for _, ctrRecords := range records.containers {
+		if ip, ok := ctrRecords.ips[network]; ok && ip.Equal(clientIP) {
+			networks := make([]string, 0, len(ctrRecords.ips))
+			for ctrNetwork := range ctrRecords.ips {
+				networks = append(networks, ctrNetwork)
+			}
+			return networks
+		}
+	}
+	return []string{network}
+}
+
+// lookupServers returns the DNS servers set for the client with the provided IP in the provided network
+func (records *dnsRecords) lookupServers(network string, clientIP net.IP) []string {
+	records.RLock()
+	defer records.RUnlock()
+
+	for _, ctrRecords := range records.containers {
+		if ip, ok := ctrRecords.ips[network]; ok && ip.Equal(clientIP) {
+			return ctrRecords.servers
+		}
+	}
+	return nil
+}
+
+// lookupName returns the IPs of the containers with the provided name in the networks visible for the client
+func (records *dnsRecords) lookupName(name string, network string, clientIP net.IP) []net.IP {
+	records.RLock()
+	defer records.RUnlock()
+
+	name = strings.ToLower(name)
+	networks := records.visibleNetworks(network, clientIP)
+	var ips []net.IP
+	for _, id := range records.sortedIDs() {
+		ctrRecords := records.containers[id]
+		if !containsName(ctrRecords.names, name) {
+			continue
+		}
+		for _, ctrNetwork := range networks {
+			if ip, ok := ctrRecords.ips[ctrNetwork]; ok && !containsIP(ips, ip) {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips
+}
+
+// lookupIP returns the name of the container with the provided IP in the networks visible for the client
+func (records *dnsRecords) lookupIP(ip net.IP, network string, clientIP net.IP) string {
+	records.RLock()
+	defer records.RUnlock()
+
+	networks := records.visibleNetworks(network, clientIP)
+	for _, id := range records.sortedIDs() {
+		ctrRecords := records.containers[id]
+		for _, ctrNetwork := range networks {
+			if ctrIP, ok := ctrRecords.ips[ctrNetwork]; ok && ctrIP.Equal(ip) {
+				return ctrRecords.names[0]
+			}
+		}
+	}
+	return ""
+}
+
+func (records *dnsRecords) sortedIDs() []string {
+	ids := make([]string, 0, len(records.containers))
+	for id := range records.containers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIP(ips []net.IP, ip net.IP) bool {
+	for _, i := range ips {
+		if i.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// dnsResolver is an embedded DNS server listening on the gateway of a bridge network.
+// It answers the queries for the names of the containers and forwards all other queries to the DNS servers of the container
+// sending the query or to the host's resolvers if the container has no DNS servers set.
+type dnsResolver struct {
+	network        string
+	listenIP       net.IP
+	port           int
+	records        *dnsRecords
+	resolvConfPath string
+	upstreamPort   int
+	udpServer      *dns.Server
+	tcpServer      *dns.Server
+	forwardTimeout time.Duration
+}
+
+func newDNSResolver(network string, listenIP net.IP, port int, records *dnsRecords, resolvConfPath string) *dnsResolver {
+	return &dnsResolver{
+		network:        network,
+		listenIP:       listenIP,
+		port:           port,
+		records:        records,
+		resolvConfPath: resolvConfPath,
+		upstreamPort:   dnsPort,
+		forwardTimeout: dnsForwardTimeout,
+	}
+}
+
+// start listens for UDP and TCP queries on the same port
+func (resolver *dnsResolver) start() error {
+	udpConn, err := net.ListenPacket("udp", net.JoinHostPort(resolver.listenIP.String(), strconv.Itoa(resolver.port)))
+	if err != nil {
+		return err
+	}
+	// the port is resolved from the UDP listener if it is not set
+	resolver.port = udpConn.LocalAddr().(*net.UDPAddr).Port
+	tcpListener, err := net.Listen("tcp", net.JoinHostPort(resolver.listenIP.String(), strconv.Itoa(resolver.port)))
+	if err != nil {
+		udpConn.Close()
+		return err
+	}
+
+	var started sync.WaitGroup
+	started.Add(2)
+	resolver.udpServer = &dns.Server{PacketConn: udpConn, Handler: resolver, NotifyStartedFunc: started.Done}
+	resolver.tcpServer = &dns.Server{Listener: tcpListener, Handler: resolver, NotifyStartedFunc: started.Done}
+	for _, server := range []*dns.Server{resolver.udpServer, resolver.tcpServer} {
+		go func(server *dns.Server) {
+			if err := server.ActivateAndServe(); err != nil {
+				log.ErrorErr(err, "the DNS server for network %s has stopped", resolver.network)
+			}
+		}(server)
+	}
+	started.Wait()
+	log.Debug("started DNS resolver for network %s on %s", resolver.network, resolver.address())
+	return nil
+}
+
+func (resolver *dnsResolver) stop() {
+	for _, server := range []*dns.Server{resolver.udpServer, resolver.tcpServer} {
+		if server != nil {
+			if err := server.Shutdown(); err != nil {
+				log.ErrorErr(err, "could not stop the DNS resolver for network %s", resolver.network)
+			}
+		}
+	}
+	log.Debug("stopped DNS resolver for network %s", resolver.network)
+}
+
+func (resolver *dnsResolver) address() string {
+	return net.JoinHostPort(resolver.listenIP.String(), strconv.Itoa(resolver.port))
+}
+
+// ServeDNS answers the queries for container names and forwards the rest
+func (resolver *dnsResolver) ServeDNS(w dns.ResponseWriter, query *dns.Msg) {
+	var clientIP net.IP
+	switch addr := w.RemoteAddr().(type) {
+	case *net.UDPAddr:
+		clientIP = addr.IP
+	case *net.TCPAddr:
+		clientIP = addr.IP
+	}
+
+	resp := resolver.resolve(query, clientIP)
+	if resp == nil {
+		resp = resolver.forward(query, w.RemoteAddr().Network(), clientIP)
+	}
+	if err := w.WriteMsg(resp); err != nil {
+		log.ErrorErr(err, "could not send DNS response to %s", w.RemoteAddr())
+	}
+}
+
+// resolve returns the response for a query for a container or nil if the query must be forwarded
+func (resolver *dnsResolver) resolve(query *dns.Msg, clientIP net.IP) *dns.Msg {
+	if len(query.Question) != 1 || query.Question[0].Qclass != dns.ClassINET {
+		return nil
+	}
+	question := query.Question[0]
+	resp := new(dns.Msg)
+	resp.SetReply(query)
+	resp.Authoritative = true
+	header := dns.RR_Header{Name: question.Name, Class: dns.ClassINET, Ttl: dnsRecordTTL}
+
+	if question.Qtype == dns.TypePTR {
+		ip := ptrToIP(question.Name)
+		if ip == nil {
+			return nil
+		}
+		name := resolver.records.lookupIP(ip, resolver.network, clientIP)
+		if name == "" {
+			return nil
+		}
+		header.Rrtype = dns.TypePTR
+		resp.Answer = append(resp.Answer, &dns.PTR{Hdr: header, Ptr: name})
+		return resp
+	}
+
+	ips := resolver.records.lookupName(question.Name, resolver.network, clientIP)
+	if len(ips) == 0 {
+		return nil
+	}
+	// the name is known, so the queries for the other record types are answered without records instead of being forwarded
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil && question.Qtype == dns.TypeA {
+			header.Rrtype = dns.TypeA
+			resp.Answer = append(resp.Answer, &dns.A{Hdr: header, A: ip4})
+		} else if ip4 == nil && question.Qtype == dns.TypeAAAA {
+			header.Rrtype = dns.TypeAAAA
+			resp.Answer = append(resp.Answer, &dns.AAAA{Hdr: header, AAAA: ip})
+		}
+	}
+	return resp
+}
+
+// forward sends the query to the client's DNS servers or to the host's resolvers, the response of the first reachable one is returned
+func (resolver *dnsResolver) forward(query *dns.Msg, network string, clientIP net.IP) *dns.Msg {
+	client := &dns.Client{Net: network, Timeout: resolver.forwardTimeout}
+	upstreams := resolver.clientUpstreams(clientIP)
+	if len(upstreams) == 0 {
+		upstreams = resolver.upstreams()
+	}
+	for _, upstream := range upstreams {
+		resp, _, err := client.Exchange(query, upstream)
+		if err != nil {
+			log.Debug("could not forward DNS query to %s: %v", upstream, err)
+			continue
+		}
+		return resp
+	}
+	resp := new(dns.Msg)
+	resp.SetRcode(query, dns.RcodeServerFailure)
+	return resp
+}
+
+// clientUpstreams returns the addresses of the DNS servers set for the client
+func (resolver *dnsResolver) clientUpstreams(clientIP net.IP) []string {
+	var upstreams []string
+	for _, server := range resolver.records.lookupServers(resolver.network, clientIP) {
+		upstreams = append(upstreams, net.JoinHostPort(server, strconv.Itoa(resolver.upstreamPort)))
+	}
+	return upstreams
+}
+
+// upstreams reads the host's resolvers on each call as the host's resolv.conf can be changed at runtime
+func (resolver *dnsResolver) upstreams() []string {
+	config, err := dns.ClientConfigFromFile(resolver.resolvConfPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.ErrorErr(err, "could not read the host's resolvers from %s", resolver.resolvConfPath)
+		}
+		return nil
+	}
+	var upstreams []string
+	for _, server := range config.Servers {
+		upstream := net.JoinHostPort(server, strconv.Itoa(resolver.upstreamPort))
+		if upstream != resolver.address() {
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
+// ptrToIP parses the IP from a reverse lookup name, e.g. 4.3.2.1.in-addr.arpa.
+func ptrToIP(name string) net.IP {
+	const ipv4Suffix = ".in-addr.arpa."
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ipv4Suffix) {
+		return nil
+	}
+	octets := strings.Split(strings.TrimSuffix(name, ipv4Suffix), ".")
+	if len(octets) != net.IPv4len {
+		return nil
+	}
+	for i, j := 0, len(octets)-1; i < j; i, j = i+1, j-1 {
+		octets[i], octets[j] = octets[j], octets[i]
+	}
+	return net.ParseIP(strings.Join(octets, ".")).To4()
+}
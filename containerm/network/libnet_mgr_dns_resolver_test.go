@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	testDNSNetwork       = "bridge"
+	testDNSOtherNetwork  = "backend"
+	testDNSCtrIP         = "172.17.0.2"
+	testDNSCtrOtherIP    = "172.30.0.2"
+	testDNSClientIP      = "172.17.0.3"
+	testDNSClientServer  = "10.10.0.53"
+	testDNSForwardedName = "example.org."
+	testDNSForwardedIP   = "93.184.216.34"
+)
+
+func newTestDNSRecords() *dnsRecords {
+	records := newDNSRecords()
+	// a container connected to the default bridge and to a user-defined network
+	records.set("ctr-1", []string{"Web", "web-host", "web-host.local"}, map[string]net.IP{
+		testDNSNetwork:      net.ParseIP(testDNSCtrIP),
+		testDNSOtherNetwork: net.ParseIP(testDNSCtrOtherIP),
+	}, nil)
+	// a container connected only to the user-defined network
+	records.set("ctr-2", []string{"db"}, map[string]net.IP{
+		testDNSOtherNetwork: net.ParseIP("172.30.0.3"),
+	}, nil)
+	// a client container connected only to the default bridge
+	records.set("ctr-3", []string{"client"}, map[string]net.IP{
+		testDNSNetwork: net.ParseIP(testDNSClientIP),
+	}, []string{testDNSClientServer})
+	return records
+}
+
+func TestDNSRecordsLookupName(t *testing.T) {
+	tests := map[string]struct {
+		name        string
+		network     string
+		clientIP    string
+		expectedIPs []net.IP
+	}{
+		"test_lookup_name": {
+			name:        "web.",
+			network:     testDNSNetwork,
+			clientIP:    testDNSClientIP,
+			expectedIPs: []net.IP{net.ParseIP(testDNSCtrIP)},
+		},
+		"test_lookup_name_case_insensitive": {
+			name:        "WEB-HOST.local.",
+			network:     testDNSNetwork,
+			clientIP:    testDNSClientIP,
+			expectedIPs: []net.IP{net.ParseIP(testDNSCtrIP)},
+		},
+		"test_lookup_name_all_client_networks": {
+			name:        "db.",
+			network:     testDNSNetwork,
+			clientIP:    testDNSCtrIP,
+			expectedIPs: []net.IP{net.ParseIP("172.30.0.3")},
+		},
+		"test_lookup_name_other_network": {
+			name:     "db.",
+			network:  testDNSNetwork,
+			clientIP: testDNSClientIP,
+		},
+		"test_lookup_name_unknown_client": {
+			name:        "web.",
+			network:     testDNSOtherNetwork,
+			clientIP:    "172.30.0.100",
+			expectedIPs: []net.IP{net.ParseIP(testDNSCtrOtherIP)},
+		},
+		"test_lookup_name_missing": {
+			name:     "missing.",
+			network:  testDNSNetwork,
+			clientIP: testDNSClientIP,
+		},
+	}
+
+	records := newTestDNSRecords()
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ips := records.lookupName(testCase.name, testCase.network, net.ParseIP(testCase.clientIP))
+			testutil.AssertEqual(t, testCase.expectedIPs, ips)
+		})
+	}
+}
+
+func TestDNSRecordsLookupIP(t *testing.T) {
+	records := newTestDNSRecords()
+
+	testutil.AssertEqual(t, "web.", records.lookupIP(net.ParseIP(testDNSCtrIP), testDNSNetwork, net.ParseIP(testDNSClientIP)))
+	testutil.AssertEqual(t, "", records.lookupIP(net.ParseIP("172.30.0.3"), testDNSNetwork, net.ParseIP(testDNSClientIP)))
+
+	records.remove("ctr-1")
+	testutil.AssertEqual(t, "", records.lookupIP(net.ParseIP(testDNSCtrIP), testDNSNetwork, net.ParseIP(testDNSClientIP)))
+}
+
+func TestDNSRecordsSet(t *testing.T) {
+	records := newTestDNSRecords()
+
+	records.set("ctr-1", []string{"web"}, nil, nil)
+	testutil.AssertNil(t, records.lookupName("web.", testDNSNetwork, net.ParseIP(testDNSClientIP)))
+
+	records.set("ctr-1", nil, map[string]net.IP{testDNSNetwork: net.ParseIP(testDNSCtrIP)}, nil)
+	testutil.AssertEqual(t, "", records.lookupIP(net.ParseIP(testDNSCtrIP), testDNSNetwork, net.ParseIP(testDNSClientIP)))
+}
+
+func TestDNSRecordsLookupServers(t *testing.T) {
+	records := newTestDNSRecords()
+
+	testutil.AssertEqual(t, []string{testDNSClientServer}, records.lookupServers(testDNSNetwork, net.ParseIP(testDNSClientIP)))
+	testutil.AssertNil(t, records.lookupServers(testDNSOtherNetwork, net.ParseIP(testDNSClientIP)))
+	testutil.AssertNil(t, records.lookupServers(testDNSNetwork, net.ParseIP(testDNSCtrIP)))
+}
+
+func TestPtrToIP(t *testing.T) {
+	tests := map[string]struct {
+		name       string
+		expectedIP net.IP
+	}{
+		"test_ptr_ipv4": {
+			name:       "2.0.17.172.in-addr.arpa.",
+			expectedIP: net.ParseIP(testDNSCtrIP).To4(),
+		},
+		"test_ptr_ipv4_upper_case": {
+			name:       "2.0.17.172.IN-ADDR.ARPA.",
+			expectedIP: net.ParseIP(testDNSCtrIP).To4(),
+		},
+		"test_ptr_ipv4_incomplete": {
+			name: "17.172.in-addr.arpa.",
+		},
+		"test_ptr_ipv4_invalid": {
+			name: "2.0.17.x.in-addr.arpa.",
+		},
+		"test_ptr_ipv6": {
+			name: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.ip6.arpa.",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expectedIP, ptrToIP(testCase.name))
+		})
+	}
+}
+
+func TestDNSResolver(t *testing.T) {
+	// another resolver acts as the host's resolver
+	upstreamRecords := newDNSRecords()
+	upstreamRecords.set("upstream", []string{testDNSForwardedName}, map[string]net.IP{"upstream": net.ParseIP(testDNSForwardedIP)}, nil)
+	upstream := newDNSResolver("upstream", net.ParseIP("127.0.0.1"), 0, upstreamRecords, "")
+	testutil.AssertNil(t, upstream.start())
+	defer upstream.stop()
+
+	resolvConfDir, err := ioutil.TempDir("", "dns-resolver-test")
+	testutil.AssertNil(t, err)
+	defer os.RemoveAll(resolvConfDir)
+	resolvConfPath := filepath.Join(resolvConfDir, "resolv.conf")
+	testutil.AssertNil(t, ioutil.WriteFile(resolvConfPath, []byte("nameserver 127.0.0.1\n"), 0644))
+
+	records := newDNSRecords()
+	records.set("ctr-1", []string{"web"}, map[string]net.IP{testDNSNetwork: net.ParseIP(testDNSCtrIP)}, nil)
+	resolver := newDNSResolver(testDNSNetwork, net.ParseIP("127.0.0.1"), 0, records, resolvConfPath)
+	resolver.upstreamPort = upstream.port
+	testutil.AssertNil(t, resolver.start())
+	defer resolver.stop()
+
+	for _, protocol := range []string{"udp", "tcp"} {
+		client := &dns.Client{Net: protocol, Timeout: time.Second}
+		t.Run("test_resolve_container_name_"+protocol, func(t *testing.T) {
+			resp := exchangeTestDNSQuery(t, client, resolver, "web.", dns.TypeA)
+			testutil.AssertTrue(t, resp.Authoritative)
+			testutil.AssertEqual(t, 1, len(resp.Answer))
+			testutil.AssertEqual(t, net.ParseIP(testDNSCtrIP).To4(), resp.Answer[0].(*dns.A).A)
+		})
+		t.Run("test_resolve_container_name_no_ipv6_"+protocol, func(t *testing.T) {
+			resp := exchangeTestDNSQuery(t, client, resolver, "web.", dns.TypeAAAA)
+			testutil.AssertEqual(t, dns.RcodeSuccess, resp.Rcode)
+			testutil.AssertEqual(t, 0, len(resp.Answer))
+		})
+		t.Run("test_resolve_container_ip_"+protocol, func(t *testing.T) {
+			resp := exchangeTestDNSQuery(t, client, resolver, "2.0.17.172.in-addr.arpa.", dns.TypePTR)
+			testutil.AssertEqual(t, 1, len(resp.Answer))
+			testutil.AssertEqual(t, "web.", resp.Answer[0].(*dns.PTR).Ptr)
+		})
+		t.Run("test_forward_"+protocol, func(t *testing.T) {
+			resp := exchangeTestDNSQuery(t, client, resolver, testDNSForwardedName, dns.TypeA)
+			testutil.AssertEqual(t, 1, len(resp.Answer))
+			testutil.AssertEqual(t, net.ParseIP(testDNSForwardedIP).To4(), resp.Answer[0].(*dns.A).A)
+		})
+	}
+
+	t.Run("test_forward_no_upstreams", func(t *testing.T) {
+		testutil.AssertNil(t, ioutil.WriteFile(resolvConfPath, []byte("search local\n"), 0644))
+		resp := exchangeTestDNSQuery(t, &dns.Client{Timeout: time.Second}, resolver, testDNSForwardedName, dns.TypeA)
+		testutil.AssertEqual(t, dns.RcodeServerFailure, resp.Rcode)
+	})
+	t.Run("test_forward_client_servers", func(t *testing.T) {
+		// the host has no resolvers, so the query can only be forwarded to the DNS servers of the client
+		testutil.AssertNil(t, ioutil.WriteFile(resolvConfPath, []byte("search local\n"), 0644))
+		records.set("client", []string{"client"}, map[string]net.IP{testDNSNetwork: net.ParseIP("127.0.0.1")}, []string{"127.0.0.1"})
+		defer records.remove("client")
+		resp := exchangeTestDNSQuery(t, &dns.Client{Timeout: time.Second}, resolver, testDNSForwardedName, dns.TypeA)
+		testutil.AssertEqual(t, 1, len(resp.Answer))
+		testutil.AssertEqual(t, net.ParseIP(testDNSForwardedIP).To4(), resp.Answer[0].(*dns.A).A)
+	})
+}
+
+func exchangeTestDNSQuery(t *testing.T, client *dns.Client, resolver *dnsResolver, name string, qtype uint16) *dns.Msg {
+	query := new(dns.Msg)
+	query.SetQuestion(name, qtype)
+	resp, _, err := client.Exchange(query, resolver.address())
+	testutil.AssertNil(t, err)
+	return resp
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func newTestDNSContainer(running bool) types.Container {
+	return types.Container{
+		ID:         testCtrID,
+		Name:       "web",
+		HostName:   "web-host",
+		DomainName: "local",
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeBridge,
+		},
+		State: &types.State{Running: running},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*types.EndpointSettings{
+				bridgeNetworkName: {IPAddress: testDNSCtrIP},
+			},
+		},
+	}
+}
+
+func TestHandleContainerEvent(t *testing.T) {
+	tests := map[string]struct {
+		action      types.EventAction
+		running     bool
+		expectedIPs []net.IP
+	}{
+		"test_event_running": {
+			action:      types.EventActionContainersRunning,
+			running:     true,
+			expectedIPs: []net.IP{net.ParseIP(testDNSCtrIP)},
+		},
+		"test_event_renamed": {
+			action:      types.EventActionContainersRenamed,
+			running:     true,
+			expectedIPs: []net.IP{net.ParseIP(testDNSCtrIP)},
+		},
+		"test_event_updated_not_running": {
+			action: types.EventActionContainersUpdated,
+		},
+		"test_event_stopped": {
+			action: types.EventActionContainersStopped,
+		},
+		"test_event_removed": {
+			action: types.EventActionContainersRemoved,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), dnsRecords: newDNSRecords()}
+			testMgr.dnsRecords.set(testCtrID, []string{"old-name"}, map[string]net.IP{bridgeNetworkName: net.ParseIP(testDNSCtrIP)}, nil)
+
+			testMgr.handleContainerEvent(&types.Event{
+				Type:   types.EventTypeContainers,
+				Action: testCase.action,
+				Source: newTestDNSContainer(testCase.running),
+			})
+
+			clientIP := net.ParseIP(testDNSClientIP)
+			for _, name := range []string{"web.", "web-host.", "web-host.local."} {
+				testutil.AssertEqual(t, testCase.expectedIPs, testMgr.dnsRecords.lookupName(name, bridgeNetworkName, clientIP))
+			}
+			testutil.AssertNil(t, testMgr.dnsRecords.lookupName("old-name.", bridgeNetworkName, clientIP))
+		})
+	}
+}
+
+func TestDNSOptions(t *testing.T) {
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), dnsResolvers: map[string]*dnsResolver{
+		bridgeNetworkName: newDNSResolver(bridgeNetworkName, net.ParseIP("172.17.0.1"), dnsPort, newDNSRecords(), defaultHostResolvConfPath),
+	}}
+
+	t.Run("test_dns_sandbox_options_bridge", func(t *testing.T) {
+		testutil.AssertEqual(t, 1, len(testMgr.dnsSandboxOptions(newDefaultContainer())))
+	})
+	t.Run("test_dns_sandbox_options_host", func(t *testing.T) {
+		ctr := newDefaultContainer()
+		ctr.HostConfig.NetworkMode = types.NetworkModeHost
+		testutil.AssertEqual(t, 0, len(testMgr.dnsSandboxOptions(ctr)))
+	})
+	t.Run("test_dns_sandbox_options_no_resolver", func(t *testing.T) {
+		ctr := newDefaultContainer()
+		ctr.HostConfig.NetworkMode = types.NetworkMode(testNetworkName)
+		testutil.AssertEqual(t, 0, len(testMgr.dnsSandboxOptions(ctr)))
+	})
+	t.Run("test_dns_sandbox_options_servers_resolver", func(t *testing.T) {
+		ctr := newDefaultContainer()
+		ctr.HostConfig.DNS = []string{"8.8.8.8", "1.1.1.1"}
+		// the servers are used by the resolver of the network
+		testutil.AssertEqual(t, 1, len(testMgr.dnsSandboxOptions(ctr)))
+	})
+	t.Run("test_dns_sandbox_options_servers_no_resolver", func(t *testing.T) {
+		ctr := newDefaultContainer()
+		ctr.HostConfig.NetworkMode = types.NetworkModeHost
+		ctr.HostConfig.DNS = []string{"8.8.8.8", "1.1.1.1"}
+		testutil.AssertEqual(t, 2, len(testMgr.dnsSandboxOptions(ctr)))
+	})
+}
@@ -14,11 +14,12 @@ package network
 
 import (
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/events"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/util"
 )
 
-func newLibnetworkMgr(netConfig config) (ContainerNetworkManager, error) {
+func newLibnetworkMgr(netConfig config, eventsMgr events.ContainerEventsManager) (ContainerNetworkManager, error) {
 	if err := util.MkDirs(netConfig.execRoot); err != nil {
 		return nil, err
 	}
@@ -27,18 +28,26 @@ func newLibnetworkMgr(netConfig config) (ContainerNetworkManager, error) {
 		return nil, err
 	}
 
-	return &libnetworkMgr{config: &netConfig, bridgeConnectedContainers: make(map[string]*types.Container)}, nil
+	return &libnetworkMgr{
+		config:                    &netConfig,
+		bridgeConnectedContainers: make(map[string]*types.Container),
+		configuredContainers:      make(map[string]*types.Container),
+		eventsMgr:                 eventsMgr,
+		dnsRecords:                newDNSRecords(),
+		dnsResolvers:              make(map[string]*dnsResolver),
+	}, nil
 }
 
 func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+	eventsMgr, err := registryCtx.Get(registry.EventsManagerService)
+	if err != nil {
+		return nil, err
+	}
 	netMgrOpts := registryCtx.Config.([]NetOpt)
 	netMgrCreateOpts := &netOpts{}
 	applyOptsNet(netMgrCreateOpts, netMgrOpts...)
 
-	var (
-		err       error
-		netConfig config
-	)
+	var netConfig config
 	//convert opts to libnet config
 	netConfig, err = netMrgOptsToLibnetConfig(netMgrCreateOpts)
 	if err != nil {
@@ -46,5 +55,5 @@ func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, er
 	}
 
 	//create libnetwork manager
-	return newLibnetworkMgr(netConfig)
+	return newLibnetworkMgr(netConfig, eventsMgr.(events.ContainerEventsManager))
 }
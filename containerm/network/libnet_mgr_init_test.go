@@ -13,12 +13,16 @@
 package network
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksevents "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/golang/mock/gomock"
 )
 
 func TestInit(t *testing.T) {
@@ -36,10 +40,23 @@ func TestInit(t *testing.T) {
 		WithLibNetMetaPath(metaPathDir),
 	}
 
-	registryCtx := &registry.ServiceRegistryContext{
-		Config: nOpts,
-	}
-	netMgr, err := registryInit(registryCtx)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockEventsManager := mocksevents.NewMockContainerEventsManager(controller)
+
+	serviceSet := registry.NewServiceInfoSet()
+	serviceSet.Add((&registry.Registration{
+		ID:   string(registry.EventsManagerService),
+		Type: registry.EventsManagerService,
+		InitFunc: func(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+			return mockEventsManager, nil
+		},
+	}).Init(&registry.ServiceRegistryContext{}))
+
+	_, err := registryInit(registry.NewContext(context.Background(), nOpts, nil, registry.NewServiceInfoSet()))
+	testutil.AssertError(t, fmt.Errorf("no services registered for %s", registry.EventsManagerService), err)
+
+	netMgr, err := registryInit(registry.NewContext(context.Background(), nOpts, nil, serviceSet))
 	testutil.AssertError(t, nil, err)
 	testNetMgr := netMgr.(*libnetworkMgr)
 	testutil.AssertNotNil(t, testNetMgr)
@@ -63,4 +80,5 @@ func TestInit(t *testing.T) {
 	testutil.AssertEqual(t, expectedCfg.bridgeConfig.mtu, testNetMgr.config.bridgeConfig.mtu)
 	testutil.AssertEqual(t, expectedCfg.bridgeConfig.ipForward, testNetMgr.config.bridgeConfig.ipForward)
 	testutil.AssertEqual(t, expectedCfg.bridgeConfig.name, testNetMgr.config.bridgeConfig.name)
+	testutil.AssertEqual(t, mockEventsManager, testNetMgr.eventsMgr)
 }
@@ -15,6 +15,7 @@ package network
 import (
 	"context"
 	"io/ioutil"
+	"net"
 	"path/filepath"
 	"strings"
 
@@ -49,6 +50,7 @@ func (netMgr *libnetworkMgr) setupContainerNetworkSandbox(container *types.Conta
 	if err != nil {
 		return nil, err
 	}
+	options = append(options, netMgr.dnsSandboxOptions(container)...)
 
 	sb, err = netMgr.netController.NewSandbox(container.ID, options...)
 	if err != nil {
@@ -77,10 +79,20 @@ func (netMgr *libnetworkMgr) setupContainerNetworkEndpoint(network libnetwork.Ne
 	}
 
 	if ep == nil {
+		// the endpoints are created one at a time so that the addresses explicitly assigned to them do not collide
+		netMgr.endpointsLock.Lock()
+		defer netMgr.endpointsLock.Unlock()
+
 		epCreateOptions, err = buildEndpointOptions()
 		if err != nil {
 			return nil, err
 		}
+		epCreateOptions = append(epCreateOptions, netMgr.dnsEndpointOptions(network)...)
+		staticEpOptions, staticErr := buildStaticEndpointOptions(network, container, netMgr.getReservedAddresses(network, container))
+		if staticErr != nil {
+			return nil, staticErr
+		}
+		epCreateOptions = append(epCreateOptions, staticEpOptions...)
 		ep, err = network.CreateEndpoint(container.ID+"-ep", epCreateOptions...)
 		if err != nil {
 			return nil, err
@@ -89,6 +101,85 @@ func (netMgr *libnetworkMgr) setupContainerNetworkEndpoint(network libnetwork.Ne
 	return ep, nil
 }
 
+func (netMgr *libnetworkMgr) getReservedAddresses(network libnetwork.Network, container *types.Container) []net.IP {
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	for id, ctr := range netMgr.configuredContainers {
+		if id != container.ID && ctr.HostConfig != nil && len(ctr.HostConfig.EndpointsConfig) > 0 {
+			return getReservedAddresses(network.Name(), container, netMgr.configuredContainers)
+		}
+	}
+	return nil
+}
+
+// reapplyStaticAddresses recreates the restored endpoints of the container in the provided networks with its static addresses.
+// The endpoints are recreated with their restored addresses if the static addresses cannot be assigned to them.
+func (netMgr *libnetworkMgr) reapplyStaticAddresses(container *types.Container, networkNames []string) error {
+	sb := getNetworkSandbox(netMgr.netController, container.ID)
+	if sb == nil {
+		return log.NewErrorf("no network sandbox for container %s ", container.ID)
+	}
+	for _, networkName := range networkNames {
+		network, err := netMgr.netController.NetworkByName(networkName)
+		if err != nil {
+			return err
+		}
+		if _, err = buildStaticEndpointOptions(network, container, netMgr.getReservedAddresses(network, container)); err != nil {
+			return err
+		}
+		ep, err := netMgr.setupContainerNetworkEndpoint(network, container)
+		if err == nil {
+			if err = ep.Join(sb); err != nil {
+				ep.Delete(true)
+			}
+		}
+		if err != nil {
+			if restoreErr := netMgr.restoreContainerNetworkEndpoint(network, container, sb); restoreErr != nil {
+				log.ErrorErr(restoreErr, "could not restore the endpoint in network %s for container id = %s - the container is disconnected from it", networkName, container.ID)
+				delete(container.NetworkSettings.Networks, networkName)
+			}
+			return err
+		}
+		container.NetworkSettings.Networks[networkName] = mapToContainerEndpointSettings(network, ep)
+		log.Debug("re-applied the static addresses in network %s for restored container id = %s", networkName, container.ID)
+	}
+	if util.IsNetworkPolicySet(container.HostConfig.NetworkPolicy) {
+		// the policy is bound to the recreated interfaces and addresses
+		if err := removeNetworkPolicy(container); err != nil {
+			return err
+		}
+		return applyNetworkPolicy(container, container.HostConfig.NetworkPolicy)
+	}
+	return nil
+}
+
+// restoreContainerNetworkEndpoint recreates the restored endpoint of the container in the network with the addresses it had, unless it still exists.
+func (netMgr *libnetworkMgr) restoreContainerNetworkEndpoint(network libnetwork.Network, container *types.Container, sb libnetwork.Sandbox) error {
+	ep, err := getNetworkEndPoint(container, network)
+	if err != nil || ep != nil {
+		return err
+	}
+	netMgr.endpointsLock.Lock()
+	defer netMgr.endpointsLock.Unlock()
+
+	epCreateOptions, err := buildEndpointOptions()
+	if err != nil {
+		return err
+	}
+	epCreateOptions = append(epCreateOptions, netMgr.dnsEndpointOptions(network)...)
+	epCreateOptions = append(epCreateOptions, buildRestoredEndpointOptions(container.NetworkSettings.Networks[network.Name()])...)
+	if ep, err = network.CreateEndpoint(container.ID+"-ep", epCreateOptions...); err != nil {
+		return err
+	}
+	if err = ep.Join(sb); err != nil {
+		ep.Delete(true)
+		return err
+	}
+	container.NetworkSettings.Networks[network.Name()] = mapToContainerEndpointSettings(network, ep)
+	return nil
+}
+
 // BuildHostnameFile writes the container's hostname file.
 func (netMgr *libnetworkMgr) setupNetworkingRelatedPaths(container *types.Container) error {
 	containerMetaPath := getContainerNetMetaPath(netMgr.config, container.ID)
@@ -153,7 +244,7 @@ func (netMgr *libnetworkMgr) removeEndpoint(ctx context.Context, sandboxID strin
 func (netMgr *libnetworkMgr) refreshConnectedContainers(ctx context.Context) {
 	for _, ctr := range netMgr.bridgeConnectedContainers {
 		for _, extraHost := range ctr.HostConfig.ExtraHosts {
-			host := strings.Split(strings.TrimSpace(extraHost), ":")
+			host := strings.SplitN(strings.TrimSpace(extraHost), ":", 2)
 			if len(host) != 2 {
 				log.Warn("host %s is incorrectly defined", host)
 				continue
@@ -186,6 +277,7 @@ func (netMgr *libnetworkMgr) refreshContainer(ctx context.Context, container *ty
 	if err != nil {
 		return err
 	}
+	options = append(options, netMgr.dnsSandboxOptions(container)...)
 
 	return sb.Refresh(options...)
 }
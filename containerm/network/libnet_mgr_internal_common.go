@@ -13,6 +13,7 @@
 package network
 
 import (
+	"net"
 	"path/filepath"
 	"strconv"
 
@@ -107,18 +108,47 @@ func buildBridgeNetworkOptions(netConfig *config) ([]libnetwork.NetworkOption, e
 	}
 	v4Conf := []*libnetwork.IpamConf{ipamV4Conf}
 
+	v6Conf, err := buildBridgeIPv6IpamConf(netConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	//generate libnetwork options
 	bridgeDriverOptions := []libnetwork.NetworkOption{}
 	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionPersist(true))
 	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionEnableIPv6(netConfig.bridgeConfig.enableIPv6))
 	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionDriverOpts(netOption))
-	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionIpam("default", "", v4Conf, nil, nil))
-	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionDeferIPv6Alloc(netConfig.bridgeConfig.enableIPv6))
+	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionIpam("default", "", v4Conf, v6Conf, nil))
+	// the IPv6 addresses are allocated on joining the network only if there is no fixed IPv6 subnet to allocate them from
+	bridgeDriverOptions = append(bridgeDriverOptions, libnetwork.NetworkOptionDeferIPv6Alloc(netConfig.bridgeConfig.enableIPv6 && netConfig.bridgeConfig.fixedCIDRv6 == ""))
 
 	return bridgeDriverOptions, nil
 
 }
 
+// buildBridgeIPv6IpamConf returns the IPv6 address pool configuration of the default bridge network if IPv6 is enabled and a fixed IPv6 subnet is set
+func buildBridgeIPv6IpamConf(netConfig *config) ([]*libnetwork.IpamConf, error) {
+	if !netConfig.bridgeConfig.enableIPv6 || netConfig.bridgeConfig.fixedCIDRv6 == "" {
+		return nil, nil
+	}
+	ip, subnet, err := net.ParseCIDR(netConfig.bridgeConfig.fixedCIDRv6)
+	if err != nil || ip.To4() != nil {
+		return nil, log.NewErrorf("invalid IPv6 subnet %s for the default bridge network", netConfig.bridgeConfig.fixedCIDRv6)
+	}
+	ipamV6Conf := &libnetwork.IpamConf{PreferredPool: subnet.String(), AuxAddresses: make(map[string]string)}
+	if netConfig.bridgeConfig.gatewayIPv6 != "" {
+		gateway := net.ParseIP(netConfig.bridgeConfig.gatewayIPv6)
+		if gateway == nil || gateway.To4() != nil {
+			return nil, log.NewErrorf("invalid IPv6 gateway %s for the default bridge network", netConfig.bridgeConfig.gatewayIPv6)
+		}
+		if !subnet.Contains(gateway) {
+			return nil, log.NewErrorf("the IPv6 gateway %s is not within the subnet %s of the default bridge network", netConfig.bridgeConfig.gatewayIPv6, subnet)
+		}
+		ipamV6Conf.Gateway = gateway.String()
+	}
+	return []*libnetwork.IpamConf{ipamV6Conf}, nil
+}
+
 func initializeDefaultBridgeNetwork(netController libnetwork.NetworkController, netConfig *config) (libnetwork.Network, error) {
 	// backwards compatibility for clearing any old bridge networks from the libnetwork controller
 	if n, err := netController.NetworkByName(netConfig.bridgeConfig.name); err == nil {
@@ -183,9 +213,10 @@ func getContainerNetMetaPath(netConfig *config, containerID string) string {
 func netMrgOptsToLibnetConfig(netCreateOpts *netOpts) (config, error) {
 
 	return config{
-		netType:  netCreateOpts.netType,
-		metaPath: netCreateOpts.metaPath,
-		execRoot: netCreateOpts.execRoot,
+		netType:    netCreateOpts.netType,
+		metaPath:   netCreateOpts.metaPath,
+		execRoot:   netCreateOpts.execRoot,
+		disableDNS: netCreateOpts.disableDNS,
 		bridgeConfig: bridgeConfig{
 			disableBridge: netCreateOpts.disableBridge,
 			name:          netCreateOpts.name,
@@ -193,6 +224,8 @@ func netMrgOptsToLibnetConfig(netCreateOpts *netOpts) (config, error) {
 			fixedCIDRv4:   netCreateOpts.fixedCIDRv4,
 			gatewayIPv4:   netCreateOpts.gatewayIPv4,
 			enableIPv6:    netCreateOpts.enableIPv6,
+			fixedCIDRv6:   netCreateOpts.fixedCIDRv6,
+			gatewayIPv6:   netCreateOpts.gatewayIPv6,
 			mtu:           netCreateOpts.mtu,
 			icc:           netCreateOpts.icc,
 			ipTables:      netCreateOpts.ipTables,
@@ -16,8 +16,11 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/docker/docker/libnetwork/netlabel"
+	"github.com/docker/docker/libnetwork/options"
 	libnettypes "github.com/docker/docker/libnetwork/types"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
@@ -77,11 +80,19 @@ func buildSandboxOptions(container *types.Container, containers []*types.Contain
 	sboxOptions = append(sboxOptions, libnetwork.OptionResolvConfPath(container.ResolvConfPath))
 	//-----------------EOF Resolve paths -------------------------------
 
+	//add DNS search domains and options to resolv.conf, the host's ones are used if not set
+	for _, search := range container.HostConfig.DNSSearch {
+		sboxOptions = append(sboxOptions, libnetwork.OptionDNSSearch(search))
+	}
+	for _, option := range container.HostConfig.DNSOptions {
+		sboxOptions = append(sboxOptions, libnetwork.OptionDNSOptions(option))
+	}
+
 	//add extra hosts to /etc/hosts
 	extraHosts := container.HostConfig.ExtraHosts
 	if extraHosts != nil {
 		for _, extraHost := range extraHosts {
-			host := strings.Split(strings.TrimSpace(extraHost), ":")
+			host := strings.SplitN(strings.TrimSpace(extraHost), ":", 2) // the IP may be an IPv6 address
 			if len(host) != 2 {
 				return nil, log.NewErrorf("host %s is incorrectly defined", host)
 			}
@@ -133,7 +144,11 @@ func resolveToHostIPOnInterface(container *types.Container, containers []*types.
 					if len(containersFound) > 0 {
 						return "", log.NewErrorf("expected to resolve to exactly one container, instead multiple containers exists for interface %s", interfaceName)
 					}
-					containersFound = append(containersFound, network.IPAddress)
+					ctrIP := network.IPAddress
+					if ctrIP == "" {
+						ctrIP = network.IPv6Address
+					}
+					containersFound = append(containersFound, ctrIP)
 				} else {
 					return "", log.NewErrorf("will not resolve container as container with id = %s is not configured in bridge network mode, use host_ip resolution instead", ctr.ID)
 				}
@@ -152,19 +167,27 @@ func resolveToHostIPOnInterface(container *types.Container, containers []*types.
 	if err != nil {
 		return "", err
 	}
-	var ip net.IP
+	var ipv6 net.IP
 	for _, ifAddress := range ifAddresses {
 		switch v := ifAddress.(type) {
 		case *net.IPNet:
-			if !v.IP.IsLoopback() && v.IP.To4() != nil {
-				if ip = v.IP; ip != nil {
-					return ip.String(), nil
-				}
+			if v.IP.IsLoopback() {
+				continue
+			}
+			if v.IP.To4() != nil {
+				return v.IP.String(), nil
+			}
+			// prefer IPv4, fallback to the first global IPv6 address if the interface has no IPv4 one
+			if ipv6 == nil && v.IP.IsGlobalUnicast() {
+				ipv6 = v.IP
 			}
 		default:
 			log.Error("The network is not an IP Network")
 		}
 	}
+	if ipv6 != nil {
+		return ipv6.String(), nil
+	}
 	return "", log.NewErrorf("could not retrieve the host's IP on interface %s for container id = %s", interfaceName, container.ID)
 }
 
@@ -188,12 +211,18 @@ func mapToContainerEndpointSettings(network libnetwork.Network, netEp libnetwork
 	if gw := epInfo.Gateway(); gw != nil {
 		epSettings.Gateway = gw.String()
 	}
+	if gw := epInfo.GatewayIPv6(); gw != nil {
+		epSettings.IPv6Gateway = gw.String()
+	}
 
 	iface := epInfo.Iface()
 	if iface != nil {
 		if addr := iface.Address(); addr != nil {
 			epSettings.IPAddress = addr.IP.String()
 		}
+		if addr := iface.AddressIPv6(); addr != nil {
+			epSettings.IPv6Address = addr.IP.String()
+		}
 		if mac := iface.MacAddress(); mac != nil {
 			epSettings.MacAddress = mac.String()
 		}
@@ -201,3 +230,199 @@ func mapToContainerEndpointSettings(network libnetwork.Network, netEp libnetwork
 	return epSettings
 
 }
+
+// buildStaticEndpointOptions returns the endpoint options requesting the static addresses configured for the container in the provided network.
+// The addresses are validated against the network's subnets, the endpoints of the other containers connected to it and the reserved addresses.
+// The reserved addresses are the static ones of the other containers configured with the network - if there are any in an address family,
+// the container is explicitly assigned the lowest free address of this family unless it has a static one so that they are never dynamically allocated.
+func buildStaticEndpointOptions(network libnetwork.Network, container *types.Container, reserved []net.IP) ([]libnetwork.EndpointOption, error) {
+	var (
+		epConfig   *types.EndpointConfig
+		ipV4, ipV6 net.IP
+		mac        net.HardwareAddr
+		err        error
+	)
+	if len(container.HostConfig.EndpointsConfig) > 0 {
+		epConfig = container.HostConfig.EndpointsConfig[network.Name()]
+	}
+	if epConfig == nil && len(reserved) == 0 {
+		return nil, nil
+	}
+	if epConfig == nil {
+		epConfig = &types.EndpointConfig{}
+	}
+	ipamV4Info, ipamV6Info := network.Info().IpamInfo()
+	if epConfig.IPv4Address != "" {
+		ipV4 = net.ParseIP(epConfig.IPv4Address)
+		if !isInIpamPools(ipV4, ipamV4Info) {
+			return nil, log.NewErrorf("the IPv4 address %s is not within the subnet of network %s", epConfig.IPv4Address, network.Name())
+		}
+		if containsIP(reserved, ipV4) {
+			return nil, log.NewErrorf("the IPv4 address %s is reserved for another container in network %s", epConfig.IPv4Address, network.Name())
+		}
+	}
+	if epConfig.IPv6Address != "" {
+		ipV6 = net.ParseIP(epConfig.IPv6Address)
+		if !isInIpamPools(ipV6, ipamV6Info) {
+			return nil, log.NewErrorf("the IPv6 address %s is not within the subnet of network %s", epConfig.IPv6Address, network.Name())
+		}
+		if containsIP(reserved, ipV6) {
+			return nil, log.NewErrorf("the IPv6 address %s is reserved for another container in network %s", epConfig.IPv6Address, network.Name())
+		}
+	}
+	if epConfig.MacAddress != "" {
+		if mac, err = net.ParseMAC(epConfig.MacAddress); err != nil {
+			return nil, log.NewErrorf("invalid MAC address %s for network %s", epConfig.MacAddress, network.Name())
+		}
+	}
+	inUse := append([]net.IP{}, reserved...)
+	for _, ep := range network.Endpoints() {
+		iface := ep.Info().Iface()
+		if ep.Name() == container.ID+"-ep" || iface == nil {
+			continue
+		}
+		if iface.Address() != nil {
+			if ipV4 != nil && iface.Address().IP.Equal(ipV4) {
+				return nil, log.NewErrorf("the IPv4 address %s is already in use in network %s", epConfig.IPv4Address, network.Name())
+			}
+			inUse = append(inUse, iface.Address().IP)
+		}
+		if iface.AddressIPv6() != nil {
+			if ipV6 != nil && iface.AddressIPv6().IP.Equal(ipV6) {
+				return nil, log.NewErrorf("the IPv6 address %s is already in use in network %s", epConfig.IPv6Address, network.Name())
+			}
+			inUse = append(inUse, iface.AddressIPv6().IP)
+		}
+		if mac != nil && iface.MacAddress().String() == mac.String() {
+			return nil, log.NewErrorf("the MAC address %s is already in use in network %s", epConfig.MacAddress, network.Name())
+		}
+	}
+	if ipV4 == nil && containsIPv4(reserved) {
+		if ipV4 = getFreeAddress(ipamV4Info, inUse); ipV4 == nil {
+			return nil, log.NewErrorf("no free IPv4 addresses are left in network %s", network.Name())
+		}
+	}
+	if ipV6 == nil && containsIPv6(reserved) {
+		if ipV6 = getFreeAddress(ipamV6Info, inUse); ipV6 == nil {
+			return nil, log.NewErrorf("no free IPv6 addresses are left in network %s", network.Name())
+		}
+	}
+
+	var epOptions []libnetwork.EndpointOption
+	if ipV4 != nil || ipV6 != nil {
+		epOptions = append(epOptions, libnetwork.CreateOptionIpam(ipV4, ipV6, nil, nil))
+	}
+	if mac != nil {
+		epOptions = append(epOptions, libnetwork.EndpointOptionGeneric(options.Generic{netlabel.MacAddress: mac}))
+	}
+	return epOptions, nil
+}
+
+// buildRestoredEndpointOptions returns the endpoint options requesting the addresses that the provided restored endpoint had.
+func buildRestoredEndpointOptions(restored *types.EndpointSettings) []libnetwork.EndpointOption {
+	var epOptions []libnetwork.EndpointOption
+	ipV4, ipV6 := net.ParseIP(restored.IPAddress), net.ParseIP(restored.IPv6Address)
+	if ipV4 != nil || ipV6 != nil {
+		epOptions = append(epOptions, libnetwork.CreateOptionIpam(ipV4, ipV6, nil, nil))
+	}
+	if mac, err := net.ParseMAC(restored.MacAddress); err == nil {
+		epOptions = append(epOptions, libnetwork.EndpointOptionGeneric(options.Generic{netlabel.MacAddress: mac}))
+	}
+	return epOptions
+}
+
+// getReservedAddresses returns the static IP addresses configured in the provided network for the containers other than the given one.
+func getReservedAddresses(networkName string, container *types.Container, containers map[string]*types.Container) []net.IP {
+	var reserved []net.IP
+	for id, ctr := range containers {
+		if id == container.ID || ctr.HostConfig == nil || ctr.HostConfig.EndpointsConfig[networkName] == nil || !containsName(getContainerNetworkNames(ctr), networkName) {
+			continue
+		}
+		epConfig := ctr.HostConfig.EndpointsConfig[networkName]
+		for _, address := range []string{epConfig.IPv4Address, epConfig.IPv6Address} {
+			if ip := net.ParseIP(address); ip != nil {
+				reserved = append(reserved, ip)
+			}
+		}
+	}
+	return reserved
+}
+
+// getMismatchedStaticEndpoints returns the names of the networks whose restored endpoints do not match the static addresses configured for the container.
+func getMismatchedStaticEndpoints(container *types.Container) []string {
+	var mismatched []string
+	for netName, epConfig := range container.HostConfig.EndpointsConfig {
+		epSettings := container.NetworkSettings.Networks[netName]
+		if epSettings == nil || epConfig == nil {
+			continue
+		}
+		if (epConfig.IPv4Address != "" && !net.ParseIP(epConfig.IPv4Address).Equal(net.ParseIP(epSettings.IPAddress))) ||
+			(epConfig.IPv6Address != "" && !net.ParseIP(epConfig.IPv6Address).Equal(net.ParseIP(epSettings.IPv6Address))) ||
+			(epConfig.MacAddress != "" && !strings.EqualFold(epConfig.MacAddress, epSettings.MacAddress)) {
+			mismatched = append(mismatched, netName)
+		}
+	}
+	sort.Strings(mismatched)
+	return mismatched
+}
+
+// getFreeAddress returns the lowest address of the IPAM pools that is neither the subnet's nor the broadcast address, the gateway or in use.
+func getFreeAddress(ipamInfo []*libnetwork.IpamInfo, inUse []net.IP) net.IP {
+	for _, info := range ipamInfo {
+		if info.Pool == nil {
+			continue
+		}
+		_, bits := info.Pool.Mask.Size()
+		ip := info.Pool.IP.Mask(info.Pool.Mask)
+		for ip = nextIP(ip); info.Pool.Contains(ip); ip = nextIP(ip) {
+			if bits == net.IPv4len*8 && !info.Pool.Contains(nextIP(ip)) {
+				// the broadcast address
+				break
+			}
+			if (info.Gateway != nil && info.Gateway.IP.Equal(ip)) || containsIP(inUse, ip) {
+				continue
+			}
+			return ip
+		}
+	}
+	return nil
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func containsIPv4(ips []net.IP) bool {
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIPv6(ips []net.IP) bool {
+	for _, ip := range ips {
+		if ip.To4() == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isInIpamPools(ip net.IP, ipamInfo []*libnetwork.IpamInfo) bool {
+	for _, info := range ipamInfo {
+		if info.Pool != nil && info.Pool.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
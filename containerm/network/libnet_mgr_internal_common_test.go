@@ -13,13 +13,20 @@
 package network
 
 import (
+	"net"
 	"path/filepath"
 	"testing"
 
+	"github.com/docker/docker/libnetwork"
 	libnetconfig "github.com/docker/docker/libnetwork/config"
+	"github.com/docker/docker/libnetwork/driverapi"
 	"github.com/docker/docker/libnetwork/netlabel"
 	"github.com/docker/docker/libnetwork/options"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocks "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
+	"github.com/golang/mock/gomock"
 )
 
 var (
@@ -199,7 +206,9 @@ func TestNetMrgOptsToLibnetConfig(t *testing.T) {
 		ipV4:          "ipV4",
 		fixedCIDRv4:   "fixedCIDRv4",
 		gatewayIPv4:   "gatewayIPv4",
-		enableIPv6:    false,
+		enableIPv6:    true,
+		fixedCIDRv6:   "fixedCIDRv6",
+		gatewayIPv6:   "gatewayIPv6",
 		mtu:           1500,
 		icc:           false,
 		ipTables:      true,
@@ -218,6 +227,8 @@ func TestNetMrgOptsToLibnetConfig(t *testing.T) {
 			fixedCIDRv4:   netOptsToTest.fixedCIDRv4,
 			gatewayIPv4:   netOptsToTest.gatewayIPv4,
 			enableIPv6:    netOptsToTest.enableIPv6,
+			fixedCIDRv6:   netOptsToTest.fixedCIDRv6,
+			gatewayIPv6:   netOptsToTest.gatewayIPv6,
 			mtu:           netOptsToTest.mtu,
 			icc:           netOptsToTest.icc,
 			ipTables:      netOptsToTest.ipTables,
@@ -236,3 +247,250 @@ func TestNetMrgOptsToLibnetConfig(t *testing.T) {
 	testutil.AssertEqual(t, expectedCfg.activeSandboxes, resultCfg.activeSandboxes)
 	testutil.AssertEqual(t, expectedCfg.bridgeConfig, resultCfg.bridgeConfig)
 }
+
+func TestBuildBridgeIPv6IpamConf(t *testing.T) {
+	tests := map[string]struct {
+		bridgeConfig bridgeConfig
+		expectedConf []*libnetwork.IpamConf
+		expectedErr  error
+	}{
+		"test_ipv6_disabled": {
+			bridgeConfig: bridgeConfig{fixedCIDRv6: "fd00:cafe::/64"},
+		},
+		"test_ipv6_enabled_no_subnet": {
+			bridgeConfig: bridgeConfig{enableIPv6: true},
+		},
+		"test_ipv6_subnet": {
+			bridgeConfig: bridgeConfig{enableIPv6: true, fixedCIDRv6: "fd00:cafe::1/64"},
+			expectedConf: []*libnetwork.IpamConf{{PreferredPool: "fd00:cafe::/64", AuxAddresses: map[string]string{}}},
+		},
+		"test_ipv6_subnet_and_gateway": {
+			bridgeConfig: bridgeConfig{enableIPv6: true, fixedCIDRv6: "2001:db8:1::/64", gatewayIPv6: "2001:db8:1::1"},
+			expectedConf: []*libnetwork.IpamConf{{PreferredPool: "2001:db8:1::/64", Gateway: "2001:db8:1::1", AuxAddresses: map[string]string{}}},
+		},
+		"test_ipv6_subnet_invalid": {
+			bridgeConfig: bridgeConfig{enableIPv6: true, fixedCIDRv6: "172.17.0.0/16"},
+			expectedErr:  log.NewError("invalid IPv6 subnet 172.17.0.0/16 for the default bridge network"),
+		},
+		"test_ipv6_gateway_invalid": {
+			bridgeConfig: bridgeConfig{enableIPv6: true, fixedCIDRv6: "fd00:cafe::/64", gatewayIPv6: "172.17.0.1"},
+			expectedErr:  log.NewError("invalid IPv6 gateway 172.17.0.1 for the default bridge network"),
+		},
+		"test_ipv6_gateway_not_in_subnet": {
+			bridgeConfig: bridgeConfig{enableIPv6: true, fixedCIDRv6: "fd00:cafe::/64", gatewayIPv6: "fd00:beef::1"},
+			expectedErr:  log.NewError("the IPv6 gateway fd00:beef::1 is not within the subnet fd00:cafe::/64 of the default bridge network"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			conf, err := buildBridgeIPv6IpamConf(&config{bridgeConfig: testCase.bridgeConfig})
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedConf, conf)
+		})
+	}
+}
+
+func TestBuildStaticEndpointOptions(t *testing.T) {
+	const testNetworkName = "backend"
+	_, testPool, _ := net.ParseCIDR("172.20.0.0/16")
+	_, testPoolV6, _ := net.ParseCIDR("fd00::/64")
+	usedMac, _ := net.ParseMAC("02:42:ac:14:00:02")
+
+	tests := map[string]struct {
+		epConfig        *types.EndpointConfig
+		reserved        []net.IP
+		expectedOptions int
+		expectedErr     error
+	}{
+		"test_no_static_addresses": {},
+		"test_no_static_addresses_reserved_ipv4": {
+			reserved:        []net.IP{net.ParseIP("172.20.0.1")},
+			expectedOptions: 1,
+		},
+		"test_static_ipv4_reserved": {
+			epConfig:    &types.EndpointConfig{IPv4Address: "172.20.0.10"},
+			reserved:    []net.IP{net.ParseIP("172.20.0.10")},
+			expectedErr: log.NewError("the IPv4 address 172.20.0.10 is reserved for another container in network backend"),
+		},
+		"test_static_ipv6_reserved": {
+			epConfig:    &types.EndpointConfig{IPv6Address: "fd00::10"},
+			reserved:    []net.IP{net.ParseIP("fd00::10")},
+			expectedErr: log.NewError("the IPv6 address fd00::10 is reserved for another container in network backend"),
+		},
+		"test_static_ipv4_other_reserved": {
+			epConfig:        &types.EndpointConfig{IPv4Address: "172.20.0.10"},
+			reserved:        []net.IP{net.ParseIP("172.20.0.11"), net.ParseIP("fd00::11")},
+			expectedOptions: 1,
+		},
+		"test_static_ipv4_and_mac": {
+			epConfig:        &types.EndpointConfig{IPv4Address: "172.20.0.10", MacAddress: "02:42:ac:14:00:0a"},
+			expectedOptions: 2,
+		},
+		"test_static_ipv6": {
+			epConfig:        &types.EndpointConfig{IPv6Address: "fd00::10"},
+			expectedOptions: 1,
+		},
+		"test_ipv4_not_in_subnet": {
+			epConfig:    &types.EndpointConfig{IPv4Address: "172.21.0.10"},
+			expectedErr: log.NewError("the IPv4 address 172.21.0.10 is not within the subnet of network backend"),
+		},
+		"test_ipv6_not_in_subnet": {
+			epConfig:    &types.EndpointConfig{IPv6Address: "fd01::10"},
+			expectedErr: log.NewError("the IPv6 address fd01::10 is not within the subnet of network backend"),
+		},
+		"test_ipv4_in_use": {
+			epConfig:    &types.EndpointConfig{IPv4Address: "172.20.0.2"},
+			expectedErr: log.NewError("the IPv4 address 172.20.0.2 is already in use in network backend"),
+		},
+		"test_mac_in_use": {
+			epConfig:    &types.EndpointConfig{MacAddress: "02:42:AC:14:00:02"},
+			expectedErr: log.NewError("the MAC address 02:42:AC:14:00:02 is already in use in network backend"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			container := &types.Container{ID: testCtrID, HostConfig: &types.HostConfig{NetworkMode: testNetworkName}}
+			if testCase.epConfig != nil {
+				container.HostConfig.EndpointsConfig = map[string]*types.EndpointConfig{testNetworkName: testCase.epConfig}
+			}
+
+			mockNetwork := mocks.NewMockNetwork(controller)
+			mockNetworkInfo := mocks.NewMockNetworkInfo(controller)
+			mockEp := mocks.NewMockEndpoint(controller)
+			mockEpInfo := mocks.NewMockEndpointInfo(controller)
+			mockIFaceInfo := mocks.NewMockInterfaceInfo(controller)
+
+			mockNetwork.EXPECT().Name().Return(testNetworkName).AnyTimes()
+			mockNetwork.EXPECT().Info().Return(mockNetworkInfo).AnyTimes()
+			mockNetworkInfo.EXPECT().IpamInfo().Return(
+				[]*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPool}}},
+				[]*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPoolV6}}}).AnyTimes()
+			mockNetwork.EXPECT().Endpoints().Return([]libnetwork.Endpoint{mockEp}).AnyTimes()
+			mockEp.EXPECT().Name().Return("other-ctr-id-ep").AnyTimes()
+			mockEp.EXPECT().Info().Return(mockEpInfo).AnyTimes()
+			mockEpInfo.EXPECT().Iface().Return(mockIFaceInfo).AnyTimes()
+			mockIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: net.ParseIP("172.20.0.2"), Mask: testPool.Mask}).AnyTimes()
+			mockIFaceInfo.EXPECT().AddressIPv6().Return(nil).AnyTimes()
+			mockIFaceInfo.EXPECT().MacAddress().Return(usedMac).AnyTimes()
+
+			epOptions, err := buildStaticEndpointOptions(mockNetwork, container, testCase.reserved)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedOptions, len(epOptions))
+		})
+	}
+}
+
+func TestGetFreeAddress(t *testing.T) {
+	_, testPool, _ := net.ParseCIDR("172.20.0.0/30")
+	_, testPoolV6, _ := net.ParseCIDR("fd00::/126")
+	testGateway := &net.IPNet{IP: net.ParseIP("172.20.0.1"), Mask: testPool.Mask}
+
+	tests := map[string]struct {
+		ipamInfo   []*libnetwork.IpamInfo
+		inUse      []net.IP
+		expectedIP net.IP
+	}{
+		"test_no_pools": {},
+		"test_first_after_gateway": {
+			ipamInfo:   []*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPool, Gateway: testGateway}}},
+			expectedIP: net.ParseIP("172.20.0.2").To4(),
+		},
+		"test_ipv4_no_broadcast": {
+			ipamInfo: []*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPool, Gateway: testGateway}}},
+			inUse:    []net.IP{net.ParseIP("172.20.0.2")},
+		},
+		"test_ipv6_last": {
+			ipamInfo:   []*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPoolV6}}},
+			inUse:      []net.IP{net.ParseIP("fd00::1"), net.ParseIP("fd00::2")},
+			expectedIP: net.ParseIP("fd00::3"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expectedIP, getFreeAddress(testCase.ipamInfo, testCase.inUse))
+		})
+	}
+}
+
+func TestGetReservedAddresses(t *testing.T) {
+	const testNetworkName = "backend"
+	container := &types.Container{ID: testCtrID, HostConfig: &types.HostConfig{NetworkMode: testNetworkName,
+		EndpointsConfig: map[string]*types.EndpointConfig{testNetworkName: {IPv4Address: "172.20.0.10"}}}}
+	containers := map[string]*types.Container{
+		container.ID: container,
+		"other-ctr-id": {ID: "other-ctr-id", HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge, Networks: []string{testNetworkName},
+			EndpointsConfig: map[string]*types.EndpointConfig{testNetworkName: {IPv4Address: "172.20.0.11", IPv6Address: "fd00::11"}}}},
+		"host-ctr-id": {ID: "host-ctr-id", HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeHost,
+			EndpointsConfig: map[string]*types.EndpointConfig{testNetworkName: {IPv4Address: "172.20.0.12"}}}},
+	}
+	testutil.AssertEqual(t, []net.IP{net.ParseIP("172.20.0.11"), net.ParseIP("fd00::11")}, getReservedAddresses(testNetworkName, container, containers))
+}
+
+func TestGetMismatchedStaticEndpoints(t *testing.T) {
+	container := &types.Container{
+		ID: testCtrID,
+		HostConfig: &types.HostConfig{EndpointsConfig: map[string]*types.EndpointConfig{
+			"backend":  {IPv4Address: "172.20.0.10"},
+			"frontend": {IPv6Address: "fd00::10", MacAddress: "02:42:AC:14:00:0A"},
+			"storage":  {IPv4Address: "172.22.0.10"},
+			"missing":  {IPv4Address: "172.23.0.10"},
+		}},
+		NetworkSettings: &types.NetworkSettings{Networks: map[string]*types.EndpointSettings{
+			"backend":  {IPAddress: "172.20.0.2"},
+			"frontend": {IPv6Address: "fd00:0::10", MacAddress: "02:42:ac:14:00:0a"},
+			"storage":  {IPAddress: "172.22.0.2"},
+		}},
+	}
+	testutil.AssertEqual(t, []string{"backend", "storage"}, getMismatchedStaticEndpoints(container))
+}
+
+func TestResolveToHostIPOnInterfaceContainer(t *testing.T) {
+	bridgeContainer := func(hostName string, epSettings *types.EndpointSettings) *types.Container {
+		return &types.Container{
+			ID:              hostName + "-id",
+			HostName:        hostName,
+			HostConfig:      &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+			NetworkSettings: &types.NetworkSettings{Networks: map[string]*types.EndpointSettings{string(types.NetworkModeBridge): epSettings}},
+		}
+	}
+	tests := map[string]struct {
+		ipToCheck   string
+		containers  []*types.Container
+		expectedIP  string
+		expectedErr error
+	}{
+		"test_plain_ipv6": {
+			ipToCheck:  "2001:db8::1",
+			expectedIP: "2001:db8::1",
+		},
+		"test_container_ipv4": {
+			ipToCheck:  "container_db",
+			containers: []*types.Container{bridgeContainer("db", &types.EndpointSettings{IPAddress: "172.17.0.3", IPv6Address: "fd00:cafe::3"})},
+			expectedIP: "172.17.0.3",
+		},
+		"test_container_ipv6_only": {
+			ipToCheck:  "container_db",
+			containers: []*types.Container{bridgeContainer("db", &types.EndpointSettings{IPv6Address: "fd00:cafe::3"})},
+			expectedIP: "fd00:cafe::3",
+		},
+		"test_container_not_found": {
+			ipToCheck:   "container_db",
+			expectedErr: log.NewError("unable to find container that matches interface container_db for container with id = app-id"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			container := bridgeContainer("app", &types.EndpointSettings{})
+			ip, err := resolveToHostIPOnInterface(container, testCase.containers, &config{}, testCase.ipToCheck)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			testutil.AssertEqual(t, testCase.expectedIP, ip)
+		})
+	}
+}
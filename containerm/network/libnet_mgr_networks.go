@@ -0,0 +1,344 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+
+	"github.com/docker/docker/libnetwork"
+	"github.com/docker/docker/libnetwork/drivers/bridge"
+	"github.com/docker/docker/libnetwork/netlabel"
+	"github.com/docker/docker/pkg/ioutils"
+)
+
+const (
+	networksRootDir       = "networks"
+	networkConfigFileExt  = ".json"
+	noneNetworkName       = "none"
+	noSuchNetworkErrorMsg = "no such network with name = %s exists"
+	networkMTUMax         = 65535
+	defaultIPAMDriver     = "default"
+)
+
+func (netMgr *libnetworkMgr) CreateNetwork(ctx context.Context, name string, opts *networktypes.NetworkOpts) (*networktypes.Network, error) {
+	if opts == nil {
+		opts = &networktypes.NetworkOpts{}
+	}
+	if err := validateNetwork(name, opts); err != nil {
+		return nil, err
+	}
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	if _, err := netMgr.netController.NetworkByName(name); err == nil {
+		return nil, log.NewErrorf("network with name = %s already exists", name)
+	}
+	network, err := netMgr.createBridgeNetwork(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	result := toNetwork(netMgr.netController, network)
+	if err = netMgr.saveNetwork(result); err != nil {
+		if deleteErr := network.Delete(); deleteErr != nil {
+			log.ErrorErr(deleteErr, "failed to delete network with name = %s that could not be persisted", name)
+		}
+		return nil, err
+	}
+	netMgr.startDNSResolver(network)
+	log.Debug("created network with name = %s and subnet = %s", name, result.Subnet)
+	return result, nil
+}
+
+func (netMgr *libnetworkMgr) GetNetwork(ctx context.Context, name string) (*networktypes.Network, error) {
+	network, err := netMgr.netController.NetworkByName(name)
+	if err != nil {
+		return nil, log.NewErrorf(noSuchNetworkErrorMsg, name)
+	}
+	return toNetwork(netMgr.netController, network), nil
+}
+
+func (netMgr *libnetworkMgr) ListNetworks(ctx context.Context) ([]*networktypes.Network, error) {
+	networks := []*networktypes.Network{}
+	for _, network := range netMgr.netController.Networks() {
+		networks = append(networks, toNetwork(netMgr.netController, network))
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+	return networks, nil
+}
+
+func (netMgr *libnetworkMgr) RemoveNetwork(ctx context.Context, name string) error {
+	if isBuiltInNetwork(name) {
+		return log.NewErrorf("network with name = %s is a built-in network and cannot be removed", name)
+	}
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	network, err := netMgr.netController.NetworkByName(name)
+	if err != nil {
+		return log.NewErrorf(noSuchNetworkErrorMsg, name)
+	}
+	if containers := netMgr.getNetworkUsers(name); len(containers) > 0 {
+		return log.NewErrorf("network with name = %s is in use by containers [%s]", name, strings.Join(containers, ", "))
+	}
+	netMgr.stopDNSResolver(name)
+	if err = network.Delete(); err != nil {
+		netMgr.startDNSResolver(network)
+		return err
+	}
+	if err = os.Remove(netMgr.getNetworkConfigPath(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.Debug("removed network with name = %s", name)
+	return nil
+}
+
+func (netMgr *libnetworkMgr) AcquireNetworks(ctx context.Context, container *types.Container) error {
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	for _, name := range getContainerNetworkNames(container) {
+		if _, err := netMgr.netController.NetworkByName(name); err != nil {
+			return log.NewErrorf(noSuchNetworkErrorMsg, name)
+		}
+	}
+	if netMgr.configuredContainers == nil {
+		netMgr.configuredContainers = make(map[string]*types.Container)
+	}
+	netMgr.configuredContainers[container.ID] = container
+	return nil
+}
+
+func (netMgr *libnetworkMgr) ReleaseNetworks(ctx context.Context, container *types.Container) error {
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	delete(netMgr.configuredContainers, container.ID)
+	return nil
+}
+
+// restoreConfiguredContainers marks the networks configured for the restored containers as used by them.
+// The networks are not checked as the user-defined ones are restored afterwards.
+func (netMgr *libnetworkMgr) restoreConfiguredContainers(containers []*types.Container) {
+	netMgr.networksLock.Lock()
+	defer netMgr.networksLock.Unlock()
+
+	if netMgr.configuredContainers == nil {
+		netMgr.configuredContainers = make(map[string]*types.Container)
+	}
+	for _, ctr := range containers {
+		if ctr.State == nil || !util.IsContainerDead(ctr) {
+			netMgr.configuredContainers[ctr.ID] = ctr
+		}
+	}
+}
+
+// getNetworkUsers returns the IDs of the containers connected to the network or configured to use it.
+// The netMgr.networksLock must be used when calling this method.
+func (netMgr *libnetworkMgr) getNetworkUsers(name string) []string {
+	containers := getNetworkContainers(netMgr.netController, name)
+	for id, ctr := range netMgr.configuredContainers {
+		for _, ctrNetworkName := range getContainerNetworkNames(ctr) {
+			if ctrNetworkName == name && !containsName(containers, id) {
+				containers = append(containers, id)
+				break
+			}
+		}
+	}
+	sort.Strings(containers)
+	return containers
+}
+
+func (netMgr *libnetworkMgr) createBridgeNetwork(name string, opts *networktypes.NetworkOpts) (libnetwork.Network, error) {
+	mtu := opts.MTU
+	if mtu == 0 {
+		mtu = netMgr.config.bridgeConfig.mtu
+	}
+	netOption := map[string]string{
+		netlabel.DriverMTU:        strconv.Itoa(mtu),
+		bridge.EnableIPMasquerade: strconv.FormatBool(!opts.DisableIPMasquerade),
+		bridge.EnableICC:          strconv.FormatBool(!opts.DisableICC),
+	}
+	ipamV4Conf := &libnetwork.IpamConf{PreferredPool: opts.Subnet, Gateway: opts.Gateway, AuxAddresses: make(map[string]string)}
+
+	bridgeDriverOptions := []libnetwork.NetworkOption{
+		libnetwork.NetworkOptionPersist(true),
+		libnetwork.NetworkOptionEnableIPv6(false),
+		libnetwork.NetworkOptionDriverOpts(netOption),
+		libnetwork.NetworkOptionIpam(defaultIPAMDriver, "", []*libnetwork.IpamConf{ipamV4Conf}, nil, nil),
+	}
+	return netMgr.netController.NewNetwork(networktypes.NetworkDriverBridge, name, "", bridgeDriverOptions...)
+}
+
+// restoreNetworks recreates the persisted user-defined networks that are missing in the network controller
+func (netMgr *libnetworkMgr) restoreNetworks() error {
+	networksPath := filepath.Join(netMgr.config.metaPath, networksRootDir)
+	if err := util.MkDir(networksPath); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(networksPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != networkConfigFileExt {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(networksPath, entry.Name()))
+		if err != nil {
+			log.WarnErr(err, "could not read the configuration of network %s", entry.Name())
+			continue
+		}
+		network := &networktypes.Network{}
+		if err = json.Unmarshal(data, network); err != nil {
+			log.WarnErr(err, "could not parse the configuration of network %s", entry.Name())
+			continue
+		}
+		if _, err = netMgr.netController.NetworkByName(network.Name); err == nil {
+			log.Debug("network with name = %s is already available", network.Name)
+			continue
+		}
+		opts := &networktypes.NetworkOpts{
+			Subnet:              network.Subnet,
+			Gateway:             network.Gateway,
+			MTU:                 network.MTU,
+			DisableICC:          !network.ICC,
+			DisableIPMasquerade: !network.IPMasquerade,
+		}
+		if _, err = netMgr.createBridgeNetwork(network.Name, opts); err != nil {
+			log.ErrorErr(err, "could not restore network with name = %s", network.Name)
+			continue
+		}
+		log.Debug("restored network with name = %s", network.Name)
+	}
+	return nil
+}
+
+func (netMgr *libnetworkMgr) saveNetwork(network *networktypes.Network) error {
+	config := *network
+	config.Containers = nil
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&config); err != nil {
+		return err
+	}
+	if err := util.MkDir(filepath.Join(netMgr.config.metaPath, networksRootDir)); err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(netMgr.getNetworkConfigPath(network.Name), buf.Bytes(), 0644)
+}
+
+func (netMgr *libnetworkMgr) getNetworkConfigPath(name string) string {
+	return filepath.Join(netMgr.config.metaPath, networksRootDir, name+networkConfigFileExt)
+}
+
+func validateNetwork(name string, opts *networktypes.NetworkOpts) error {
+	if err := util.ValidateNetworkName(name); err != nil {
+		return err
+	}
+	if isBuiltInNetwork(name) || name == noneNetworkName {
+		return log.NewErrorf("network name %s is reserved", name)
+	}
+	var subnet *net.IPNet
+	if opts.Subnet != "" {
+		ip, ipNet, err := net.ParseCIDR(opts.Subnet)
+		if err != nil || ip.To4() == nil {
+			return log.NewErrorf("invalid IPv4 subnet %s", opts.Subnet)
+		}
+		subnet = ipNet
+	}
+	if opts.Gateway != "" {
+		gateway := net.ParseIP(opts.Gateway)
+		if gateway == nil || gateway.To4() == nil {
+			return log.NewErrorf("invalid IPv4 gateway %s", opts.Gateway)
+		}
+		if subnet == nil {
+			return log.NewErrorf("the gateway %s can be set only together with a subnet", opts.Gateway)
+		}
+		if !subnet.Contains(gateway) {
+			return log.NewErrorf("the gateway %s is not within the subnet %s", opts.Gateway, opts.Subnet)
+		}
+	}
+	if opts.MTU < 0 || opts.MTU > networkMTUMax {
+		return log.NewErrorf("invalid MTU %d, it must be between 0 and %d", opts.MTU, networkMTUMax)
+	}
+	return nil
+}
+
+func isBuiltInNetwork(name string) bool {
+	return name == bridgeNetworkName || name == hostNetworkName
+}
+
+func toNetwork(netController libnetwork.NetworkController, network libnetwork.Network) *networktypes.Network {
+	info := network.Info()
+	result := &networktypes.Network{
+		Name:       network.Name(),
+		ID:         network.ID(),
+		Driver:     network.Type(),
+		BuiltIn:    isBuiltInNetwork(network.Name()),
+		Created:    info.Created().UTC().Format(time.RFC3339Nano),
+		Containers: getNetworkContainers(netController, network.Name()),
+	}
+	if ipamV4Info, _ := info.IpamInfo(); len(ipamV4Info) > 0 {
+		if ipamV4Info[0].Pool != nil {
+			result.Subnet = ipamV4Info[0].Pool.String()
+		}
+		if ipamV4Info[0].Gateway != nil {
+			result.Gateway = ipamV4Info[0].Gateway.IP.String()
+		}
+	}
+	driverOpts := info.DriverOptions()
+	result.MTU, _ = strconv.Atoi(driverOpts[netlabel.DriverMTU])
+	result.ICC, _ = strconv.ParseBool(driverOpts[bridge.EnableICC])
+	result.IPMasquerade, _ = strconv.ParseBool(driverOpts[bridge.EnableIPMasquerade])
+	return result
+}
+
+// getContainerNetworkNames returns the names of the networks the container is connected to when started
+func getContainerNetworkNames(container *types.Container) []string {
+	if !util.IsContainerNetworkBridge(container) {
+		return nil
+	}
+	return append([]string{string(container.HostConfig.NetworkMode)}, container.HostConfig.Networks...)
+}
+
+// getNetworkContainers returns the IDs of the containers that have an endpoint in the network
+func getNetworkContainers(netController libnetwork.NetworkController, networkName string) []string {
+	var containers []string
+	netController.WalkSandboxes(func(sb libnetwork.Sandbox) bool {
+		for _, ep := range sb.Endpoints() {
+			if ep.Network() == networkName {
+				containers = append(containers, sb.ContainerID())
+				break
+			}
+		}
+		return false
+	})
+	sort.Strings(containers)
+	return containers
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocks "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+
+	"github.com/docker/docker/libnetwork"
+	"github.com/docker/docker/libnetwork/driverapi"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	testNetworkName    = "backend"
+	testNetworkID      = "backend-net-id"
+	testNetworkSubnet  = "172.30.0.0/16"
+	testNetworkGateway = "172.30.0.1"
+)
+
+var testNetworkCreated = time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+func newTestNetwork() *networktypes.Network {
+	return &networktypes.Network{
+		Name:         testNetworkName,
+		ID:           testNetworkID,
+		Driver:       networktypes.NetworkDriverBridge,
+		Subnet:       testNetworkSubnet,
+		Gateway:      testNetworkGateway,
+		MTU:          1500,
+		ICC:          true,
+		IPMasquerade: true,
+		Created:      testNetworkCreated.Format(time.RFC3339Nano),
+	}
+}
+
+// mockNetworkInfo sets the expectations for converting the mocked network to the expected one
+func mockNetworkInfo(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController, mockNetwork *mocks.MockNetwork, expected *networktypes.Network) {
+	mockNetworkInfo := mocks.NewMockNetworkInfo(gomockCtrl)
+	mockNetwork.EXPECT().Name().Return(expected.Name).AnyTimes()
+	mockNetwork.EXPECT().ID().Return(expected.ID)
+	mockNetwork.EXPECT().Type().Return(expected.Driver)
+	mockNetwork.EXPECT().Info().Return(mockNetworkInfo)
+	mockNetworkInfo.EXPECT().Created().Return(testNetworkCreated)
+	_, subnet, _ := net.ParseCIDR(expected.Subnet)
+	mockNetworkInfo.EXPECT().IpamInfo().Return([]*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{
+		Pool:    subnet,
+		Gateway: &net.IPNet{IP: net.ParseIP(expected.Gateway), Mask: subnet.Mask},
+	}}}, nil)
+	mockNetworkInfo.EXPECT().DriverOptions().Return(map[string]string{
+		"com.docker.network.driver.mtu":                  "1500",
+		"com.docker.network.bridge.enable_icc":           "true",
+		"com.docker.network.bridge.enable_ip_masquerade": "true",
+	})
+
+	var sandboxes []libnetwork.Sandbox
+	for _, ctrID := range expected.Containers {
+		mockSb := mocks.NewMockSandbox(gomockCtrl)
+		mockEp := mocks.NewMockEndpoint(gomockCtrl)
+		mockSb.EXPECT().Endpoints().Return([]libnetwork.Endpoint{mockEp})
+		mockSb.EXPECT().ContainerID().Return(ctrID)
+		mockEp.EXPECT().Network().Return(expected.Name)
+		sandboxes = append(sandboxes, mockSb)
+	}
+	mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any()).Do(func(walker libnetwork.SandboxWalker) {
+		for _, sb := range sandboxes {
+			if walker(sb) {
+				return
+			}
+		}
+	})
+}
+
+func TestCreateNetwork(t *testing.T) {
+	tests := map[string]struct {
+		name        string
+		opts        *networktypes.NetworkOpts
+		mock        func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController)
+		expectedErr error
+	}{
+		"test_create_network": {
+			name: testNetworkName,
+			opts: &networktypes.NetworkOpts{Subnet: testNetworkSubnet, Gateway: testNetworkGateway},
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockNetwork := mocks.NewMockNetwork(gomockCtrl)
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+				mockLibnetMgr.EXPECT().NewNetwork(networktypes.NetworkDriverBridge, testNetworkName, "", gomock.Any()).Return(mockNetwork, nil)
+				mockNetworkInfo(gomockCtrl, mockLibnetMgr, mockNetwork, newTestNetwork())
+			},
+		},
+		"test_create_network_invalid_name": {
+			name:        "-backend",
+			expectedErr: log.NewError("invalid network name -backend, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed"),
+		},
+		"test_create_network_reserved_name": {
+			name:        "none",
+			expectedErr: log.NewError("network name none is reserved"),
+		},
+		"test_create_network_invalid_subnet": {
+			name:        testNetworkName,
+			opts:        &networktypes.NetworkOpts{Subnet: "172.30.0.0"},
+			expectedErr: log.NewError("invalid IPv4 subnet 172.30.0.0"),
+		},
+		"test_create_network_invalid_gateway": {
+			name:        testNetworkName,
+			opts:        &networktypes.NetworkOpts{Subnet: testNetworkSubnet, Gateway: "172.30.0"},
+			expectedErr: log.NewError("invalid IPv4 gateway 172.30.0"),
+		},
+		"test_create_network_gateway_without_subnet": {
+			name:        testNetworkName,
+			opts:        &networktypes.NetworkOpts{Gateway: testNetworkGateway},
+			expectedErr: log.NewErrorf("the gateway %s can be set only together with a subnet", testNetworkGateway),
+		},
+		"test_create_network_gateway_outside_subnet": {
+			name:        testNetworkName,
+			opts:        &networktypes.NetworkOpts{Subnet: testNetworkSubnet, Gateway: "172.31.0.1"},
+			expectedErr: log.NewErrorf("the gateway 172.31.0.1 is not within the subnet %s", testNetworkSubnet),
+		},
+		"test_create_network_invalid_mtu": {
+			name:        testNetworkName,
+			opts:        &networktypes.NetworkOpts{MTU: -1},
+			expectedErr: log.NewError("invalid MTU -1, it must be between 0 and 65535"),
+		},
+		"test_create_network_existing": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mocks.NewMockNetwork(gomockCtrl), nil)
+			},
+			expectedErr: log.NewErrorf("network with name = %s already exists", testNetworkName),
+		},
+		"test_create_network_error": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+				mockLibnetMgr.EXPECT().NewNetwork(networktypes.NetworkDriverBridge, testNetworkName, "", gomock.Any()).Return(nil, log.NewError("pool overlaps"))
+			},
+			expectedErr: log.NewError("pool overlaps"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer func() {
+				os.RemoveAll(testDirsRoot)
+				controller.Finish()
+			}()
+			mgrConfig := newDefaultMgrConfig()
+			mockLibnetMgr := mocks.NewMockNetworkController(controller)
+			if testCase.mock != nil {
+				testCase.mock(controller, mockLibnetMgr)
+			}
+			testMgr := &libnetworkMgr{config: mgrConfig, netController: mockLibnetMgr}
+
+			network, err := testMgr.CreateNetwork(context.Background(), testCase.name, testCase.opts)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if testCase.expectedErr != nil {
+				testutil.AssertNil(t, network)
+				return
+			}
+			testutil.AssertEqual(t, newTestNetwork(), network)
+
+			data, err := ioutil.ReadFile(filepath.Join(mgrConfig.metaPath, networksRootDir, testNetworkName+networkConfigFileExt))
+			testutil.AssertNil(t, err)
+			persisted := &networktypes.Network{}
+			testutil.AssertNil(t, json.Unmarshal(data, persisted))
+			testutil.AssertEqual(t, newTestNetwork(), persisted)
+		})
+	}
+}
+
+func TestGetNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), netController: mockLibnetMgr}
+
+	t.Run("test_get_network", func(t *testing.T) {
+		expected := newTestNetwork()
+		expected.Containers = []string{"ctr-1", "ctr-2"}
+		mockNetwork := mocks.NewMockNetwork(controller)
+		mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mockNetwork, nil)
+		mockNetworkInfo(controller, mockLibnetMgr, mockNetwork, expected)
+
+		network, err := testMgr.GetNetwork(context.Background(), testNetworkName)
+		testutil.AssertNil(t, err)
+		testutil.AssertEqual(t, expected, network)
+	})
+	t.Run("test_get_network_missing", func(t *testing.T) {
+		mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+
+		network, err := testMgr.GetNetwork(context.Background(), testNetworkName)
+		testutil.AssertError(t, log.NewErrorf(noSuchNetworkErrorMsg, testNetworkName), err)
+		testutil.AssertNil(t, network)
+	})
+}
+
+func TestListNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), netController: mockLibnetMgr}
+
+	userNetwork := newTestNetwork()
+	bridgeNetwork := newTestNetwork()
+	bridgeNetwork.Name = bridgeNetworkName
+	bridgeNetwork.ID = "bridge-net-id"
+	bridgeNetwork.BuiltIn = true
+	bridgeNetwork.Containers = []string{testCtrID}
+
+	mockUserNetwork := mocks.NewMockNetwork(controller)
+	mockBridgeNetwork := mocks.NewMockNetwork(controller)
+	mockLibnetMgr.EXPECT().Networks().Return([]libnetwork.Network{mockUserNetwork, mockBridgeNetwork})
+	mockNetworkInfo(controller, mockLibnetMgr, mockUserNetwork, userNetwork)
+	mockNetworkInfo(controller, mockLibnetMgr, mockBridgeNetwork, bridgeNetwork)
+
+	networks, err := testMgr.ListNetworks(context.Background())
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []*networktypes.Network{userNetwork, bridgeNetwork}, networks)
+}
+
+func TestRemoveNetwork(t *testing.T) {
+	tests := map[string]struct {
+		name        string
+		containers  []*types.Container
+		mock        func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController)
+		expectedErr error
+	}{
+		"test_remove_network": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockNetwork := mocks.NewMockNetwork(gomockCtrl)
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mockNetwork, nil)
+				mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any())
+				mockNetwork.EXPECT().Delete().Return(nil)
+			},
+		},
+		"test_remove_network_built_in": {
+			name:        bridgeNetworkName,
+			expectedErr: log.NewErrorf("network with name = %s is a built-in network and cannot be removed", bridgeNetworkName),
+		},
+		"test_remove_network_missing": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+			},
+			expectedErr: log.NewErrorf(noSuchNetworkErrorMsg, testNetworkName),
+		},
+		"test_remove_network_in_use": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mocks.NewMockNetwork(gomockCtrl), nil)
+				mockSb := mocks.NewMockSandbox(gomockCtrl)
+				mockEp := mocks.NewMockEndpoint(gomockCtrl)
+				mockSb.EXPECT().Endpoints().Return([]libnetwork.Endpoint{mockEp})
+				mockSb.EXPECT().ContainerID().Return(testCtrID)
+				mockEp.EXPECT().Network().Return(testNetworkName)
+				mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any()).Do(func(walker libnetwork.SandboxWalker) {
+					walker(mockSb)
+				})
+			},
+			expectedErr: log.NewErrorf("network with name = %s is in use by containers [%s]", testNetworkName, testCtrID),
+		},
+		"test_remove_network_configured_for_stopped_container": {
+			name: testNetworkName,
+			containers: []*types.Container{
+				{ID: "test-ctr-other", HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge, Networks: []string{"monitoring"}}},
+				{ID: testCtrID, HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge, Networks: []string{testNetworkName}}},
+			},
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mocks.NewMockNetwork(gomockCtrl), nil)
+				mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any())
+			},
+			expectedErr: log.NewErrorf("network with name = %s is in use by containers [%s]", testNetworkName, testCtrID),
+		},
+		"test_remove_network_error": {
+			name: testNetworkName,
+			mock: func(gomockCtrl *gomock.Controller, mockLibnetMgr *mocks.MockNetworkController) {
+				mockNetwork := mocks.NewMockNetwork(gomockCtrl)
+				mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mockNetwork, nil)
+				mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any())
+				mockNetwork.EXPECT().Delete().Return(log.NewError("error deleting network"))
+			},
+			expectedErr: log.NewError("error deleting network"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer func() {
+				os.RemoveAll(testDirsRoot)
+				controller.Finish()
+			}()
+			mgrConfig := newDefaultMgrConfig()
+			mockLibnetMgr := mocks.NewMockNetworkController(controller)
+			if testCase.mock != nil {
+				testCase.mock(controller, mockLibnetMgr)
+			}
+			testMgr := &libnetworkMgr{config: mgrConfig, netController: mockLibnetMgr}
+			testMgr.restoreConfiguredContainers(testCase.containers)
+			testutil.AssertNil(t, testMgr.saveNetwork(newTestNetwork()))
+
+			err := testMgr.RemoveNetwork(context.Background(), testCase.name)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			_, statErr := os.Stat(testMgr.getNetworkConfigPath(testNetworkName))
+			testutil.AssertEqual(t, testCase.expectedErr == nil, os.IsNotExist(statErr))
+		})
+	}
+}
+
+func TestRestoreNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer func() {
+		os.RemoveAll(testDirsRoot)
+		controller.Finish()
+	}()
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), netController: mockLibnetMgr}
+
+	existing := newTestNetwork()
+	existing.Name = "existing"
+	testutil.AssertNil(t, testMgr.saveNetwork(existing))
+	testutil.AssertNil(t, testMgr.saveNetwork(newTestNetwork()))
+	testutil.AssertNil(t, ioutil.WriteFile(testMgr.getNetworkConfigPath("invalid"), []byte("{"), 0644))
+
+	mockLibnetMgr.EXPECT().NetworkByName("existing").Return(mocks.NewMockNetwork(controller), nil)
+	mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+	mockLibnetMgr.EXPECT().NewNetwork(networktypes.NetworkDriverBridge, testNetworkName, "", gomock.Any()).Return(mocks.NewMockNetwork(controller), nil)
+
+	testutil.AssertNil(t, testMgr.restoreNetworks())
+}
+
+func TestConnectUserDefinedNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer func() {
+		os.RemoveAll(testDirsRoot)
+		controller.Finish()
+	}()
+	mgrConfig := newDefaultMgrConfig()
+	if dirsErr := util.MkDirs(mgrConfig.metaPath, mgrConfig.execRoot); dirsErr != nil {
+		t.Fatalf("could not create the test directories meta and exec : %s, %s", mgrConfig.metaPath, mgrConfig.execRoot)
+	}
+	container := &types.Container{
+		ID: testCtrID,
+		HostConfig: &types.HostConfig{
+			NetworkMode: testNetworkName,
+			Networks:    []string{"monitoring"},
+		},
+	}
+
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	mockSb := mocks.NewMockSandbox(controller)
+	mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any()).Do(func(walker libnetwork.SandboxWalker) {
+		walker(mockSb)
+	})
+	mockSb.EXPECT().ContainerID().Return(container.ID)
+	mockSb.EXPECT().ID().Return(testCtrSandboxID)
+	mockSb.EXPECT().Key().Return(testCtrSandboxKey)
+	mockLibnetMgr.EXPECT().ID().Return(testNetworkControllerID)
+	for _, networkName := range []string{testNetworkName, "monitoring"} {
+		mockNetwork := mocks.NewMockNetwork(controller)
+		mockEp := mocks.NewMockEndpoint(controller)
+		mockEpInfo := mocks.NewMockEndpointInfo(controller)
+		mockLibnetMgr.EXPECT().NetworkByName(networkName).Return(mockNetwork, nil)
+		mockNetwork.EXPECT().CreateEndpoint(container.ID+"-ep", gomock.Any()).Return(mockEp, nil)
+		mockEp.EXPECT().Join(mockSb).Return(nil)
+		mockNetwork.EXPECT().ID().Return(networkName + "-id")
+		mockEp.EXPECT().ID().Return(networkName + "-ep-id")
+		mockEp.EXPECT().Info().Return(mockEpInfo)
+		mockEpInfo.EXPECT().Gateway().Return(nil)
+		mockEpInfo.EXPECT().GatewayIPv6().Return(nil)
+		mockEpInfo.EXPECT().Iface().Return(nil)
+	}
+	testMgr := &libnetworkMgr{config: mgrConfig, netController: mockLibnetMgr, bridgeConnectedContainers: make(map[string]*types.Container)}
+
+	err := testMgr.Connect(context.Background(), container)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, map[string]*types.EndpointSettings{
+		testNetworkName: {ID: testNetworkName + "-ep-id", NetworkID: testNetworkName + "-id"},
+		"monitoring":    {ID: "monitoring-ep-id", NetworkID: "monitoring-id"},
+	}, container.NetworkSettings.Networks)
+	testutil.AssertEqual(t, container, testMgr.bridgeConnectedContainers[container.ID])
+}
+
+func TestConnectUserDefinedNetworksError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	container := &types.Container{
+		ID: testCtrID,
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeBridge,
+			Networks:    []string{testNetworkName},
+		},
+	}
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	mockLibnetMgr.EXPECT().NetworkByName(bridgeNetworkName).Return(mocks.NewMockNetwork(controller), nil)
+	mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(nil, libnetwork.ErrNoSuchNetwork(testNetworkName))
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), netController: mockLibnetMgr}
+
+	err := testMgr.Connect(context.Background(), container)
+	testutil.AssertError(t, log.NewErrorf("no network [%s] found while connecting container %s ", testNetworkName, container.ID), err)
+}
+
+func TestAcquireReleaseNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	container := &types.Container{
+		ID: testCtrID,
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkMode(testNetworkName),
+			Networks:    []string{"monitoring"},
+		},
+	}
+	mockLibnetMgr := mocks.NewMockNetworkController(controller)
+	testMgr := &libnetworkMgr{config: newDefaultMgrConfig(), netController: mockLibnetMgr}
+
+	mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mocks.NewMockNetwork(controller), nil)
+	mockLibnetMgr.EXPECT().NetworkByName("monitoring").Return(nil, libnetwork.ErrNoSuchNetwork("monitoring"))
+	err := testMgr.AcquireNetworks(context.Background(), container)
+	testutil.AssertError(t, log.NewErrorf(noSuchNetworkErrorMsg, "monitoring"), err)
+	testutil.AssertEqual(t, 0, len(testMgr.configuredContainers))
+
+	mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mocks.NewMockNetwork(controller), nil)
+	mockLibnetMgr.EXPECT().NetworkByName("monitoring").Return(mocks.NewMockNetwork(controller), nil)
+	testutil.AssertNil(t, testMgr.AcquireNetworks(context.Background(), container))
+	mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any())
+	testutil.AssertEqual(t, []string{testCtrID}, testMgr.getNetworkUsers("monitoring"))
+
+	testutil.AssertNil(t, testMgr.ReleaseNetworks(context.Background(), container))
+	mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any())
+	testutil.AssertEqual(t, 0, len(testMgr.getNetworkUsers("monitoring")))
+}
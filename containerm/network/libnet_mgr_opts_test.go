@@ -67,6 +67,14 @@ func TestMgrOpts(t *testing.T) {
 			expectedOpts: &netOpts{fixedCIDRv4: "fixed"},
 			testOpts:     []NetOpt{WithLibNetFixedCIDRv4("fixed")},
 		},
+		"netmgr_test_opts_fixed_cidr6": {
+			expectedOpts: &netOpts{fixedCIDRv6: "fd00:cafe::/64"},
+			testOpts:     []NetOpt{WithLibNetFixedCIDRv6("fd00:cafe::/64")},
+		},
+		"netmgr_test_opts_gw_ipv6": {
+			expectedOpts: &netOpts{gatewayIPv6: "fd00:cafe::1"},
+			testOpts:     []NetOpt{WithLibNetGatewayIPv6("fd00:cafe::1")},
+		},
 		"netmgr_test_opts_gw_ipv4": {
 			expectedOpts: &netOpts{gatewayIPv4: netSettingsIPGW},
 			testOpts:     []NetOpt{WithLibNetGatewayIPv4(netSettingsIPGW)},
@@ -94,6 +102,10 @@ func TestMgrOpts(t *testing.T) {
 			expectedOpts: &netOpts{metaPath: testNetMetaPath},
 			testOpts:     []NetOpt{WithLibNetMetaPath(testNetMetaPath)},
 		},
+		"netmgr_test_opts_net_disable_dns": {
+			expectedOpts: &netOpts{disableDNS: true},
+			testOpts:     []NetOpt{WithLibNetDisableDNS(true)},
+		},
 		"netmgr_test_opts_net_mtu": {
 			expectedOpts: &netOpts{mtu: 1500},
 			testOpts:     []NetOpt{WithLibNetMtu(1500)},
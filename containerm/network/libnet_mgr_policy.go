@@ -0,0 +1,332 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"net"
+	"strings"
+
+	"github.com/docker/docker/libnetwork/iptables"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	egressChainPrefix   = "KANTO-EGRESS-"
+	egressChainIDLength = 12
+	forwardChain        = "FORWARD"
+
+	// the burst of the rate limits is at least the size of a few full-sized packets and at most 100ms of traffic at the limited rate
+	minTrafficBurst = 32 * 1024
+	// the queue of the traffic towards the container holds up to 50ms of traffic at the limited rate
+	trafficQueueLatencyDivisor = 20
+)
+
+// applyNetworkPolicy applies the rate limits and the egress rules of the given policy on the network interfaces of the container.
+// The rate limits are applied with traffic control on the host side of the container's veth pairs and the egress rules
+// are applied with a container-specific iptables chain referenced from the FORWARD chain for each of the container's addresses.
+func applyNetworkPolicy(container *types.Container, policy *types.NetworkPolicy) error {
+	if !util.IsNetworkPolicySet(policy) || container.NetworkSettings == nil {
+		return nil
+	}
+	if err := setupTrafficShaping(container.NetworkSettings.SandboxKey, policy); err != nil {
+		removeNetworkPolicy(container)
+		return log.NewErrorf("failed to apply the rate limits for container id = %s: %v", container.ID, err)
+	}
+	if err := setupEgressFiltering(container, policy); err != nil {
+		removeNetworkPolicy(container)
+		return log.NewErrorf("failed to apply the egress rules for container id = %s: %v", container.ID, err)
+	}
+	log.Debug("applied network policy for container id = %s", container.ID)
+	return nil
+}
+
+// removeNetworkPolicy removes the rate limits and the egress rules applied for the container
+func removeNetworkPolicy(container *types.Container) error {
+	var shapingErr error
+	if container.NetworkSettings != nil && container.NetworkSettings.SandboxKey != "" {
+		shapingErr = clearTrafficShaping(container.NetworkSettings.SandboxKey)
+	}
+	filteringErr := clearEgressFiltering(container.ID)
+	if shapingErr != nil {
+		return shapingErr
+	}
+	return filteringErr
+}
+
+func setupTrafficShaping(sandboxKey string, policy *types.NetworkPolicy) error {
+	if policy.IngressRate == 0 && policy.EgressRate == 0 {
+		return nil
+	}
+	links, err := hostVethLinks(sandboxKey)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		if err = clearLinkTrafficShaping(link); err != nil {
+			return err
+		}
+		// the traffic received by the container is sent by the host side of the veth pair
+		if policy.IngressRate > 0 {
+			burst := trafficBurst(policy.IngressRate)
+			tbf := &netlink.Tbf{
+				QdiscAttrs: netlink.QdiscAttrs{
+					LinkIndex: link.Attrs().Index,
+					Handle:    netlink.MakeHandle(1, 0),
+					Parent:    netlink.HANDLE_ROOT,
+				},
+				Rate:   policy.IngressRate,
+				Buffer: netlink.Xmittime(policy.IngressRate, burst),
+				Limit:  uint32(policy.IngressRate/trafficQueueLatencyDivisor) + burst,
+			}
+			if err = netlink.QdiscReplace(tbf); err != nil {
+				return err
+			}
+		}
+		// the traffic sent by the container is received by the host side of the veth pair where it can only be policed
+		if policy.EgressRate > 0 {
+			if err = setupIngressPolicing(link, uint32(policy.EgressRate)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func setupIngressPolicing(link netlink.Link, rate uint32) error {
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscReplace(ingress); err != nil {
+		return err
+	}
+	police := netlink.NewPoliceAction()
+	police.Rate = rate
+	police.Burst = trafficBurst(uint64(rate))
+	police.ExceedAction = netlink.TC_POLICE_SHOT
+	police.NotExceedAction = netlink.TC_POLICE_OK
+	filter := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingress.Handle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		// match all packets
+		Sel: &netlink.TcU32Sel{
+			Flags: netlink.TC_U32_TERMINAL,
+			Keys:  []netlink.TcU32Key{{Mask: 0, Val: 0}},
+		},
+		Actions: []netlink.Action{police},
+	}
+	return netlink.FilterReplace(filter)
+}
+
+func clearTrafficShaping(sandboxKey string) error {
+	links, err := hostVethLinks(sandboxKey)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		if err = clearLinkTrafficShaping(link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func clearLinkTrafficShaping(link netlink.Link) error {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	for _, qdisc := range qdiscs {
+		if _, isTbf := qdisc.(*netlink.Tbf); (isTbf && qdisc.Attrs().Parent == netlink.HANDLE_ROOT) || qdisc.Type() == "ingress" {
+			if err = netlink.QdiscDel(qdisc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hostVethLinks returns the host side of the veth pairs whose other side is in the network namespace of the given sandbox
+func hostVethLinks(sandboxKey string) ([]netlink.Link, error) {
+	nsHandle, err := netns.GetFromPath(sandboxKey)
+	if err != nil {
+		return nil, err
+	}
+	defer nsHandle.Close()
+	nlHandle, err := netlink.NewHandleAt(nsHandle)
+	if err != nil {
+		return nil, err
+	}
+	defer nlHandle.Delete()
+
+	ctrLinks, err := nlHandle.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	var links []netlink.Link
+	for _, ctrLink := range ctrLinks {
+		if _, isVeth := ctrLink.(*netlink.Veth); !isVeth {
+			continue
+		}
+		// the parent index of a veth is the index of its peer
+		link, err := netlink.LinkByIndex(ctrLink.Attrs().ParentIndex)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+func trafficBurst(rate uint64) uint32 {
+	if burst := rate / 10; burst > minTrafficBurst {
+		return uint32(burst)
+	}
+	return minTrafficBurst
+}
+
+func setupEgressFiltering(container *types.Container, policy *types.NetworkPolicy) error {
+	if policy.EgressDefault != types.EgressActionDeny && len(policy.EgressRules) == 0 {
+		return nil
+	}
+	chain := egressChainName(container.ID)
+	for version, addresses := range containerAddresses(container) {
+		if len(addresses) == 0 {
+			continue
+		}
+		iptable := iptables.GetIptable(version)
+		if _, err := iptable.NewChain(chain, iptables.Filter, false); err != nil {
+			return err
+		}
+		if _, err := iptable.Raw("-t", string(iptables.Filter), "-F", chain); err != nil {
+			return err
+		}
+		for _, rule := range buildEgressChainRules(policy, version) {
+			if err := iptable.RawCombinedOutput(append([]string{"-t", string(iptables.Filter), string(iptables.Append), chain}, rule...)...); err != nil {
+				return err
+			}
+		}
+		for _, address := range addresses {
+			if err := iptable.ProgramRule(iptables.Filter, forwardChain, iptables.Insert, []string{"-s", address, "-j", chain}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func clearEgressFiltering(containerID string) error {
+	chain := egressChainName(containerID)
+	for _, version := range []iptables.IPVersion{iptables.IPv4, iptables.IPv6} {
+		iptable := iptables.GetIptable(version)
+		if !iptable.ExistChain(chain, iptables.Filter) {
+			continue
+		}
+		forwardRules, err := iptable.Raw("-t", string(iptables.Filter), "-S", forwardChain)
+		if err != nil {
+			return err
+		}
+		for _, jumpRule := range egressJumpRules(string(forwardRules), chain) {
+			if err = iptable.RawCombinedOutput(append([]string{"-t", string(iptables.Filter), string(iptables.Delete), forwardChain}, jumpRule...)...); err != nil {
+				return err
+			}
+		}
+		if err = iptable.RemoveExistingChain(chain, iptables.Filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildEgressChainRules returns the rules of the container's egress chain for the given IP version.
+// The replies of the already established connections are always allowed, the allowed traffic is returned
+// to the FORWARD chain for further processing and the denied one is dropped.
+func buildEgressChainRules(policy *types.NetworkPolicy, version iptables.IPVersion) [][]string {
+	rules := [][]string{{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"}}
+	for _, egressRule := range policy.EgressRules {
+		ip, _, err := net.ParseCIDR(egressRule.CIDR)
+		if err != nil || (ip.To4() != nil) != (version == iptables.IPv4) {
+			continue
+		}
+		rule := []string{"-d", egressRule.CIDR}
+		if egressRule.Protocol != "" {
+			rule = append(rule, "-p", egressRule.Protocol)
+			if egressRule.Ports != "" {
+				rule = append(rule, "--dport", strings.Replace(egressRule.Ports, "-", ":", 1))
+			}
+		}
+		rules = append(rules, append(rule, "-j", egressTarget(egressRule.Action)))
+	}
+	if policy.EgressDefault == types.EgressActionDeny {
+		rules = append(rules, []string{"-j", egressTarget(types.EgressActionDeny)})
+	}
+	return rules
+}
+
+func egressTarget(action types.EgressAction) string {
+	if action == types.EgressActionDeny {
+		return "DROP"
+	}
+	return "RETURN"
+}
+
+// egressJumpRules returns the rules from the output of iptables -S which jump to the given chain without the append command
+func egressJumpRules(rules string, chain string) [][]string {
+	var jumpRules [][]string
+	for _, rule := range strings.Split(rules, "\n") {
+		fields := strings.Fields(rule)
+		if len(fields) < 4 || fields[0] != string(iptables.Append) || fields[len(fields)-2] != "-j" || fields[len(fields)-1] != chain {
+			continue
+		}
+		jumpRules = append(jumpRules, fields[2:])
+	}
+	return jumpRules
+}
+
+func egressChainName(containerID string) string {
+	if len(containerID) > egressChainIDLength {
+		containerID = containerID[:egressChainIDLength]
+	}
+	return egressChainPrefix + containerID
+}
+
+func containerAddresses(container *types.Container) map[iptables.IPVersion][]string {
+	addresses := map[iptables.IPVersion][]string{}
+	if container.NetworkSettings == nil {
+		return addresses
+	}
+	for _, epSettings := range container.NetworkSettings.Networks {
+		if epSettings == nil {
+			continue
+		}
+		if epSettings.IPAddress != "" {
+			addresses[iptables.IPv4] = append(addresses[iptables.IPv4], epSettings.IPAddress)
+		}
+		if epSettings.IPv6Address != "" {
+			addresses[iptables.IPv6] = append(addresses[iptables.IPv6], epSettings.IPv6Address)
+		}
+	}
+	return addresses
+}
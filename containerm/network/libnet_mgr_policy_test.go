@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package network
+
+import (
+	"testing"
+
+	"github.com/docker/docker/libnetwork/iptables"
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func TestBuildEgressChainRules(t *testing.T) {
+	establishedRule := []string{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"}
+	policy := &types.NetworkPolicy{
+		EgressDefault: types.EgressActionDeny,
+		EgressRules: []types.EgressRule{
+			{Action: types.EgressActionDeny, CIDR: "10.0.1.0/24"},
+			{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "8000-8080"},
+			{Action: types.EgressActionAllow, CIDR: "2001:db8::/32", Protocol: "udp"},
+		},
+	}
+
+	tests := map[string]struct {
+		policy        *types.NetworkPolicy
+		version       iptables.IPVersion
+		expectedRules [][]string
+	}{
+		"test_ipv4_rules": {
+			policy:  policy,
+			version: iptables.IPv4,
+			expectedRules: [][]string{
+				establishedRule,
+				{"-d", "10.0.1.0/24", "-j", "DROP"},
+				{"-d", "10.0.0.0/8", "-p", "tcp", "--dport", "8000:8080", "-j", "RETURN"},
+				{"-j", "DROP"},
+			},
+		},
+		"test_ipv6_rules": {
+			policy:  policy,
+			version: iptables.IPv6,
+			expectedRules: [][]string{
+				establishedRule,
+				{"-d", "2001:db8::/32", "-p", "udp", "-j", "RETURN"},
+				{"-j", "DROP"},
+			},
+		},
+		"test_default_allow": {
+			policy:  &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "192.168.0.0/16", Protocol: "tcp", Ports: "22"}}},
+			version: iptables.IPv4,
+			expectedRules: [][]string{
+				establishedRule,
+				{"-d", "192.168.0.0/16", "-p", "tcp", "--dport", "22", "-j", "DROP"},
+			},
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expectedRules, buildEgressChainRules(testCase.policy, testCase.version))
+		})
+	}
+}
+
+func TestEgressJumpRules(t *testing.T) {
+	chain := egressChainName("61aff3dc-1f31-420b-883a-686165e1b06b")
+	testutil.AssertEqual(t, "KANTO-EGRESS-61aff3dc-1f3", chain)
+
+	forwardRules := "-P FORWARD DROP\n" +
+		"-A FORWARD -s 172.17.0.2/32 -j KANTO-EGRESS-61aff3dc-1f3\n" +
+		"-A FORWARD -j DOCKER-ISOLATION-STAGE-1\n" +
+		"-A FORWARD -s 172.17.0.3/32 -j KANTO-EGRESS-7c9e2b1a-aa0\n" +
+		"-A FORWARD -s 172.20.0.2/32 -j KANTO-EGRESS-61aff3dc-1f3\n"
+	testutil.AssertEqual(t, [][]string{
+		{"-s", "172.17.0.2/32", "-j", chain},
+		{"-s", "172.20.0.2/32", "-j", chain},
+	}, egressJumpRules(forwardRules, chain))
+}
+
+func TestContainerAddresses(t *testing.T) {
+	container := &types.Container{
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*types.EndpointSettings{
+				bridgeNetworkName: {IPAddress: "172.17.0.2", IPv6Address: "fd00:cafe::2"},
+			},
+		},
+	}
+	testutil.AssertEqual(t, map[iptables.IPVersion][]string{
+		iptables.IPv4: {"172.17.0.2"},
+		iptables.IPv6: {"fd00:cafe::2"},
+	}, containerAddresses(container))
+	testutil.AssertEqual(t, map[iptables.IPVersion][]string{}, containerAddresses(&types.Container{}))
+}
+
+func TestApplyNetworkPolicyNotSet(t *testing.T) {
+	container := &types.Container{
+		ID:              "test-ctr",
+		HostConfig:      &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+		NetworkSettings: &types.NetworkSettings{SandboxKey: "/var/run/no-such-sandbox"},
+	}
+	testutil.AssertNil(t, applyNetworkPolicy(container, nil))
+	testutil.AssertNil(t, applyNetworkPolicy(container, &types.NetworkPolicy{}))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/util"
 
 	"github.com/docker/docker/libnetwork"
+	"github.com/docker/docker/libnetwork/driverapi"
 	libnetTypes "github.com/docker/docker/libnetwork/types"
 	"github.com/golang/mock/gomock"
 )
@@ -46,6 +47,9 @@ const (
 	netSettingsIPGW = "216.51.200.201"
 	netSettingsIP   = "216.58.208.238"
 	netSettingsMac  = "00:a0:c9:14:c8:29"
+
+	netSettingsIPv6GW = "fd00:cafe::1"
+	netSettingsIPv6   = "fd00:cafe::242:ac11:2"
 )
 
 func newDefaultMgrConfig() *config {
@@ -56,6 +60,7 @@ func newDefaultMgrConfig() *config {
 		bridgeConfig: bridgeConfig{
 			name: "test0",
 		},
+		disableDNS: true,
 	}
 }
 
@@ -67,6 +72,14 @@ func newDefaultContainer() *types.Container {
 		},
 	}
 }
+func newNetworkModeContainer() *types.Container {
+	return &types.Container{
+		ID: testCtrID,
+		HostConfig: &types.HostConfig{
+			NetworkMode: types.NetworkModeContainerPrefix + "target-ctr-id",
+		},
+	}
+}
 func newDefaultConnectedContainer() *types.Container {
 	return &types.Container{
 		ID: testCtrID,
@@ -118,6 +131,25 @@ func TestManage(t *testing.T) {
 			assertCtr:         assertManagedContainer,
 			expectedErr:       nil,
 		},
+		"netmgr_test_manage_none_mode": {
+			mgrConfig: newDefaultMgrConfig(),
+			container: &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeNone,
+				},
+			},
+			prepareMgrForTest: prepareDefault,
+			assertCtr:         assertManagedContainer,
+			expectedErr:       nil,
+		},
+		"netmgr_test_manage_container_mode": {
+			mgrConfig:         newDefaultMgrConfig(),
+			container:         newNetworkModeContainer(),
+			prepareMgrForTest: prepareNoCalls,
+			assertCtr:         assertManagedContainer,
+			expectedErr:       nil,
+		},
 		"netmgr_test_manage_default_extra_hosts": {
 			mgrConfig: newDefaultMgrConfig(),
 			container: &types.Container{
@@ -372,6 +404,22 @@ func TestConnect(t *testing.T) {
 			prepareMgrForTest: prepareConnectFullWithNetSettings,
 			assertCtr:         assertConnectedContainer,
 		},
+		"netmgr_test_connect_none_mode": {
+			mgrConfig: newDefaultMgrConfig(),
+			container: &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeNone,
+				},
+			},
+			prepareMgrForTest: prepareConnectNone,
+			assertCtr:         assertConnectedContainerNone,
+		},
+		"netmgr_test_connect_container_mode": {
+			mgrConfig:         newDefaultMgrConfig(),
+			container:         newNetworkModeContainer(),
+			prepareMgrForTest: prepareNoCalls,
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -438,6 +486,7 @@ func TestInitialize(t *testing.T) {
 				activeSandboxes: map[string]interface{}{
 					"test": "test",
 				},
+				disableDNS: true,
 			},
 			prepareMgrForTest: prepareInitWithSbs,
 		},
@@ -452,6 +501,7 @@ func TestInitialize(t *testing.T) {
 				activeSandboxes: map[string]interface{}{
 					"test": "test",
 				},
+				disableDNS: true,
 			},
 			prepareMgrForTest: prepareInitWithSbsDefaultBridgeError,
 			expectedErr:       log.NewError("default bridge failed"),
@@ -543,6 +593,33 @@ func TestReleaseNetworkResources(t *testing.T) {
 			prepareMgrForTest: prepareReleaseResourcesSbDeleteError,
 			expectedErr:       log.NewError("error deleting sandbox"),
 		},
+		"netmgr_test_rnr_none_mode": {
+			mgrConfig: newDefaultMgrConfig(),
+			container: &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeNone,
+				},
+				NetworkSettings: &types.NetworkSettings{
+					SandboxID: testCtrSandboxID,
+				},
+			},
+			prepareMgrForTest: prepareReleaseResourcesNone,
+		},
+		"netmgr_test_rnr_none_mode_sb_destroy_err": {
+			mgrConfig: newDefaultMgrConfig(),
+			container: &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeNone,
+				},
+				NetworkSettings: &types.NetworkSettings{
+					SandboxID: testCtrSandboxID,
+				},
+			},
+			prepareMgrForTest: prepareReleaseResourcesNoneSbDestroyError,
+			expectedErr:       log.NewError("error destroying sandbox"),
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -662,6 +739,142 @@ func TestRestore(t *testing.T) {
 	}
 }
 
+func TestReapplyStaticAddresses(t *testing.T) {
+	const (
+		testNetworkName      = "backend"
+		testStaticIP         = "172.20.0.10"
+		testRestoredIP       = "172.20.0.2"
+		testNewEndpoint      = "new-ep-id"
+		testRestoredEndpoint = "restored-ep-id"
+		otherCtrEndpoint     = "other-ctr-id-ep"
+	)
+	_, testPool, _ := net.ParseCIDR("172.20.0.0/16")
+	testCreateErr := log.NewError("test endpoint create error")
+	testJoinErr := log.NewError("test endpoint join error")
+
+	tests := map[string]struct {
+		otherCtrIP         string
+		createErr          error
+		restoreErr         error
+		expectedEndpointID string
+		expectedErr        error
+	}{
+		"test_reapply_static_addresses": {
+			otherCtrIP:         "172.20.0.3",
+			expectedEndpointID: testNewEndpoint,
+		},
+		"test_reapply_static_addresses_in_use": {
+			otherCtrIP:         testStaticIP,
+			expectedEndpointID: testCtrEndpointID,
+			expectedErr:        log.NewErrorf("the IPv4 address %s is already in use in network %s", testStaticIP, testNetworkName),
+		},
+		"test_reapply_static_addresses_create_error_restored": {
+			otherCtrIP:         "172.20.0.3",
+			createErr:          testCreateErr,
+			expectedEndpointID: testRestoredEndpoint,
+			expectedErr:        testCreateErr,
+		},
+		"test_reapply_static_addresses_create_error_not_restored": {
+			otherCtrIP:  "172.20.0.3",
+			createErr:   testCreateErr,
+			restoreErr:  testJoinErr,
+			expectedErr: testCreateErr,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			container := &types.Container{
+				ID: testCtrID,
+				HostConfig: &types.HostConfig{
+					NetworkMode:     testNetworkName,
+					EndpointsConfig: map[string]*types.EndpointConfig{testNetworkName: {IPv4Address: testStaticIP}},
+				},
+				NetworkSettings: &types.NetworkSettings{
+					SandboxID: testCtrSandboxID,
+					Networks:  map[string]*types.EndpointSettings{testNetworkName: {ID: testCtrEndpointID, IPAddress: testRestoredIP}},
+				},
+			}
+
+			mockLibnetMgr := mocks.NewMockNetworkController(controller)
+			mockNetwork := mocks.NewMockNetwork(controller)
+			mockNetworkInfo := mocks.NewMockNetworkInfo(controller)
+			mockSb := mocks.NewMockSandbox(controller)
+			mockOtherEp := mocks.NewMockEndpoint(controller)
+			mockOtherEpInfo := mocks.NewMockEndpointInfo(controller)
+			mockOtherIFaceInfo := mocks.NewMockInterfaceInfo(controller)
+
+			mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any()).Do(func(walker libnetwork.SandboxWalker) {
+				walker(mockSb)
+			}).Times(1)
+			mockSb.EXPECT().ContainerID().Return(container.ID).Times(1)
+			mockLibnetMgr.EXPECT().NetworkByName(testNetworkName).Return(mockNetwork, nil).Times(1)
+			mockNetwork.EXPECT().Name().Return(testNetworkName).AnyTimes()
+			mockNetwork.EXPECT().Info().Return(mockNetworkInfo).AnyTimes()
+			mockNetworkInfo.EXPECT().IpamInfo().Return([]*libnetwork.IpamInfo{{IPAMData: driverapi.IPAMData{Pool: testPool}}}, nil).AnyTimes()
+			mockNetwork.EXPECT().Endpoints().Return([]libnetwork.Endpoint{mockOtherEp}).AnyTimes()
+			mockOtherEp.EXPECT().Name().Return(otherCtrEndpoint).AnyTimes()
+			mockOtherEp.EXPECT().Info().Return(mockOtherEpInfo).AnyTimes()
+			mockOtherEpInfo.EXPECT().Iface().Return(mockOtherIFaceInfo).AnyTimes()
+			mockOtherIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: net.ParseIP(testCase.otherCtrIP), Mask: testPool.Mask}).AnyTimes()
+			mockOtherIFaceInfo.EXPECT().AddressIPv6().Return(nil).AnyTimes()
+
+			mockNewEndpoint := func(id string, ip string) libnetwork.Endpoint {
+				mockNewEp := mocks.NewMockEndpoint(controller)
+				mockNewEpInfo := mocks.NewMockEndpointInfo(controller)
+				mockNewIFaceInfo := mocks.NewMockInterfaceInfo(controller)
+				mockNewEp.EXPECT().Join(mockSb).Return(nil).Times(1)
+				mockNetwork.EXPECT().ID().Return(testNetworkName).Times(1)
+				mockNewEp.EXPECT().ID().Return(id).Times(1)
+				mockNewEp.EXPECT().Info().Return(mockNewEpInfo).Times(1)
+				mockNewEpInfo.EXPECT().Gateway().Return(nil).Times(1)
+				mockNewEpInfo.EXPECT().GatewayIPv6().Return(nil).Times(1)
+				mockNewEpInfo.EXPECT().Iface().Return(mockNewIFaceInfo).Times(1)
+				mockNewIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: net.ParseIP(ip), Mask: testPool.Mask}).Times(1)
+				mockNewIFaceInfo.EXPECT().AddressIPv6().Return(nil).Times(1)
+				mockNewIFaceInfo.EXPECT().MacAddress().Return(nil).Times(1)
+				return mockNewEp
+			}
+
+			if testCase.otherCtrIP != testStaticIP {
+				mockEp := mocks.NewMockEndpoint(controller)
+				mockNetwork.EXPECT().EndpointByID(testCtrEndpointID).Return(mockEp, nil).Times(1)
+				mockEp.EXPECT().Delete(true).Return(nil).Times(1)
+				if testCase.createErr == nil {
+					mockNetwork.EXPECT().CreateEndpoint(container.ID+"-ep", gomock.Any()).Return(mockNewEndpoint(testNewEndpoint, testStaticIP), nil).Times(1)
+				} else {
+					mockNetwork.EXPECT().EndpointByID(testCtrEndpointID).Return(nil, libnetwork.ErrNoSuchEndpoint(testCtrEndpointID)).Times(1)
+					var mockRestoredEp libnetwork.Endpoint
+					if testCase.restoreErr == nil {
+						mockRestoredEp = mockNewEndpoint(testRestoredEndpoint, testRestoredIP)
+					} else {
+						mockFailedEp := mocks.NewMockEndpoint(controller)
+						mockFailedEp.EXPECT().Join(mockSb).Return(testCase.restoreErr).Times(1)
+						mockFailedEp.EXPECT().Delete(true).Return(nil).Times(1)
+						mockRestoredEp = mockFailedEp
+					}
+					gomock.InOrder(
+						mockNetwork.EXPECT().CreateEndpoint(container.ID+"-ep", gomock.Any()).Return(nil, testCase.createErr).Times(1),
+						mockNetwork.EXPECT().CreateEndpoint(container.ID+"-ep", gomock.Any()).Return(mockRestoredEp, nil).Times(1),
+					)
+				}
+			}
+
+			testNetMgr := &libnetworkMgr{netController: mockLibnetMgr, configuredContainers: map[string]*types.Container{container.ID: container}}
+			err := testNetMgr.reapplyStaticAddresses(container, []string{testNetworkName})
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if testCase.expectedEndpointID == "" {
+				testutil.AssertNil(t, container.NetworkSettings.Networks[testNetworkName])
+			} else {
+				testutil.AssertEqual(t, testCase.expectedEndpointID, container.NetworkSettings.Networks[testNetworkName].ID)
+			}
+		})
+	}
+}
+
 func TestStats(t *testing.T) {
 	tests := map[string]struct {
 		container         *types.Container
@@ -684,6 +897,10 @@ func TestStats(t *testing.T) {
 			prepareMgrForTest: prepareStatsErrorGettingStatistics,
 			expectedErr:       log.NewError("error getting statistics"),
 		},
+		"netmgr_test_stats_container_mode": {
+			container:         newNetworkModeContainer(),
+			prepareMgrForTest: prepareNoCalls,
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -718,6 +935,16 @@ func assertConnectedContainerFailed(t *testing.T, mgrConfig *config, container *
 	testutil.AssertEqual(t, 0, len(container.NetworkSettings.Networks))
 }
 
+func assertConnectedContainerNone(t *testing.T, mgrConfig *config, container *types.Container) {
+	// assert container
+	ctrNetSettings := container.NetworkSettings
+	testutil.AssertNotNil(t, ctrNetSettings)
+	testutil.AssertEqual(t, testCtrSandboxID, ctrNetSettings.SandboxID)
+	testutil.AssertEqual(t, testCtrSandboxKey, ctrNetSettings.SandboxKey)
+	testutil.AssertEqual(t, testNetworkControllerID, ctrNetSettings.NetworkControllerID)
+	testutil.AssertEqual(t, 0, len(ctrNetSettings.Networks))
+}
+
 func assertConnectedContainer(t *testing.T, mgrConfig *config, container *types.Container) {
 	// assert container
 	ctrNetSettings := container.NetworkSettings
@@ -732,12 +959,17 @@ func assertConnectedContainer(t *testing.T, mgrConfig *config, container *types.
 	testutil.AssertEqual(t, netSettingsMac, epSettings.MacAddress)
 	testutil.AssertEqual(t, netSettingsIPGW, epSettings.Gateway)
 	testutil.AssertEqual(t, netSettingsIP, epSettings.IPAddress)
+	testutil.AssertEqual(t, netSettingsIPv6GW, epSettings.IPv6Gateway)
+	testutil.AssertEqual(t, netSettingsIPv6, epSettings.IPv6Address)
 	testutil.AssertEqual(t, mgrConfig.bridgeConfig.name, epSettings.NetworkID)
 }
 
 func prepareNilCtrl(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
 	return &libnetworkMgr{config: config}
 }
+func prepareNoCalls(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
+	return &libnetworkMgr{config: config, netController: mocks.NewMockNetworkController(gomockCtrl)}
+}
 func prepareDefault(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
 	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
 	mockSb := mocks.NewMockSandbox(gomockCtrl)
@@ -941,9 +1173,11 @@ func prepareConnectFullNoCtrNetworks(gomockCtrl *gomock.Controller, config *conf
 	mockEp.EXPECT().ID().Return(testCtrEndpointID).Times(1)
 	ipGw := net.ParseIP(netSettingsIPGW)
 	mockEpInfo.EXPECT().Gateway().Return(ipGw).Times(1)
+	mockEpInfo.EXPECT().GatewayIPv6().Return(net.ParseIP(netSettingsIPv6GW)).Times(1)
 	mockEpInfo.EXPECT().Iface().Return(mockIFaceInfo).Times(1)
 	ip := net.ParseIP(netSettingsIP)
 	mockIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: ip}).Times(1)
+	mockIFaceInfo.EXPECT().AddressIPv6().Return(&net.IPNet{IP: net.ParseIP(netSettingsIPv6)}).Times(1)
 	mac, _ := net.ParseMAC(netSettingsMac)
 	mockIFaceInfo.EXPECT().MacAddress().Return(mac).Times(1)
 
@@ -954,6 +1188,27 @@ func prepareConnectFullNoCtrNetworks(gomockCtrl *gomock.Controller, config *conf
 	return &libnetworkMgr{config: config, netController: mockLibnetMgr, bridgeConnectedContainers: make(map[string]*types.Container)}
 }
 
+func prepareConnectNone(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
+	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
+	mockSb := mocks.NewMockSandbox(gomockCtrl)
+
+	mockLibnetMgr.EXPECT().WalkSandboxes(gomock.Any()).Do(func(walker libnetwork.SandboxWalker) {
+		for _, sb := range mockLibnetMgr.Sandboxes() {
+			if walker(sb) {
+				return
+			}
+		}
+	}).Times(1)
+	mockLibnetMgr.EXPECT().Sandboxes().Times(1).Return([]libnetwork.Sandbox{mockSb})
+	mockSb.EXPECT().ContainerID().Times(1).Return(container.ID)
+
+	mockSb.EXPECT().ID().Return(testCtrSandboxID).Times(1)
+	mockSb.EXPECT().Key().Return(testCtrSandboxKey).Times(1)
+	mockLibnetMgr.EXPECT().ID().Return(testNetworkControllerID).Times(1)
+
+	return &libnetworkMgr{config: config, netController: mockLibnetMgr, bridgeConnectedContainers: make(map[string]*types.Container)}
+}
+
 func prepareConnectFullWithOtherCtrNetworks(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
 	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
 	mockNetwork := mocks.NewMockNetwork(gomockCtrl)
@@ -987,9 +1242,11 @@ func prepareConnectFullWithOtherCtrNetworks(gomockCtrl *gomock.Controller, confi
 	mockEp.EXPECT().ID().Return(testCtrEndpointID).Times(1)
 	ipGw := net.ParseIP(netSettingsIPGW)
 	mockEpInfo.EXPECT().Gateway().Return(ipGw).Times(1)
+	mockEpInfo.EXPECT().GatewayIPv6().Return(net.ParseIP(netSettingsIPv6GW)).Times(1)
 	mockEpInfo.EXPECT().Iface().Return(mockIFaceInfo).Times(1)
 	ip := net.ParseIP(netSettingsIP)
 	mockIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: ip}).Times(1)
+	mockIFaceInfo.EXPECT().AddressIPv6().Return(&net.IPNet{IP: net.ParseIP(netSettingsIPv6)}).Times(1)
 	mac, _ := net.ParseMAC(netSettingsMac)
 	mockIFaceInfo.EXPECT().MacAddress().Return(mac).Times(1)
 
@@ -1025,9 +1282,11 @@ func prepareConnectFullWithNetSettings(gomockCtrl *gomock.Controller, config *co
 	mockEp.EXPECT().ID().Return(testCtrEndpointID).Times(1)
 	ipGw := net.ParseIP(netSettingsIPGW)
 	mockEpInfo.EXPECT().Gateway().Return(ipGw).Times(1)
+	mockEpInfo.EXPECT().GatewayIPv6().Return(net.ParseIP(netSettingsIPv6GW)).Times(1)
 	mockEpInfo.EXPECT().Iface().Return(mockIFaceInfo).Times(1)
 	ip := net.ParseIP(netSettingsIP)
 	mockIFaceInfo.EXPECT().Address().Return(&net.IPNet{IP: ip}).Times(1)
+	mockIFaceInfo.EXPECT().AddressIPv6().Return(&net.IPNet{IP: net.ParseIP(netSettingsIPv6)}).Times(1)
 	mac, _ := net.ParseMAC(netSettingsMac)
 	mockIFaceInfo.EXPECT().MacAddress().Return(mac).Times(1)
 
@@ -1175,6 +1434,16 @@ func prepareReleaseResourcesFull(gomockCtrl *gomock.Controller, config *config,
 
 	return &libnetworkMgr{config: config, netController: mockLibnetMgr}
 }
+func prepareReleaseResourcesNone(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
+	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
+	mockLibnetMgr.EXPECT().SandboxDestroy(container.ID).Return(nil).Times(1)
+	return &libnetworkMgr{config: config, netController: mockLibnetMgr}
+}
+func prepareReleaseResourcesNoneSbDestroyError(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
+	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
+	mockLibnetMgr.EXPECT().SandboxDestroy(container.ID).Return(log.NewError("error destroying sandbox")).Times(1)
+	return &libnetworkMgr{config: config, netController: mockLibnetMgr}
+}
 func prepareReleaseResourcesGetSbErr(gomockCtrl *gomock.Controller, config *config, container *types.Container) ContainerNetworkManager {
 	mockLibnetMgr := mocks.NewMockNetworkController(gomockCtrl)
 
@@ -16,6 +16,7 @@ import (
 	"context"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 )
 
 // ContainerNetworkManager  abstracts container's network operations
@@ -38,9 +39,30 @@ type ContainerNetworkManager interface {
 	// Restore restores all networking resources for all running containers
 	Restore(ctx context.Context, container []*types.Container) error
 
-	// Initialize initializes all base networks for the manager depending on the modes supported - currently on bridge is supported
+	// Initialize initializes all base networks for the manager depending on the modes supported and restores the user-defined ones
 	Initialize(ctx context.Context) error
 
 	// Stats retrieves the network statistics of the provided container
 	Stats(ctx context.Context, container *types.Container) (*types.IOStats, error)
+
+	// UpdateNetworkPolicy replaces the rate limits and egress rules applied on the network interfaces of a connected container with the provided ones
+	UpdateNetworkPolicy(ctx context.Context, container *types.Container, policy *types.NetworkPolicy) error
+
+	// CreateNetwork creates a new user-defined bridge network
+	CreateNetwork(ctx context.Context, name string, opts *networktypes.NetworkOpts) (*networktypes.Network, error)
+
+	// GetNetwork returns information about a network
+	GetNetwork(ctx context.Context, name string) (*networktypes.Network, error)
+
+	// ListNetworks returns information about all networks
+	ListNetworks(ctx context.Context) ([]*networktypes.Network, error)
+
+	// RemoveNetwork removes a user-defined network if there are no containers connected to it or configured to use it
+	RemoveNetwork(ctx context.Context, name string) error
+
+	// AcquireNetworks marks the networks configured for the container as used by it so that they cannot be removed while the container exists
+	AcquireNetworks(ctx context.Context, container *types.Container) error
+
+	// ReleaseNetworks marks the networks configured for the container as no longer used by it
+	ReleaseNetworks(ctx context.Context, container *types.Container) error
 }
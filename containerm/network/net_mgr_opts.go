@@ -20,6 +20,8 @@ type netOpts struct {
 	metaPath string
 	execRoot string
 
+	disableDNS bool
+
 	// default bridge config
 	disableBridge bool
 	name          string
@@ -27,6 +29,8 @@ type netOpts struct {
 	fixedCIDRv4   string
 	gatewayIPv4   string
 	enableIPv6    bool
+	fixedCIDRv6   string
+	gatewayIPv6   string
 
 	mtu           int
 	icc           bool
@@ -69,6 +73,14 @@ func WithLibNetExecRoot(execRoot string) NetOpt {
 	}
 }
 
+// WithLibNetDisableDNS disables the embedded DNS resolvers of the bridge networks that resolve the names of the containers.
+func WithLibNetDisableDNS(disableDNS bool) NetOpt {
+	return func(netOpts *netOpts) error {
+		netOpts.disableDNS = disableDNS
+		return nil
+	}
+}
+
 // WithLibNetDisableBridge disables the default network bridge interface creation and usage.
 func WithLibNetDisableBridge(disableBridge bool) NetOpt {
 	return func(netOpts *netOpts) error {
@@ -117,6 +129,22 @@ func WithLibNetEnableIPv6(enableIPv6 bool) NetOpt {
 	}
 }
 
+// WithLibNetFixedCIDRv6 sets network fixed CIDRv6.
+func WithLibNetFixedCIDRv6(fixedCIDRv6 string) NetOpt {
+	return func(netOpts *netOpts) error {
+		netOpts.fixedCIDRv6 = fixedCIDRv6
+		return nil
+	}
+}
+
+// WithLibNetGatewayIPv6 sets network gateway IPv6.
+func WithLibNetGatewayIPv6(gatewayIPv6 string) NetOpt {
+	return func(netOpts *netOpts) error {
+		netOpts.gatewayIPv6 = gatewayIPv6
+		return nil
+	}
+}
+
 // WithLibNetMtu sets network MTU
 func WithLibNetMtu(mtu int) NetOpt {
 	return func(netOpts *netOpts) error {
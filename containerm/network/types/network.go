@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+const (
+	// NetworkDriverBridge connects the containers in the network to a dedicated bridge interface on the host
+	NetworkDriverBridge = "bridge"
+	// NetworkDriverHost makes the containers in the network share the network stack of the host
+	NetworkDriverHost = "host"
+)
+
+// Network contains the information about a network managed by the system
+type Network struct {
+	// Name is the unique name of the network
+	Name string `json:"name"`
+	// ID is the unique identifier of the network
+	ID string `json:"id"`
+	// Driver is the type of the network - bridge or host
+	Driver string `json:"driver"`
+	// Subnet is the IPv4 subnet of the network in CIDR format
+	Subnet string `json:"subnet,omitempty"`
+	// Gateway is the IPv4 address of the network's gateway
+	Gateway string `json:"gateway,omitempty"`
+	// MTU is the maximum transmission unit of the network's interfaces
+	MTU int `json:"mtu,omitempty"`
+	// ICC is true if the inter-container communication is enabled in the network
+	ICC bool `json:"icc"`
+	// IPMasquerade is true if the IP masquerading is enabled for the outgoing traffic of the network
+	IPMasquerade bool `json:"ip_masquerade"`
+	// BuiltIn is true if the network is created by the system and cannot be removed
+	BuiltIn bool `json:"built_in"`
+	// Created is the time of the network's creation
+	Created string `json:"created"`
+	// Containers are the IDs of the containers that are connected to the network
+	Containers []string `json:"containers,omitempty"`
+}
+
+// NetworkOpts represent the options for creating a user-defined bridge network
+type NetworkOpts struct {
+	// Subnet is the IPv4 subnet of the network in CIDR format, a free one is allocated if not set
+	Subnet string `json:"subnet,omitempty"`
+	// Gateway is the IPv4 address of the network's gateway, it must be within the subnet
+	Gateway string `json:"gateway,omitempty"`
+	// MTU is the maximum transmission unit of the network's interfaces, the one of the default bridge is used if not set
+	MTU int `json:"mtu,omitempty"`
+	// DisableICC disables the inter-container communication in the network, which is enabled by default
+	DisableICC bool `json:"disable_icc,omitempty"`
+	// DisableIPMasquerade disables the IP masquerading for the outgoing traffic of the network, which is enabled by default
+	DisableIPMasquerade bool `json:"disable_ip_masquerade,omitempty"`
+}
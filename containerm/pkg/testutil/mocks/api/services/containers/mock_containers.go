@@ -11,7 +11,7 @@
 // SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/eclipse-kanto/container-management/containerm/api/services/containers (interfaces: ContainersClient,Containers_AttachClient)
+// Source: github.com/eclipse-kanto/container-management/containerm/api/services/containers (interfaces: ContainersClient,Containers_AttachClient,Containers_ExecClient,Containers_StatsClient,Containers_EventsClient)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -22,9 +22,9 @@ import (
 
 	containers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
 	gomock "github.com/golang/mock/gomock"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // MockContainersClient is a mock of ContainersClient interface.
@@ -70,6 +70,26 @@ func (mr *MockContainersClientMockRecorder) Attach(arg0 interface{}, arg1 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockContainersClient)(nil).Attach), varargs...)
 }
 
+// Checkpoint mocks base method.
+func (m *MockContainersClient) Checkpoint(arg0 context.Context, arg1 *containers.CheckpointContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Checkpoint", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockContainersClientMockRecorder) Checkpoint(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockContainersClient)(nil).Checkpoint), varargs...)
+}
+
 // Create mocks base method.
 func (m *MockContainersClient) Create(arg0 context.Context, arg1 *containers.CreateContainerRequest, arg2 ...grpc.CallOption) (*containers.CreateContainerResponse, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +110,46 @@ func (mr *MockContainersClientMockRecorder) Create(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockContainersClient)(nil).Create), varargs...)
 }
 
+// Events mocks base method.
+func (m *MockContainersClient) Events(arg0 context.Context, arg1 *containers.EventsRequest, arg2 ...grpc.CallOption) (containers.Containers_EventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Events", varargs...)
+	ret0, _ := ret[0].(containers.Containers_EventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockContainersClientMockRecorder) Events(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockContainersClient)(nil).Events), varargs...)
+}
+
+// Exec mocks base method.
+func (m *MockContainersClient) Exec(arg0 context.Context, arg1 ...grpc.CallOption) (containers.Containers_ExecClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exec", varargs...)
+	ret0, _ := ret[0].(containers.Containers_ExecClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockContainersClientMockRecorder) Exec(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockContainersClient)(nil).Exec), varargs...)
+}
+
 // Get mocks base method.
 func (m *MockContainersClient) Get(arg0 context.Context, arg1 *containers.GetContainerRequest, arg2 ...grpc.CallOption) (*containers.GetContainerResponse, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +190,26 @@ func (mr *MockContainersClientMockRecorder) List(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockContainersClient)(nil).List), varargs...)
 }
 
+// ListCheckpoints mocks base method.
+func (m *MockContainersClient) ListCheckpoints(arg0 context.Context, arg1 *containers.ListCheckpointsRequest, arg2 ...grpc.CallOption) (*containers.ListCheckpointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCheckpoints", varargs...)
+	ret0, _ := ret[0].(*containers.ListCheckpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckpoints indicates an expected call of ListCheckpoints.
+func (mr *MockContainersClientMockRecorder) ListCheckpoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckpoints", reflect.TypeOf((*MockContainersClient)(nil).ListCheckpoints), varargs...)
+}
+
 // ListStream mocks base method.
 func (m *MockContainersClient) ListStream(arg0 context.Context, arg1 *containers.ListContainersRequest, arg2 ...grpc.CallOption) (containers.Containers_ListStreamClient, error) {
 	m.ctrl.T.Helper()
@@ -150,15 +230,55 @@ func (mr *MockContainersClientMockRecorder) ListStream(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockContainersClient)(nil).ListStream), varargs...)
 }
 
+// Logs mocks base method.
+func (m *MockContainersClient) Logs(arg0 context.Context, arg1 *containers.GetLogsRequest, arg2 ...grpc.CallOption) (containers.Containers_LogsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logs", varargs...)
+	ret0, _ := ret[0].(containers.Containers_LogsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockContainersClientMockRecorder) Logs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockContainersClient)(nil).Logs), varargs...)
+}
+
+// Metrics mocks base method.
+func (m *MockContainersClient) Metrics(arg0 context.Context, arg1 *containers.GetMetricsRequest, arg2 ...grpc.CallOption) (*containers.GetMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Metrics", varargs...)
+	ret0, _ := ret[0].(*containers.GetMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metrics indicates an expected call of Metrics.
+func (mr *MockContainersClientMockRecorder) Metrics(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metrics", reflect.TypeOf((*MockContainersClient)(nil).Metrics), varargs...)
+}
+
 // Pause mocks base method.
-func (m *MockContainersClient) Pause(arg0 context.Context, arg1 *containers.PauseContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Pause(arg0 context.Context, arg1 *containers.PauseContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Pause", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -171,14 +291,14 @@ func (mr *MockContainersClientMockRecorder) Pause(arg0, arg1 interface{}, arg2 .
 }
 
 // Remove mocks base method.
-func (m *MockContainersClient) Remove(arg0 context.Context, arg1 *containers.RemoveContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Remove(arg0 context.Context, arg1 *containers.RemoveContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Remove", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -190,15 +310,35 @@ func (mr *MockContainersClientMockRecorder) Remove(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockContainersClient)(nil).Remove), varargs...)
 }
 
+// RemoveCheckpoint mocks base method.
+func (m *MockContainersClient) RemoveCheckpoint(arg0 context.Context, arg1 *containers.RemoveCheckpointRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveCheckpoint", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCheckpoint indicates an expected call of RemoveCheckpoint.
+func (mr *MockContainersClientMockRecorder) RemoveCheckpoint(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCheckpoint", reflect.TypeOf((*MockContainersClient)(nil).RemoveCheckpoint), varargs...)
+}
+
 // Rename mocks base method.
-func (m *MockContainersClient) Rename(arg0 context.Context, arg1 *containers.RenameContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Rename(arg0 context.Context, arg1 *containers.RenameContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Rename", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -211,14 +351,14 @@ func (mr *MockContainersClientMockRecorder) Rename(arg0, arg1 interface{}, arg2
 }
 
 // Restart mocks base method.
-func (m *MockContainersClient) Restart(arg0 context.Context, arg1 *containers.RestartContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Restart(arg0 context.Context, arg1 *containers.RestartContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Restart", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -230,15 +370,35 @@ func (mr *MockContainersClientMockRecorder) Restart(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockContainersClient)(nil).Restart), varargs...)
 }
 
+// Restore mocks base method.
+func (m *MockContainersClient) Restore(arg0 context.Context, arg1 *containers.RestoreContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Restore", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockContainersClientMockRecorder) Restore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockContainersClient)(nil).Restore), varargs...)
+}
+
 // Start mocks base method.
-func (m *MockContainersClient) Start(arg0 context.Context, arg1 *containers.StartContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Start(arg0 context.Context, arg1 *containers.StartContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Start", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -250,15 +410,35 @@ func (mr *MockContainersClientMockRecorder) Start(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockContainersClient)(nil).Start), varargs...)
 }
 
+// Stats mocks base method.
+func (m *MockContainersClient) Stats(arg0 context.Context, arg1 *containers.StatsRequest, arg2 ...grpc.CallOption) (containers.Containers_StatsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stats", varargs...)
+	ret0, _ := ret[0].(containers.Containers_StatsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockContainersClientMockRecorder) Stats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockContainersClient)(nil).Stats), varargs...)
+}
+
 // Stop mocks base method.
-func (m *MockContainersClient) Stop(arg0 context.Context, arg1 *containers.StopContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Stop(arg0 context.Context, arg1 *containers.StopContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Stop", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -271,14 +451,14 @@ func (mr *MockContainersClientMockRecorder) Stop(arg0, arg1 interface{}, arg2 ..
 }
 
 // Unpause mocks base method.
-func (m *MockContainersClient) Unpause(arg0 context.Context, arg1 *containers.UnpauseContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Unpause(arg0 context.Context, arg1 *containers.UnpauseContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Unpause", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -291,14 +471,14 @@ func (mr *MockContainersClientMockRecorder) Unpause(arg0, arg1 interface{}, arg2
 }
 
 // Update mocks base method.
-func (m *MockContainersClient) Update(arg0 context.Context, arg1 *containers.UpdateContainerRequest, arg2 ...grpc.CallOption) (*empty.Empty, error) {
+func (m *MockContainersClient) Update(arg0 context.Context, arg1 *containers.UpdateContainerRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Update", varargs...)
-	ret0, _ := ret[0].(*empty.Empty)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -447,21 +627,385 @@ func (mr *MockContainers_AttachClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockContainers_AttachClient)(nil).Trailer))
 }
 
-// Logs mocks base method.
-func (m *MockContainersClient) Logs(arg0 context.Context, arg1 *containers.GetLogsRequest, arg2 ...grpc.CallOption) (containers.Containers_LogsClient, error) {
+// MockContainers_ExecClient is a mock of Containers_ExecClient interface.
+type MockContainers_ExecClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainers_ExecClientMockRecorder
+}
+
+// MockContainers_ExecClientMockRecorder is the mock recorder for MockContainers_ExecClient.
+type MockContainers_ExecClientMockRecorder struct {
+	mock *MockContainers_ExecClient
+}
+
+// NewMockContainers_ExecClient creates a new mock instance.
+func NewMockContainers_ExecClient(ctrl *gomock.Controller) *MockContainers_ExecClient {
+	mock := &MockContainers_ExecClient{ctrl: ctrl}
+	mock.recorder = &MockContainers_ExecClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContainers_ExecClient) EXPECT() *MockContainers_ExecClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockContainers_ExecClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Logs", varargs...)
-	ret0, _ := ret[0].(containers.Containers_LogsClient)
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockContainers_ExecClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockContainers_ExecClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockContainers_ExecClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockContainers_ExecClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockContainers_ExecClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockContainers_ExecClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Logs indicates an expected call of Logs.
-func (mr *MockContainersClientMockRecorder) Logs(arg0, arg1 interface{}, arg2 interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockContainers_ExecClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockContainers_ExecClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockContainers_ExecClient) Recv() (*containers.ExecContainerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*containers.ExecContainerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockContainers_ExecClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockContainers_ExecClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockContainers_ExecClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockContainers_ExecClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockContainers_ExecClient)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockContainers_ExecClient) Send(arg0 *containers.ExecContainerRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockContainers_ExecClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockContainers_ExecClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockContainers_ExecClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockContainers_ExecClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockContainers_ExecClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockContainers_ExecClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockContainers_ExecClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockContainers_ExecClient)(nil).Trailer))
+}
+
+// MockContainers_StatsClient is a mock of Containers_StatsClient interface.
+type MockContainers_StatsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainers_StatsClientMockRecorder
+}
+
+// MockContainers_StatsClientMockRecorder is the mock recorder for MockContainers_StatsClient.
+type MockContainers_StatsClientMockRecorder struct {
+	mock *MockContainers_StatsClient
+}
+
+// NewMockContainers_StatsClient creates a new mock instance.
+func NewMockContainers_StatsClient(ctrl *gomock.Controller) *MockContainers_StatsClient {
+	mock := &MockContainers_StatsClient{ctrl: ctrl}
+	mock.recorder = &MockContainers_StatsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContainers_StatsClient) EXPECT() *MockContainers_StatsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockContainers_StatsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockContainers_StatsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockContainers_StatsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockContainers_StatsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockContainers_StatsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockContainers_StatsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockContainers_StatsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockContainers_StatsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockContainers_StatsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockContainers_StatsClient) Recv() (*containers.StatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*containers.StatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockContainers_StatsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockContainers_StatsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockContainers_StatsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockContainers_StatsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockContainers_StatsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockContainers_StatsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockContainers_StatsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockContainers_StatsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockContainers_StatsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockContainers_StatsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockContainers_StatsClient)(nil).Trailer))
+}
+
+// MockContainers_EventsClient is a mock of Containers_EventsClient interface.
+type MockContainers_EventsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainers_EventsClientMockRecorder
+}
+
+// MockContainers_EventsClientMockRecorder is the mock recorder for MockContainers_EventsClient.
+type MockContainers_EventsClientMockRecorder struct {
+	mock *MockContainers_EventsClient
+}
+
+// NewMockContainers_EventsClient creates a new mock instance.
+func NewMockContainers_EventsClient(ctrl *gomock.Controller) *MockContainers_EventsClient {
+	mock := &MockContainers_EventsClient{ctrl: ctrl}
+	mock.recorder = &MockContainers_EventsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContainers_EventsClient) EXPECT() *MockContainers_EventsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockContainers_EventsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockContainers_EventsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockContainers_EventsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockContainers_EventsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockContainers_EventsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockContainers_EventsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockContainers_EventsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockContainers_EventsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockContainers_EventsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockContainers_EventsClient) Recv() (*containers.EventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*containers.EventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockContainers_EventsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockContainers_EventsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockContainers_EventsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockContainers_EventsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockContainers_EventsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockContainers_EventsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockContainers_EventsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockContainers_EventsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockContainers_EventsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockContainers_EventsClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockContainersClient)(nil).Logs), []interface{}{arg0, arg1, arg2}...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockContainers_EventsClient)(nil).Trailer))
 }
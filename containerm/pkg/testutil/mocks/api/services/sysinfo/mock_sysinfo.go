@@ -67,3 +67,23 @@ func (mr *MockSystemInfoClientMockRecorder) ProjectInfo(arg0, arg1 interface{},
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectInfo", reflect.TypeOf((*MockSystemInfoClient)(nil).ProjectInfo), varargs...)
 }
+
+// RuntimeInfo mocks base method
+func (m *MockSystemInfoClient) RuntimeInfo(arg0 context.Context, arg1 *empty.Empty, arg2 ...grpc.CallOption) (*sysinfo.RuntimeInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RuntimeInfo", varargs...)
+	ret0, _ := ret[0].(*sysinfo.RuntimeInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RuntimeInfo indicates an expected call of RuntimeInfo
+func (mr *MockSystemInfoClientMockRecorder) RuntimeInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuntimeInfo", reflect.TypeOf((*MockSystemInfoClient)(nil).RuntimeInfo), varargs...)
+}
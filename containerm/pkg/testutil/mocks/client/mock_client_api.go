@@ -20,10 +20,14 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	client "github.com/eclipse-kanto/container-management/containerm/client"
 	types "github.com/eclipse-kanto/container-management/containerm/containers/types"
-	types0 "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	types0 "github.com/eclipse-kanto/container-management/containerm/images/types"
+	types1 "github.com/eclipse-kanto/container-management/containerm/network/types"
+	types2 "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	types3 "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -66,6 +70,20 @@ func (mr *MockClientMockRecorder) Attach(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockClient)(nil).Attach), arg0, arg1, arg2)
 }
 
+// Checkpoint mocks base method.
+func (m *MockClient) Checkpoint(arg0 context.Context, arg1 string, arg2 *types.CheckpointOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockClientMockRecorder) Checkpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockClient)(nil).Checkpoint), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockClient) Create(arg0 context.Context, arg1 *types.Container) (*types.Container, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +99,36 @@ func (mr *MockClientMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClient)(nil).Create), arg0, arg1)
 }
 
+// CreateNetwork mocks base method.
+func (m *MockClient) CreateNetwork(arg0 context.Context, arg1 string, arg2 *types1.NetworkOpts) (*types1.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types1.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockClientMockRecorder) CreateNetwork(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockClient)(nil).CreateNetwork), arg0, arg1, arg2)
+}
+
+// CreateVolume mocks base method.
+func (m *MockClient) CreateVolume(arg0 context.Context, arg1 string, arg2 *types3.VolumeOpts) (*types3.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types3.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockClientMockRecorder) CreateVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockClient)(nil).CreateVolume), arg0, arg1, arg2)
+}
+
 // Dispose mocks base method.
 func (m *MockClient) Dispose() error {
 	m.ctrl.T.Helper()
@@ -95,6 +143,35 @@ func (mr *MockClientMockRecorder) Dispose() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispose", reflect.TypeOf((*MockClient)(nil).Dispose))
 }
 
+// Events mocks base method.
+func (m *MockClient) Events(arg0 context.Context, arg1 *types.EventsFilter, arg2 client.EventsHandler) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockClientMockRecorder) Events(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockClient)(nil).Events), arg0, arg1, arg2)
+}
+
+// Exec mocks base method.
+func (m *MockClient) Exec(arg0 context.Context, arg1 string, arg2 *types.ExecConfig, arg3 io.Reader, arg4 io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockClientMockRecorder) Exec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockClient)(nil).Exec), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Get mocks base method.
 func (m *MockClient) Get(arg0 context.Context, arg1 string) (*types.Container, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +187,51 @@ func (mr *MockClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClient)(nil).Get), arg0, arg1)
 }
 
+// GetImage mocks base method.
+func (m *MockClient) GetImage(arg0 context.Context, arg1 string) (*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", arg0, arg1)
+	ret0, _ := ret[0].(*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockClientMockRecorder) GetImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockClient)(nil).GetImage), arg0, arg1)
+}
+
+// GetNetwork mocks base method.
+func (m *MockClient) GetNetwork(arg0 context.Context, arg1 string) (*types1.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetwork", arg0, arg1)
+	ret0, _ := ret[0].(*types1.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork.
+func (mr *MockClientMockRecorder) GetNetwork(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockClient)(nil).GetNetwork), arg0, arg1)
+}
+
+// GetVolume mocks base method.
+func (m *MockClient) GetVolume(arg0 context.Context, arg1 string) (*types3.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", arg0, arg1)
+	ret0, _ := ret[0].(*types3.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockClientMockRecorder) GetVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockClient)(nil).GetVolume), arg0, arg1)
+}
+
 // List mocks base method.
 func (m *MockClient) List(arg0 context.Context, arg1 ...client.Filter) ([]*types.Container, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +252,95 @@ func (mr *MockClientMockRecorder) List(arg0 interface{}, arg1 ...interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List), varargs...)
 }
 
+// ListCheckpoints mocks base method.
+func (m *MockClient) ListCheckpoints(arg0 context.Context, arg1 string) ([]*types.Checkpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCheckpoints", arg0, arg1)
+	ret0, _ := ret[0].([]*types.Checkpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckpoints indicates an expected call of ListCheckpoints.
+func (mr *MockClientMockRecorder) ListCheckpoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckpoints", reflect.TypeOf((*MockClient)(nil).ListCheckpoints), arg0, arg1)
+}
+
+// ListImages mocks base method.
+func (m *MockClient) ListImages(arg0 context.Context) ([]*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", arg0)
+	ret0, _ := ret[0].([]*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockClientMockRecorder) ListImages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockClient)(nil).ListImages), arg0)
+}
+
+// ListNetworks mocks base method.
+func (m *MockClient) ListNetworks(arg0 context.Context) ([]*types1.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworks", arg0)
+	ret0, _ := ret[0].([]*types1.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworks indicates an expected call of ListNetworks.
+func (mr *MockClientMockRecorder) ListNetworks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockClient)(nil).ListNetworks), arg0)
+}
+
+// ListVolumes mocks base method.
+func (m *MockClient) ListVolumes(arg0 context.Context) ([]*types3.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", arg0)
+	ret0, _ := ret[0].([]*types3.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockClientMockRecorder) ListVolumes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockClient)(nil).ListVolumes), arg0)
+}
+
+// Logs mocks base method.
+func (m *MockClient) Logs(arg0 context.Context, arg1 string, arg2 *types.LogsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockClientMockRecorder) Logs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockClient)(nil).Logs), arg0, arg1, arg2)
+}
+
+// Metrics mocks base method.
+func (m *MockClient) Metrics(arg0 context.Context, arg1 string) (*types.Metrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metrics", arg0, arg1)
+	ret0, _ := ret[0].(*types.Metrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metrics indicates an expected call of Metrics.
+func (mr *MockClientMockRecorder) Metrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metrics", reflect.TypeOf((*MockClient)(nil).Metrics), arg0, arg1)
+}
+
 // Pause mocks base method.
 func (m *MockClient) Pause(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -145,10 +356,10 @@ func (mr *MockClientMockRecorder) Pause(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // ProjectInfo mocks base method.
-func (m *MockClient) ProjectInfo(arg0 context.Context) (types0.ProjectInfo, error) {
+func (m *MockClient) ProjectInfo(arg0 context.Context) (types2.ProjectInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ProjectInfo", arg0)
-	ret0, _ := ret[0].(types0.ProjectInfo)
+	ret0, _ := ret[0].(types2.ProjectInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -159,6 +370,21 @@ func (mr *MockClientMockRecorder) ProjectInfo(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectInfo", reflect.TypeOf((*MockClient)(nil).ProjectInfo), arg0)
 }
 
+// PullImage mocks base method.
+func (m *MockClient) PullImage(arg0 context.Context, arg1 types.Image) (*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullImage", arg0, arg1)
+	ret0, _ := ret[0].(*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullImage indicates an expected call of PullImage.
+func (mr *MockClientMockRecorder) PullImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullImage", reflect.TypeOf((*MockClient)(nil).PullImage), arg0, arg1)
+}
+
 // Remove mocks base method.
 func (m *MockClient) Remove(arg0 context.Context, arg1 string, arg2 bool, arg3 *types.StopOpts) error {
 	m.ctrl.T.Helper()
@@ -173,6 +399,62 @@ func (mr *MockClientMockRecorder) Remove(arg0, arg1, arg2, arg3 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockClient)(nil).Remove), arg0, arg1, arg2, arg3)
 }
 
+// RemoveCheckpoint mocks base method.
+func (m *MockClient) RemoveCheckpoint(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCheckpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCheckpoint indicates an expected call of RemoveCheckpoint.
+func (mr *MockClientMockRecorder) RemoveCheckpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCheckpoint", reflect.TypeOf((*MockClient)(nil).RemoveCheckpoint), arg0, arg1, arg2)
+}
+
+// RemoveImage mocks base method.
+func (m *MockClient) RemoveImage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImage indicates an expected call of RemoveImage.
+func (mr *MockClientMockRecorder) RemoveImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockClient)(nil).RemoveImage), arg0, arg1)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MockClient) RemoveNetwork(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MockClientMockRecorder) RemoveNetwork(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MockClient)(nil).RemoveNetwork), arg0, arg1)
+}
+
+// RemoveVolume mocks base method.
+func (m *MockClient) RemoveVolume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVolume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVolume indicates an expected call of RemoveVolume.
+func (mr *MockClientMockRecorder) RemoveVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockClient)(nil).RemoveVolume), arg0, arg1)
+}
+
 // Rename mocks base method.
 func (m *MockClient) Rename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -201,6 +483,20 @@ func (mr *MockClientMockRecorder) Restart(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockClient)(nil).Restart), arg0, arg1, arg2)
 }
 
+// Restore mocks base method.
+func (m *MockClient) Restore(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockClientMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockClient)(nil).Restore), arg0, arg1, arg2)
+}
+
 // Resume mocks base method.
 func (m *MockClient) Resume(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -215,6 +511,21 @@ func (mr *MockClientMockRecorder) Resume(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockClient)(nil).Resume), arg0, arg1)
 }
 
+// RuntimeInfo mocks base method.
+func (m *MockClient) RuntimeInfo(arg0 context.Context) (types2.RuntimeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RuntimeInfo", arg0)
+	ret0, _ := ret[0].(types2.RuntimeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RuntimeInfo indicates an expected call of RuntimeInfo.
+func (mr *MockClientMockRecorder) RuntimeInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RuntimeInfo", reflect.TypeOf((*MockClient)(nil).RuntimeInfo), arg0)
+}
+
 // Start mocks base method.
 func (m *MockClient) Start(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -229,6 +540,25 @@ func (mr *MockClientMockRecorder) Start(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockClient)(nil).Start), arg0, arg1)
 }
 
+// Stats mocks base method.
+func (m *MockClient) Stats(arg0 context.Context, arg1 time.Duration, arg2 client.StatsHandler, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stats", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockClientMockRecorder) Stats(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockClient)(nil).Stats), varargs...)
+}
+
 // Stop mocks base method.
 func (m *MockClient) Stop(arg0 context.Context, arg1 string, arg2 *types.StopOpts) error {
 	m.ctrl.T.Helper()
@@ -256,17 +586,3 @@ func (mr *MockClientMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClient)(nil).Update), arg0, arg1, arg2)
 }
-
-// Locks mocks base method.
-func (m *MockClient) Logs(arg0 context.Context, arg1 string, arg2 int32) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logs", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Logs indicates an expected call of Logs.
-func (mr *MockClientMockRecorder) Logs(arg0, arg1, arg2 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockClient)(nil).Logs), arg0, arg1, arg2)
-}
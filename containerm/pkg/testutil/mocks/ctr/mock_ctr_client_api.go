@@ -23,6 +23,7 @@ import (
 
 	types "github.com/eclipse-kanto/container-management/containerm/containers/types"
 	ctr "github.com/eclipse-kanto/container-management/containerm/ctr"
+	types0 "github.com/eclipse-kanto/container-management/containerm/images/types"
 	streams "github.com/eclipse-kanto/container-management/containerm/streams"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -128,6 +129,35 @@ func (mr *MockContainerAPIClientMockRecorder) AttachContainer(ctx, container, at
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachContainer", reflect.TypeOf((*MockContainerAPIClient)(nil).AttachContainer), ctx, container, attachConfig)
 }
 
+// ExecContainer mocks base method
+func (m *MockContainerAPIClient) ExecContainer(ctx context.Context, container *types.Container, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecContainer", ctx, container, execConfig, attachConfig)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContainer indicates an expected call of ExecContainer
+func (mr *MockContainerAPIClientMockRecorder) ExecContainer(ctx, container, execConfig, attachConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContainer", reflect.TypeOf((*MockContainerAPIClient)(nil).ExecContainer), ctx, container, execConfig, attachConfig)
+}
+
+// CheckpointContainer mocks base method
+func (m *MockContainerAPIClient) CheckpointContainer(ctx context.Context, container *types.Container, checkpointDir string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointContainer", ctx, container, checkpointDir)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckpointContainer indicates an expected call of CheckpointContainer
+func (mr *MockContainerAPIClientMockRecorder) CheckpointContainer(ctx, container, checkpointDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointContainer", reflect.TypeOf((*MockContainerAPIClient)(nil).CheckpointContainer), ctx, container, checkpointDir)
+}
+
 // PauseContainer mocks base method
 func (m *MockContainerAPIClient) PauseContainer(ctx context.Context, container *types.Container) error {
 	m.ctrl.T.Helper()
@@ -257,3 +287,77 @@ func (mr *MockContainerAPIClientMockRecorder) UpdateContainer(ctx, container int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContainer", reflect.TypeOf((*MockContainerAPIClient)(nil).UpdateContainer), ctx, container, resources)
 }
+
+// ListImages mocks base method
+func (m *MockContainerAPIClient) ListImages(ctx context.Context) ([]*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx)
+	ret0, _ := ret[0].([]*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages
+func (mr *MockContainerAPIClientMockRecorder) ListImages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockContainerAPIClient)(nil).ListImages), ctx)
+}
+
+// GetImage mocks base method
+func (m *MockContainerAPIClient) GetImage(ctx context.Context, imageRef string) (*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, imageRef)
+	ret0, _ := ret[0].(*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage
+func (mr *MockContainerAPIClientMockRecorder) GetImage(ctx, imageRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockContainerAPIClient)(nil).GetImage), ctx, imageRef)
+}
+
+// PullImage mocks base method
+func (m *MockContainerAPIClient) PullImage(ctx context.Context, imageInfo types.Image) (*types0.ImageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullImage", ctx, imageInfo)
+	ret0, _ := ret[0].(*types0.ImageInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullImage indicates an expected call of PullImage
+func (mr *MockContainerAPIClientMockRecorder) PullImage(ctx, imageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullImage", reflect.TypeOf((*MockContainerAPIClient)(nil).PullImage), ctx, imageInfo)
+}
+
+// RemoveImage mocks base method
+func (m *MockContainerAPIClient) RemoveImage(ctx context.Context, imageRef string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImage", ctx, imageRef)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImage indicates an expected call of RemoveImage
+func (mr *MockContainerAPIClientMockRecorder) RemoveImage(ctx, imageRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockContainerAPIClient)(nil).RemoveImage), ctx, imageRef)
+}
+
+// ListRuntimeHandlers mocks base method
+func (m *MockContainerAPIClient) ListRuntimeHandlers(ctx context.Context) ([]*types.RuntimeHandler, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuntimeHandlers", ctx)
+	ret0, _ := ret[0].([]*types.RuntimeHandler)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// ListRuntimeHandlers indicates an expected call of ListRuntimeHandlers
+func (mr *MockContainerAPIClientMockRecorder) ListRuntimeHandlers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuntimeHandlers", reflect.TypeOf((*MockContainerAPIClient)(nil).ListRuntimeHandlers), ctx)
+}
@@ -27,6 +27,7 @@ import (
 	leases "github.com/containerd/containerd/leases"
 	snapshots "github.com/containerd/containerd/snapshots"
 	gomock "github.com/golang/mock/gomock"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // MockcontainerClientWrapper is a mock of containerClientWrapper interface.
@@ -438,9 +439,9 @@ func (mr *MockcontainerdSpiMockRecorder) MountSnapshot(ctx, containerID, rootFS
 }
 
 // PrepareSnapshot mocks base method.
-func (m *MockcontainerdSpi) PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, opts ...containerd.UnpackOpt) error {
+func (m *MockcontainerdSpi) PrepareSnapshot(ctx context.Context, containerID string, image containerd.Image, uidMappings, gidMappings []specs.LinuxIDMapping, opts ...containerd.UnpackOpt) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, containerID, image}
+	varargs := []interface{}{ctx, containerID, image, uidMappings, gidMappings}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -450,9 +451,9 @@ func (m *MockcontainerdSpi) PrepareSnapshot(ctx context.Context, containerID str
 }
 
 // PrepareSnapshot indicates an expected call of PrepareSnapshot.
-func (mr *MockcontainerdSpiMockRecorder) PrepareSnapshot(ctx, containerID, image interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockcontainerdSpiMockRecorder) PrepareSnapshot(ctx, containerID, image, uidMappings, gidMappings interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, containerID, image}, opts...)
+	varargs := append([]interface{}{ctx, containerID, image, uidMappings, gidMappings}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareSnapshot", reflect.TypeOf((*MockcontainerdSpi)(nil).PrepareSnapshot), varargs...)
 }
 
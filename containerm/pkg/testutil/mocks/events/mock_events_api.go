@@ -74,3 +74,18 @@ func (mr *MockContainerEventsManagerMockRecorder) Subscribe(ctx interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockContainerEventsManager)(nil).Subscribe), ctx)
 }
+
+// SubscribeSince mocks base method
+func (m *MockContainerEventsManager) SubscribeSince(ctx context.Context, since int64) (<-chan *types.Event, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeSince", ctx, since)
+	ret0, _ := ret[0].(<-chan *types.Event)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// SubscribeSince indicates an expected call of SubscribeSince
+func (mr *MockContainerEventsManagerMockRecorder) SubscribeSince(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeSince", reflect.TypeOf((*MockContainerEventsManager)(nil).SubscribeSince), ctx, since)
+}
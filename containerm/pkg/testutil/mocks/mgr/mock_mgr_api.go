@@ -62,6 +62,20 @@ func (mr *MockContainerManagerMockRecorder) Attach(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockContainerManager)(nil).Attach), arg0, arg1, arg2)
 }
 
+// Checkpoint mocks base method.
+func (m *MockContainerManager) Checkpoint(arg0 context.Context, arg1 string, arg2 *types.CheckpointOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockContainerManagerMockRecorder) Checkpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockContainerManager)(nil).Checkpoint), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockContainerManager) Create(arg0 context.Context, arg1 *types.Container) (*types.Container, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +105,21 @@ func (mr *MockContainerManagerMockRecorder) Dispose(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispose", reflect.TypeOf((*MockContainerManager)(nil).Dispose), arg0)
 }
 
+// Exec mocks base method.
+func (m *MockContainerManager) Exec(arg0 context.Context, arg1 string, arg2 *types.ExecConfig, arg3 *streams.AttachConfig) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockContainerManagerMockRecorder) Exec(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockContainerManager)(nil).Exec), arg0, arg1, arg2, arg3)
+}
+
 // Get mocks base method.
 func (m *MockContainerManager) Get(arg0 context.Context, arg1 string) (*types.Container, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +150,21 @@ func (mr *MockContainerManagerMockRecorder) List(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockContainerManager)(nil).List), arg0)
 }
 
+// ListCheckpoints mocks base method.
+func (m *MockContainerManager) ListCheckpoints(arg0 context.Context, arg1 string) ([]*types.Checkpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCheckpoints", arg0, arg1)
+	ret0, _ := ret[0].([]*types.Checkpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckpoints indicates an expected call of ListCheckpoints.
+func (mr *MockContainerManagerMockRecorder) ListCheckpoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckpoints", reflect.TypeOf((*MockContainerManager)(nil).ListCheckpoints), arg0, arg1)
+}
+
 // Load mocks base method.
 func (m *MockContainerManager) Load(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -163,6 +207,20 @@ func (mr *MockContainerManagerMockRecorder) Remove(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockContainerManager)(nil).Remove), arg0, arg1, arg2, arg3)
 }
 
+// RemoveCheckpoint mocks base method.
+func (m *MockContainerManager) RemoveCheckpoint(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCheckpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCheckpoint indicates an expected call of RemoveCheckpoint.
+func (mr *MockContainerManagerMockRecorder) RemoveCheckpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCheckpoint", reflect.TypeOf((*MockContainerManager)(nil).RemoveCheckpoint), arg0, arg1, arg2)
+}
+
 // Rename mocks base method.
 func (m *MockContainerManager) Rename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -205,6 +263,20 @@ func (mr *MockContainerManagerMockRecorder) Restore(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockContainerManager)(nil).Restore), arg0)
 }
 
+// RestoreFromCheckpoint mocks base method.
+func (m *MockContainerManager) RestoreFromCheckpoint(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFromCheckpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFromCheckpoint indicates an expected call of RestoreFromCheckpoint.
+func (mr *MockContainerManagerMockRecorder) RestoreFromCheckpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromCheckpoint", reflect.TypeOf((*MockContainerManager)(nil).RestoreFromCheckpoint), arg0, arg1, arg2)
+}
+
 // Start mocks base method.
 func (m *MockContainerManager) Start(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -21,6 +21,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	types0 "github.com/eclipse-kanto/container-management/containerm/network/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,6 +48,20 @@ func (m *MockContainerNetworkManager) EXPECT() *MockContainerNetworkManagerMockR
 	return m.recorder
 }
 
+// AcquireNetworks mocks base method.
+func (m *MockContainerNetworkManager) AcquireNetworks(ctx context.Context, container *types.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireNetworks", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcquireNetworks indicates an expected call of AcquireNetworks.
+func (mr *MockContainerNetworkManagerMockRecorder) AcquireNetworks(ctx, container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireNetworks", reflect.TypeOf((*MockContainerNetworkManager)(nil).AcquireNetworks), ctx, container)
+}
+
 // Connect mocks base method.
 func (m *MockContainerNetworkManager) Connect(ctx context.Context, containers *types.Container) error {
 	m.ctrl.T.Helper()
@@ -61,6 +76,21 @@ func (mr *MockContainerNetworkManagerMockRecorder) Connect(ctx, containers inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockContainerNetworkManager)(nil).Connect), ctx, containers)
 }
 
+// CreateNetwork mocks base method.
+func (m *MockContainerNetworkManager) CreateNetwork(ctx context.Context, name string, opts *types0.NetworkOpts) (*types0.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", ctx, name, opts)
+	ret0, _ := ret[0].(*types0.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockContainerNetworkManagerMockRecorder) CreateNetwork(ctx, name, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockContainerNetworkManager)(nil).CreateNetwork), ctx, name, opts)
+}
+
 // Disconnect mocks base method.
 func (m *MockContainerNetworkManager) Disconnect(ctx context.Context, container *types.Container, force bool) error {
 	m.ctrl.T.Helper()
@@ -89,6 +119,21 @@ func (mr *MockContainerNetworkManagerMockRecorder) Dispose(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispose", reflect.TypeOf((*MockContainerNetworkManager)(nil).Dispose), ctx)
 }
 
+// GetNetwork mocks base method.
+func (m *MockContainerNetworkManager) GetNetwork(ctx context.Context, name string) (*types0.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetwork", ctx, name)
+	ret0, _ := ret[0].(*types0.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork.
+func (mr *MockContainerNetworkManagerMockRecorder) GetNetwork(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockContainerNetworkManager)(nil).GetNetwork), ctx, name)
+}
+
 // Initialize mocks base method.
 func (m *MockContainerNetworkManager) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -103,6 +148,21 @@ func (mr *MockContainerNetworkManagerMockRecorder) Initialize(ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockContainerNetworkManager)(nil).Initialize), ctx)
 }
 
+// ListNetworks mocks base method.
+func (m *MockContainerNetworkManager) ListNetworks(ctx context.Context) ([]*types0.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworks", ctx)
+	ret0, _ := ret[0].([]*types0.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworks indicates an expected call of ListNetworks.
+func (mr *MockContainerNetworkManagerMockRecorder) ListNetworks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockContainerNetworkManager)(nil).ListNetworks), ctx)
+}
+
 // Manage mocks base method.
 func (m *MockContainerNetworkManager) Manage(ctx context.Context, container *types.Container) error {
 	m.ctrl.T.Helper()
@@ -131,6 +191,34 @@ func (mr *MockContainerNetworkManagerMockRecorder) ReleaseNetworkResources(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseNetworkResources", reflect.TypeOf((*MockContainerNetworkManager)(nil).ReleaseNetworkResources), ctx, container)
 }
 
+// ReleaseNetworks mocks base method.
+func (m *MockContainerNetworkManager) ReleaseNetworks(ctx context.Context, container *types.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseNetworks", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseNetworks indicates an expected call of ReleaseNetworks.
+func (mr *MockContainerNetworkManagerMockRecorder) ReleaseNetworks(ctx, container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseNetworks", reflect.TypeOf((*MockContainerNetworkManager)(nil).ReleaseNetworks), ctx, container)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MockContainerNetworkManager) RemoveNetwork(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MockContainerNetworkManagerMockRecorder) RemoveNetwork(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MockContainerNetworkManager)(nil).RemoveNetwork), ctx, name)
+}
+
 // Restore mocks base method.
 func (m *MockContainerNetworkManager) Restore(ctx context.Context, container []*types.Container) error {
 	m.ctrl.T.Helper()
@@ -159,3 +247,17 @@ func (mr *MockContainerNetworkManagerMockRecorder) Stats(ctx, container interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockContainerNetworkManager)(nil).Stats), ctx, container)
 }
+
+// UpdateNetworkPolicy mocks base method.
+func (m *MockContainerNetworkManager) UpdateNetworkPolicy(ctx context.Context, container *types.Container, policy *types.NetworkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkPolicy", ctx, container, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkPolicy indicates an expected call of UpdateNetworkPolicy.
+func (mr *MockContainerNetworkManagerMockRecorder) UpdateNetworkPolicy(ctx, container, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkPolicy", reflect.TypeOf((*MockContainerNetworkManager)(nil).UpdateNetworkPolicy), ctx, container, policy)
+}
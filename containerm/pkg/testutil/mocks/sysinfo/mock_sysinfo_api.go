@@ -17,6 +17,7 @@
 package mocks
 
 import (
+	context "context"
 	types "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -58,3 +59,17 @@ func (mr *MockSystemInfoManagerMockRecorder) GetProjectInfo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInfo", reflect.TypeOf((*MockSystemInfoManager)(nil).GetProjectInfo))
 }
+
+// GetRuntimeInfo mocks base method
+func (m *MockSystemInfoManager) GetRuntimeInfo(arg0 context.Context) types.RuntimeInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuntimeInfo", arg0)
+	ret0, _ := ret[0].(types.RuntimeInfo)
+	return ret0
+}
+
+// GetRuntimeInfo indicates an expected call of GetRuntimeInfo
+func (mr *MockSystemInfoManagerMockRecorder) GetRuntimeInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuntimeInfo", reflect.TypeOf((*MockSystemInfoManager)(nil).GetRuntimeInfo), arg0)
+}
@@ -34,6 +34,10 @@ const (
 	ContainerClientService Type = "container-management.service.ctrs.client.v1"
 	// ContainerManagerService implements THE container manager service
 	ContainerManagerService Type = "container-management.service.ctrs.manager.v1"
+	// VolumesManagerService implements THE volumes manager service
+	VolumesManagerService Type = "container-management.service.volumes.manager.v1"
+	// ImagesManagerService implements THE images manager service
+	ImagesManagerService Type = "container-management.service.images.manager.v1"
 	// SystemInfoService implements THE system information service
 	SystemInfoService Type = "container-management.service.system.info.v1"
 	// ThingsContainerManagerService implements THE container management via the IoT Rollouts and IoT Things services
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package services
+
+import (
+	"io"
+	"sync"
+
+	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
+)
+
+// execReader reads the STDIN data of an executed process from the exec stream until the client finishes writing.
+type execReader struct {
+	execServer pbcontainers.Containers_ExecServer
+	buf        []byte
+	err        error
+}
+
+func newExecReader(execServer pbcontainers.Containers_ExecServer) *execReader {
+	return &execReader{execServer: execServer}
+}
+
+// Read implements io.Reader.
+func (r *execReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		req, err := r.execServer.Recv()
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		r.buf = req.DataToWrite
+		if req.FinishWrite {
+			r.err = io.EOF
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close implements io.Closer.
+func (r *execReader) Close() error {
+	return nil
+}
+
+// execWriter sends the output of an executed process over the exec stream.
+// As both STDOUT and STDERR are written concurrently, the sending is synchronized.
+type execWriter struct {
+	sync.Mutex
+	containerID string
+	execServer  pbcontainers.Containers_ExecServer
+}
+
+func newExecWriter(containerID string, execServer pbcontainers.Containers_ExecServer) *execWriter {
+	return &execWriter{containerID: containerID, execServer: execServer}
+}
+
+// Write implements io.Writer.
+func (w *execWriter) Write(p []byte) (int, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	n := 0
+	for n < len(p) {
+		bufSize := len(p) - n
+		if bufSize > MaxBufSize {
+			bufSize = MaxBufSize
+		}
+		if err := w.execServer.Send(&pbcontainers.ExecContainerResponse{Id: w.containerID, ReadData: p[n : n+bufSize]}); err != nil {
+			return n, err
+		}
+		n += bufSize
+	}
+	return n, nil
+}
+
+func (w *execWriter) sendExitCode(exitCode int64) error {
+	w.Lock()
+	defer w.Unlock()
+	return w.execServer.Send(&pbcontainers.ExecContainerResponse{Id: w.containerID, Exited: true, ExitCode: exitCode})
+}
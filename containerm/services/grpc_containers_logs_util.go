@@ -15,94 +15,388 @@ package services
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/logger/jsonfile"
+	"github.com/eclipse-kanto/container-management/containerm/logger/local"
 )
 
-func sendAllLogs(file *os.File, srv pbcontainers.Containers_LogsServer) error {
-	const maxBuffSize = 3 << 20
-	scanner := bufio.NewScanner(file)
-	buff := bytes.NewBufferString("")
+const (
+	maxLogsResponseSize = 3 << 20
+	logsFollowInterval  = 250 * time.Millisecond
+
+	logsStreamStdout = "stdout"
+	logsStreamStderr = "stderr"
+)
+
+// jsonLogEntry is a single entry of the json-file log driver
+type jsonLogEntry struct {
+	Stream string    `json:"stream,omitempty"`
+	Log    string    `json:"log,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// toLogsOpts converts the logs request to the options for sending the container's logs
+func toLogsOpts(request *pbcontainers.GetLogsRequest) (*types.LogsOpts, error) {
+	opts := &types.LogsOpts{
+		Tail:       request.Tail,
+		Follow:     request.Follow,
+		Stdout:     request.Stdout,
+		Stderr:     request.Stderr,
+		Timestamps: request.Timestamps,
+	}
+	var err error
+	if request.Since != "" {
+		if opts.Since, err = time.Parse(time.RFC3339Nano, request.Since); err != nil {
+			return nil, fmt.Errorf("invalid since time %s", request.Since)
+		}
+	}
+	if request.Until != "" {
+		if opts.Until, err = time.Parse(time.RFC3339Nano, request.Until); err != nil {
+			return nil, fmt.Errorf("invalid until time %s", request.Until)
+		}
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return nil, fmt.Errorf("the until time %s is before the since time %s", request.Until, request.Since)
+	}
+	return opts, nil
+}
+
+// logsSender filters the log entries according to the requested options and sends them in batches.
+// While tailing, only the most recent matching entries are kept and they are sent once the tailing is over.
+type logsSender struct {
+	srv         pbcontainers.Containers_LogsServer
+	opts        *types.LogsOpts
+	tailing     bool
+	tail        []string
+	buff        bytes.Buffer
+	lineStarted bool
+	done        bool
+}
+
+func newLogsSender(srv pbcontainers.Containers_LogsServer, opts *types.LogsOpts) *logsSender {
+	return &logsSender{
+		srv:     srv,
+		opts:    opts,
+		tailing: opts.Tail >= 0,
+	}
+}
+
+// process handles a single raw line of the log file
+func (sender *logsSender) process(rawLine []byte) error {
+	rawLine = bytes.TrimRight(rawLine, "\r\n")
+	if len(rawLine) == 0 {
+		return nil
+	}
+	line, ok := sender.format(rawLine)
+	if !ok {
+		return nil
+	}
+	if sender.tailing {
+		if sender.opts.Tail == 0 {
+			return nil
+		}
+		if len(sender.tail) == int(sender.opts.Tail) {
+			sender.tail = sender.tail[1:]
+		}
+		sender.tail = append(sender.tail, line)
+		return nil
+	}
+	return sender.write(line)
+}
+
+// format filters a raw log line and returns the line to be sent.
+// The raw lines are sent unless timestamps are requested, in which case the decoded log messages prefixed with the time they were written are sent.
+// The lines that are not valid log entries are returned unchanged unless the entries are filtered.
+func (sender *logsSender) format(rawLine []byte) (string, bool) {
+	filtered := sender.opts.Stdout != sender.opts.Stderr || !sender.opts.Since.IsZero() || !sender.opts.Until.IsZero()
+	if !filtered && !sender.opts.Timestamps {
+		return string(rawLine) + "\n", true
+	}
+	entry := &jsonLogEntry{}
+	if err := json.Unmarshal(rawLine, entry); err != nil {
+		return string(rawLine) + "\n", !filtered
+	}
+	if sender.opts.Stdout != sender.opts.Stderr {
+		if (sender.opts.Stdout && entry.Stream != logsStreamStdout) || (sender.opts.Stderr && entry.Stream != logsStreamStderr) {
+			return "", false
+		}
+	}
+	if !sender.opts.Since.IsZero() && entry.Time.Before(sender.opts.Since) {
+		return "", false
+	}
+	if !sender.opts.Until.IsZero() && entry.Time.After(sender.opts.Until) {
+		sender.done = true
+		return "", false
+	}
+	if !sender.opts.Timestamps {
+		return string(rawLine) + "\n", true
+	}
+	line := entry.Log
+	// the partial entries of a long line are prefixed with a timestamp only at the line start
+	if !sender.lineStarted {
+		line = entry.Time.UTC().Format(time.RFC3339Nano) + " " + line
+	}
+	sender.lineStarted = !strings.HasSuffix(entry.Log, "\n")
+	return line, true
+}
+
+func (sender *logsSender) write(line string) error {
+	sender.buff.WriteString(line)
+	if sender.buff.Len() > maxLogsResponseSize {
+		return sender.flush()
+	}
+	return nil
+}
+
+// endTail sends the kept most recent entries and switches to sending all new matching entries
+func (sender *logsSender) endTail() error {
+	sender.tailing = false
+	for _, line := range sender.tail {
+		if err := sender.write(line); err != nil {
+			return err
+		}
+	}
+	sender.tail = nil
+	return sender.flush()
+}
+
+func (sender *logsSender) flush() error {
+	if sender.buff.Len() == 0 {
+		return nil
+	}
+	err := sender.srv.Send(&pbcontainers.GetLogsResponse{Log: sender.buff.String()})
+	sender.buff.Reset()
+	return err
+}
+
+// logFileReader reads the complete lines of a log file that can still be written to.
+// The records of the local log driver's files are read as json-file log entries.
+type logFileReader struct {
+	file    *os.File
+	reader  *bufio.Reader
+	local   bool
+	pending []byte
+	offset  int64
+}
+
+func openLogFile(logFile string, isLocal bool) (*logFileReader, error) {
+	file, err := os.Open(logFile)
+	if err != nil {
+		return nil, err
+	}
+	return &logFileReader{file: file, reader: bufio.NewReader(file), local: isLocal}, nil
+}
+
+// readLogs processes the complete lines available in the log file.
+// A trailing incomplete line is processed only if the log file is not written to anymore, otherwise it is kept
+// until the rest of it is written.
+func (lr *logFileReader) readLogs(sender *logsSender, completed bool) error {
+	if lr.local {
+		return lr.readLocalLogs(sender, completed)
+	}
 	for {
-		if !scanner.Scan() {
-			if scanner.Err() != nil {
-				return scanner.Err()
-			}
-			if err := srv.Send(&pbcontainers.GetLogsResponse{Log: buff.String()}); err != nil {
-				return err
+		line, err := lr.reader.ReadBytes('\n')
+		lr.offset += int64(len(line))
+		if len(lr.pending) > 0 {
+			line = append(lr.pending, line...)
+			lr.pending = nil
+		}
+		if err == io.EOF {
+			if len(line) > 0 {
+				if !completed {
+					lr.pending = line
+					return nil
+				}
+				return sender.process(line)
 			}
-			break
+			return nil
 		}
+		if err != nil {
+			return err
+		}
+		if err := sender.process(line); err != nil {
+			return err
+		}
+	}
+}
 
-		buff.WriteString(fmt.Sprintf("%s\n", scanner.Text()))
-		if len(buff.Bytes()) > maxBuffSize {
-			if err := srv.Send(&pbcontainers.GetLogsResponse{Log: buff.String()}); err != nil {
+// readLocalLogs processes the complete records available in the log file of the local log driver.
+// A trailing incomplete record is read again once the rest of it is written, unless the log file is not written to anymore.
+func (lr *logFileReader) readLocalLogs(sender *logsSender, completed bool) error {
+	for {
+		counter := &countingReader{reader: lr.reader}
+		msg, err := local.ReadLogMessage(counter)
+		if err == io.ErrUnexpectedEOF && !completed && lr.file != nil {
+			// go back to the start of the incomplete record
+			if _, err := lr.file.Seek(lr.offset, io.SeekStart); err != nil {
 				return err
 			}
-			buff.Reset()
+			lr.reader.Reset(lr.file)
+			return nil
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		lr.offset += counter.count
+		line, err := json.Marshal(&jsonLogEntry{Stream: msg.Source, Log: string(msg.Line), Time: msg.Timestamp})
+		if err != nil {
+			return err
+		}
+		if err := sender.process(line); err != nil {
+			return err
 		}
 	}
-	return nil
 }
 
-func tailLogs(file *os.File, srv pbcontainers.Containers_LogsServer, tail int) error {
-	var (
-		cursor   int64 = 0
-		buff           = bytes.NewBufferString("")
-		logLines       = make([]string, 0)
-	)
+func (lr *logFileReader) reset(file *os.File) {
+	lr.file.Close()
+	lr.file = file
+	lr.reader.Reset(file)
+	lr.pending = nil
+	lr.offset = 0
+}
 
-	stat, err := file.Stat()
+func (lr *logFileReader) close() error {
+	return lr.file.Close()
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.reader.Read(p)
+	cr.count += int64(n)
+	return n, err
+}
+
+// sendLogs sends the logs from the rotated log files and the current one in the order they were written.
+// The logs of the local log driver are sent as json-file log entries.
+// If following is requested, the new log entries are sent until the context is done,
+// the until time is reached or the provided running check fails.
+func sendLogs(ctx context.Context, logFile string, driver types.LogDriver, opts *types.LogsOpts, srv pbcontainers.Containers_LogsServer, running func() bool) error {
+	sender := newLogsSender(srv, opts)
+	isLocal := driver == types.LogConfigDriverLocal
+
+	rotatedFiles, err := jsonfile.RotatedLogFiles(logFile)
 	if err != nil {
 		return err
 	}
+	for _, rotatedFile := range rotatedFiles {
+		if err := readLogFile(rotatedFile, isLocal, sender); err != nil {
+			return err
+		}
+	}
 
-	fileSize := stat.Size()
-	if fileSize == 0 {
+	lr, err := openLogFile(logFile, isLocal)
+	if err != nil {
+		return err
+	}
+	defer lr.close()
+
+	if err := lr.readLogs(sender, !opts.Follow); err != nil {
+		return err
+	}
+	if err := sender.endTail(); err != nil {
+		return err
+	}
+	if !opts.Follow {
 		return nil
 	}
 
-	for {
-		cursor--
-		if _, err := file.Seek(cursor, io.SeekEnd); err != nil {
-			return err
+	ticker := time.NewTicker(logsFollowInterval)
+	defer ticker.Stop()
+	for !sender.done {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
 		}
 
-		char := make([]byte, 1)
-		if _, err := file.Read(char); err != nil {
+		if err := lr.readLogs(sender, false); err != nil {
 			return err
 		}
-
-		if cursor != -1 && (char[0] == 10 || char[0] == 13) {
-			logLines = append(logLines, reverse(buff.String()))
-			buff.Reset()
-		} else {
-			buff.WriteString(string(char))
+		if err := followLogFile(logFile, lr, sender); err != nil {
+			return err
 		}
-
-		if cursor == -fileSize || len(logLines) == tail {
+		if running != nil && !running() {
+			if err := lr.readLogs(sender, true); err != nil {
+				return err
+			}
 			break
 		}
+		if err := sender.flush(); err != nil {
+			return err
+		}
+	}
+	return sender.flush()
+}
+
+// followLogFile switches to the new log file if the followed one has been rotated or truncated
+func followLogFile(logFile string, lr *logFileReader, sender *logsSender) error {
+	currentStat, err := lr.file.Stat()
+	if err != nil {
+		return err
 	}
-	for i := len(logLines) - 1; i >= 0; i-- {
-		if err := srv.Send(&pbcontainers.GetLogsResponse{Log: logLines[i]}); err != nil {
+	stat, err := os.Stat(logFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the new log file is not created yet
+			return nil
+		}
+		return err
+	}
+	if !os.SameFile(stat, currentStat) {
+		// the log file has been rotated - read the rest of the rotated one and continue with the new one
+		if err := lr.readLogs(sender, true); err != nil {
 			return err
 		}
+		file, err := os.Open(logFile)
+		if err != nil {
+			return err
+		}
+		lr.reset(file)
+		return nil
+	}
+	if currentStat.Size() < lr.offset {
+		// the log file has been truncated as no rotated files are kept
+		if _, err := lr.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		lr.reader.Reset(lr.file)
+		lr.pending = nil
+		lr.offset = 0
 	}
 	return nil
 }
 
-func reverse(s string) (result string) {
-	for _, r := range s {
-		result = string(r) + result
+// readLogFile reads a rotated log file, which is decompressed if needed
+func readLogFile(logFile string, isLocal bool, sender *logsSender) error {
+	file, err := jsonfile.OpenLogFile(logFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
 	}
-	return
+	defer file.Close()
+	lr := &logFileReader{reader: bufio.NewReader(file), local: isLocal}
+	return lr.readLogs(sender, true)
 }
 
 func getLogFilePath(container *types.Container) (string, error) {
@@ -118,12 +412,20 @@ func getLogFilePath(container *types.Container) (string, error) {
 	if container.HostConfig.LogConfig.DriverConfig.Type == types.LogConfigDriverNone {
 		return "", fmt.Errorf("there are not any logs for container %s with log type %s", container.ID, types.LogConfigDriverNone)
 	}
-	if container.HostConfig.LogConfig.DriverConfig.Type == types.LogConfigDriverJSONFile {
-		if container.HostConfig.LogConfig.DriverConfig.RootDir != "" {
-			return filepath.Join(container.HostConfig.LogConfig.DriverConfig.RootDir, jsonfile.JSONLogFileName), nil
-		}
-		logFileDir, _ := filepath.Split(container.HostsPath)
-		return filepath.Join(logFileDir, jsonfile.JSONLogFileName), nil
+	var logFileName string
+	switch container.HostConfig.LogConfig.DriverConfig.Type {
+	case types.LogConfigDriverJSONFile:
+		logFileName = jsonfile.JSONLogFileName
+	case types.LogConfigDriverLocal:
+		logFileName = local.LocalLogFileName
+	case types.LogConfigDriverSyslog, types.LogConfigDriverJournald:
+		return "", fmt.Errorf("reading the logs of container %s is not supported for log type %s", container.ID, container.HostConfig.LogConfig.DriverConfig.Type)
+	default:
+		return "", fmt.Errorf("unknown log type %s", container.HostConfig.LogConfig.DriverConfig.Type)
+	}
+	if container.HostConfig.LogConfig.DriverConfig.RootDir != "" {
+		return filepath.Join(container.HostConfig.LogConfig.DriverConfig.RootDir, logFileName), nil
 	}
-	return "", fmt.Errorf("unknown log type %s", container.HostConfig.LogConfig.DriverConfig.Type)
+	logFileDir, _ := filepath.Split(container.HostsPath)
+	return filepath.Join(logFileDir, logFileName), nil
 }
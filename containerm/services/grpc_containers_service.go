@@ -15,11 +15,13 @@ package services
 import (
 	"context"
 	"fmt"
-	"os"
+	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
 	pbcontainerstypes "github.com/eclipse-kanto/container-management/containerm/api/types/containers"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/events"
+	"github.com/eclipse-kanto/container-management/containerm/log"
 	"github.com/eclipse-kanto/container-management/containerm/mgr"
 	"github.com/eclipse-kanto/container-management/containerm/streams"
 	"github.com/eclipse-kanto/container-management/containerm/util"
@@ -29,8 +31,11 @@ import (
 	"google.golang.org/grpc"
 )
 
+const defaultStatsInterval = time.Second
+
 type containers struct {
-	mgr mgr.ContainerManager
+	mgr       mgr.ContainerManager
+	eventsMgr events.ContainerEventsManager
 }
 
 func (server *containers) Register(grpcServer *grpc.Server) error {
@@ -124,6 +129,33 @@ func (server *containers) Attach(attachServer pbcontainers.Containers_AttachServ
 	return nil
 }
 
+func (server *containers) Exec(execServer pbcontainers.Containers_ExecServer) error {
+	req, err := execServer.Recv()
+	if err != nil {
+		return err
+	}
+	execConfig := protobuf.ToInternalExecConfig(req.ExecConfig)
+	if execConfig == nil {
+		execConfig = &types.ExecConfig{}
+	}
+
+	writer := newExecWriter(req.Id, execServer)
+	attach := &streams.AttachConfig{
+		UseStdin:  execConfig.OpenStdin,
+		Stdin:     newExecReader(execServer),
+		UseStdout: true,
+		Stdout:    writer,
+		UseStderr: true,
+		Stderr:    writer,
+	}
+
+	exitCode, err := server.mgr.Exec(execServer.Context(), req.Id, execConfig, attach)
+	if err != nil {
+		return err
+	}
+	return writer.sendExitCode(exitCode)
+}
+
 func (server *containers) Stop(ctx context.Context, request *pbcontainers.StopContainerRequest) (*empty.Empty, error) {
 	err := server.mgr.Stop(ctx, request.Id, protobuf.ToInternalStopOptions(request.StopOptions))
 	if err != nil {
@@ -176,6 +208,159 @@ func (server *containers) Remove(ctx context.Context, request *pbcontainers.Remo
 	return &empty.Empty{}, nil
 }
 
+func (server *containers) Checkpoint(ctx context.Context, request *pbcontainers.CheckpointContainerRequest) (*empty.Empty, error) {
+	err := server.mgr.Checkpoint(ctx, request.Id, protobuf.ToInternalCheckpointOptions(request.CheckpointOptions))
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (server *containers) ListCheckpoints(ctx context.Context, request *pbcontainers.ListCheckpointsRequest) (*pbcontainers.ListCheckpointsResponse, error) {
+	checkpoints, err := server.mgr.ListCheckpoints(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pbcontainers.ListCheckpointsResponse{Checkpoints: protobuf.ToProtoCheckpoints(checkpoints)}, nil
+}
+
+func (server *containers) RemoveCheckpoint(ctx context.Context, request *pbcontainers.RemoveCheckpointRequest) (*empty.Empty, error) {
+	err := server.mgr.RemoveCheckpoint(ctx, request.Id, request.CheckpointId)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (server *containers) Restore(ctx context.Context, request *pbcontainers.RestoreContainerRequest) (*empty.Empty, error) {
+	err := server.mgr.RestoreFromCheckpoint(ctx, request.Id, request.CheckpointId)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (server *containers) Metrics(ctx context.Context, request *pbcontainers.GetMetricsRequest) (*pbcontainers.GetMetricsResponse, error) {
+	metrics, err := server.mgr.Metrics(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pbcontainers.GetMetricsResponse{Metrics: protobuf.ToProtoMetrics(metrics)}, nil
+}
+
+func (server *containers) Stats(request *pbcontainers.StatsRequest, srv pbcontainers.Containers_StatsServer) error {
+	interval := time.Duration(request.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultStatsInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := server.collectStats(srv.Context(), request.Ids)
+		if err != nil {
+			return err
+		}
+		if err = srv.Send(&pbcontainers.StatsResponse{Stats: protobuf.ToProtoContainerStats(stats)}); err != nil {
+			return err
+		}
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (server *containers) collectStats(ctx context.Context, ids []string) ([]*types.ContainerStats, error) {
+	var ctrs []*types.Container
+	if len(ids) == 0 {
+		all, err := server.mgr.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ctr := range all {
+			if util.IsContainerRunningOrPaused(ctr) {
+				ctrs = append(ctrs, ctr)
+			}
+		}
+	} else {
+		for _, id := range ids {
+			ctr, err := server.mgr.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if ctr == nil {
+				return nil, fmt.Errorf("no such container with id = %s exists", id)
+			}
+			ctrs = append(ctrs, ctr)
+		}
+	}
+
+	stats := make([]*types.ContainerStats, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		metrics, err := server.mgr.Metrics(ctx, ctr.ID)
+		if err != nil {
+			if len(ids) > 0 {
+				return nil, err
+			}
+			log.WarnErr(err, "could not get metrics for container with id = %s", ctr.ID)
+			continue
+		}
+		stats = append(stats, &types.ContainerStats{ID: ctr.ID, Name: ctr.Name, Metrics: metrics})
+	}
+	return stats, nil
+}
+
+func (server *containers) Events(request *pbcontainers.EventsRequest, srv pbcontainers.Containers_EventsServer) error {
+	filter := &types.EventsFilter{
+		IDs:   request.Ids,
+		Names: request.Names,
+		Since: request.Since,
+		Until: request.Until,
+	}
+	for _, action := range request.Actions {
+		filter.Actions = append(filter.Actions, types.EventAction(action))
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+	if filter.Until > 0 {
+		until := time.Unix(filter.Until, 0)
+		if !time.Now().Before(until) {
+			return nil
+		}
+		ctx, cancel = context.WithDeadline(ctx, until)
+		defer cancel()
+	}
+
+	var (
+		eventsCh <-chan *types.Event
+		errCh    <-chan error
+	)
+	if filter.Since > 0 {
+		eventsCh, errCh = server.eventsMgr.SubscribeSince(ctx, filter.Since)
+	} else {
+		eventsCh, errCh = server.eventsMgr.Subscribe(ctx)
+	}
+	for {
+		select {
+		case event := <-eventsCh:
+			if !util.MatchesEventsFilter(event, filter) {
+				continue
+			}
+			if err := srv.Send(&pbcontainers.EventsResponse{Event: protobuf.ToProtoEvent(event)}); err != nil {
+				return err
+			}
+		case err := <-errCh:
+			if err == context.DeadlineExceeded {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 func (server *containers) Logs(request *pbcontainers.GetLogsRequest, srv pbcontainers.Containers_LogsServer) error {
 	container, err := server.mgr.Get(context.Background(), request.Id)
 	if err != nil {
@@ -199,16 +384,14 @@ func (server *containers) Logs(request *pbcontainers.GetLogsRequest, srv pbconta
 		return err
 	}
 
-	f, err := os.Open(logFile)
+	opts, err := toLogsOpts(request)
 	if err != nil {
 		return err
 	}
 
-	defer f.Close()
-
-	if request.Tail < 0 {
-		return sendAllLogs(f, srv)
+	running := func() bool {
+		ctr, err := server.mgr.Get(context.Background(), request.Id)
+		return err == nil && ctr != nil && ctr.State != nil && (ctr.State.Running || ctr.State.Paused)
 	}
-
-	return tailLogs(f, srv, int(request.Tail))
+	return sendLogs(srv.Context(), logFile, container.HostConfig.LogConfig.DriverConfig.Type, opts, srv, running)
 }
@@ -13,6 +13,7 @@
 package services
 
 import (
+	"github.com/eclipse-kanto/container-management/containerm/events"
 	"github.com/eclipse-kanto/container-management/containerm/mgr"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 )
@@ -26,7 +27,11 @@ func init() {
 			if err != nil {
 				return nil, err
 			}
-			return &containers{mgr: mgrService.(mgr.ContainerManager)}, nil
+			eventsMgrService, err := registryCtx.Get(registry.EventsManagerService)
+			if err != nil {
+				return nil, err
+			}
+			return &containers{mgr: mgrService.(mgr.ContainerManager), eventsMgr: eventsMgrService.(events.ContainerEventsManager)}, nil
 		},
 	})
 }
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	pbimages "github.com/eclipse-kanto/container-management/containerm/api/services/images"
+	"github.com/eclipse-kanto/container-management/containerm/images"
+	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+)
+
+type imagesService struct {
+	imagesMgr images.ImageManager
+}
+
+func (server *imagesService) Register(grpcServer *grpc.Server) error {
+	pbimages.RegisterImagesServer(grpcServer, server)
+	return nil
+}
+
+func (server *imagesService) List(ctx context.Context, request *pbimages.ListImagesRequest) (*pbimages.ListImagesResponse, error) {
+	imageInfos, err := server.imagesMgr.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbimages.ListImagesResponse{Images: protobuf.ToProtoImageInfos(imageInfos)}, nil
+}
+
+func (server *imagesService) Get(ctx context.Context, request *pbimages.GetImageRequest) (*pbimages.GetImageResponse, error) {
+	imageInfo, err := server.imagesMgr.Get(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pbimages.GetImageResponse{Image: protobuf.ToProtoImageInfo(imageInfo)}, nil
+}
+
+func (server *imagesService) Pull(ctx context.Context, request *pbimages.PullImageRequest) (*pbimages.PullImageResponse, error) {
+	if request.Image == nil {
+		return nil, fmt.Errorf("image must be provided")
+	}
+	imageInfo, err := server.imagesMgr.Pull(ctx, *protobuf.ToInternalImage(request.Image))
+	if err != nil {
+		return nil, err
+	}
+	return &pbimages.PullImageResponse{Image: protobuf.ToProtoImageInfo(imageInfo)}, nil
+}
+
+func (server *imagesService) Remove(ctx context.Context, request *pbimages.RemoveImageRequest) (*empty.Empty, error) {
+	err := server.imagesMgr.Remove(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package services
+
+import (
+	"github.com/eclipse-kanto/container-management/containerm/images"
+	"github.com/eclipse-kanto/container-management/containerm/registry"
+)
+
+func init() {
+	registry.Register(&registry.Registration{
+		ID:   ImagesServiceID,
+		Type: registry.GRPCService,
+		InitFunc: func(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+			imagesMgrService, err := registryCtx.Get(registry.ImagesManagerService)
+			if err != nil {
+				return nil, err
+			}
+			return &imagesService{imagesMgr: imagesMgrService.(images.ImageManager)}, nil
+		},
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package services
+
+import (
+	"context"
+
+	pbnetworks "github.com/eclipse-kanto/container-management/containerm/api/services/networks"
+	"github.com/eclipse-kanto/container-management/containerm/network"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
+	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+)
+
+type networksService struct {
+	netMgr network.ContainerNetworkManager
+}
+
+func (server *networksService) Register(grpcServer *grpc.Server) error {
+	pbnetworks.RegisterNetworksServer(grpcServer, server)
+	return nil
+}
+
+func (server *networksService) Create(ctx context.Context, request *pbnetworks.CreateNetworkRequest) (*pbnetworks.CreateNetworkResponse, error) {
+	net, err := server.netMgr.CreateNetwork(ctx, request.Name, &networktypes.NetworkOpts{
+		Subnet:              request.Subnet,
+		Gateway:             request.Gateway,
+		MTU:                 int(request.Mtu),
+		DisableICC:          request.DisableIcc,
+		DisableIPMasquerade: request.DisableIpMasquerade,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbnetworks.CreateNetworkResponse{Network: protobuf.ToProtoNetwork(net)}, nil
+}
+
+func (server *networksService) Get(ctx context.Context, request *pbnetworks.GetNetworkRequest) (*pbnetworks.GetNetworkResponse, error) {
+	net, err := server.netMgr.GetNetwork(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pbnetworks.GetNetworkResponse{Network: protobuf.ToProtoNetwork(net)}, nil
+}
+
+func (server *networksService) List(ctx context.Context, request *pbnetworks.ListNetworksRequest) (*pbnetworks.ListNetworksResponse, error) {
+	networks, err := server.netMgr.ListNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbnetworks.ListNetworksResponse{Networks: protobuf.ToProtoNetworks(networks)}, nil
+}
+
+func (server *networksService) Remove(ctx context.Context, request *pbnetworks.RemoveNetworkRequest) (*empty.Empty, error) {
+	err := server.netMgr.RemoveNetwork(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
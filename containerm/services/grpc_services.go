@@ -18,4 +18,10 @@ const (
 	ContainersServiceID = "container-management.grpc.v1.service-containers"
 	// Service ID of the system information gRPC service
 	SystemInfoServiceID = "container-management.grpc.v1.service-systemInfo"
+	// Service ID of the images management gRPC service
+	ImagesServiceID = "container-management.grpc.v1.service-images"
+	// Service ID of the volumes management gRPC service
+	VolumesServiceID = "container-management.grpc.v1.service-volumes"
+	// Service ID of the networks management gRPC service
+	NetworksServiceID = "container-management.grpc.v1.service-networks"
 )
@@ -13,22 +13,45 @@
 package services
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	pbcontainers "github.com/eclipse-kanto/container-management/containerm/api/services/containers"
+	pbimages "github.com/eclipse-kanto/container-management/containerm/api/services/images"
+	pbnetworks "github.com/eclipse-kanto/container-management/containerm/api/services/networks"
 	pbsysinfo "github.com/eclipse-kanto/container-management/containerm/api/services/sysinfo"
+	pbvolumes "github.com/eclipse-kanto/container-management/containerm/api/services/volumes"
 	pbcontainerstypes "github.com/eclipse-kanto/container-management/containerm/api/types/containers"
 	pbsysinfotypes "github.com/eclipse-kanto/container-management/containerm/api/types/sysinfo"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagestypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	"github.com/eclipse-kanto/container-management/containerm/logger"
+	"github.com/eclipse-kanto/container-management/containerm/logger/jsonfile"
+	"github.com/eclipse-kanto/container-management/containerm/logger/local"
+	networktypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksevents "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/events"
+	mocksimages "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/images"
 	mocksmgrspb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/mgr"
+	mocksnetwork "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/network"
 	mockssysinfopb "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/sysinfo"
+	mocksvolumes "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/volumes"
+	"github.com/eclipse-kanto/container-management/containerm/streams"
 	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
@@ -40,25 +63,50 @@ const (
 	containerID2      = "test-ctr-2"
 	containerImageID2 = "host/group/image2:tag"
 	containerName2    = "test-ctr-name-2"
+	checkpointID      = "test-checkpoint"
+	checkpointCreated = "2026-01-02T15:04:05Z"
+	volumeName        = "test-volume"
+	networkName       = "test-network"
 )
 
 var (
 	mockContainerManager  *mocksmgrspb.MockContainerManager
+	mockEventsManager     *mocksevents.MockContainerEventsManager
 	mockSystemInfoManager *mockssysinfopb.MockSystemInfoManager
 	testCtrsService       containers
 	testSysInfoService    systemInfo
+	mockImageManager      *mocksimages.MockImageManager
+	testImagesService     imagesService
+	mockVolumeManager     *mocksvolumes.MockVolumeManager
+	testVolumesService    volumesService
+	mockNetworkManager    *mocksnetwork.MockContainerNetworkManager
+	testNetworksService   networksService
 	testCtx               context.Context
 )
 
 func setup(controller *gomock.Controller) {
 	mockContainerManager = mocksmgrspb.NewMockContainerManager(controller)
+	mockEventsManager = mocksevents.NewMockContainerEventsManager(controller)
 	testCtrsService = containers{
-		mgr: mockContainerManager,
+		mgr:       mockContainerManager,
+		eventsMgr: mockEventsManager,
 	}
 	mockSystemInfoManager = mockssysinfopb.NewMockSystemInfoManager(controller)
 	testSysInfoService = systemInfo{
 		sysInfoMgr: mockSystemInfoManager,
 	}
+	mockImageManager = mocksimages.NewMockImageManager(controller)
+	testImagesService = imagesService{
+		imagesMgr: mockImageManager,
+	}
+	mockVolumeManager = mocksvolumes.NewMockVolumeManager(controller)
+	testVolumesService = volumesService{
+		volumesMgr: mockVolumeManager,
+	}
+	mockNetworkManager = mocksnetwork.NewMockContainerNetworkManager(controller)
+	testNetworksService = networksService{
+		netMgr: mockNetworkManager,
+	}
 	testCtx = context.Background()
 }
 
@@ -663,16 +711,23 @@ type mockExecLogs func(args testLogsArgs) error
 
 type fakeLogsServer struct {
 	pbcontainers.Containers_LogsServer
+	ctx  context.Context
+	logs *bytes.Buffer
 }
 
 func newFakeClient() *fakeLogsServer {
-	return &fakeLogsServer{}
+	return &fakeLogsServer{ctx: context.Background(), logs: &bytes.Buffer{}}
 }
 
 func (f fakeLogsServer) Send(m *pbcontainers.GetLogsResponse) error {
+	f.logs.WriteString(m.Log)
 	return nil
 }
 
+func (f fakeLogsServer) Context() context.Context {
+	return f.ctx
+}
+
 func TestLogs(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -702,6 +757,29 @@ func TestLogs(t *testing.T) {
 			},
 			mockExecution: mockExecLogsNoHostConfig,
 		},
+		"test_logs_invalid_since": {
+			args: testLogsArgs{
+				request: &pbcontainers.GetLogsRequest{
+					Id:    containerID,
+					Tail:  10,
+					Since: "yesterday",
+				},
+				srv: newFakeClient(),
+			},
+			mockExecution: mockExecLogsInvalidSince,
+		},
+		"test_logs_until_before_since": {
+			args: testLogsArgs{
+				request: &pbcontainers.GetLogsRequest{
+					Id:    containerID,
+					Tail:  10,
+					Since: "2022-12-13T09:14:05Z",
+					Until: "2022-12-13T09:14:01Z",
+				},
+				srv: newFakeClient(),
+			},
+			mockExecution: mockExecLogsUntilBeforeSince,
+		},
 	}
 
 	// execute tests
@@ -718,213 +796,1970 @@ func TestLogs(t *testing.T) {
 	}
 }
 
-// Mock executions -------------------------------------------------------------
-// SystemInfo -------------------------------------------------------------
-// ProjectInfo -------------------------------------------------------------
-func mockExecProjectInfoNoErrors(args testProjectInfoArgs) (*pbsysinfo.ProjectInfoResponse, error) {
-	pbProjectInfo := &pbsysinfotypes.ProjectInfo{
-		ProjectVersion: projectVersion,
-		ApiVersion:     apiVersion,
-	}
-	pbResponse := &pbsysinfo.ProjectInfoResponse{
-		ProjectInfo: pbProjectInfo,
+func writeTestLogEntries(t *testing.T, logFile string, entries ...string) {
+	f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	testutil.AssertNil(t, err)
+	defer f.Close()
+	for _, entry := range entries {
+		_, err = f.WriteString(entry)
+		testutil.AssertNil(t, err)
 	}
-	projectInfo := protobuf.ToInternalProjectInfo(pbProjectInfo)
-	mockSystemInfoManager.EXPECT().GetProjectInfo().Times(1).Return(projectInfo)
-	return pbResponse, nil
 }
 
-// Containers -------------------------------------------------------------
-// Create -------------------------------------------------------------
-func mockExecCreateNoErrors(args testCreateArgs) (*pbcontainers.CreateContainerResponse, error) {
-	pbCtr := &pbcontainerstypes.Container{
-		Id:    containerID,
-		Name:  containerName,
-		Image: &pbcontainerstypes.Image{Name: containerImageID},
-	}
-	pbResponse := &pbcontainers.CreateContainerResponse{
-		Container: pbCtr,
-	}
-	ctr := protobuf.ToInternalContainer(pbCtr)
-	mockContainerManager.EXPECT().Create(args.ctx, gomock.Eq(protobuf.ToInternalContainer(args.request.Container))).Times(1).Return(ctr, nil)
-	return pbResponse, nil
+func testLogEntry(stream, log string, second int) string {
+	return fmt.Sprintf("{\"stream\":\"%s\",\"log\":\"%s\\n\",\"time\":\"2022-12-13T09:14:%02d.5Z\"}\n", stream, log, second)
 }
 
-func mockExecCreateErrors(args testCreateArgs) (*pbcontainers.CreateContainerResponse, error) {
-	err := errors.New("failed to create container")
-	mockContainerManager.EXPECT().Create(args.ctx, gomock.Eq(protobuf.ToInternalContainer(args.request.Container))).Times(1).Return(nil, err)
-	return nil, err
-}
+func TestSendLogs(t *testing.T) {
+	logDir := t.TempDir()
+	logFile := filepath.Join(logDir, jsonfile.JSONLogFileName)
+	entries := []string{
+		testLogEntry("stdout", "1", 1), testLogEntry("stderr", "2", 2),
+		testLogEntry("stdout", "3", 3), testLogEntry("stderr", "4", 4),
+		testLogEntry("stdout", "5", 5), "not a json entry\n", testLogEntry("stderr", "6", 6),
+	}
+	writeTestLogEntries(t, logFile+".2", entries[0:2]...)
+	writeTestLogEntries(t, logFile+".1", entries[2:4]...)
+	writeTestLogEntries(t, logFile, entries[4:]...)
 
-// Get -------------------------------------------------------------
-func mockExecGetNoErrors(args testGetArgs) (*pbcontainers.GetContainerResponse, error) {
-	pbCtr := &pbcontainerstypes.Container{
-		Id:    containerID,
-		Name:  containerName,
-		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	tests := map[string]struct {
+		opts         *types.LogsOpts
+		expectedLogs string
+	}{
+		"test_send_logs_all": {
+			opts:         &types.LogsOpts{Tail: -1},
+			expectedLogs: strings.Join(entries, ""),
+		},
+		"test_send_logs_tail_across_rotated_files": {
+			opts:         &types.LogsOpts{Tail: 5},
+			expectedLogs: strings.Join(entries[2:], ""),
+		},
+		"test_send_logs_tail_zero": {
+			opts: &types.LogsOpts{Tail: 0},
+		},
+		"test_send_logs_stdout": {
+			opts:         &types.LogsOpts{Tail: -1, Stdout: true},
+			expectedLogs: entries[0] + entries[2] + entries[4],
+		},
+		"test_send_logs_stderr_tail": {
+			opts:         &types.LogsOpts{Tail: 2, Stderr: true},
+			expectedLogs: entries[3] + entries[6],
+		},
+		"test_send_logs_stdout_and_stderr": {
+			opts:         &types.LogsOpts{Tail: 2, Stdout: true, Stderr: true},
+			expectedLogs: entries[5] + entries[6],
+		},
+		"test_send_logs_since_until": {
+			opts: &types.LogsOpts{
+				Tail:  -1,
+				Since: time.Date(2022, 12, 13, 9, 14, 2, 0, time.UTC),
+				Until: time.Date(2022, 12, 13, 9, 14, 4, 0, time.UTC),
+			},
+			expectedLogs: entries[1] + entries[2],
+		},
+		"test_send_logs_timestamps": {
+			opts:         &types.LogsOpts{Tail: 2, Stderr: true, Timestamps: true},
+			expectedLogs: "2022-12-13T09:14:04.5Z 4\n2022-12-13T09:14:06.5Z 6\n",
+		},
 	}
-	pbResponse := &pbcontainers.GetContainerResponse{
-		Container: pbCtr,
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			srv := newFakeClient()
+			err := sendLogs(context.Background(), logFile, types.LogConfigDriverJSONFile, testCase.opts, srv, nil)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedLogs, srv.logs.String())
+		})
 	}
-	mockContainerManager.EXPECT().Get(args.ctx, args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
-	return pbResponse, nil
 }
 
-func mockExecGetErrors(args testGetArgs) (*pbcontainers.GetContainerResponse, error) {
-	err := errors.New("failed to get container")
-	mockContainerManager.EXPECT().Get(args.ctx, args.request.Id).Times(1).Return(nil, err)
-	return nil, err
+func writeCompressedTestLogEntries(t *testing.T, logFile string, newWriter func(io.Writer) io.WriteCloser, entries ...string) {
+	f, err := os.Create(logFile)
+	testutil.AssertNil(t, err)
+	defer f.Close()
+	w := newWriter(f)
+	for _, entry := range entries {
+		_, err = io.WriteString(w, entry)
+		testutil.AssertNil(t, err)
+	}
+	testutil.AssertNil(t, w.Close())
 }
 
-// List -------------------------------------------------------------
-func mockExecListNoErrors(args testListArgs) (*pbcontainers.ListContainersResponse, error) {
-	pbCtr := &pbcontainerstypes.Container{
-		Id:    containerID,
-		Name:  containerName,
-		Image: &pbcontainerstypes.Image{Name: containerImageID},
-	}
-	pbCtr2 := &pbcontainerstypes.Container{
-		Id:    containerID2,
-		Name:  containerName2,
-		Image: &pbcontainerstypes.Image{Name: containerImageID2},
+func TestSendLogsCompressed(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), jsonfile.JSONLogFileName)
+	writeTestLogEntries(t, logFile+".3", testLogEntry("stdout", "1", 1))
+	writeCompressedTestLogEntries(t, logFile+".2.gz", func(w io.Writer) io.WriteCloser {
+		return gzip.NewWriter(w)
+	}, testLogEntry("stdout", "2", 2), testLogEntry("stderr", "3", 3))
+	writeCompressedTestLogEntries(t, logFile+".1.zst", func(w io.Writer) io.WriteCloser {
+		zw, err := zstd.NewWriter(w)
+		testutil.AssertNil(t, err)
+		return zw
+	}, testLogEntry("stdout", "4", 4))
+	writeTestLogEntries(t, logFile, testLogEntry("stdout", "5", 5))
+
+	srv := newFakeClient()
+	err := sendLogs(context.Background(), logFile, types.LogConfigDriverJSONFile, &types.LogsOpts{Tail: 4}, srv, nil)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, testLogEntry("stdout", "2", 2)+testLogEntry("stderr", "3", 3)+testLogEntry("stdout", "4", 4)+testLogEntry("stdout", "5", 5), srv.logs.String())
+}
+
+func TestSendLogsFollow(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), jsonfile.JSONLogFileName)
+	writeTestLogEntries(t, logFile, testLogEntry("stdout", "1", 1), testLogEntry("stdout", "2", 2))
+
+	var (
+		running = make(chan bool, 1)
+		done    = make(chan error, 1)
+		srv     = newFakeClient()
+	)
+	running <- true
+	isRunning := func() bool {
+		select {
+		case r := <-running:
+			running <- r
+			return r
+		default:
+			return true
+		}
 	}
-	pbResponse := &pbcontainers.ListContainersResponse{
-		Containers: []*pbcontainerstypes.Container{pbCtr, pbCtr2},
+	go func() {
+		done <- sendLogs(context.Background(), logFile, types.LogConfigDriverJSONFile, &types.LogsOpts{Tail: 1, Follow: true}, srv, isRunning)
+	}()
+
+	time.Sleep(2 * logsFollowInterval)
+	// write a partial line that is completed later
+	entry := testLogEntry("stdout", "3", 3)
+	writeTestLogEntries(t, logFile, entry[:10])
+	time.Sleep(2 * logsFollowInterval)
+	writeTestLogEntries(t, logFile, entry[10:])
+	// rotate the log file
+	testutil.AssertNil(t, os.Rename(logFile, logFile+".1"))
+	writeTestLogEntries(t, logFile, testLogEntry("stdout", "4", 4))
+	time.Sleep(2 * logsFollowInterval)
+	writeTestLogEntries(t, logFile, testLogEntry("stdout", "5", 5))
+
+	<-running
+	running <- false
+	select {
+	case err := <-done:
+		testutil.AssertNil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("following the logs has not finished after the container has exited")
 	}
-	ctrs := []*types.Container{protobuf.ToInternalContainer(pbCtr), protobuf.ToInternalContainer(pbCtr2)}
-	mockContainerManager.EXPECT().List(args.ctx).Times(1).Return(ctrs, nil)
-	return pbResponse, nil
+	testutil.AssertEqual(t, testLogEntry("stdout", "2", 2)+testLogEntry("stdout", "3", 3)+testLogEntry("stdout", "4", 4)+testLogEntry("stdout", "5", 5), srv.logs.String())
 }
 
-func mockExecListErrors(args testListArgs) (*pbcontainers.ListContainersResponse, error) {
-	err := errors.New("failed to list container")
-	mockContainerManager.EXPECT().List(args.ctx).Times(1).Return(nil, err)
-	return &pbcontainers.ListContainersResponse{Containers: []*pbcontainerstypes.Container{}}, err
+func TestSendLogsFollowCancelled(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), jsonfile.JSONLogFileName)
+	writeTestLogEntries(t, logFile, testLogEntry("stdout", "1", 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newFakeClient()
+	done := make(chan error, 1)
+	go func() {
+		done <- sendLogs(ctx, logFile, types.LogConfigDriverJSONFile, &types.LogsOpts{Tail: -1, Follow: true}, srv, func() bool { return true })
+	}()
+	cancel()
+	select {
+	case err := <-done:
+		testutil.AssertNil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("following the logs has not finished after the request has been cancelled")
+	}
+	testutil.AssertEqual(t, testLogEntry("stdout", "1", 1), srv.logs.String())
 }
 
-// Start -------------------------------------------------------------
-func mockExecStartNoErrors(args testStartArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Start(args.ctx, args.request.Id).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+func writeTestLocalLogMessages(t *testing.T, logDir string, maxSize int64, messages ...*logger.LogMessage) {
+	driver, err := local.NewLocalLog(logger.LogDriverInfo{ContainerRootDir: logDir}, local.WithMaxFiles(3), local.WithMaxSize(maxSize))
+	testutil.AssertNil(t, err)
+	defer driver.Close()
+	for _, msg := range messages {
+		testutil.AssertNil(t, driver.WriteLogMessage(msg))
+	}
 }
 
-func mockExecStartErrors(args testStartArgs) (*empty.Empty, error) {
-	err := errors.New("failed to start container")
-	mockContainerManager.EXPECT().Start(args.ctx, args.request.Id).Times(1).Return(err)
-	return nil, err
+func testLocalLogMessage(stream, log string, second int) *logger.LogMessage {
+	return &logger.LogMessage{Source: stream, Line: []byte(log + "\n"), Timestamp: time.Date(2022, 12, 13, 9, 14, second, 500000000, time.UTC)}
 }
 
-// Stop -------------------------------------------------------------
-func mockExecStopNoErrors(args testStopArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
-	return &empty.Empty{}, nil
-}
+func TestSendLogsLocal(t *testing.T) {
+	logDir := t.TempDir()
+	logFile := filepath.Join(logDir, local.LocalLogFileName)
+	// every message is written to a new log file
+	writeTestLocalLogMessages(t, logDir, 1, testLocalLogMessage("stdout", "1", 1), testLocalLogMessage("stderr", "2", 2), testLocalLogMessage("stdout", "3", 3))
 
-func mockExecStopDefaultOpts(args testStopArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, nil).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+	tests := map[string]struct {
+		opts         *types.LogsOpts
+		expectedLogs string
+	}{
+		"test_send_local_logs_all": {
+			opts:         &types.LogsOpts{Tail: -1},
+			expectedLogs: testLogEntry("stdout", "1", 1) + testLogEntry("stderr", "2", 2) + testLogEntry("stdout", "3", 3),
+		},
+		"test_send_local_logs_stdout_tail": {
+			opts:         &types.LogsOpts{Tail: 1, Stdout: true},
+			expectedLogs: testLogEntry("stdout", "3", 3),
+		},
+		"test_send_local_logs_timestamps": {
+			opts:         &types.LogsOpts{Tail: -1, Stderr: true, Timestamps: true},
+			expectedLogs: "2022-12-13T09:14:02.5Z 2\n",
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			srv := newFakeClient()
+			err := sendLogs(context.Background(), logFile, types.LogConfigDriverLocal, testCase.opts, srv, nil)
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedLogs, srv.logs.String())
+		})
+	}
 }
 
-func mockExecStopErrors(args testStopArgs) (*empty.Empty, error) {
-	err := errors.New("failed to stop container")
-	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(err)
-	return nil, err
+func TestSendLogsLocalFollow(t *testing.T) {
+	logDir := t.TempDir()
+	logFile := filepath.Join(logDir, local.LocalLogFileName)
+	writeTestLocalLogMessages(t, logDir, 0, testLocalLogMessage("stdout", "1", 1))
+
+	recordDir := t.TempDir()
+	writeTestLocalLogMessages(t, recordDir, 0, testLocalLogMessage("stdout", "2", 2))
+	record, err := os.ReadFile(filepath.Join(recordDir, local.LocalLogFileName))
+	testutil.AssertNil(t, err)
+
+	var (
+		running = make(chan bool, 1)
+		done    = make(chan error, 1)
+		srv     = newFakeClient()
+	)
+	running <- true
+	isRunning := func() bool {
+		r := <-running
+		running <- r
+		return r
+	}
+	go func() {
+		done <- sendLogs(context.Background(), logFile, types.LogConfigDriverLocal, &types.LogsOpts{Tail: -1, Follow: true}, srv, isRunning)
+	}()
+
+	time.Sleep(2 * logsFollowInterval)
+	// write a partial record that is completed later
+	writeTestLogEntries(t, logFile, string(record[:6]))
+	time.Sleep(2 * logsFollowInterval)
+	writeTestLogEntries(t, logFile, string(record[6:]))
+
+	<-running
+	running <- false
+	select {
+	case err := <-done:
+		testutil.AssertNil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("following the logs has not finished after the container has exited")
+	}
+	testutil.AssertEqual(t, testLogEntry("stdout", "1", 1)+testLogEntry("stdout", "2", 2), srv.logs.String())
 }
 
-// Pause -------------------------------------------------------------
-func mockExecPauseNoErrors(args testPauseArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Pause(args.ctx, args.request.Id).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+type testExecArgs struct {
+	srv *fakeExecServer
 }
+type mockExecExec func(args testExecArgs) ([]*pbcontainers.ExecContainerResponse, error)
 
-func mockExecPauseErrors(args testPauseArgs) (*empty.Empty, error) {
-	err := errors.New("failed to pause container")
-	mockContainerManager.EXPECT().Pause(args.ctx, args.request.Id).Times(1).Return(err)
-	return nil, err
+type fakeExecServer struct {
+	pbcontainers.Containers_ExecServer
+	requests  []*pbcontainers.ExecContainerRequest
+	responses []*pbcontainers.ExecContainerResponse
 }
 
-// Unpause -------------------------------------------------------------
-func mockExecUnpauseNoErrors(args testUnpauseArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Unpause(args.ctx, args.request.Id).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+func newFakeExecServer(requests ...*pbcontainers.ExecContainerRequest) *fakeExecServer {
+	return &fakeExecServer{requests: requests}
 }
 
-func mockExecUnpauseErrors(args testUnpauseArgs) (*empty.Empty, error) {
-	err := errors.New("failed to unpause container")
-	mockContainerManager.EXPECT().Unpause(args.ctx, args.request.Id).Times(1).Return(err)
-	return nil, err
+func (f *fakeExecServer) Context() context.Context {
+	return testCtx
 }
 
-// Remove -------------------------------------------------------------
-func mockExecRemoveNoErrors(args testRemoveArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, args.request.Force, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+func (f *fakeExecServer) Recv() (*pbcontainers.ExecContainerRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
 }
 
-func mockExecRemoveForce(args testRemoveArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, true, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+func (f *fakeExecServer) Send(m *pbcontainers.ExecContainerResponse) error {
+	f.responses = append(f.responses, m)
+	return nil
 }
 
-//TODO add tests for timeout
+func TestExec(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
 
-func mockExecRemoveErrors(args testRemoveArgs) (*empty.Empty, error) {
-	err := errors.New("failed to remove container")
-	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, args.request.Force, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(err)
-	return nil, err
-}
+	execRequest := &pbcontainers.ExecContainerRequest{
+		Id:         containerID,
+		ExecConfig: &pbcontainerstypes.ExecConfig{Cmd: []string{"ls", "-la"}},
+	}
+	tests := map[string]struct {
+		args          testExecArgs
+		mockExecution mockExecExec
+	}{
+		"test_exec_no_errs": {
+			args:          testExecArgs{srv: newFakeExecServer(execRequest)},
+			mockExecution: mockExecExecNoErrors,
+		},
+		"test_exec_errs": {
+			args:          testExecArgs{srv: newFakeExecServer(execRequest)},
+			mockExecution: mockExecExecErrors,
+		},
+		"test_exec_no_request": {
+			args:          testExecArgs{srv: newFakeExecServer()},
+			mockExecution: mockExecExecNoRequest,
+		},
+	}
 
-// Update -------------------------------------------------------------
-func mockExecUpdateNoErrors(args testUpdateArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Update(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalUpdateOptions(args.request.UpdateOptions))).Times(1).Return(nil)
-	return &empty.Empty{}, nil
-}
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
 
-func mockExecUpdateErrors(args testUpdateArgs) (*empty.Empty, error) {
-	err := errors.New("failed to update container")
-	mockContainerManager.EXPECT().Update(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalUpdateOptions(args.request.UpdateOptions))).Times(1).Return(err)
-	return nil, err
-}
+			expectedResponses, expectedRunErr := testCase.mockExecution(testCase.args)
 
-// Rename -------------------------------------------------------------
-func mockExecRenameNoErrors(args testRenameArgs) (*empty.Empty, error) {
-	mockContainerManager.EXPECT().Rename(args.ctx, args.request.Id, gomock.Eq(args.request.Name)).Times(1).Return(nil)
-	return &empty.Empty{}, nil
+			resultErr := testCtrsService.Exec(testCase.args.srv)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+			testutil.AssertEqual(t, expectedResponses, testCase.args.srv.responses)
+		})
+	}
 }
 
-func mockExecRenameErrors(args testRenameArgs) (*empty.Empty, error) {
-	err := errors.New("failed to rename container")
-	mockContainerManager.EXPECT().Rename(args.ctx, args.request.Id, gomock.Eq(args.request.Name)).Times(1).Return(err)
-	return nil, err
+type testCheckpointArgs struct {
+	ctx     context.Context
+	request *pbcontainers.CheckpointContainerRequest
 }
+type mockExecCheckpoint func(args testCheckpointArgs) (*empty.Empty, error)
 
-// Logs -------------------------------------------------------------
-func mockExecLogsNoErrors(args testLogsArgs) error {
-	pbCtr := &pbcontainerstypes.Container{
-		Id:    containerID,
-		Name:  containerName,
-		Image: &pbcontainerstypes.Image{Name: containerImageID},
-		HostConfig: &pbcontainerstypes.HostConfig{
-			LogConfig: &pbcontainerstypes.LogConfiguration{
-				DriverConfig: &pbcontainerstypes.LogDriverConfiguration{
-					Type:    "json-file",
-					RootDir: "../pkg/testutil/logs",
+func TestCheckpoint(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCheckpointArgs
+		mockExecution mockExecCheckpoint
+	}{
+		"test_checkpoint_no_errs": {
+			args: testCheckpointArgs{
+				ctx: testCtx,
+				request: &pbcontainers.CheckpointContainerRequest{
+					Id:                containerID,
+					CheckpointOptions: &pbcontainerstypes.CheckpointOptions{CheckpointId: checkpointID, Exit: true},
+				},
+			},
+			mockExecution: mockExecCheckpointNoErrors,
+		},
+		"test_checkpoint_errs": {
+			args: testCheckpointArgs{
+				ctx: testCtx,
+				request: &pbcontainers.CheckpointContainerRequest{
+					Id:                containerID,
+					CheckpointOptions: &pbcontainerstypes.CheckpointOptions{CheckpointId: checkpointID},
 				},
 			},
+			mockExecution: mockExecCheckpointErrors,
 		},
 	}
-	mockContainerManager.EXPECT().Get(context.Background(), args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
-	return nil
-}
 
-func mockExecLogsNoHostConfig(args testLogsArgs) error {
-	err := errors.New("no host config for container test-ctr")
-	pbCtr := &pbcontainerstypes.Container{
-		Id:    containerID,
-		Name:  containerName,
-		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testCtrsService.Checkpoint(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
 	}
+}
 
-	mockContainerManager.EXPECT().Get(context.Background(), args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
-	return err
+type testListCheckpointsArgs struct {
+	ctx     context.Context
+	request *pbcontainers.ListCheckpointsRequest
+}
+type mockExecListCheckpoints func(args testListCheckpointsArgs) (*pbcontainers.ListCheckpointsResponse, error)
+
+func TestListCheckpoints(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListCheckpointsArgs
+		mockExecution mockExecListCheckpoints
+	}{
+		"test_list_checkpoints_no_errs": {
+			args: testListCheckpointsArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.ListCheckpointsRequest{Id: containerID},
+			},
+			mockExecution: mockExecListCheckpointsNoErrors,
+		},
+		"test_list_checkpoints_errs": {
+			args: testListCheckpointsArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.ListCheckpointsRequest{Id: containerID},
+			},
+			mockExecution: mockExecListCheckpointsErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testCtrsService.ListCheckpoints(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testRemoveCheckpointArgs struct {
+	ctx     context.Context
+	request *pbcontainers.RemoveCheckpointRequest
+}
+type mockExecRemoveCheckpoint func(args testRemoveCheckpointArgs) (*empty.Empty, error)
+
+func TestRemoveCheckpoint(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testRemoveCheckpointArgs
+		mockExecution mockExecRemoveCheckpoint
+	}{
+		"test_remove_checkpoint_no_errs": {
+			args: testRemoveCheckpointArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.RemoveCheckpointRequest{Id: containerID, CheckpointId: checkpointID},
+			},
+			mockExecution: mockExecRemoveCheckpointNoErrors,
+		},
+		"test_remove_checkpoint_errs": {
+			args: testRemoveCheckpointArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.RemoveCheckpointRequest{Id: containerID, CheckpointId: checkpointID},
+			},
+			mockExecution: mockExecRemoveCheckpointErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testCtrsService.RemoveCheckpoint(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testRestoreArgs struct {
+	ctx     context.Context
+	request *pbcontainers.RestoreContainerRequest
+}
+type mockExecRestore func(args testRestoreArgs) (*empty.Empty, error)
+
+func TestRestore(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testRestoreArgs
+		mockExecution mockExecRestore
+	}{
+		"test_restore_no_errs": {
+			args: testRestoreArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.RestoreContainerRequest{Id: containerID, CheckpointId: checkpointID},
+			},
+			mockExecution: mockExecRestoreNoErrors,
+		},
+		"test_restore_errs": {
+			args: testRestoreArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.RestoreContainerRequest{Id: containerID, CheckpointId: checkpointID},
+			},
+			mockExecution: mockExecRestoreErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testCtrsService.Restore(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testMetricsArgs struct {
+	ctx     context.Context
+	request *pbcontainers.GetMetricsRequest
+}
+type mockExecMetrics func(args testMetricsArgs) (*pbcontainers.GetMetricsResponse, error)
+
+func TestMetrics(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testMetricsArgs
+		mockExecution mockExecMetrics
+	}{
+		"test_metrics_no_errs": {
+			args: testMetricsArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.GetMetricsRequest{Id: containerID},
+			},
+			mockExecution: mockExecMetricsNoErrors,
+		},
+		"test_metrics_not_running": {
+			args: testMetricsArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.GetMetricsRequest{Id: containerID},
+			},
+			mockExecution: mockExecMetricsNotRunning,
+		},
+		"test_metrics_errs": {
+			args: testMetricsArgs{
+				ctx:     testCtx,
+				request: &pbcontainers.GetMetricsRequest{Id: containerID},
+			},
+			mockExecution: mockExecMetricsErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testCtrsService.Metrics(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testStatsArgs struct {
+	request *pbcontainers.StatsRequest
+	srv     *fakeStatsServer
+}
+type mockExecStats func(args testStatsArgs) ([]*pbcontainers.StatsResponse, error)
+
+type fakeStatsServer struct {
+	pbcontainers.Containers_StatsServer
+	ctx       context.Context
+	cancel    context.CancelFunc
+	responses []*pbcontainers.StatsResponse
+}
+
+func newFakeStatsServer() *fakeStatsServer {
+	ctx, cancel := context.WithCancel(testCtx)
+	return &fakeStatsServer{ctx: ctx, cancel: cancel}
+}
+
+func (f *fakeStatsServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeStatsServer) Send(m *pbcontainers.StatsResponse) error {
+	f.responses = append(f.responses, m)
+	// a single sample is enough for the tests
+	f.cancel()
+	return nil
+}
+
+func TestStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testStatsArgs
+		mockExecution mockExecStats
+	}{
+		"test_stats_all_running": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsAllRunning,
+		},
+		"test_stats_all_metrics_errs": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsAllMetricsErrors,
+		},
+		"test_stats_all_list_errs": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsAllListErrors,
+		},
+		"test_stats_by_id": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{Ids: []string{containerID}, Interval: 5},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsByID,
+		},
+		"test_stats_by_id_not_existing": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{Ids: []string{containerID}},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsByIDNotExisting,
+		},
+		"test_stats_by_id_metrics_errs": {
+			args: testStatsArgs{
+				request: &pbcontainers.StatsRequest{Ids: []string{containerID}},
+				srv:     newFakeStatsServer(),
+			},
+			mockExecution: mockExecStatsByIDMetricsErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedResponses, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testCtrsService.Stats(testCase.args.request, testCase.args.srv)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+			testutil.AssertEqual(t, expectedResponses, testCase.args.srv.responses)
+		})
+	}
+}
+
+type testEventsArgs struct {
+	request *pbcontainers.EventsRequest
+	srv     *fakeEventsServer
+}
+type mockExecEvents func(args testEventsArgs) ([]*pbcontainers.EventsResponse, error)
+
+type fakeEventsServer struct {
+	pbcontainers.Containers_EventsServer
+	sendErr   error
+	responses []*pbcontainers.EventsResponse
+}
+
+func (f *fakeEventsServer) Context() context.Context {
+	return testCtx
+}
+
+func (f *fakeEventsServer) Send(m *pbcontainers.EventsResponse) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.responses = append(f.responses, m)
+	return nil
+}
+
+func TestEvents(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testEventsArgs
+		mockExecution mockExecEvents
+	}{
+		"test_events_no_filters": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsNoFilters,
+		},
+		"test_events_filtered": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{Names: []string{containerName}, Actions: []string{string(types.EventActionContainersRunning)}},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsFiltered,
+		},
+		"test_events_since_replayed": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{Since: testEventStopped.Time},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsSinceReplayed,
+		},
+		"test_events_until_reached": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{Until: time.Now().Add(time.Hour).Unix()},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsUntilReached,
+		},
+		"test_events_until_in_the_past": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{Until: time.Now().Add(-time.Hour).Unix()},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsUntilInThePast,
+		},
+		"test_events_subscribe_errs": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{},
+				srv:     &fakeEventsServer{},
+			},
+			mockExecution: mockExecEventsSubscribeErrors,
+		},
+		"test_events_send_errs": {
+			args: testEventsArgs{
+				request: &pbcontainers.EventsRequest{},
+				srv:     &fakeEventsServer{sendErr: errors.New("failed to send")},
+			},
+			mockExecution: mockExecEventsSendErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedResponses, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			resultErr := testCtrsService.Events(testCase.args.request, testCase.args.srv)
+
+			testutil.AssertError(t, expectedRunErr, resultErr)
+			testutil.AssertEqual(t, expectedResponses, testCase.args.srv.responses)
+		})
+	}
+}
+
+// Images -------------------------------------------------------------
+type testListImagesArgs struct {
+	ctx     context.Context
+	request *pbimages.ListImagesRequest
+}
+type mockExecListImages func(args testListImagesArgs) (*pbimages.ListImagesResponse, error)
+
+func TestListImages(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListImagesArgs
+		mockExecution mockExecListImages
+	}{
+		"test_list_images_no_errs": {
+			args: testListImagesArgs{
+				ctx:     testCtx,
+				request: &pbimages.ListImagesRequest{},
+			},
+			mockExecution: mockExecListImagesNoErrors,
+		},
+		"test_list_images_errs": {
+			args: testListImagesArgs{
+				ctx:     testCtx,
+				request: &pbimages.ListImagesRequest{},
+			},
+			mockExecution: mockExecListImagesErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testImagesService.List(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testGetImageArgs struct {
+	ctx     context.Context
+	request *pbimages.GetImageRequest
+}
+type mockExecGetImage func(args testGetImageArgs) (*pbimages.GetImageResponse, error)
+
+func TestGetImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testGetImageArgs
+		mockExecution mockExecGetImage
+	}{
+		"test_get_image_no_errs": {
+			args: testGetImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.GetImageRequest{Name: containerImageID},
+			},
+			mockExecution: mockExecGetImageNoErrors,
+		},
+		"test_get_image_errs": {
+			args: testGetImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.GetImageRequest{Name: containerImageID},
+			},
+			mockExecution: mockExecGetImageErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testImagesService.Get(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testPullImageArgs struct {
+	ctx     context.Context
+	request *pbimages.PullImageRequest
+}
+type mockExecPullImage func(args testPullImageArgs) (*pbimages.PullImageResponse, error)
+
+func TestPullImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testPullImageArgs
+		mockExecution mockExecPullImage
+	}{
+		"test_pull_image_no_errs": {
+			args: testPullImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.PullImageRequest{Image: &pbcontainerstypes.Image{Name: containerImageID}},
+			},
+			mockExecution: mockExecPullImageNoErrors,
+		},
+		"test_pull_image_errs": {
+			args: testPullImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.PullImageRequest{Image: &pbcontainerstypes.Image{Name: containerImageID}},
+			},
+			mockExecution: mockExecPullImageErrors,
+		},
+		"test_pull_image_no_image": {
+			args: testPullImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.PullImageRequest{},
+			},
+			mockExecution: mockExecPullImageNoImage,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testImagesService.Pull(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testRemoveImageArgs struct {
+	ctx     context.Context
+	request *pbimages.RemoveImageRequest
+}
+type mockExecRemoveImage func(args testRemoveImageArgs) (*empty.Empty, error)
+
+func TestRemoveImage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testRemoveImageArgs
+		mockExecution mockExecRemoveImage
+	}{
+		"test_remove_image_no_errs": {
+			args: testRemoveImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.RemoveImageRequest{Name: containerImageID},
+			},
+			mockExecution: mockExecRemoveImageNoErrors,
+		},
+		"test_remove_image_errs": {
+			args: testRemoveImageArgs{
+				ctx:     testCtx,
+				request: &pbimages.RemoveImageRequest{Name: containerImageID},
+			},
+			mockExecution: mockExecRemoveImageErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testImagesService.Remove(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+// Volumes -------------------------------------------------------------
+type testCreateVolumeArgs struct {
+	ctx     context.Context
+	request *pbvolumes.CreateVolumeRequest
+}
+type mockExecCreateVolume func(args testCreateVolumeArgs) (*pbvolumes.CreateVolumeResponse, error)
+
+func TestCreateVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCreateVolumeArgs
+		mockExecution mockExecCreateVolume
+	}{
+		"test_create_volume_no_errs": {
+			args: testCreateVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.CreateVolumeRequest{Name: volumeName, Driver: "tmpfs", Size: "64M"},
+			},
+			mockExecution: mockExecCreateVolumeNoErrors,
+		},
+		"test_create_volume_errs": {
+			args: testCreateVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.CreateVolumeRequest{Name: volumeName, Driver: "tmpfs", Size: "64M"},
+			},
+			mockExecution: mockExecCreateVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testVolumesService.Create(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testGetVolumeArgs struct {
+	ctx     context.Context
+	request *pbvolumes.GetVolumeRequest
+}
+type mockExecGetVolume func(args testGetVolumeArgs) (*pbvolumes.GetVolumeResponse, error)
+
+func TestGetVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testGetVolumeArgs
+		mockExecution mockExecGetVolume
+	}{
+		"test_get_volume_no_errs": {
+			args: testGetVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.GetVolumeRequest{Name: volumeName},
+			},
+			mockExecution: mockExecGetVolumeNoErrors,
+		},
+		"test_get_volume_errs": {
+			args: testGetVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.GetVolumeRequest{Name: volumeName},
+			},
+			mockExecution: mockExecGetVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testVolumesService.Get(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testListVolumesArgs struct {
+	ctx     context.Context
+	request *pbvolumes.ListVolumesRequest
+}
+type mockExecListVolumes func(args testListVolumesArgs) (*pbvolumes.ListVolumesResponse, error)
+
+func TestListVolumes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListVolumesArgs
+		mockExecution mockExecListVolumes
+	}{
+		"test_list_volumes_no_errs": {
+			args: testListVolumesArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.ListVolumesRequest{},
+			},
+			mockExecution: mockExecListVolumesNoErrors,
+		},
+		"test_list_volumes_errs": {
+			args: testListVolumesArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.ListVolumesRequest{},
+			},
+			mockExecution: mockExecListVolumesErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testVolumesService.List(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testRemoveVolumeArgs struct {
+	ctx     context.Context
+	request *pbvolumes.RemoveVolumeRequest
+}
+type mockExecRemoveVolume func(args testRemoveVolumeArgs) (*empty.Empty, error)
+
+func TestRemoveVolume(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testRemoveVolumeArgs
+		mockExecution mockExecRemoveVolume
+	}{
+		"test_remove_volume_no_errs": {
+			args: testRemoveVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.RemoveVolumeRequest{Name: volumeName},
+			},
+			mockExecution: mockExecRemoveVolumeNoErrors,
+		},
+		"test_remove_volume_errs": {
+			args: testRemoveVolumeArgs{
+				ctx:     testCtx,
+				request: &pbvolumes.RemoveVolumeRequest{Name: volumeName},
+			},
+			mockExecution: mockExecRemoveVolumeErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testVolumesService.Remove(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+// Networks -------------------------------------------------------------
+type testCreateNetworkArgs struct {
+	ctx     context.Context
+	request *pbnetworks.CreateNetworkRequest
+}
+type mockExecCreateNetwork func(args testCreateNetworkArgs) (*pbnetworks.CreateNetworkResponse, error)
+
+func TestCreateNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testCreateNetworkArgs
+		mockExecution mockExecCreateNetwork
+	}{
+		"test_create_network_no_errs": {
+			args: testCreateNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.CreateNetworkRequest{Name: networkName, Subnet: "172.30.0.0/16", Gateway: "172.30.0.1", Mtu: 1400, DisableIcc: true, DisableIpMasquerade: true},
+			},
+			mockExecution: mockExecCreateNetworkNoErrors,
+		},
+		"test_create_network_errs": {
+			args: testCreateNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.CreateNetworkRequest{Name: networkName, Subnet: "172.30.0.0/16", Gateway: "172.30.0.1", Mtu: 1400, DisableIcc: true, DisableIpMasquerade: true},
+			},
+			mockExecution: mockExecCreateNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testNetworksService.Create(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testGetNetworkArgs struct {
+	ctx     context.Context
+	request *pbnetworks.GetNetworkRequest
+}
+type mockExecGetNetwork func(args testGetNetworkArgs) (*pbnetworks.GetNetworkResponse, error)
+
+func TestGetNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testGetNetworkArgs
+		mockExecution mockExecGetNetwork
+	}{
+		"test_get_network_no_errs": {
+			args: testGetNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.GetNetworkRequest{Name: networkName},
+			},
+			mockExecution: mockExecGetNetworkNoErrors,
+		},
+		"test_get_network_errs": {
+			args: testGetNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.GetNetworkRequest{Name: networkName},
+			},
+			mockExecution: mockExecGetNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testNetworksService.Get(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testListNetworksArgs struct {
+	ctx     context.Context
+	request *pbnetworks.ListNetworksRequest
+}
+type mockExecListNetworks func(args testListNetworksArgs) (*pbnetworks.ListNetworksResponse, error)
+
+func TestListNetworks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testListNetworksArgs
+		mockExecution mockExecListNetworks
+	}{
+		"test_list_networks_no_errs": {
+			args: testListNetworksArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.ListNetworksRequest{},
+			},
+			mockExecution: mockExecListNetworksNoErrors,
+		},
+		"test_list_networks_errs": {
+			args: testListNetworksArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.ListNetworksRequest{},
+			},
+			mockExecution: mockExecListNetworksErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testNetworksService.List(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+type testRemoveNetworkArgs struct {
+	ctx     context.Context
+	request *pbnetworks.RemoveNetworkRequest
+}
+type mockExecRemoveNetwork func(args testRemoveNetworkArgs) (*empty.Empty, error)
+
+func TestRemoveNetwork(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	setup(controller)
+
+	tests := map[string]struct {
+		args          testRemoveNetworkArgs
+		mockExecution mockExecRemoveNetwork
+	}{
+		"test_remove_network_no_errs": {
+			args: testRemoveNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.RemoveNetworkRequest{Name: networkName},
+			},
+			mockExecution: mockExecRemoveNetworkNoErrors,
+		},
+		"test_remove_network_errs": {
+			args: testRemoveNetworkArgs{
+				ctx:     testCtx,
+				request: &pbnetworks.RemoveNetworkRequest{Name: networkName},
+			},
+			mockExecution: mockExecRemoveNetworkErrors,
+		},
+	}
+
+	// execute tests
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+
+			expectedRsp, expectedRunErr := testCase.mockExecution(testCase.args)
+
+			rsp, resultErr := testNetworksService.Remove(testCase.args.ctx, testCase.args.request)
+			// assert response
+			testutil.AssertEqual(t, expectedRsp, rsp)
+			testutil.AssertError(t, expectedRunErr, resultErr)
+		})
+	}
+}
+
+// Mock executions -------------------------------------------------------------
+// SystemInfo -------------------------------------------------------------
+// ProjectInfo -------------------------------------------------------------
+func mockExecProjectInfoNoErrors(args testProjectInfoArgs) (*pbsysinfo.ProjectInfoResponse, error) {
+	pbProjectInfo := &pbsysinfotypes.ProjectInfo{
+		ProjectVersion: projectVersion,
+		ApiVersion:     apiVersion,
+	}
+	pbResponse := &pbsysinfo.ProjectInfoResponse{
+		ProjectInfo: pbProjectInfo,
+	}
+	projectInfo := protobuf.ToInternalProjectInfo(pbProjectInfo)
+	mockSystemInfoManager.EXPECT().GetProjectInfo().Times(1).Return(projectInfo)
+	return pbResponse, nil
+}
+
+// Containers -------------------------------------------------------------
+// Create -------------------------------------------------------------
+func mockExecCreateNoErrors(args testCreateArgs) (*pbcontainers.CreateContainerResponse, error) {
+	pbCtr := &pbcontainerstypes.Container{
+		Id:    containerID,
+		Name:  containerName,
+		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	}
+	pbResponse := &pbcontainers.CreateContainerResponse{
+		Container: pbCtr,
+	}
+	ctr := protobuf.ToInternalContainer(pbCtr)
+	mockContainerManager.EXPECT().Create(args.ctx, gomock.Eq(protobuf.ToInternalContainer(args.request.Container))).Times(1).Return(ctr, nil)
+	return pbResponse, nil
+}
+
+func mockExecCreateErrors(args testCreateArgs) (*pbcontainers.CreateContainerResponse, error) {
+	err := errors.New("failed to create container")
+	mockContainerManager.EXPECT().Create(args.ctx, gomock.Eq(protobuf.ToInternalContainer(args.request.Container))).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// Get -------------------------------------------------------------
+func mockExecGetNoErrors(args testGetArgs) (*pbcontainers.GetContainerResponse, error) {
+	pbCtr := &pbcontainerstypes.Container{
+		Id:    containerID,
+		Name:  containerName,
+		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	}
+	pbResponse := &pbcontainers.GetContainerResponse{
+		Container: pbCtr,
+	}
+	mockContainerManager.EXPECT().Get(args.ctx, args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
+	return pbResponse, nil
+}
+
+func mockExecGetErrors(args testGetArgs) (*pbcontainers.GetContainerResponse, error) {
+	err := errors.New("failed to get container")
+	mockContainerManager.EXPECT().Get(args.ctx, args.request.Id).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// List -------------------------------------------------------------
+func mockExecListNoErrors(args testListArgs) (*pbcontainers.ListContainersResponse, error) {
+	pbCtr := &pbcontainerstypes.Container{
+		Id:    containerID,
+		Name:  containerName,
+		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	}
+	pbCtr2 := &pbcontainerstypes.Container{
+		Id:    containerID2,
+		Name:  containerName2,
+		Image: &pbcontainerstypes.Image{Name: containerImageID2},
+	}
+	pbResponse := &pbcontainers.ListContainersResponse{
+		Containers: []*pbcontainerstypes.Container{pbCtr, pbCtr2},
+	}
+	ctrs := []*types.Container{protobuf.ToInternalContainer(pbCtr), protobuf.ToInternalContainer(pbCtr2)}
+	mockContainerManager.EXPECT().List(args.ctx).Times(1).Return(ctrs, nil)
+	return pbResponse, nil
+}
+
+func mockExecListErrors(args testListArgs) (*pbcontainers.ListContainersResponse, error) {
+	err := errors.New("failed to list container")
+	mockContainerManager.EXPECT().List(args.ctx).Times(1).Return(nil, err)
+	return &pbcontainers.ListContainersResponse{Containers: []*pbcontainerstypes.Container{}}, err
+}
+
+// Start -------------------------------------------------------------
+func mockExecStartNoErrors(args testStartArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Start(args.ctx, args.request.Id).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecStartErrors(args testStartArgs) (*empty.Empty, error) {
+	err := errors.New("failed to start container")
+	mockContainerManager.EXPECT().Start(args.ctx, args.request.Id).Times(1).Return(err)
+	return nil, err
+}
+
+// Stop -------------------------------------------------------------
+func mockExecStopNoErrors(args testStopArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecStopDefaultOpts(args testStopArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, nil).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecStopErrors(args testStopArgs) (*empty.Empty, error) {
+	err := errors.New("failed to stop container")
+	mockContainerManager.EXPECT().Stop(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(err)
+	return nil, err
+}
+
+// Pause -------------------------------------------------------------
+func mockExecPauseNoErrors(args testPauseArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Pause(args.ctx, args.request.Id).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecPauseErrors(args testPauseArgs) (*empty.Empty, error) {
+	err := errors.New("failed to pause container")
+	mockContainerManager.EXPECT().Pause(args.ctx, args.request.Id).Times(1).Return(err)
+	return nil, err
+}
+
+// Unpause -------------------------------------------------------------
+func mockExecUnpauseNoErrors(args testUnpauseArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Unpause(args.ctx, args.request.Id).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecUnpauseErrors(args testUnpauseArgs) (*empty.Empty, error) {
+	err := errors.New("failed to unpause container")
+	mockContainerManager.EXPECT().Unpause(args.ctx, args.request.Id).Times(1).Return(err)
+	return nil, err
+}
+
+// Remove -------------------------------------------------------------
+func mockExecRemoveNoErrors(args testRemoveArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, args.request.Force, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRemoveForce(args testRemoveArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, true, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+//TODO add tests for timeout
+
+func mockExecRemoveErrors(args testRemoveArgs) (*empty.Empty, error) {
+	err := errors.New("failed to remove container")
+	mockContainerManager.EXPECT().Remove(args.ctx, args.request.Id, args.request.Force, gomock.Eq(protobuf.ToInternalStopOptions(args.request.StopOptions))).Times(1).Return(err)
+	return nil, err
+}
+
+// Update -------------------------------------------------------------
+func mockExecUpdateNoErrors(args testUpdateArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Update(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalUpdateOptions(args.request.UpdateOptions))).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecUpdateErrors(args testUpdateArgs) (*empty.Empty, error) {
+	err := errors.New("failed to update container")
+	mockContainerManager.EXPECT().Update(args.ctx, args.request.Id, gomock.Eq(protobuf.ToInternalUpdateOptions(args.request.UpdateOptions))).Times(1).Return(err)
+	return nil, err
+}
+
+// Rename -------------------------------------------------------------
+func mockExecRenameNoErrors(args testRenameArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Rename(args.ctx, args.request.Id, gomock.Eq(args.request.Name)).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRenameErrors(args testRenameArgs) (*empty.Empty, error) {
+	err := errors.New("failed to rename container")
+	mockContainerManager.EXPECT().Rename(args.ctx, args.request.Id, gomock.Eq(args.request.Name)).Times(1).Return(err)
+	return nil, err
+}
+
+// Logs -------------------------------------------------------------
+func mockExecLogsNoErrors(args testLogsArgs) error {
+	pbCtr := &pbcontainerstypes.Container{
+		Id:    containerID,
+		Name:  containerName,
+		Image: &pbcontainerstypes.Image{Name: containerImageID},
+		HostConfig: &pbcontainerstypes.HostConfig{
+			LogConfig: &pbcontainerstypes.LogConfiguration{
+				DriverConfig: &pbcontainerstypes.LogDriverConfiguration{
+					Type:    "json-file",
+					RootDir: "../pkg/testutil/logs",
+				},
+			},
+		},
+	}
+	mockContainerManager.EXPECT().Get(context.Background(), args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
+	return nil
+}
+
+func mockExecLogsInvalidSince(args testLogsArgs) error {
+	mockExecLogsNoErrors(args)
+	return errors.New("invalid since time yesterday")
+}
+
+func mockExecLogsUntilBeforeSince(args testLogsArgs) error {
+	mockExecLogsNoErrors(args)
+	return errors.New("the until time 2022-12-13T09:14:01Z is before the since time 2022-12-13T09:14:05Z")
+}
+
+func mockExecLogsNoHostConfig(args testLogsArgs) error {
+	err := errors.New("no host config for container test-ctr")
+	pbCtr := &pbcontainerstypes.Container{
+		Id:    containerID,
+		Name:  containerName,
+		Image: &pbcontainerstypes.Image{Name: containerImageID},
+	}
+
+	mockContainerManager.EXPECT().Get(context.Background(), args.request.Id).Times(1).Return(protobuf.ToInternalContainer(pbCtr), nil)
+	return err
+}
+
+// Exec -------------------------------------------------------------
+func mockExecExecNoErrors(args testExecArgs) ([]*pbcontainers.ExecContainerResponse, error) {
+	mockContainerManager.EXPECT().Exec(testCtx, containerID, &types.ExecConfig{Cmd: []string{"ls", "-la"}}, gomock.Any()).Times(1).DoAndReturn(
+		func(ctx context.Context, id string, execConfig *types.ExecConfig, attachConfig *streams.AttachConfig) (int64, error) {
+			attachConfig.Stdout.Write([]byte("test"))
+			return 1, nil
+		})
+	return []*pbcontainers.ExecContainerResponse{
+		{Id: containerID, ReadData: []byte("test")},
+		{Id: containerID, Exited: true, ExitCode: 1},
+	}, nil
+}
+
+func mockExecExecErrors(args testExecArgs) ([]*pbcontainers.ExecContainerResponse, error) {
+	err := errors.New("failed to exec")
+	mockContainerManager.EXPECT().Exec(testCtx, containerID, gomock.Any(), gomock.Any()).Times(1).Return(int64(-1), err)
+	return nil, err
+}
+
+func mockExecExecNoRequest(args testExecArgs) ([]*pbcontainers.ExecContainerResponse, error) {
+	mockContainerManager.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	return nil, io.EOF
+}
+
+// Checkpoint -------------------------------------------------------------
+func mockExecCheckpointNoErrors(args testCheckpointArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().Checkpoint(args.ctx, args.request.Id, gomock.Eq(&types.CheckpointOpts{CheckpointID: checkpointID, Exit: true})).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecCheckpointErrors(args testCheckpointArgs) (*empty.Empty, error) {
+	err := errors.New("failed to checkpoint container")
+	mockContainerManager.EXPECT().Checkpoint(args.ctx, args.request.Id, gomock.Eq(&types.CheckpointOpts{CheckpointID: checkpointID})).Times(1).Return(err)
+	return nil, err
+}
+
+// ListCheckpoints -------------------------------------------------------------
+func mockExecListCheckpointsNoErrors(args testListCheckpointsArgs) (*pbcontainers.ListCheckpointsResponse, error) {
+	checkpoints := []*types.Checkpoint{{ID: checkpointID, Created: checkpointCreated}}
+	mockContainerManager.EXPECT().ListCheckpoints(args.ctx, args.request.Id).Times(1).Return(checkpoints, nil)
+	return &pbcontainers.ListCheckpointsResponse{Checkpoints: []*pbcontainerstypes.Checkpoint{{Id: checkpointID, Created: checkpointCreated}}}, nil
+}
+
+func mockExecListCheckpointsErrors(args testListCheckpointsArgs) (*pbcontainers.ListCheckpointsResponse, error) {
+	err := errors.New("failed to list checkpoints")
+	mockContainerManager.EXPECT().ListCheckpoints(args.ctx, args.request.Id).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// RemoveCheckpoint -------------------------------------------------------------
+func mockExecRemoveCheckpointNoErrors(args testRemoveCheckpointArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().RemoveCheckpoint(args.ctx, args.request.Id, args.request.CheckpointId).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRemoveCheckpointErrors(args testRemoveCheckpointArgs) (*empty.Empty, error) {
+	err := errors.New("failed to remove checkpoint")
+	mockContainerManager.EXPECT().RemoveCheckpoint(args.ctx, args.request.Id, args.request.CheckpointId).Times(1).Return(err)
+	return nil, err
+}
+
+// Restore -------------------------------------------------------------
+func mockExecRestoreNoErrors(args testRestoreArgs) (*empty.Empty, error) {
+	mockContainerManager.EXPECT().RestoreFromCheckpoint(args.ctx, args.request.Id, args.request.CheckpointId).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRestoreErrors(args testRestoreArgs) (*empty.Empty, error) {
+	err := errors.New("failed to restore container")
+	mockContainerManager.EXPECT().RestoreFromCheckpoint(args.ctx, args.request.Id, args.request.CheckpointId).Times(1).Return(err)
+	return nil, err
+}
+
+// Metrics -------------------------------------------------------------
+func mockExecMetricsNoErrors(args testMetricsArgs) (*pbcontainers.GetMetricsResponse, error) {
+	metrics := &types.Metrics{
+		CPU:       &types.CPUStats{Used: 100, Total: 1000},
+		Memory:    &types.MemoryStats{Used: 512, Total: 1024},
+		Timestamp: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+	}
+	mockContainerManager.EXPECT().Metrics(args.ctx, args.request.Id).Times(1).Return(metrics, nil)
+	return &pbcontainers.GetMetricsResponse{Metrics: protobuf.ToProtoMetrics(metrics)}, nil
+}
+
+func mockExecMetricsNotRunning(args testMetricsArgs) (*pbcontainers.GetMetricsResponse, error) {
+	mockContainerManager.EXPECT().Metrics(args.ctx, args.request.Id).Times(1).Return(nil, nil)
+	return &pbcontainers.GetMetricsResponse{}, nil
+}
+
+func mockExecMetricsErrors(args testMetricsArgs) (*pbcontainers.GetMetricsResponse, error) {
+	err := errors.New("failed to get metrics")
+	mockContainerManager.EXPECT().Metrics(args.ctx, args.request.Id).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// Stats -------------------------------------------------------------
+func mockExecStatsAllRunning(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	running := &types.Container{ID: containerID, Name: containerName, State: &types.State{Running: true, Status: types.Running}}
+	stopped := &types.Container{ID: containerID2, Name: containerName2, State: &types.State{Status: types.Stopped}}
+	metrics := &types.Metrics{CPU: &types.CPUStats{Used: 100, Total: 1000}, PIDs: 3}
+	mockContainerManager.EXPECT().List(args.srv.ctx).Times(1).Return([]*types.Container{running, stopped}, nil)
+	mockContainerManager.EXPECT().Metrics(args.srv.ctx, containerID).Times(1).Return(metrics, nil)
+	stats := []*types.ContainerStats{{ID: containerID, Name: containerName, Metrics: metrics}}
+	return []*pbcontainers.StatsResponse{{Stats: protobuf.ToProtoContainerStats(stats)}}, nil
+}
+
+func mockExecStatsAllMetricsErrors(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	running := &types.Container{ID: containerID, Name: containerName, State: &types.State{Running: true, Status: types.Running}}
+	mockContainerManager.EXPECT().List(args.srv.ctx).Times(1).Return([]*types.Container{running}, nil)
+	mockContainerManager.EXPECT().Metrics(args.srv.ctx, containerID).Times(1).Return(nil, errors.New("failed to get metrics"))
+	return []*pbcontainers.StatsResponse{{Stats: protobuf.ToProtoContainerStats([]*types.ContainerStats{})}}, nil
+}
+
+func mockExecStatsAllListErrors(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	err := errors.New("failed to list containers")
+	mockContainerManager.EXPECT().List(args.srv.ctx).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecStatsByID(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	ctr := &types.Container{ID: containerID, Name: containerName, State: &types.State{Running: true, Status: types.Running}}
+	metrics := &types.Metrics{Memory: &types.MemoryStats{Used: 512, Total: 1024}}
+	mockContainerManager.EXPECT().Get(args.srv.ctx, containerID).Times(1).Return(ctr, nil)
+	mockContainerManager.EXPECT().Metrics(args.srv.ctx, containerID).Times(1).Return(metrics, nil)
+	stats := []*types.ContainerStats{{ID: containerID, Name: containerName, Metrics: metrics}}
+	return []*pbcontainers.StatsResponse{{Stats: protobuf.ToProtoContainerStats(stats)}}, nil
+}
+
+func mockExecStatsByIDNotExisting(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	mockContainerManager.EXPECT().Get(args.srv.ctx, containerID).Times(1).Return(nil, nil)
+	return nil, fmt.Errorf("no such container with id = %s exists", containerID)
+}
+
+func mockExecStatsByIDMetricsErrors(args testStatsArgs) ([]*pbcontainers.StatsResponse, error) {
+	err := errors.New("failed to get metrics")
+	ctr := &types.Container{ID: containerID, Name: containerName, State: &types.State{Running: true, Status: types.Running}}
+	mockContainerManager.EXPECT().Get(args.srv.ctx, containerID).Times(1).Return(ctr, nil)
+	mockContainerManager.EXPECT().Metrics(args.srv.ctx, containerID).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// Events -------------------------------------------------------------
+var (
+	testEventRunning = &types.Event{
+		Type:   types.EventTypeContainers,
+		Action: types.EventActionContainersRunning,
+		Source: types.Container{ID: containerID, Name: containerName, State: &types.State{Running: true, Status: types.Running}},
+		Time:   1767366245,
+	}
+	testEventStopped = &types.Event{
+		Type:   types.EventTypeContainers,
+		Action: types.EventActionContainersStopped,
+		Source: types.Container{ID: containerID2, Name: containerName2, State: &types.State{Status: types.Stopped}},
+		Time:   1767366246,
+	}
+)
+
+func mockExecEventsSubscribe(subscribeErr error, events ...*types.Event) {
+	mockEventsManager.EXPECT().Subscribe(gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context) (<-chan *types.Event, <-chan error) {
+		eventsCh := make(chan *types.Event)
+		errCh := make(chan error, 1)
+		go func() {
+			for _, event := range events {
+				select {
+				case eventsCh <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			errCh <- subscribeErr
+			close(errCh)
+		}()
+		return eventsCh, errCh
+	})
+}
+
+func mockExecEventsSinceReplayed(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockEventsManager.EXPECT().SubscribeSince(gomock.Any(), testEventStopped.Time).Times(1).DoAndReturn(func(ctx context.Context, since int64) (<-chan *types.Event, <-chan error) {
+		eventsCh := make(chan *types.Event, 1)
+		errCh := make(chan error, 1)
+		eventsCh <- testEventStopped
+		go func() {
+			for len(eventsCh) > 0 {
+				time.Sleep(time.Millisecond)
+			}
+			errCh <- nil
+			close(errCh)
+		}()
+		return eventsCh, errCh
+	})
+	return []*pbcontainers.EventsResponse{{Event: protobuf.ToProtoEvent(testEventStopped)}}, nil
+}
+
+func mockExecEventsNoFilters(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockExecEventsSubscribe(nil, testEventRunning, testEventStopped)
+	return []*pbcontainers.EventsResponse{
+		{Event: protobuf.ToProtoEvent(testEventRunning)},
+		{Event: protobuf.ToProtoEvent(testEventStopped)},
+	}, nil
+}
+
+func mockExecEventsFiltered(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockExecEventsSubscribe(nil, testEventStopped, testEventRunning)
+	return []*pbcontainers.EventsResponse{{Event: protobuf.ToProtoEvent(testEventRunning)}}, nil
+}
+
+func mockExecEventsUntilReached(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockExecEventsSubscribe(context.DeadlineExceeded, testEventRunning)
+	return []*pbcontainers.EventsResponse{{Event: protobuf.ToProtoEvent(testEventRunning)}}, nil
+}
+
+func mockExecEventsUntilInThePast(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockEventsManager.EXPECT().Subscribe(gomock.Any()).Times(0)
+	return nil, nil
+}
+
+func mockExecEventsSubscribeErrors(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	err := errors.New("failed to receive events")
+	mockExecEventsSubscribe(err)
+	return nil, err
+}
+
+func mockExecEventsSendErrors(args testEventsArgs) ([]*pbcontainers.EventsResponse, error) {
+	mockExecEventsSubscribe(nil, testEventRunning)
+	return nil, args.srv.sendErr
+}
+
+// Images -------------------------------------------------------------
+var testImageInfo = &imagestypes.ImageInfo{
+	Name:       containerImageID,
+	Digest:     "sha256:1f1e11e4b5ecad1c45f3d2f1a7d3e0c9b7f8d4c3b2a1e0f9d8c7b6a5f4e3d2c1",
+	Size:       1024,
+	Platform:   "linux/arm64",
+	Containers: []string{containerID},
+}
+
+// ListImages -------------------------------------------------------------
+func mockExecListImagesNoErrors(args testListImagesArgs) (*pbimages.ListImagesResponse, error) {
+	imageInfos := []*imagestypes.ImageInfo{testImageInfo}
+	mockImageManager.EXPECT().List(args.ctx).Times(1).Return(imageInfos, nil)
+	return &pbimages.ListImagesResponse{Images: protobuf.ToProtoImageInfos(imageInfos)}, nil
+}
+
+func mockExecListImagesErrors(args testListImagesArgs) (*pbimages.ListImagesResponse, error) {
+	err := errors.New("failed to list images")
+	mockImageManager.EXPECT().List(args.ctx).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// GetImage -------------------------------------------------------------
+func mockExecGetImageNoErrors(args testGetImageArgs) (*pbimages.GetImageResponse, error) {
+	mockImageManager.EXPECT().Get(args.ctx, args.request.Name).Times(1).Return(testImageInfo, nil)
+	return &pbimages.GetImageResponse{Image: protobuf.ToProtoImageInfo(testImageInfo)}, nil
+}
+
+func mockExecGetImageErrors(args testGetImageArgs) (*pbimages.GetImageResponse, error) {
+	err := errors.New("failed to get image")
+	mockImageManager.EXPECT().Get(args.ctx, args.request.Name).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// PullImage -------------------------------------------------------------
+func mockExecPullImageNoErrors(args testPullImageArgs) (*pbimages.PullImageResponse, error) {
+	mockImageManager.EXPECT().Pull(args.ctx, types.Image{Name: containerImageID}).Times(1).Return(testImageInfo, nil)
+	return &pbimages.PullImageResponse{Image: protobuf.ToProtoImageInfo(testImageInfo)}, nil
+}
+
+func mockExecPullImageErrors(args testPullImageArgs) (*pbimages.PullImageResponse, error) {
+	err := errors.New("failed to pull image")
+	mockImageManager.EXPECT().Pull(args.ctx, types.Image{Name: containerImageID}).Times(1).Return(nil, err)
+	return nil, err
+}
+
+func mockExecPullImageNoImage(args testPullImageArgs) (*pbimages.PullImageResponse, error) {
+	mockImageManager.EXPECT().Pull(gomock.Any(), gomock.Any()).Times(0)
+	return nil, errors.New("image must be provided")
+}
+
+// RemoveImage -------------------------------------------------------------
+func mockExecRemoveImageNoErrors(args testRemoveImageArgs) (*empty.Empty, error) {
+	mockImageManager.EXPECT().Remove(args.ctx, args.request.Name).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRemoveImageErrors(args testRemoveImageArgs) (*empty.Empty, error) {
+	err := errors.New("failed to remove image")
+	mockImageManager.EXPECT().Remove(args.ctx, args.request.Name).Times(1).Return(err)
+	return nil, err
+}
+
+// Volumes -------------------------------------------------------------
+var testVolume = &volumestypes.Volume{
+	Name:       volumeName,
+	Driver:     volumestypes.VolumeDriverTmpfs,
+	Size:       "64M",
+	Mountpoint: "/var/lib/container-management/volumes/test-volume/_data",
+	Containers: []string{containerID},
+}
+
+// CreateVolume -------------------------------------------------------------
+func mockExecCreateVolumeNoErrors(args testCreateVolumeArgs) (*pbvolumes.CreateVolumeResponse, error) {
+	mockVolumeManager.EXPECT().Create(args.ctx, args.request.Name, &volumestypes.VolumeOpts{Driver: args.request.Driver, Size: args.request.Size}).Times(1).Return(testVolume, nil)
+	return &pbvolumes.CreateVolumeResponse{Volume: protobuf.ToProtoVolume(testVolume)}, nil
+}
+
+func mockExecCreateVolumeErrors(args testCreateVolumeArgs) (*pbvolumes.CreateVolumeResponse, error) {
+	err := errors.New("failed to create volume")
+	mockVolumeManager.EXPECT().Create(args.ctx, args.request.Name, gomock.Any()).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// GetVolume -------------------------------------------------------------
+func mockExecGetVolumeNoErrors(args testGetVolumeArgs) (*pbvolumes.GetVolumeResponse, error) {
+	mockVolumeManager.EXPECT().Get(args.ctx, args.request.Name).Times(1).Return(testVolume, nil)
+	return &pbvolumes.GetVolumeResponse{Volume: protobuf.ToProtoVolume(testVolume)}, nil
+}
+
+func mockExecGetVolumeErrors(args testGetVolumeArgs) (*pbvolumes.GetVolumeResponse, error) {
+	err := errors.New("failed to get volume")
+	mockVolumeManager.EXPECT().Get(args.ctx, args.request.Name).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// ListVolumes -------------------------------------------------------------
+func mockExecListVolumesNoErrors(args testListVolumesArgs) (*pbvolumes.ListVolumesResponse, error) {
+	volumes := []*volumestypes.Volume{testVolume}
+	mockVolumeManager.EXPECT().List(args.ctx).Times(1).Return(volumes, nil)
+	return &pbvolumes.ListVolumesResponse{Volumes: protobuf.ToProtoVolumes(volumes)}, nil
+}
+
+func mockExecListVolumesErrors(args testListVolumesArgs) (*pbvolumes.ListVolumesResponse, error) {
+	err := errors.New("failed to list volumes")
+	mockVolumeManager.EXPECT().List(args.ctx).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// RemoveVolume -------------------------------------------------------------
+func mockExecRemoveVolumeNoErrors(args testRemoveVolumeArgs) (*empty.Empty, error) {
+	mockVolumeManager.EXPECT().Remove(args.ctx, args.request.Name).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRemoveVolumeErrors(args testRemoveVolumeArgs) (*empty.Empty, error) {
+	err := errors.New("failed to remove volume")
+	mockVolumeManager.EXPECT().Remove(args.ctx, args.request.Name).Times(1).Return(err)
+	return nil, err
+}
+
+// Networks -------------------------------------------------------------
+var testNetwork = &networktypes.Network{
+	Name:         networkName,
+	ID:           "test-network-id",
+	Driver:       networktypes.NetworkDriverBridge,
+	Subnet:       "172.30.0.0/16",
+	Gateway:      "172.30.0.1",
+	MTU:          1400,
+	ICC:          true,
+	IPMasquerade: true,
+	Containers:   []string{containerID},
+}
+
+// CreateNetwork -------------------------------------------------------------
+func mockExecCreateNetworkNoErrors(args testCreateNetworkArgs) (*pbnetworks.CreateNetworkResponse, error) {
+	mockNetworkManager.EXPECT().CreateNetwork(args.ctx, args.request.Name, &networktypes.NetworkOpts{Subnet: args.request.Subnet, Gateway: args.request.Gateway, MTU: int(args.request.Mtu), DisableICC: args.request.DisableIcc, DisableIPMasquerade: args.request.DisableIpMasquerade}).Times(1).Return(testNetwork, nil)
+	return &pbnetworks.CreateNetworkResponse{Network: protobuf.ToProtoNetwork(testNetwork)}, nil
+}
+
+func mockExecCreateNetworkErrors(args testCreateNetworkArgs) (*pbnetworks.CreateNetworkResponse, error) {
+	err := errors.New("failed to create network")
+	mockNetworkManager.EXPECT().CreateNetwork(args.ctx, args.request.Name, gomock.Any()).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// GetNetwork -------------------------------------------------------------
+func mockExecGetNetworkNoErrors(args testGetNetworkArgs) (*pbnetworks.GetNetworkResponse, error) {
+	mockNetworkManager.EXPECT().GetNetwork(args.ctx, args.request.Name).Times(1).Return(testNetwork, nil)
+	return &pbnetworks.GetNetworkResponse{Network: protobuf.ToProtoNetwork(testNetwork)}, nil
+}
+
+func mockExecGetNetworkErrors(args testGetNetworkArgs) (*pbnetworks.GetNetworkResponse, error) {
+	err := errors.New("failed to get network")
+	mockNetworkManager.EXPECT().GetNetwork(args.ctx, args.request.Name).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// ListNetworks -------------------------------------------------------------
+func mockExecListNetworksNoErrors(args testListNetworksArgs) (*pbnetworks.ListNetworksResponse, error) {
+	networks := []*networktypes.Network{testNetwork}
+	mockNetworkManager.EXPECT().ListNetworks(args.ctx).Times(1).Return(networks, nil)
+	return &pbnetworks.ListNetworksResponse{Networks: protobuf.ToProtoNetworks(networks)}, nil
+}
+
+func mockExecListNetworksErrors(args testListNetworksArgs) (*pbnetworks.ListNetworksResponse, error) {
+	err := errors.New("failed to list networks")
+	mockNetworkManager.EXPECT().ListNetworks(args.ctx).Times(1).Return(nil, err)
+	return nil, err
+}
+
+// RemoveNetwork -------------------------------------------------------------
+func mockExecRemoveNetworkNoErrors(args testRemoveNetworkArgs) (*empty.Empty, error) {
+	mockNetworkManager.EXPECT().RemoveNetwork(args.ctx, args.request.Name).Times(1).Return(nil)
+	return &empty.Empty{}, nil
+}
+
+func mockExecRemoveNetworkErrors(args testRemoveNetworkArgs) (*empty.Empty, error) {
+	err := errors.New("failed to remove network")
+	mockNetworkManager.EXPECT().RemoveNetwork(args.ctx, args.request.Name).Times(1).Return(err)
+	return nil, err
 }
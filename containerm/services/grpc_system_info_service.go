@@ -38,3 +38,11 @@ func (server *systemInfo) ProjectInfo(ctx context.Context, request *empty.Empty)
 	}
 	return response, nil
 }
+
+func (server *systemInfo) RuntimeInfo(ctx context.Context, request *empty.Empty) (*pbsysinfo.RuntimeInfoResponse, error) {
+	runtimeInfo := server.sysInfoMgr.GetRuntimeInfo(ctx)
+	response := &pbsysinfo.RuntimeInfoResponse{
+		RuntimeInfo: protobuf.ToProtoRuntimeInfo(runtimeInfo),
+	}
+	return response, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package services
+
+import (
+	"context"
+
+	pbvolumes "github.com/eclipse-kanto/container-management/containerm/api/services/volumes"
+	"github.com/eclipse-kanto/container-management/containerm/util/protobuf"
+	"github.com/eclipse-kanto/container-management/containerm/volumes"
+	volumestypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+)
+
+type volumesService struct {
+	volumesMgr volumes.VolumeManager
+}
+
+func (server *volumesService) Register(grpcServer *grpc.Server) error {
+	pbvolumes.RegisterVolumesServer(grpcServer, server)
+	return nil
+}
+
+func (server *volumesService) Create(ctx context.Context, request *pbvolumes.CreateVolumeRequest) (*pbvolumes.CreateVolumeResponse, error) {
+	volume, err := server.volumesMgr.Create(ctx, request.Name, &volumestypes.VolumeOpts{Driver: request.Driver, Size: request.Size})
+	if err != nil {
+		return nil, err
+	}
+	return &pbvolumes.CreateVolumeResponse{Volume: protobuf.ToProtoVolume(volume)}, nil
+}
+
+func (server *volumesService) Get(ctx context.Context, request *pbvolumes.GetVolumeRequest) (*pbvolumes.GetVolumeResponse, error) {
+	volume, err := server.volumesMgr.Get(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pbvolumes.GetVolumeResponse{Volume: protobuf.ToProtoVolume(volume)}, nil
+}
+
+func (server *volumesService) List(ctx context.Context, request *pbvolumes.ListVolumesRequest) (*pbvolumes.ListVolumesResponse, error) {
+	volumes, err := server.volumesMgr.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbvolumes.ListVolumesResponse{Volumes: protobuf.ToProtoVolumes(volumes)}, nil
+}
+
+func (server *volumesService) Remove(ctx context.Context, request *pbvolumes.RemoveVolumeRequest) (*empty.Empty, error) {
+	err := server.volumesMgr.Remove(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
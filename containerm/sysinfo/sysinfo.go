@@ -12,16 +12,30 @@
 
 package sysinfo
 
-import "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+import (
+	"context"
+
+	"github.com/eclipse-kanto/container-management/containerm/ctr"
+	"github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+)
 
 type systemInfoMgr struct {
 	mgrVersionInfo types.ProjectInfo
+	ctrClient      ctr.ContainerAPIClient
 }
 
-func newSystemInfoMgr(mgrVersionInfo types.ProjectInfo) *systemInfoMgr {
-	return &systemInfoMgr{mgrVersionInfo: mgrVersionInfo}
+func newSystemInfoMgr(mgrVersionInfo types.ProjectInfo, ctrClient ctr.ContainerAPIClient) *systemInfoMgr {
+	return &systemInfoMgr{mgrVersionInfo: mgrVersionInfo, ctrClient: ctrClient}
 }
 
 func (sysInfoMgr *systemInfoMgr) GetProjectInfo() types.ProjectInfo {
 	return sysInfoMgr.mgrVersionInfo
 }
+
+func (sysInfoMgr *systemInfoMgr) GetRuntimeInfo(ctx context.Context) types.RuntimeInfo {
+	handlers, defaultHandler := sysInfoMgr.ctrClient.ListRuntimeHandlers(ctx)
+	return types.RuntimeInfo{
+		DefaultRuntimeHandler: defaultHandler,
+		RuntimeHandlers:       handlers,
+	}
+}
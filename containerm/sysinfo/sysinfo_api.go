@@ -12,11 +12,17 @@
 
 package sysinfo
 
-import "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+import (
+	"context"
+
+	"github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+)
 
 // SystemInfoManager provides access to the system information related to the current runtime - both environment and daemon's specifics
 type SystemInfoManager interface {
 	// GetProjectInfo provides information about the current daemon's implementation
 	GetProjectInfo() types.ProjectInfo
+	// GetRuntimeInfo provides information about the runtime handlers that the containers can be run with
+	GetRuntimeInfo(ctx context.Context) types.RuntimeInfo
 	// ... will add mo information in the future - e.g. Go version. Go runtime, OS specifics, etc.
 }
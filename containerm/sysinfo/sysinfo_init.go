@@ -13,6 +13,7 @@
 package sysinfo
 
 import (
+	"github.com/eclipse-kanto/container-management/containerm/ctr"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	"github.com/eclipse-kanto/container-management/containerm/version"
@@ -32,11 +33,15 @@ func init() {
 }
 
 func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+	ctrClientService, err := registryCtx.Get(registry.ContainerClientService)
+	if err != nil {
+		return nil, err
+	}
 	//create system info service instance
 	return newSystemInfoMgr(types.ProjectInfo{
 		ProjectVersion: version.ProjectVersion,
 		BuildTime:      version.BuildTime,
 		APIVersion:     version.APIVersion,
 		GitCommit:      version.GitCommit,
-	}), nil
+	}, ctrClientService.(ctr.ContainerAPIClient)), nil
 }
@@ -13,21 +13,42 @@
 package sysinfo
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksctr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
 	"github.com/eclipse-kanto/container-management/containerm/registry"
 	"github.com/eclipse-kanto/container-management/containerm/version"
+	"github.com/golang/mock/gomock"
 )
 
 func TestRegistryInit(t *testing.T) {
-	arg := &registry.ServiceRegistryContext{}
+	controller := gomock.NewController(t)
+	defer controller.Finish()
 
-	got, _ := registryInit(arg)
+	mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+	services := registry.NewServiceInfoSet()
+	services.Add((&registry.Registration{
+		ID:   string(registry.ContainerClientService),
+		Type: registry.ContainerClientService,
+		InitFunc: func(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+			return mockCtrClient, nil
+		},
+	}).Init(&registry.ServiceRegistryContext{}))
+
+	got, err := registryInit(registry.NewContext(context.Background(), nil, nil, services))
+	testutil.AssertNil(t, err)
 	expectedSysInfoMgr := got.(*systemInfoMgr)
 	testutil.AssertEqual(t, expectedSysInfoMgr.mgrVersionInfo.ProjectVersion, version.ProjectVersion)
 	testutil.AssertEqual(t, expectedSysInfoMgr.mgrVersionInfo.BuildTime, version.BuildTime)
 	testutil.AssertEqual(t, expectedSysInfoMgr.mgrVersionInfo.APIVersion, version.APIVersion)
 	testutil.AssertEqual(t, expectedSysInfoMgr.mgrVersionInfo.GitCommit, version.GitCommit)
+	testutil.AssertEqual(t, mockCtrClient, expectedSysInfoMgr.ctrClient)
 
+	t.Run("test_registry_init_no_ctr_client", func(t *testing.T) {
+		_, err := registryInit(registry.NewContext(context.Background(), nil, nil, registry.NewServiceInfoSet()))
+		testutil.AssertError(t, fmt.Errorf("no services registered for %s", registry.ContainerClientService), err)
+	})
 }
@@ -13,10 +13,14 @@
 package sysinfo
 
 import (
+	"context"
 	"testing"
 
+	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	mocksctr "github.com/eclipse-kanto/container-management/containerm/pkg/testutil/mocks/ctr"
 	"github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	"github.com/golang/mock/gomock"
 )
 
 var (
@@ -62,7 +66,7 @@ func TestNewSystemInfoMgr(t *testing.T) {
 
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
-			testutil.AssertEqual(t, newSystemInfoMgr(testCase.arg), testCase.want)
+			testutil.AssertEqual(t, newSystemInfoMgr(testCase.arg, nil), testCase.want)
 		})
 	}
 }
@@ -81,3 +85,19 @@ func TestGetProjectInfo(t *testing.T) {
 	testutil.AssertEqual(t, expected.APIVersion, actual.APIVersion)
 	testutil.AssertEqual(t, expected.GitCommit, actual.GitCommit)
 }
+
+func TestGetRuntimeInfo(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockCtrClient := mocksctr.NewMockContainerAPIClient(controller)
+	testSystemInfoMgr := newSystemInfoMgr(projectInfo, mockCtrClient)
+
+	handlers := []*ctrtypes.RuntimeHandler{
+		{Name: "crun", RuntimeType: ctrtypes.RuntimeTypeV2runcV2, Options: &ctrtypes.RuntimeOptions{BinaryName: "/usr/bin/crun"}},
+		{Name: "runc", RuntimeType: ctrtypes.RuntimeTypeV2runcV2},
+	}
+	mockCtrClient.EXPECT().ListRuntimeHandlers(gomock.Any()).Return(handlers, "runc")
+
+	testutil.AssertEqual(t, types.RuntimeInfo{DefaultRuntimeHandler: "runc", RuntimeHandlers: handlers}, testSystemInfoMgr.GetRuntimeInfo(context.Background()))
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+import ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+
+// RuntimeInfo contains the information about the runtime handlers that the containers can be run with
+type RuntimeInfo struct {
+	DefaultRuntimeHandler string                     `json:"default_runtime_handler"`
+	RuntimeHandlers       []*ctrtypes.RuntimeHandler `json:"runtime_handlers"`
+}
@@ -24,18 +24,34 @@ type configuration struct {
 	Cmd         []string                 `json:"cmd,omitempty"`
 	Decryption  *decryptionConfiguration `json:"decryption,omitempty"`
 	// host resources
-	Devices           []*device      `json:"devices,omitempty"`
-	Privileged        bool           `json:"privileged,omitempty"`
-	RestartPolicy     *restartPolicy `json:"restartPolicy,omitempty"`
-	ExtraHosts        []string       `json:"extraHosts,omitempty"`
-	ExtraCapabilities []string       `json:"extraCapabilities,omitempty"`
-	PortMappings      []*portMapping `json:"portMappings,omitempty"`
-	NetworkMode       networkMode    `json:"networkMode,omitempty"`
+	Devices             []*device         `json:"devices,omitempty"`
+	Privileged          bool              `json:"privileged,omitempty"`
+	ReadOnlyRootfs      bool              `json:"readOnlyRootfs,omitempty"`
+	UsernsMode          string            `json:"usernsMode,omitempty"`
+	IpcMode             string            `json:"ipcMode,omitempty"`
+	PidMode             string            `json:"pidMode,omitempty"`
+	ShmSize             string            `json:"shmSize,omitempty"`
+	RuntimeHandler      string            `json:"runtimeHandler,omitempty"`
+	Ulimits             []*ulimit         `json:"ulimits,omitempty"`
+	Sysctls             map[string]string `json:"sysctls,omitempty"`
+	RestartPolicy       *restartPolicy    `json:"restartPolicy,omitempty"`
+	ExtraHosts          []string          `json:"extraHosts,omitempty"`
+	DNS                 []string          `json:"dns,omitempty"`
+	DNSSearch           []string          `json:"dnsSearch,omitempty"`
+	DNSOptions          []string          `json:"dnsOptions,omitempty"`
+	ExtraCapabilities   []string          `json:"extraCapabilities,omitempty"`
+	DroppedCapabilities []string          `json:"droppedCapabilities,omitempty"`
+	PortMappings        []*portMapping    `json:"portMappings,omitempty"`
+	NetworkMode         networkMode       `json:"networkMode,omitempty"`
+	NetworkPolicy       *networkPolicy    `json:"networkPolicy,omitempty"`
+	SecurityOpts        *securityOptions  `json:"securityOpts,omitempty"`
 	// IO Config
 	OpenStdin bool              `json:"openStdin,omitempty"`
 	Tty       bool              `json:"tty,omitempty"`
 	Log       *logConfiguration `json:"log,omitempty"`
 	Resources *resources        `json:"resources,omitempty"`
+	// health check
+	HealthCheck *healthCheck `json:"healthCheck,omitempty"`
 }
 
 func fromAPIContainerConfig(ctr *types.Container) *configuration {
@@ -45,15 +61,42 @@ func fromAPIContainerConfig(ctr *types.Container) *configuration {
 
 	if ctr.HostConfig != nil {
 		cfg.Privileged = ctr.HostConfig.Privileged
+		cfg.ReadOnlyRootfs = ctr.HostConfig.ReadOnlyRootfs
+		cfg.UsernsMode = string(ctr.HostConfig.UsernsMode)
+		cfg.IpcMode = string(ctr.HostConfig.IpcMode)
+		cfg.PidMode = string(ctr.HostConfig.PidMode)
+		cfg.ShmSize = ctr.HostConfig.ShmSize
+		cfg.RuntimeHandler = ctr.HostConfig.RuntimeHandler
+		if len(ctr.HostConfig.Ulimits) > 0 {
+			cfg.Ulimits = []*ulimit{}
+			for _, ul := range ctr.HostConfig.Ulimits {
+				cfg.Ulimits = append(cfg.Ulimits, fromAPIUlimit(ul))
+			}
+		}
+		if len(ctr.HostConfig.Sysctls) > 0 {
+			cfg.Sysctls = ctr.HostConfig.Sysctls
+		}
 		if ctr.HostConfig.RestartPolicy != nil {
 			cfg.RestartPolicy = fromAPIRestartPolicy(ctr.HostConfig.RestartPolicy)
 		}
 		if !ctr.HostConfig.Privileged && ctr.HostConfig.ExtraCapabilities != nil && len(ctr.HostConfig.ExtraCapabilities) > 0 {
 			cfg.ExtraCapabilities = ctr.HostConfig.ExtraCapabilities
 		}
+		if !ctr.HostConfig.Privileged && len(ctr.HostConfig.DroppedCapabilities) > 0 {
+			cfg.DroppedCapabilities = ctr.HostConfig.DroppedCapabilities
+		}
 		if ctr.HostConfig.ExtraHosts != nil && len(ctr.HostConfig.ExtraHosts) > 0 {
 			cfg.ExtraHosts = ctr.HostConfig.ExtraHosts
 		}
+		if len(ctr.HostConfig.DNS) > 0 {
+			cfg.DNS = ctr.HostConfig.DNS
+		}
+		if len(ctr.HostConfig.DNSSearch) > 0 {
+			cfg.DNSSearch = ctr.HostConfig.DNSSearch
+		}
+		if len(ctr.HostConfig.DNSOptions) > 0 {
+			cfg.DNSOptions = ctr.HostConfig.DNSOptions
+		}
 		if ctr.HostConfig.Devices != nil {
 			cfg.Devices = []*device{}
 			for _, dev := range ctr.HostConfig.Devices {
@@ -73,6 +116,12 @@ func fromAPIContainerConfig(ctr *types.Container) *configuration {
 			cfg.Resources = fromAPIResources(ctr.HostConfig.Resources)
 		}
 		cfg.NetworkMode = fromAPINetworkMode(ctr.HostConfig.NetworkMode)
+		if ctr.HostConfig.NetworkPolicy != nil {
+			cfg.NetworkPolicy = fromAPINetworkPolicy(ctr.HostConfig.NetworkPolicy)
+		}
+		if ctr.HostConfig.SecurityOpts != nil {
+			cfg.SecurityOpts = fromAPISecurityOptions(ctr.HostConfig.SecurityOpts)
+		}
 	}
 	if ctr.Mounts != nil && len(ctr.Mounts) > 0 {
 		for _, mp := range ctr.Mounts {
@@ -98,6 +147,9 @@ func fromAPIContainerConfig(ctr *types.Container) *configuration {
 	if len(ctr.HostName) > 0 {
 		cfg.HostName = ctr.HostName
 	}
+	if ctr.HealthCheck != nil {
+		cfg.HealthCheck = fromAPIHealthCheck(ctr.HealthCheck)
+	}
 	return cfg
 }
 
@@ -113,7 +165,13 @@ func toAPIContainerConfig(cfg *configuration) *types.Container {
 		},
 	}
 	ctr.HostConfig = &types.HostConfig{
-		Privileged: cfg.Privileged,
+		Privileged:     cfg.Privileged,
+		ReadOnlyRootfs: cfg.ReadOnlyRootfs,
+		UsernsMode:     types.UsernsMode(cfg.UsernsMode),
+		IpcMode:        types.NamespaceMode(cfg.IpcMode),
+		PidMode:        types.NamespaceMode(cfg.PidMode),
+		ShmSize:        cfg.ShmSize,
+		RuntimeHandler: cfg.RuntimeHandler,
 	}
 
 	if cfg.RestartPolicy != nil {
@@ -122,9 +180,30 @@ func toAPIContainerConfig(cfg *configuration) *types.Container {
 	if cfg.ExtraHosts != nil && len(cfg.ExtraHosts) > 0 {
 		ctr.HostConfig.ExtraHosts = cfg.ExtraHosts
 	}
+	if len(cfg.Ulimits) > 0 {
+		ctr.HostConfig.Ulimits = []types.Ulimit{}
+		for _, ul := range cfg.Ulimits {
+			ctr.HostConfig.Ulimits = append(ctr.HostConfig.Ulimits, toAPIUlimit(ul))
+		}
+	}
+	if len(cfg.Sysctls) > 0 {
+		ctr.HostConfig.Sysctls = cfg.Sysctls
+	}
+	if len(cfg.DNS) > 0 {
+		ctr.HostConfig.DNS = cfg.DNS
+	}
+	if len(cfg.DNSSearch) > 0 {
+		ctr.HostConfig.DNSSearch = cfg.DNSSearch
+	}
+	if len(cfg.DNSOptions) > 0 {
+		ctr.HostConfig.DNSOptions = cfg.DNSOptions
+	}
 	if !cfg.Privileged && len(cfg.ExtraCapabilities) > 0 {
 		ctr.HostConfig.ExtraCapabilities = cfg.ExtraCapabilities
 	}
+	if !cfg.Privileged && len(cfg.DroppedCapabilities) > 0 {
+		ctr.HostConfig.DroppedCapabilities = cfg.DroppedCapabilities
+	}
 	if cfg.Devices != nil && len(cfg.Devices) > 0 {
 		ctr.HostConfig.Devices = []types.DeviceMapping{}
 		for _, dev := range cfg.Devices {
@@ -162,6 +241,15 @@ func toAPIContainerConfig(cfg *configuration) *types.Container {
 	if len(cfg.HostName) > 0 {
 		ctr.HostName = cfg.HostName
 	}
+	if cfg.HealthCheck != nil {
+		ctr.HealthCheck = toAPIHealthCheck(cfg.HealthCheck)
+	}
 	ctr.HostConfig.NetworkMode = cfg.NetworkMode.toAPINetworkMode()
+	if cfg.NetworkPolicy != nil {
+		ctr.HostConfig.NetworkPolicy = toAPINetworkPolicy(cfg.NetworkPolicy)
+	}
+	if cfg.SecurityOpts != nil {
+		ctr.HostConfig.SecurityOpts = toAPISecurityOptions(cfg.SecurityOpts)
+	}
 	return ctr
 }
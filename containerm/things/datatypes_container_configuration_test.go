@@ -66,11 +66,28 @@ var (
 	cmdVar                      = []string{cmd}
 	hostConfigExtraHosts        = []string{"ctrhost:host_ip"}
 	hostConfigExtraCapabilities = []string{"CAP_NET_ADMIN"}
+	hostConfigDroppedCaps       = []string{"CAP_NET_RAW"}
+	hostConfigDNS               = []string{"8.8.8.8"}
+	hostConfigDNSSearch         = []string{"example.com"}
+	hostConfigDNSOptions        = []string{"ndots:2"}
+	hostConfigSysctls           = map[string]string{"net.ipv4.ip_forward": "1"}
 	internalHostConfig          = &types.HostConfig{
-		Privileged:        hostConfigPrivileged,
-		ExtraHosts:        hostConfigExtraHosts,
-		ExtraCapabilities: hostConfigExtraCapabilities,
-		NetworkMode:       hostConfigNetType,
+		Privileged:          hostConfigPrivileged,
+		ReadOnlyRootfs:      true,
+		UsernsMode:          types.UsernsModeRemap,
+		IpcMode:             types.NamespaceModePrivate,
+		PidMode:             types.NamespaceModePrivate,
+		ShmSize:             "128M",
+		RuntimeHandler:      "crun",
+		Ulimits:             []types.Ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}},
+		Sysctls:             hostConfigSysctls,
+		ExtraHosts:          hostConfigExtraHosts,
+		DNS:                 hostConfigDNS,
+		DNSSearch:           hostConfigDNSSearch,
+		DNSOptions:          hostConfigDNSOptions,
+		ExtraCapabilities:   hostConfigExtraCapabilities,
+		DroppedCapabilities: hostConfigDroppedCaps,
+		NetworkMode:         hostConfigNetType,
 		PortMappings: []types.PortMapping{{
 			ContainerPort: hostConfigContainerPort,
 			HostPort:      hostConfigHostPort,
@@ -150,6 +167,24 @@ func TestFromAPIContainerConfig(t *testing.T) {
 	t.Run("test_from_api_container_config_privileged", func(t *testing.T) {
 		testutil.AssertEqual(t, ctr.HostConfig.Privileged, ctrParsed.Privileged)
 	})
+	t.Run("test_from_api_container_config_read_only_rootfs", func(t *testing.T) {
+		testutil.AssertEqual(t, ctr.HostConfig.ReadOnlyRootfs, ctrParsed.ReadOnlyRootfs)
+	})
+	t.Run("test_from_api_container_config_userns_mode", func(t *testing.T) {
+		testutil.AssertEqual(t, string(ctr.HostConfig.UsernsMode), ctrParsed.UsernsMode)
+	})
+	t.Run("test_from_api_container_config_namespace_modes", func(t *testing.T) {
+		testutil.AssertEqual(t, string(ctr.HostConfig.IpcMode), ctrParsed.IpcMode)
+		testutil.AssertEqual(t, string(ctr.HostConfig.PidMode), ctrParsed.PidMode)
+		testutil.AssertEqual(t, ctr.HostConfig.ShmSize, ctrParsed.ShmSize)
+	})
+	t.Run("test_from_api_container_config_runtime_handler", func(t *testing.T) {
+		testutil.AssertEqual(t, ctr.HostConfig.RuntimeHandler, ctrParsed.RuntimeHandler)
+	})
+	t.Run("test_from_api_container_config_ulimits_sysctls", func(t *testing.T) {
+		testutil.AssertEqual(t, []*ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}}, ctrParsed.Ulimits)
+		testutil.AssertEqual(t, ctr.HostConfig.Sysctls, ctrParsed.Sysctls)
+	})
 	t.Run("test_from_api_container_config_restart_policy", func(t *testing.T) {
 		testutil.AssertEqual(t, ctr.HostConfig.RestartPolicy, toAPIRestartPolicy(ctrParsed.RestartPolicy))
 	})
@@ -157,9 +192,18 @@ func TestFromAPIContainerConfig(t *testing.T) {
 		ctr.HostConfig.Privileged = false
 		testutil.AssertEqual(t, ctr.HostConfig.ExtraCapabilities, fromAPIContainerConfig(ctr).ExtraCapabilities)
 	})
+	t.Run("test_from_api_container_config_dropped_caps", func(t *testing.T) {
+		ctr.HostConfig.Privileged = false
+		testutil.AssertEqual(t, ctr.HostConfig.DroppedCapabilities, fromAPIContainerConfig(ctr).DroppedCapabilities)
+	})
 	t.Run("test_from_api_container_config_extra_hosts", func(t *testing.T) {
 		testutil.AssertEqual(t, ctr.HostConfig.ExtraHosts, ctrParsed.ExtraHosts)
 	})
+	t.Run("test_from_api_container_config_dns", func(t *testing.T) {
+		testutil.AssertEqual(t, ctr.HostConfig.DNS, ctrParsed.DNS)
+		testutil.AssertEqual(t, ctr.HostConfig.DNSSearch, ctrParsed.DNSSearch)
+		testutil.AssertEqual(t, ctr.HostConfig.DNSOptions, ctrParsed.DNSOptions)
+	})
 	t.Run("test_from_api_container_config_extra_port_mappings_len", func(t *testing.T) {
 		testutil.AssertEqual(t, len(ctr.HostConfig.PortMappings), len(ctrParsed.PortMappings))
 	})
@@ -203,23 +247,35 @@ var (
 			Source:          mountPointSource,
 			PropagationMode: rprivate,
 		}},
-		HostName:   hostName,
-		Env:        envVar,
-		Cmd:        cmdVar,
-		Decryption: &decryptionConfiguration{},
-		Devices:    []*device{{}},
-		Privileged: hostConfigPrivileged,
+		HostName:       hostName,
+		Env:            envVar,
+		Cmd:            cmdVar,
+		Decryption:     &decryptionConfiguration{},
+		Devices:        []*device{{}},
+		Privileged:     hostConfigPrivileged,
+		ReadOnlyRootfs: true,
+		UsernsMode:     string(types.UsernsModeRemap),
+		IpcMode:        string(types.NamespaceModePrivate),
+		PidMode:        string(types.NamespaceModePrivate),
+		ShmSize:        "128M",
+		RuntimeHandler: "crun",
+		Ulimits:        []*ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}},
+		Sysctls:        hostConfigSysctls,
 		RestartPolicy: &restartPolicy{
 			MaxRetryCount: hostConfigRestartPolicyMaxRetry,
 			RetryTimeout:  hostConfigRestartPolicyTimeout.Seconds(),
 			RpType:        onFailure,
 		},
-		NetworkMode:       host,
-		ExtraCapabilities: hostConfigExtraCapabilities,
-		ExtraHosts:        hostConfigExtraHosts,
-		PortMappings:      []*portMapping{{}},
-		OpenStdin:         internalIOConfig.OpenStdin,
-		Tty:               internalIOConfig.Tty,
+		NetworkMode:         host,
+		ExtraCapabilities:   hostConfigExtraCapabilities,
+		DroppedCapabilities: hostConfigDroppedCaps,
+		ExtraHosts:          hostConfigExtraHosts,
+		DNS:                 hostConfigDNS,
+		DNSSearch:           hostConfigDNSSearch,
+		DNSOptions:          hostConfigDNSOptions,
+		PortMappings:        []*portMapping{{}},
+		OpenStdin:           internalIOConfig.OpenStdin,
+		Tty:                 internalIOConfig.Tty,
 		Log: &logConfiguration{
 			Type:          testLogDriverType,
 			MaxFiles:      testLogMaxFiles,
@@ -252,6 +308,24 @@ func TestToAPIContainerConfig(t *testing.T) {
 	t.Run("test_to_api_container_config_privileged", func(t *testing.T) {
 		testutil.AssertEqual(t, testContainerConfig.Privileged, ctrParsed.HostConfig.Privileged)
 	})
+	t.Run("test_to_api_container_config_read_only_rootfs", func(t *testing.T) {
+		testutil.AssertEqual(t, testContainerConfig.ReadOnlyRootfs, ctrParsed.HostConfig.ReadOnlyRootfs)
+	})
+	t.Run("test_to_api_container_config_userns_mode", func(t *testing.T) {
+		testutil.AssertEqual(t, types.UsernsMode(testContainerConfig.UsernsMode), ctrParsed.HostConfig.UsernsMode)
+	})
+	t.Run("test_to_api_container_config_namespace_modes", func(t *testing.T) {
+		testutil.AssertEqual(t, types.NamespaceMode(testContainerConfig.IpcMode), ctrParsed.HostConfig.IpcMode)
+		testutil.AssertEqual(t, types.NamespaceMode(testContainerConfig.PidMode), ctrParsed.HostConfig.PidMode)
+		testutil.AssertEqual(t, testContainerConfig.ShmSize, ctrParsed.HostConfig.ShmSize)
+	})
+	t.Run("test_to_api_container_config_runtime_handler", func(t *testing.T) {
+		testutil.AssertEqual(t, testContainerConfig.RuntimeHandler, ctrParsed.HostConfig.RuntimeHandler)
+	})
+	t.Run("test_to_api_container_config_ulimits_sysctls", func(t *testing.T) {
+		testutil.AssertEqual(t, []types.Ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}}, ctrParsed.HostConfig.Ulimits)
+		testutil.AssertEqual(t, testContainerConfig.Sysctls, ctrParsed.HostConfig.Sysctls)
+	})
 	t.Run("test_to_api_container_config_restart_policy", func(t *testing.T) {
 		testutil.AssertEqual(t, testContainerConfig.RestartPolicy, fromAPIRestartPolicy(ctrParsed.HostConfig.RestartPolicy))
 	})
@@ -261,9 +335,20 @@ func TestToAPIContainerConfig(t *testing.T) {
 		ctrParsedExtraCapabilities := toAPIContainerConfig(&copyTestContainerConfig)
 		testutil.AssertEqual(t, copyTestContainerConfig.ExtraCapabilities, ctrParsedExtraCapabilities.HostConfig.ExtraCapabilities)
 	})
+	t.Run("test_to_api_container_config_dropped_caps", func(t *testing.T) {
+		copyTestContainerConfig := *testContainerConfig
+		copyTestContainerConfig.Privileged = false
+		ctrParsedDroppedCapabilities := toAPIContainerConfig(&copyTestContainerConfig)
+		testutil.AssertEqual(t, copyTestContainerConfig.DroppedCapabilities, ctrParsedDroppedCapabilities.HostConfig.DroppedCapabilities)
+	})
 	t.Run("test_to_api_container_config_extra_hosts", func(t *testing.T) {
 		testutil.AssertEqual(t, testContainerConfig.ExtraHosts, ctrParsed.HostConfig.ExtraHosts)
 	})
+	t.Run("test_to_api_container_config_dns", func(t *testing.T) {
+		testutil.AssertEqual(t, testContainerConfig.DNS, ctrParsed.HostConfig.DNS)
+		testutil.AssertEqual(t, testContainerConfig.DNSSearch, ctrParsed.HostConfig.DNSSearch)
+		testutil.AssertEqual(t, testContainerConfig.DNSOptions, ctrParsed.HostConfig.DNSOptions)
+	})
 	t.Run("test_to_api_container_config_extra_port_mappings_len", func(t *testing.T) {
 		testutil.AssertEqual(t, len(testContainerConfig.PortMappings), len(ctrParsed.HostConfig.PortMappings))
 	})
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"strings"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+)
+
+type healthCheck struct {
+	Type        string   `json:"type"`
+	Cmd         []string `json:"cmd,omitempty"`
+	Port        int      `json:"port,omitempty"`
+	Path        string   `json:"path,omitempty"`
+	Interval    float64  `json:"interval,omitempty"`
+	Timeout     float64  `json:"timeout,omitempty"`
+	Retries     int      `json:"retries,omitempty"`
+	StartPeriod float64  `json:"startPeriod,omitempty"`
+}
+
+type healthStatus string
+
+const (
+	healthStarting  healthStatus = "STARTING"
+	healthHealthy   healthStatus = "HEALTHY"
+	healthUnhealthy healthStatus = "UNHEALTHY"
+)
+
+func toAPIHealthCheck(hc *healthCheck) *types.HealthCheckConfig {
+	return &types.HealthCheckConfig{
+		Type:        types.HealthCheckType(strings.ToLower(hc.Type)),
+		Cmd:         hc.Cmd,
+		Port:        hc.Port,
+		Path:        hc.Path,
+		Interval:    time.Duration(hc.Interval) * time.Second,
+		Timeout:     time.Duration(hc.Timeout) * time.Second,
+		Retries:     hc.Retries,
+		StartPeriod: time.Duration(hc.StartPeriod) * time.Second,
+	}
+}
+
+func fromAPIHealthCheck(hc *types.HealthCheckConfig) *healthCheck {
+	return &healthCheck{
+		Type:        strings.ToUpper(string(hc.Type)),
+		Cmd:         hc.Cmd,
+		Port:        hc.Port,
+		Path:        hc.Path,
+		Interval:    hc.Interval.Seconds(),
+		Timeout:     hc.Timeout.Seconds(),
+		Retries:     hc.Retries,
+		StartPeriod: hc.StartPeriod.Seconds(),
+	}
+}
+
+func fromAPIHealthStatus(apiHealthStatus types.HealthStatus) healthStatus {
+	switch apiHealthStatus {
+	case types.HealthStarting:
+		return healthStarting
+	case types.HealthHealthy:
+		return healthHealthy
+	case types.HealthUnhealthy:
+		return healthUnhealthy
+	default:
+		return healthStatus(apiHealthStatus)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func TestHealthCheckConversion(t *testing.T) {
+	apiHealthCheck := &types.HealthCheckConfig{
+		Type:        types.HealthCheckHTTP,
+		Port:        8080,
+		Path:        "/health",
+		Interval:    30 * time.Second,
+		Timeout:     10 * time.Second,
+		Retries:     3,
+		StartPeriod: 5 * time.Second,
+	}
+
+	thingsHealthCheck := fromAPIHealthCheck(apiHealthCheck)
+	testutil.AssertEqual(t, &healthCheck{
+		Type:        "HTTP",
+		Port:        8080,
+		Path:        "/health",
+		Interval:    30,
+		Timeout:     10,
+		Retries:     3,
+		StartPeriod: 5,
+	}, thingsHealthCheck)
+	testutil.AssertEqual(t, apiHealthCheck, toAPIHealthCheck(thingsHealthCheck))
+}
+
+func TestFromAPIHealthStatus(t *testing.T) {
+	tests := map[string]struct {
+		apiHealthStatus types.HealthStatus
+		expected        healthStatus
+	}{
+		"test_from_api_health_status_starting": {
+			apiHealthStatus: types.HealthStarting,
+			expected:        healthStarting,
+		},
+		"test_from_api_health_status_healthy": {
+			apiHealthStatus: types.HealthHealthy,
+			expected:        healthHealthy,
+		},
+		"test_from_api_health_status_unhealthy": {
+			apiHealthStatus: types.HealthUnhealthy,
+			expected:        healthUnhealthy,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expected, fromAPIHealthStatus(testCase.apiHealthStatus))
+		})
+	}
+}
+
+func TestFromAPIContainerStateHealth(t *testing.T) {
+	st := fromAPIContainerState(&types.State{Status: types.Running})
+	testutil.AssertEqual(t, healthStatus(""), st.Health)
+
+	st = fromAPIContainerState(&types.State{Status: types.Running, Health: &types.Health{Status: types.HealthUnhealthy}})
+	testutil.AssertEqual(t, healthUnhealthy, st.Health)
+}
@@ -18,9 +18,20 @@ type logDriver string
 
 const (
 	jsonFile logDriver = "JSON_FILE"
+	local    logDriver = "LOCAL"
+	syslog   logDriver = "SYSLOG"
+	journald logDriver = "JOURNALD"
 	none     logDriver = "NONE"
 )
 
+type logCompression string
+
+const (
+	compressionNone logCompression = "NONE"
+	compressionGzip logCompression = "GZIP"
+	compressionZstd logCompression = "ZSTD"
+)
+
 type logMode string
 
 const (
@@ -29,12 +40,17 @@ const (
 )
 
 type logConfiguration struct {
-	Type          logDriver `json:"type,omitempty"`
-	MaxFiles      int       `json:"maxFiles,omitempty"`
-	MaxSize       string    `json:"maxSize,omitempty"`
-	RootDir       string    `json:"rootDir,omitempty"`
-	Mode          logMode   `json:"mode,omitempty"`
-	MaxBufferSize string    `json:"maxBufferSize,omitempty"`
+	Type          logDriver      `json:"type,omitempty"`
+	MaxFiles      int            `json:"maxFiles,omitempty"`
+	MaxSize       string         `json:"maxSize,omitempty"`
+	RootDir       string         `json:"rootDir,omitempty"`
+	Compress      logCompression `json:"compress,omitempty"`
+	MaxAge        string         `json:"maxAge,omitempty"`
+	Address       string         `json:"address,omitempty"`
+	Facility      string         `json:"facility,omitempty"`
+	Tag           string         `json:"tag,omitempty"`
+	Mode          logMode        `json:"mode,omitempty"`
+	MaxBufferSize string         `json:"maxBufferSize,omitempty"`
 }
 
 func toAPILogConfiguration(logConfig *logConfiguration) *types.LogConfiguration {
@@ -44,6 +60,11 @@ func toAPILogConfiguration(logConfig *logConfiguration) *types.LogConfiguration
 			MaxFiles: logConfig.MaxFiles,
 			MaxSize:  logConfig.MaxSize,
 			RootDir:  logConfig.RootDir,
+			Compress: toAPILogCompression(logConfig.Compress),
+			MaxAge:   logConfig.MaxAge,
+			Address:  logConfig.Address,
+			Facility: logConfig.Facility,
+			Tag:      logConfig.Tag,
 		},
 		ModeConfig: &types.LogModeConfiguration{
 			Mode:          toAPILogMode(logConfig.Mode),
@@ -59,6 +80,11 @@ func fromAPILogConfiguration(logConfig *types.LogConfiguration) *logConfiguratio
 		cfg.MaxFiles = logConfig.DriverConfig.MaxFiles
 		cfg.MaxSize = logConfig.DriverConfig.MaxSize
 		cfg.RootDir = logConfig.DriverConfig.RootDir
+		cfg.Compress = fromAPILogCompression(logConfig.DriverConfig.Compress)
+		cfg.MaxAge = logConfig.DriverConfig.MaxAge
+		cfg.Address = logConfig.DriverConfig.Address
+		cfg.Facility = logConfig.DriverConfig.Facility
+		cfg.Tag = logConfig.DriverConfig.Tag
 	}
 	if logConfig.ModeConfig != nil {
 		cfg.Mode = fromAPILogMode(logConfig.ModeConfig.Mode)
@@ -71,6 +97,12 @@ func toAPILogDriver(logType logDriver) types.LogDriver {
 	switch logType {
 	case jsonFile:
 		return types.LogConfigDriverJSONFile
+	case local:
+		return types.LogConfigDriverLocal
+	case syslog:
+		return types.LogConfigDriverSyslog
+	case journald:
+		return types.LogConfigDriverJournald
 	case none:
 		return types.LogConfigDriverNone
 	default:
@@ -82,6 +114,12 @@ func fromAPILogDriver(apiLogDriver types.LogDriver) logDriver {
 	switch apiLogDriver {
 	case types.LogConfigDriverJSONFile:
 		return jsonFile
+	case types.LogConfigDriverLocal:
+		return local
+	case types.LogConfigDriverSyslog:
+		return syslog
+	case types.LogConfigDriverJournald:
+		return journald
 	case types.LogConfigDriverNone:
 		return none
 	default:
@@ -89,6 +127,32 @@ func fromAPILogDriver(apiLogDriver types.LogDriver) logDriver {
 	}
 }
 
+func toAPILogCompression(compression logCompression) types.LogCompression {
+	switch compression {
+	case compressionNone:
+		return types.LogCompressionNone
+	case compressionGzip:
+		return types.LogCompressionGzip
+	case compressionZstd:
+		return types.LogCompressionZstd
+	default:
+		return types.LogCompression(compression)
+	}
+}
+
+func fromAPILogCompression(apiCompression types.LogCompression) logCompression {
+	switch apiCompression {
+	case types.LogCompressionNone:
+		return compressionNone
+	case types.LogCompressionGzip:
+		return compressionGzip
+	case types.LogCompressionZstd:
+		return compressionZstd
+	default:
+		return logCompression(apiCompression)
+	}
+}
+
 func toAPILogMode(logMode logMode) types.LogMode {
 	switch logMode {
 	case blocking:
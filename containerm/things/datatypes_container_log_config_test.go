@@ -26,6 +26,13 @@ const (
 	testLogMaxFiles = 2
 	testLogMaxSize  = "100M"
 
+	testLogCompress = compressionGzip
+	testLogMaxAge   = "72h"
+
+	testLogAddress  = "udp://localhost:514"
+	testLogFacility = "local0"
+	testLogTag      = "app"
+
 	testAPILogMode = types.LogModeBlocking
 	testLogMode    = blocking
 
@@ -37,6 +44,11 @@ func TestToAPILogConfiguration(t *testing.T) {
 		Type:          testLogDriverType,
 		MaxFiles:      testLogMaxFiles,
 		MaxSize:       testLogMaxSize,
+		Compress:      testLogCompress,
+		MaxAge:        testLogMaxAge,
+		Address:       testLogAddress,
+		Facility:      testLogFacility,
+		Tag:           testLogTag,
 		Mode:          testLogMode,
 		MaxBufferSize: testLogBufferSize,
 	}
@@ -52,6 +64,15 @@ func TestToAPILogConfiguration(t *testing.T) {
 	t.Run("test_to_api_log_configuration_driver_max_size", func(t *testing.T) {
 		testutil.AssertEqual(t, logConfig.MaxSize, result.DriverConfig.MaxSize)
 	})
+	t.Run("test_to_api_log_configuration_driver_retention", func(t *testing.T) {
+		testutil.AssertEqual(t, types.LogCompressionGzip, result.DriverConfig.Compress)
+		testutil.AssertEqual(t, logConfig.MaxAge, result.DriverConfig.MaxAge)
+	})
+	t.Run("test_to_api_log_configuration_driver_syslog", func(t *testing.T) {
+		testutil.AssertEqual(t, logConfig.Address, result.DriverConfig.Address)
+		testutil.AssertEqual(t, logConfig.Facility, result.DriverConfig.Facility)
+		testutil.AssertEqual(t, logConfig.Tag, result.DriverConfig.Tag)
+	})
 	t.Run("test_to_api_log_configuration_mode", func(t *testing.T) {
 		testutil.AssertEqual(t, toAPILogMode(logConfig.Mode), result.ModeConfig.Mode)
 	})
@@ -67,6 +88,11 @@ func TestFromAPILogConfiguration(t *testing.T) {
 			Type:     testAPILogDriverType,
 			MaxFiles: testLogMaxFiles,
 			MaxSize:  testLogMaxSize,
+			Compress: types.LogCompressionZstd,
+			MaxAge:   testLogMaxAge,
+			Address:  testLogAddress,
+			Facility: testLogFacility,
+			Tag:      testLogTag,
 		},
 		ModeConfig: &types.LogModeConfiguration{
 			Mode:          testAPILogMode,
@@ -84,6 +110,15 @@ func TestFromAPILogConfiguration(t *testing.T) {
 	t.Run("test_from_api_log_configuration_driver_max_size", func(t *testing.T) {
 		testutil.AssertEqual(t, logConfig.DriverConfig.MaxSize, result.MaxSize)
 	})
+	t.Run("test_from_api_log_configuration_driver_retention", func(t *testing.T) {
+		testutil.AssertEqual(t, compressionZstd, result.Compress)
+		testutil.AssertEqual(t, logConfig.DriverConfig.MaxAge, result.MaxAge)
+	})
+	t.Run("test_from_api_log_configuration_driver_syslog", func(t *testing.T) {
+		testutil.AssertEqual(t, logConfig.DriverConfig.Address, result.Address)
+		testutil.AssertEqual(t, logConfig.DriverConfig.Facility, result.Facility)
+		testutil.AssertEqual(t, logConfig.DriverConfig.Tag, result.Tag)
+	})
 	t.Run("test_from_api_log_configuration_mode", func(t *testing.T) {
 		testutil.AssertEqual(t, fromAPILogMode(logConfig.ModeConfig.Mode), result.Mode)
 	})
@@ -97,6 +132,18 @@ func TestToAPILogDriver(t *testing.T) {
 		testutil.AssertEqual(t, types.LogConfigDriverJSONFile, toAPILogDriver(jsonFile))
 	})
 
+	t.Run("test_to_api_log_driver_driver_type_local", func(t *testing.T) {
+		testutil.AssertEqual(t, types.LogConfigDriverLocal, toAPILogDriver(local))
+	})
+
+	t.Run("test_to_api_log_driver_driver_type_syslog", func(t *testing.T) {
+		testutil.AssertEqual(t, types.LogConfigDriverSyslog, toAPILogDriver(syslog))
+	})
+
+	t.Run("test_to_api_log_driver_driver_type_journald", func(t *testing.T) {
+		testutil.AssertEqual(t, types.LogConfigDriverJournald, toAPILogDriver(journald))
+	})
+
 	t.Run("test_to_api_log_driver_driver_type_none", func(t *testing.T) {
 		testutil.AssertEqual(t, types.LogConfigDriverNone, toAPILogDriver(none))
 	})
@@ -108,6 +155,18 @@ func TestTFromAPILogDriver(t *testing.T) {
 		testutil.AssertEqual(t, jsonFile, fromAPILogDriver(types.LogConfigDriverJSONFile))
 	})
 
+	t.Run("test_from_api_log_driver_driver_type_local", func(t *testing.T) {
+		testutil.AssertEqual(t, local, fromAPILogDriver(types.LogConfigDriverLocal))
+	})
+
+	t.Run("test_from_api_log_driver_driver_type_syslog", func(t *testing.T) {
+		testutil.AssertEqual(t, syslog, fromAPILogDriver(types.LogConfigDriverSyslog))
+	})
+
+	t.Run("test_from_api_log_driver_driver_type_journald", func(t *testing.T) {
+		testutil.AssertEqual(t, journald, fromAPILogDriver(types.LogConfigDriverJournald))
+	})
+
 	t.Run("test_form_api_log_driver_driver_type_none", func(t *testing.T) {
 		testutil.AssertEqual(t, none, fromAPILogDriver(types.LogConfigDriverNone))
 	})
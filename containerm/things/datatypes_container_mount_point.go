@@ -27,10 +27,19 @@ const (
 	slave    propagationMode = "SLAVE"
 )
 
+type mountType string
+
+const (
+	bind   mountType = "BIND"
+	volume mountType = "VOLUME"
+)
+
 type mountPoint struct {
 	Source          string          `json:"source"`
 	Destination     string          `json:"destination"`
 	PropagationMode propagationMode `json:"propagationMode,omitempty"`
+	Type            mountType       `json:"type,omitempty"`
+	Options         []string        `json:"options,omitempty"`
 }
 
 func toAPIMountPoint(internalMP *mountPoint) types.MountPoint {
@@ -38,6 +47,8 @@ func toAPIMountPoint(internalMP *mountPoint) types.MountPoint {
 		Destination:     internalMP.Destination,
 		Source:          internalMP.Source,
 		PropagationMode: toAPIPRMode(internalMP.PropagationMode),
+		Type:            toAPIMountType(internalMP.Type),
+		Options:         internalMP.Options,
 	}
 }
 func fromAPIMountPoint(apiMP types.MountPoint) *mountPoint {
@@ -45,6 +56,30 @@ func fromAPIMountPoint(apiMP types.MountPoint) *mountPoint {
 		Destination:     apiMP.Destination,
 		Source:          apiMP.Source,
 		PropagationMode: fromAPIPRMode(apiMP.PropagationMode),
+		Type:            fromAPIMountType(apiMP.Type),
+		Options:         apiMP.Options,
+	}
+}
+
+func fromAPIMountType(mntType string) mountType {
+	switch mntType {
+	case types.MountTypeVolume:
+		return volume
+	case types.MountTypeBind:
+		return bind
+	default:
+		return ""
+	}
+}
+
+func toAPIMountType(mntType mountType) string {
+	switch mntType {
+	case volume:
+		return types.MountTypeVolume
+	case bind:
+		return types.MountTypeBind
+	default:
+		return ""
 	}
 }
 
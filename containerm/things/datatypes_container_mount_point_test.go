@@ -29,6 +29,7 @@ func TestToAPIMountPoint(t *testing.T) {
 		Destination:     mountPointDestination,
 		Source:          mountPointSource,
 		PropagationMode: rprivate,
+		Options:         []string{types.MountOptionReadOnly},
 	}
 	result := toAPIMountPoint(mountPoint)
 
@@ -41,6 +42,12 @@ func TestToAPIMountPoint(t *testing.T) {
 	t.Run("test_to_api_mount_point_pr_mode", func(t *testing.T) {
 		testutil.AssertEqual(t, toAPIPRMode(mountPoint.PropagationMode), result.PropagationMode)
 	})
+	t.Run("test_to_api_mount_point_type", func(t *testing.T) {
+		testutil.AssertEqual(t, "", result.Type)
+	})
+	t.Run("test_to_api_mount_point_options", func(t *testing.T) {
+		testutil.AssertEqual(t, mountPoint.Options, result.Options)
+	})
 }
 
 func TestFromAPIMountPoint(t *testing.T) {
@@ -48,6 +55,7 @@ func TestFromAPIMountPoint(t *testing.T) {
 		Destination:     mountPointDestination,
 		Source:          mountPointSource,
 		PropagationMode: types.RPrivatePropagationMode,
+		Options:         []string{types.MountOptionReadOnly},
 	}
 	result := fromAPIMountPoint(*mountPoint)
 
@@ -60,6 +68,39 @@ func TestFromAPIMountPoint(t *testing.T) {
 	t.Run("test_to_api_mount_point_pr_mode", func(t *testing.T) {
 		testutil.AssertEqual(t, fromAPIPRMode(mountPoint.PropagationMode), result.PropagationMode)
 	})
+	t.Run("test_to_api_mount_point_type", func(t *testing.T) {
+		testutil.AssertEqual(t, mountType(""), result.Type)
+	})
+	t.Run("test_to_api_mount_point_options", func(t *testing.T) {
+		testutil.AssertEqual(t, mountPoint.Options, result.Options)
+	})
+}
+
+func TestMountType(t *testing.T) {
+	tests := map[string]struct {
+		apiType   string
+		thingType mountType
+	}{
+		"test_mount_type_bind": {
+			apiType:   types.MountTypeBind,
+			thingType: bind,
+		},
+		"test_mount_type_volume": {
+			apiType:   types.MountTypeVolume,
+			thingType: volume,
+		},
+		"test_mount_type_default": {
+			apiType:   "",
+			thingType: "",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.thingType, fromAPIMountType(testCase.apiType))
+			testutil.AssertEqual(t, testCase.apiType, toAPIMountType(testCase.thingType))
+		})
+	}
 }
 
 func TestToAPIPRMode(t *testing.T) {
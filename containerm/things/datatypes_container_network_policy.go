@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"strings"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+)
+
+type networkPolicy struct {
+	IngressRate   uint64        `json:"ingressRate,omitempty"`
+	EgressRate    uint64        `json:"egressRate,omitempty"`
+	EgressDefault string        `json:"egressDefault,omitempty"`
+	EgressRules   []*egressRule `json:"egressRules,omitempty"`
+}
+
+type egressRule struct {
+	Action   string `json:"action"`
+	CIDR     string `json:"cidr"`
+	Protocol string `json:"protocol,omitempty"`
+	Ports    string `json:"ports,omitempty"`
+}
+
+func toAPINetworkPolicy(np *networkPolicy) *types.NetworkPolicy {
+	policy := &types.NetworkPolicy{
+		IngressRate:   np.IngressRate,
+		EgressRate:    np.EgressRate,
+		EgressDefault: types.EgressAction(strings.ToLower(np.EgressDefault)),
+	}
+	for _, rule := range np.EgressRules {
+		policy.EgressRules = append(policy.EgressRules, types.EgressRule{
+			Action:   types.EgressAction(strings.ToLower(rule.Action)),
+			CIDR:     rule.CIDR,
+			Protocol: strings.ToLower(rule.Protocol),
+			Ports:    rule.Ports,
+		})
+	}
+	return policy
+}
+
+func fromAPINetworkPolicy(np *types.NetworkPolicy) *networkPolicy {
+	policy := &networkPolicy{
+		IngressRate:   np.IngressRate,
+		EgressRate:    np.EgressRate,
+		EgressDefault: strings.ToUpper(string(np.EgressDefault)),
+	}
+	for _, rule := range np.EgressRules {
+		policy.EgressRules = append(policy.EgressRules, &egressRule{
+			Action:   strings.ToUpper(string(rule.Action)),
+			CIDR:     rule.CIDR,
+			Protocol: strings.ToUpper(rule.Protocol),
+			Ports:    rule.Ports,
+		})
+	}
+	return policy
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+var (
+	testAPINetworkPolicy = &types.NetworkPolicy{
+		IngressRate:   1024 * 1024,
+		EgressRate:    512 * 1024,
+		EgressDefault: types.EgressActionDeny,
+		EgressRules: []types.EgressRule{
+			{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "443"},
+			{Action: types.EgressActionDeny, CIDR: "2001:db8::/32"},
+		},
+	}
+	testNetworkPolicy = &networkPolicy{
+		IngressRate:   1024 * 1024,
+		EgressRate:    512 * 1024,
+		EgressDefault: "DENY",
+		EgressRules: []*egressRule{
+			{Action: "ALLOW", CIDR: "10.0.0.0/8", Protocol: "TCP", Ports: "443"},
+			{Action: "DENY", CIDR: "2001:db8::/32"},
+		},
+	}
+)
+
+func TestToAPINetworkPolicy(t *testing.T) {
+	testutil.AssertEqual(t, testAPINetworkPolicy, toAPINetworkPolicy(testNetworkPolicy))
+}
+
+func TestFromAPINetworkPolicy(t *testing.T) {
+	testutil.AssertEqual(t, testNetworkPolicy, fromAPINetworkPolicy(testAPINetworkPolicy))
+}
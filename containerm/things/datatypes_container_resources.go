@@ -15,23 +15,83 @@ package things
 import "github.com/eclipse-kanto/container-management/containerm/containers/types"
 
 type resources struct {
-	Memory            string `json:"memory,omitempty"`
-	MemoryReservation string `json:"memoryReservation,omitempty"`
-	MemorySwap        string `json:"memorySwap,omitempty"`
+	Memory               string           `json:"memory,omitempty"`
+	MemoryReservation    string           `json:"memoryReservation,omitempty"`
+	MemorySwap           string           `json:"memorySwap,omitempty"`
+	CPUQuota             int64            `json:"cpuQuota,omitempty"`
+	CPUPeriod            uint64           `json:"cpuPeriod,omitempty"`
+	CPUShares            uint64           `json:"cpuShares,omitempty"`
+	CPUSetCPUs           string           `json:"cpusetCpus,omitempty"`
+	CPUSetMems           string           `json:"cpusetMems,omitempty"`
+	PidsLimit            int64            `json:"pidsLimit,omitempty"`
+	BlkioWeight          uint16           `json:"blkioWeight,omitempty"`
+	BlkioDeviceReadBps   []throttleDevice `json:"blkioDeviceReadBps,omitempty"`
+	BlkioDeviceWriteBps  []throttleDevice `json:"blkioDeviceWriteBps,omitempty"`
+	BlkioDeviceReadIOps  []throttleDevice `json:"blkioDeviceReadIOps,omitempty"`
+	BlkioDeviceWriteIOps []throttleDevice `json:"blkioDeviceWriteIOps,omitempty"`
+}
+
+type throttleDevice struct {
+	Path string `json:"path"`
+	Rate uint64 `json:"rate"`
 }
 
 func toAPIResources(r *resources) *types.Resources {
 	return &types.Resources{
-		Memory:            r.Memory,
-		MemoryReservation: r.MemoryReservation,
-		MemorySwap:        r.MemorySwap,
+		Memory:               r.Memory,
+		MemoryReservation:    r.MemoryReservation,
+		MemorySwap:           r.MemorySwap,
+		CPUQuota:             r.CPUQuota,
+		CPUPeriod:            r.CPUPeriod,
+		CPUShares:            r.CPUShares,
+		CPUSetCPUs:           r.CPUSetCPUs,
+		CPUSetMems:           r.CPUSetMems,
+		PidsLimit:            r.PidsLimit,
+		BlkioWeight:          r.BlkioWeight,
+		BlkioDeviceReadBps:   toAPIThrottleDevices(r.BlkioDeviceReadBps),
+		BlkioDeviceWriteBps:  toAPIThrottleDevices(r.BlkioDeviceWriteBps),
+		BlkioDeviceReadIOps:  toAPIThrottleDevices(r.BlkioDeviceReadIOps),
+		BlkioDeviceWriteIOps: toAPIThrottleDevices(r.BlkioDeviceWriteIOps),
 	}
 }
 
 func fromAPIResources(r *types.Resources) *resources {
 	return &resources{
-		Memory:            r.Memory,
-		MemoryReservation: r.MemoryReservation,
-		MemorySwap:        r.MemorySwap,
+		Memory:               r.Memory,
+		MemoryReservation:    r.MemoryReservation,
+		MemorySwap:           r.MemorySwap,
+		CPUQuota:             r.CPUQuota,
+		CPUPeriod:            r.CPUPeriod,
+		CPUShares:            r.CPUShares,
+		CPUSetCPUs:           r.CPUSetCPUs,
+		CPUSetMems:           r.CPUSetMems,
+		PidsLimit:            r.PidsLimit,
+		BlkioWeight:          r.BlkioWeight,
+		BlkioDeviceReadBps:   fromAPIThrottleDevices(r.BlkioDeviceReadBps),
+		BlkioDeviceWriteBps:  fromAPIThrottleDevices(r.BlkioDeviceWriteBps),
+		BlkioDeviceReadIOps:  fromAPIThrottleDevices(r.BlkioDeviceReadIOps),
+		BlkioDeviceWriteIOps: fromAPIThrottleDevices(r.BlkioDeviceWriteIOps),
+	}
+}
+
+func toAPIThrottleDevices(devices []throttleDevice) []types.ThrottleDevice {
+	if devices == nil {
+		return nil
+	}
+	apiDevices := make([]types.ThrottleDevice, len(devices))
+	for i, device := range devices {
+		apiDevices[i] = types.ThrottleDevice{Path: device.Path, Rate: device.Rate}
+	}
+	return apiDevices
+}
+
+func fromAPIThrottleDevices(apiDevices []types.ThrottleDevice) []throttleDevice {
+	if apiDevices == nil {
+		return nil
+	}
+	devices := make([]throttleDevice, len(apiDevices))
+	for i, apiDevice := range apiDevices {
+		devices[i] = throttleDevice{Path: apiDevice.Path, Rate: apiDevice.Rate}
 	}
+	return devices
 }
@@ -22,13 +22,24 @@ const (
 	testMemory            = "500M"
 	testMemoryReservation = "300M"
 	testMemorySwap        = "1G"
+	testCPUQuota          = 50000
+	testCPUShares         = 512
+	testCPUSetCPUs        = "0-1"
+	testPidsLimit         = 100
 )
 
+var testThrottleDevices = []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1024}}
+
 func TestFromAPIResources(t *testing.T) {
 	apiResources := &types.Resources{
-		Memory:            testMemory,
-		MemoryReservation: testMemoryReservation,
-		MemorySwap:        testMemorySwap,
+		Memory:             testMemory,
+		MemoryReservation:  testMemoryReservation,
+		MemorySwap:         testMemorySwap,
+		CPUQuota:           testCPUQuota,
+		CPUShares:          testCPUShares,
+		CPUSetCPUs:         testCPUSetCPUs,
+		PidsLimit:          testPidsLimit,
+		BlkioDeviceReadBps: testThrottleDevices,
 	}
 
 	thingsResources := fromAPIResources(apiResources)
@@ -45,13 +56,32 @@ func TestFromAPIResources(t *testing.T) {
 		testutil.AssertEqual(t, apiResources.MemorySwap, thingsResources.MemorySwap)
 	})
 
+	t.Run("test_from_api_resource_cpu", func(t *testing.T) {
+		testutil.AssertEqual(t, apiResources.CPUQuota, thingsResources.CPUQuota)
+		testutil.AssertEqual(t, apiResources.CPUShares, thingsResources.CPUShares)
+		testutil.AssertEqual(t, apiResources.CPUSetCPUs, thingsResources.CPUSetCPUs)
+	})
+
+	t.Run("test_from_api_resource_pids_limit", func(t *testing.T) {
+		testutil.AssertEqual(t, apiResources.PidsLimit, thingsResources.PidsLimit)
+	})
+
+	t.Run("test_from_api_resource_blkio", func(t *testing.T) {
+		testutil.AssertEqual(t, []throttleDevice{{Path: "/dev/sda", Rate: 1024}}, thingsResources.BlkioDeviceReadBps)
+		testutil.AssertNil(t, thingsResources.BlkioDeviceWriteBps)
+	})
 }
 
 func TestToAPIResources(t *testing.T) {
 	thingsResources := &resources{
-		Memory:            testMemory,
-		MemoryReservation: testMemoryReservation,
-		MemorySwap:        testMemorySwap,
+		Memory:             testMemory,
+		MemoryReservation:  testMemoryReservation,
+		MemorySwap:         testMemorySwap,
+		CPUQuota:           testCPUQuota,
+		CPUShares:          testCPUShares,
+		CPUSetCPUs:         testCPUSetCPUs,
+		PidsLimit:          testPidsLimit,
+		BlkioDeviceReadBps: []throttleDevice{{Path: "/dev/sda", Rate: 1024}},
 	}
 
 	apiResources := toAPIResources(thingsResources)
@@ -65,4 +95,16 @@ func TestToAPIResources(t *testing.T) {
 	t.Run("test_to_api_resource_memory_swap", func(t *testing.T) {
 		testutil.AssertEqual(t, thingsResources.MemorySwap, apiResources.MemorySwap)
 	})
+	t.Run("test_to_api_resource_cpu", func(t *testing.T) {
+		testutil.AssertEqual(t, thingsResources.CPUQuota, apiResources.CPUQuota)
+		testutil.AssertEqual(t, thingsResources.CPUShares, apiResources.CPUShares)
+		testutil.AssertEqual(t, thingsResources.CPUSetCPUs, apiResources.CPUSetCPUs)
+	})
+	t.Run("test_to_api_resource_pids_limit", func(t *testing.T) {
+		testutil.AssertEqual(t, thingsResources.PidsLimit, apiResources.PidsLimit)
+	})
+	t.Run("test_to_api_resource_blkio", func(t *testing.T) {
+		testutil.AssertEqual(t, testThrottleDevices, apiResources.BlkioDeviceReadBps)
+		testutil.AssertNil(t, apiResources.BlkioDeviceWriteBps)
+	})
 }
@@ -31,13 +31,15 @@ type restartPolicy struct {
 	MaxRetryCount int               `json:"maxRetryCount,omitempty"`
 	RetryTimeout  float64           `json:"retryTimeout,omitempty"`
 	RpType        restartPolicyType `json:"type,omitempty"`
+	OnUnhealthy   bool              `json:"restartOnUnhealthy,omitempty"`
 }
 
 func toAPIRestartPolicy(internalRP *restartPolicy) *types.RestartPolicy {
 	return &types.RestartPolicy{
-		MaximumRetryCount: internalRP.MaxRetryCount,
-		RetryTimeout:      time.Duration(internalRP.RetryTimeout) * time.Second,
-		Type:              toAPIRPType(internalRP.RpType),
+		MaximumRetryCount:  internalRP.MaxRetryCount,
+		RetryTimeout:       time.Duration(internalRP.RetryTimeout) * time.Second,
+		Type:               toAPIRPType(internalRP.RpType),
+		RestartOnUnhealthy: internalRP.OnUnhealthy,
 	}
 }
 
@@ -46,6 +48,7 @@ func fromAPIRestartPolicy(apiPolicy *types.RestartPolicy) *restartPolicy {
 		MaxRetryCount: apiPolicy.MaximumRetryCount,
 		RetryTimeout:  apiPolicy.RetryTimeout.Seconds(),
 		RpType:        fromAPIRPType(apiPolicy.Type),
+		OnUnhealthy:   apiPolicy.RestartOnUnhealthy,
 	}
 }
 
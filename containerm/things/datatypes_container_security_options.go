@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import "github.com/eclipse-kanto/container-management/containerm/containers/types"
+
+type securityOptions struct {
+	Seccomp         string `json:"seccomp,omitempty"`
+	AppArmorProfile string `json:"apparmorProfile,omitempty"`
+	SELinuxLabel    string `json:"selinuxLabel,omitempty"`
+	NoNewPrivileges bool   `json:"noNewPrivileges,omitempty"`
+}
+
+func toAPISecurityOptions(so *securityOptions) *types.SecurityOptions {
+	return &types.SecurityOptions{
+		Seccomp:         so.Seccomp,
+		AppArmorProfile: so.AppArmorProfile,
+		SELinuxLabel:    so.SELinuxLabel,
+		NoNewPrivileges: so.NoNewPrivileges,
+	}
+}
+
+func fromAPISecurityOptions(so *types.SecurityOptions) *securityOptions {
+	return &securityOptions{
+		Seccomp:         so.Seccomp,
+		AppArmorProfile: so.AppArmorProfile,
+		SELinuxLabel:    so.SELinuxLabel,
+		NoNewPrivileges: so.NoNewPrivileges,
+	}
+}
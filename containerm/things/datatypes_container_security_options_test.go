@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+var (
+	testAPISecurityOptions = &types.SecurityOptions{
+		Seccomp:         "/etc/kanto/seccomp/vendor.json",
+		AppArmorProfile: "kanto-vendor",
+		SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+		NoNewPrivileges: true,
+	}
+	testSecurityOptions = &securityOptions{
+		Seccomp:         "/etc/kanto/seccomp/vendor.json",
+		AppArmorProfile: "kanto-vendor",
+		SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+		NoNewPrivileges: true,
+	}
+)
+
+func TestToAPISecurityOptions(t *testing.T) {
+	testutil.AssertEqual(t, testAPISecurityOptions, toAPISecurityOptions(testSecurityOptions))
+}
+
+func TestFromAPISecurityOptions(t *testing.T) {
+	testutil.AssertEqual(t, testSecurityOptions, fromAPISecurityOptions(testAPISecurityOptions))
+}
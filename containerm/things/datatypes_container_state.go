@@ -15,17 +15,18 @@ package things
 import "github.com/eclipse-kanto/container-management/containerm/containers/types"
 
 type state struct {
-	Status     status `json:"status"`
-	Pid        int64  `json:"pid,omitempty"`
-	Error      string `json:"error,omitempty"`
-	ExitCode   int64  `json:"exitCode,omitempty"`
-	StartedAt  string `json:"startedAt,omitempty"`
-	FinishedAt string `json:"finishedAt,omitempty"`
-	OOMKilled  bool   `json:"oomKilled,omitempty"`
+	Status     status       `json:"status"`
+	Pid        int64        `json:"pid,omitempty"`
+	Error      string       `json:"error,omitempty"`
+	ExitCode   int64        `json:"exitCode,omitempty"`
+	StartedAt  string       `json:"startedAt,omitempty"`
+	FinishedAt string       `json:"finishedAt,omitempty"`
+	OOMKilled  bool         `json:"oomKilled,omitempty"`
+	Health     healthStatus `json:"health,omitempty"`
 }
 
 func fromAPIContainerState(ctrState *types.State) *state {
-	return &state{
+	st := &state{
 		Status:     fromAPIStatus(ctrState.Status),
 		Pid:        ctrState.Pid,
 		Error:      ctrState.Error,
@@ -34,6 +35,10 @@ func fromAPIContainerState(ctrState *types.State) *state {
 		FinishedAt: ctrState.FinishedAt,
 		OOMKilled:  ctrState.OOMKilled,
 	}
+	if ctrState.Health != nil {
+		st.Health = fromAPIHealthStatus(ctrState.Health.Status)
+	}
+	return st
 }
 
 func toAPIContainerState(state *state) *types.State {
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import "github.com/eclipse-kanto/container-management/containerm/containers/types"
+
+type ulimit struct {
+	Name string `json:"name"`
+	Soft int64  `json:"soft"`
+	Hard int64  `json:"hard"`
+}
+
+func toAPIUlimit(ul *ulimit) types.Ulimit {
+	return types.Ulimit{
+		Name: ul.Name,
+		Soft: ul.Soft,
+		Hard: ul.Hard,
+	}
+}
+
+func fromAPIUlimit(ul types.Ulimit) *ulimit {
+	return &ulimit{
+		Name: ul.Name,
+		Soft: ul.Soft,
+		Hard: ul.Hard,
+	}
+}
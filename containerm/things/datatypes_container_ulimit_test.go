@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package things
+
+import (
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+var (
+	testAPIUlimit = types.Ulimit{Name: "nofile", Soft: 1024, Hard: -1}
+	testUlimit    = &ulimit{Name: "nofile", Soft: 1024, Hard: -1}
+)
+
+func TestToAPIUlimit(t *testing.T) {
+	testutil.AssertEqual(t, testAPIUlimit, toAPIUlimit(testUlimit))
+}
+
+func TestFromAPIUlimit(t *testing.T) {
+	testutil.AssertEqual(t, testUlimit, fromAPIUlimit(testAPIUlimit))
+}
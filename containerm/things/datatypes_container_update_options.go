@@ -17,6 +17,7 @@ import "github.com/eclipse-kanto/container-management/containerm/containers/type
 type updateOptions struct {
 	RestartPolicy *restartPolicy `json:"restartPolicy,omitempty"`
 	Resources     *resources     `json:"resources,omitempty"`
+	NetworkPolicy *networkPolicy `json:"networkPolicy,omitempty"`
 }
 
 func toAPIUpdateOptions(internalUpdateOpts *updateOptions) *types.UpdateOpts {
@@ -29,6 +30,9 @@ func toAPIUpdateOptions(internalUpdateOpts *updateOptions) *types.UpdateOpts {
 		if internalUpdateOpts.Resources != nil {
 			opts.Resources = toAPIResources(internalUpdateOpts.Resources)
 		}
+		if internalUpdateOpts.NetworkPolicy != nil {
+			opts.NetworkPolicy = toAPINetworkPolicy(internalUpdateOpts.NetworkPolicy)
+		}
 	}
 	return opts
 }
@@ -33,10 +33,12 @@ func TestToAPIUpdateOpts(t *testing.T) {
 				MemoryReservation: testMemoryReservation,
 				MemorySwap:        testMemorySwap,
 			},
+			NetworkPolicy: testNetworkPolicy,
 		}
 		apiOpts := toAPIUpdateOptions(opts)
 		testutil.AssertEqual(t, apiOpts.RestartPolicy, toAPIRestartPolicy(opts.RestartPolicy))
 		testutil.AssertEqual(t, apiOpts.Resources, toAPIResources(opts.Resources))
+		testutil.AssertEqual(t, apiOpts.NetworkPolicy, testAPINetworkPolicy)
 	})
 	t.Run("test_to_api_update_opts_is_nil", func(t *testing.T) {
 		testutil.AssertEqual(t, toAPIUpdateOptions(nil), &types.UpdateOpts{})
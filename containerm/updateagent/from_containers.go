@@ -13,6 +13,7 @@
 package updateagent
 
 import (
+	"sort"
 	"strconv"
 
 	ctrtypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
@@ -97,6 +98,24 @@ func hostConfigParameters(hostConfig *ctrtypes.HostConfig, verbose bool) []*type
 	if verbose || hostConfig.Privileged {
 		appendParameter(&kvPair, keyPrivileged, strconv.FormatBool(hostConfig.Privileged))
 	}
+	if hostConfig.ReadOnlyRootfs {
+		appendParameter(&kvPair, keyReadOnlyRootfs, strconv.FormatBool(hostConfig.ReadOnlyRootfs))
+	}
+	if hostConfig.UsernsMode != "" {
+		appendParameter(&kvPair, keyUsernsMode, string(hostConfig.UsernsMode))
+	}
+	if hostConfig.IpcMode != "" {
+		appendParameter(&kvPair, keyIpcMode, string(hostConfig.IpcMode))
+	}
+	if hostConfig.PidMode != "" {
+		appendParameter(&kvPair, keyPidMode, string(hostConfig.PidMode))
+	}
+	if hostConfig.ShmSize != "" {
+		appendParameter(&kvPair, keyShmSize, hostConfig.ShmSize)
+	}
+	if hostConfig.RuntimeHandler != "" {
+		appendParameter(&kvPair, keyRuntimeHandler, hostConfig.RuntimeHandler)
+	}
 
 	if hostConfig.RestartPolicy != nil {
 		if verbose || hostConfig.RestartPolicy.Type != defaultRestartPolicyType {
@@ -118,13 +137,60 @@ func hostConfigParameters(hostConfig *ctrtypes.HostConfig, verbose bool) []*type
 	if (verbose || hostConfig.NetworkMode != defaultHostConfigNetworkMode) && len(hostConfig.NetworkMode) > 0 {
 		appendParameter(&kvPair, keyNetwork, string(hostConfig.NetworkMode))
 	}
+	// sort the network names to have a stable order of the parameters
+	endpointNetworks := make([]string, 0, len(hostConfig.EndpointsConfig))
+	for network := range hostConfig.EndpointsConfig {
+		endpointNetworks = append(endpointNetworks, network)
+	}
+	sort.Strings(endpointNetworks)
+	for _, network := range endpointNetworks {
+		appendParameter(&kvPair, keyNetworkAddress, util.EndpointConfigToString(network, hostConfig.EndpointsConfig[network]))
+	}
 	for _, host := range hostConfig.ExtraHosts {
 		appendParameter(&kvPair, keyHost, host)
 	}
+	for _, capability := range hostConfig.DroppedCapabilities {
+		appendParameter(&kvPair, keyCapDrop, capability)
+	}
+	for _, server := range hostConfig.DNS {
+		appendParameter(&kvPair, keyDNS, server)
+	}
+	for _, search := range hostConfig.DNSSearch {
+		appendParameter(&kvPair, keyDNSSearch, search)
+	}
+	for _, option := range hostConfig.DNSOptions {
+		appendParameter(&kvPair, keyDNSOption, option)
+	}
+	for _, ulimit := range hostConfig.Ulimits {
+		appendParameter(&kvPair, keyUlimit, util.UlimitToString(&ulimit))
+	}
+	// sort the sysctls to have a stable order of the parameters
+	sysctlKeys := make([]string, 0, len(hostConfig.Sysctls))
+	for key := range hostConfig.Sysctls {
+		sysctlKeys = append(sysctlKeys, key)
+	}
+	sort.Strings(sysctlKeys)
+	for _, key := range sysctlKeys {
+		appendParameter(&kvPair, keySysctl, key+"="+hostConfig.Sysctls[key])
+	}
+	if hostConfig.SecurityOpts != nil {
+		if hostConfig.SecurityOpts.Seccomp != "" {
+			appendParameter(&kvPair, keySeccomp, hostConfig.SecurityOpts.Seccomp)
+		}
+		if hostConfig.SecurityOpts.AppArmorProfile != "" {
+			appendParameter(&kvPair, keyAppArmorProfile, hostConfig.SecurityOpts.AppArmorProfile)
+		}
+		if hostConfig.SecurityOpts.SELinuxLabel != "" {
+			appendParameter(&kvPair, keySELinuxLabel, hostConfig.SecurityOpts.SELinuxLabel)
+		}
+		if hostConfig.SecurityOpts.NoNewPrivileges {
+			appendParameter(&kvPair, keyNoNewPrivileges, strconv.FormatBool(hostConfig.SecurityOpts.NoNewPrivileges))
+		}
+	}
 	if hostConfig.LogConfig != nil {
 		if hostConfig.LogConfig.DriverConfig != nil {
 			logDriverConfig := hostConfig.LogConfig.DriverConfig
-			fileLogging := logDriverConfig.Type == ctrtypes.LogConfigDriverJSONFile
+			fileLogging := logDriverConfig.Type == ctrtypes.LogConfigDriverJSONFile || logDriverConfig.Type == ctrtypes.LogConfigDriverLocal
 			if verbose || (len(logDriverConfig.Type) != 0 && logDriverConfig.Type != defaultLogConfigDriverConfigType) {
 				appendParameter(&kvPair, keyLogDriver, string(logDriverConfig.Type))
 			}
@@ -137,6 +203,21 @@ func hostConfigParameters(hostConfig *ctrtypes.HostConfig, verbose bool) []*type
 			if fileLogging && len(logDriverConfig.RootDir) > 0 {
 				appendParameter(&kvPair, keyLogPath, logDriverConfig.RootDir)
 			}
+			if len(logDriverConfig.Compress) > 0 {
+				appendParameter(&kvPair, keyLogCompress, string(logDriverConfig.Compress))
+			}
+			if len(logDriverConfig.MaxAge) > 0 {
+				appendParameter(&kvPair, keyLogMaxAge, logDriverConfig.MaxAge)
+			}
+			if len(logDriverConfig.Address) > 0 {
+				appendParameter(&kvPair, keyLogAddress, logDriverConfig.Address)
+			}
+			if len(logDriverConfig.Facility) > 0 {
+				appendParameter(&kvPair, keyLogFacility, logDriverConfig.Facility)
+			}
+			if len(logDriverConfig.Tag) > 0 {
+				appendParameter(&kvPair, keyLogTag, logDriverConfig.Tag)
+			}
 		}
 		if hostConfig.LogConfig.ModeConfig != nil {
 			logModeConfig := hostConfig.LogConfig.ModeConfig
@@ -179,12 +260,36 @@ func containerConfigParameters(config *ctrtypes.ContainerConfiguration) []*types
 	for i, cmd := range config.Cmd {
 		kvPair[i+len(config.Env)] = &types.KeyValuePair{Key: keyCmd, Value: cmd}
 	}
+	for _, entrypoint := range config.Entrypoint {
+		appendParameter(&kvPair, keyEntrypoint, entrypoint)
+	}
+	if len(config.WorkingDir) > 0 {
+		appendParameter(&kvPair, keyWorkingDir, config.WorkingDir)
+	}
+	if len(config.User) > 0 {
+		appendParameter(&kvPair, keyUser, config.User)
+	}
+	for _, group := range config.Groups {
+		appendParameter(&kvPair, keyGroup, group)
+	}
+	// sort the labels to have a stable order of the parameters
+	labelKeys := make([]string, 0, len(config.Labels))
+	for key := range config.Labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+	for _, key := range labelKeys {
+		appendParameter(&kvPair, keyLabel, util.LabelToString(key, config.Labels[key]))
+	}
 	return kvPair
 }
 
 func stateParameters(containerState *ctrtypes.State, verbose bool) []*types.KeyValuePair {
 	kvPair := []*types.KeyValuePair{}
 	appendParameter(&kvPair, keyStatus, containerState.Status.String())
+	if containerState.Health != nil {
+		appendParameter(&kvPair, keyHealth, string(containerState.Health.Status))
+	}
 	if verbose || (len(containerState.FinishedAt) > 0 && containerState.Status != ctrtypes.Running) {
 		appendParameter(&kvPair, keyFinishedAt, containerState.FinishedAt)
 	}
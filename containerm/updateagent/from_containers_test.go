@@ -74,6 +74,24 @@ func TestHostConfigParameters(t *testing.T) {
 				},
 			},
 		},
+		"test_host_config_params_read_only_rootfs": {
+			hostConfig: ctrtypes.HostConfig{ReadOnlyRootfs: true},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyReadOnlyRootfs, Value: "true"},
+				},
+				verboseParams: verboseNonPrivilegedKVs,
+			},
+		},
+		"test_host_config_params_userns_mode": {
+			hostConfig: ctrtypes.HostConfig{UsernsMode: ctrtypes.UsernsModeRemap},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyUsernsMode, Value: "remap"},
+				},
+				verboseParams: verboseNonPrivilegedKVs,
+			},
+		},
 		"test_host_config_params_non_privileged": {
 			hostConfig: ctrtypes.HostConfig{Privileged: false},
 			expectedParams: testExpectedParams{
@@ -191,6 +209,52 @@ func TestHostConfigParameters(t *testing.T) {
 				},
 			},
 		},
+		"test_host_config_params_log_driver_json_with_compression_and_max_age": {
+			hostConfig: ctrtypes.HostConfig{LogConfig: &ctrtypes.LogConfiguration{
+				DriverConfig: &ctrtypes.LogDriverConfiguration{Type: ctrtypes.LogConfigDriverJSONFile, MaxFiles: 2, MaxSize: "100M", Compress: ctrtypes.LogCompressionGzip, MaxAge: "72h"},
+			}},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyLogCompress, Value: "gzip"},
+					{Key: keyLogMaxAge, Value: "72h"},
+				},
+				verboseParams: []*types.KeyValuePair{
+					verboseNonPrivilegedKV,
+					{Key: keyLogDriver, Value: "json-file"},
+					{Key: keyLogMaxFiles, Value: "2"},
+					{Key: keyLogMaxSize, Value: "100M"},
+				},
+			},
+		},
+		"test_host_config_params_log_driver_local": {
+			hostConfig: ctrtypes.HostConfig{LogConfig: &ctrtypes.LogConfiguration{
+				DriverConfig: &ctrtypes.LogDriverConfiguration{Type: ctrtypes.LogConfigDriverLocal, MaxFiles: 3, MaxSize: "100M"},
+			}},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyLogDriver, Value: "local"},
+					{Key: keyLogMaxFiles, Value: "3"},
+				},
+				verboseParams: []*types.KeyValuePair{
+					verboseNonPrivilegedKV,
+					{Key: keyLogMaxSize, Value: "100M"},
+				},
+			},
+		},
+		"test_host_config_params_log_driver_syslog": {
+			hostConfig: ctrtypes.HostConfig{LogConfig: &ctrtypes.LogConfiguration{
+				DriverConfig: &ctrtypes.LogDriverConfiguration{Type: ctrtypes.LogConfigDriverSyslog, Address: "udp://192.168.1.10:514", Facility: "local0", Tag: "app"},
+			}},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyLogDriver, Value: "syslog"},
+					{Key: keyLogAddress, Value: "udp://192.168.1.10:514"},
+					{Key: keyLogFacility, Value: "local0"},
+					{Key: keyLogTag, Value: "app"},
+				},
+				verboseParams: verboseNonPrivilegedKVs,
+			},
+		},
 
 		"test_host_config_params_log_mode_blocking": {
 			hostConfig: ctrtypes.HostConfig{LogConfig: &ctrtypes.LogConfiguration{
@@ -317,6 +381,87 @@ func TestHostConfigParametersExtraHosts(t *testing.T) {
 	testutil.AssertEqual(t, len(hostConfig.ExtraHosts), len(params))
 }
 
+func TestHostConfigParametersDroppedCapabilities(t *testing.T) {
+	hostConfig := &ctrtypes.HostConfig{
+		DroppedCapabilities: []string{"CAP_NET_RAW", "CAP_MKNOD"},
+	}
+	params := hostConfigParameters(hostConfig, false)
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keyCapDrop, Value: "CAP_NET_RAW"},
+		{Key: keyCapDrop, Value: "CAP_MKNOD"},
+	}, params)
+}
+
+func TestHostConfigParametersNamespacesUlimitsSysctls(t *testing.T) {
+	hostConfig := &ctrtypes.HostConfig{
+		IpcMode:        ctrtypes.NamespaceModeHost,
+		PidMode:        "container:test-ctr",
+		ShmSize:        "128M",
+		RuntimeHandler: "crun",
+		Ulimits:        []ctrtypes.Ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}, {Name: "memlock", Soft: -1, Hard: -1}},
+		Sysctls:        map[string]string{"net.ipv4.ip_forward": "1", "kernel.msgmax": "65536"},
+	}
+	params := hostConfigParameters(hostConfig, false)
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keyIpcMode, Value: "host"},
+		{Key: keyPidMode, Value: "container:test-ctr"},
+		{Key: keyShmSize, Value: "128M"},
+		{Key: keyRuntimeHandler, Value: "crun"},
+		{Key: keyUlimit, Value: "nofile=1024:4096"},
+		{Key: keyUlimit, Value: "memlock=unlimited:unlimited"},
+		{Key: keySysctl, Value: "kernel.msgmax=65536"},
+		{Key: keySysctl, Value: "net.ipv4.ip_forward=1"},
+	}, params)
+}
+
+func TestHostConfigParametersDNS(t *testing.T) {
+	hostConfig := &ctrtypes.HostConfig{
+		DNS:        []string{"8.8.8.8", "1.1.1.1"},
+		DNSSearch:  []string{"example.com"},
+		DNSOptions: []string{"ndots:2", "rotate"},
+	}
+	params := hostConfigParameters(hostConfig, false)
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keyDNS, Value: "8.8.8.8"},
+		{Key: keyDNS, Value: "1.1.1.1"},
+		{Key: keyDNSSearch, Value: "example.com"},
+		{Key: keyDNSOption, Value: "ndots:2"},
+		{Key: keyDNSOption, Value: "rotate"},
+	}, params)
+}
+
+func TestHostConfigParametersSecurityOpts(t *testing.T) {
+	hostConfig := &ctrtypes.HostConfig{
+		SecurityOpts: &ctrtypes.SecurityOptions{
+			Seccomp:         ctrtypes.SeccompProfileDefault,
+			AppArmorProfile: "kanto-vendor",
+			SELinuxLabel:    "system_u:system_r:container_t:s0",
+			NoNewPrivileges: true,
+		},
+	}
+	params := hostConfigParameters(hostConfig, false)
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keySeccomp, Value: ctrtypes.SeccompProfileDefault},
+		{Key: keyAppArmorProfile, Value: "kanto-vendor"},
+		{Key: keySELinuxLabel, Value: "system_u:system_r:container_t:s0"},
+		{Key: keyNoNewPrivileges, Value: "true"},
+	}, params)
+}
+
+func TestHostConfigParametersNetworkAddresses(t *testing.T) {
+	hostConfig := &ctrtypes.HostConfig{
+		EndpointsConfig: map[string]*ctrtypes.EndpointConfig{
+			"monitoring": {IPv6Address: "fd00::10"},
+			"backend":    {IPv4Address: "172.20.0.10", MacAddress: "02:42:ac:14:00:0a"},
+		},
+	}
+	params := hostConfigParameters(hostConfig, false)
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keyNetworkAddress, Value: "backend,ip=172.20.0.10,mac=02:42:ac:14:00:0a"},
+		{Key: keyNetworkAddress, Value: "monitoring,ip6=fd00::10"},
+	}, params)
+}
+
 func TestMountPointParameters(t *testing.T) {
 	testMounts := []string{
 		"/home/someuser:/home/root:private", "/var:/var:rprivate",
@@ -408,6 +553,28 @@ func TestContainerConfigParameters(t *testing.T) {
 	}
 }
 
+func TestContainerConfigParametersProcess(t *testing.T) {
+	params := containerConfigParameters(&ctrtypes.ContainerConfiguration{
+		Cmd:        []string{"-v"},
+		Entrypoint: []string{"/bin/app", "--debug"},
+		WorkingDir: "/app",
+		User:       "app:1000",
+		Groups:     []string{"audio", "44"},
+		Labels:     map[string]string{"tier": "frontend", "app": "web"},
+	})
+	testutil.AssertEqual(t, []*types.KeyValuePair{
+		{Key: keyCmd, Value: "-v"},
+		{Key: keyEntrypoint, Value: "/bin/app"},
+		{Key: keyEntrypoint, Value: "--debug"},
+		{Key: keyWorkingDir, Value: "/app"},
+		{Key: keyUser, Value: "app:1000"},
+		{Key: keyGroup, Value: "audio"},
+		{Key: keyGroup, Value: "44"},
+		{Key: keyLabel, Value: "app=web"},
+		{Key: keyLabel, Value: "tier=frontend"},
+	}, params)
+}
+
 func TestStateParameters(t *testing.T) {
 	commonVerboseExpectedParams := []*types.KeyValuePair{
 		{Key: keyFinishedAt, Value: ""},
@@ -494,6 +661,20 @@ func TestStateParameters(t *testing.T) {
 				},
 			},
 		},
+		"test_state_params_container_running_unhealthy": {
+			testSetup: func(c *ctrtypes.Container) {
+				c.HealthCheck = &ctrtypes.HealthCheckConfig{Type: ctrtypes.HealthCheckTCP, Port: 80}
+				util.SetContainerStatusRunning(c, 1234)
+				c.State.Health.Status = ctrtypes.HealthUnhealthy
+			},
+			expectedParams: testExpectedParams{
+				nonVerboseParams: []*types.KeyValuePair{
+					{Key: keyStatus, Value: "Running"},
+					{Key: keyHealth, Value: "unhealthy"},
+				},
+				verboseParams: commonVerboseExpectedParams,
+			},
+		},
 		"test_state_params_container_dead": {
 			testSetup: func(c *ctrtypes.Container) { util.SetContainerStatusDead(c) },
 			expectedParams: testExpectedParams{
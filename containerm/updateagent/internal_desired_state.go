@@ -28,20 +28,47 @@ const (
 	keyTerminal                  = "terminal"
 	keyInteractive               = "interactive"
 	keyPrivileged                = "privileged"
+	keyReadOnlyRootfs            = "readOnlyRootfs"
+	keyUsernsMode                = "usernsMode"
+	keyIpcMode                   = "ipcMode"
+	keyPidMode                   = "pidMode"
+	keyShmSize                   = "shmSize"
+	keyRuntimeHandler            = "runtimeHandler"
+	keyUlimit                    = "ulimit"
+	keySysctl                    = "sysctl"
 	keyRestartPolicy             = "restartPolicy"
 	keyRestartMaxRetries         = "restartMaxRetries"
 	keyRestartTimeout            = "restartTimeout"
 	keyDevice                    = "device"
 	keyPort                      = "port"
 	keyNetwork                   = "network"
+	keyNetworkAddress            = "networkAddress"
 	keyHost                      = "host"
+	keyCapDrop                   = "capDrop"
+	keyDNS                       = "dns"
+	keyDNSSearch                 = "dnsSearch"
+	keyDNSOption                 = "dnsOption"
+	keySeccomp                   = "seccomp"
+	keyAppArmorProfile           = "apparmorProfile"
+	keySELinuxLabel              = "selinuxLabel"
+	keyNoNewPrivileges           = "noNewPrivileges"
 	keyMount                     = "mount"
 	keyEnv                       = "env"
 	keyCmd                       = "cmd"
+	keyEntrypoint                = "entrypoint"
+	keyWorkingDir                = "workingDir"
+	keyUser                      = "user"
+	keyGroup                     = "group"
+	keyLabel                     = "label"
 	keyLogDriver                 = "logDriver"
 	keyLogMaxFiles               = "logMaxFiles"
 	keyLogMaxSize                = "logMaxSize"
 	keyLogPath                   = "logPath"
+	keyLogCompress               = "logCompress"
+	keyLogMaxAge                 = "logMaxAge"
+	keyLogAddress                = "logAddress"
+	keyLogFacility               = "logFacility"
+	keyLogTag                    = "logTag"
 	keyLogMode                   = "logMode"
 	keyLogMaxBufferSize          = "logMaxBufferSize"
 	keyMemory                    = "memory"
@@ -52,6 +79,7 @@ const (
 	keyStatus                    = "status"
 	keyFinishedAt                = "finishedAt"
 	keyExitCode                  = "exitCode"
+	keyHealth                    = "health"
 	keyCreated                   = "created"
 	keyRestartCount              = "restartCount"
 	keyManuallyStopped           = "manuallyStopped"
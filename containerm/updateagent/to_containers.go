@@ -40,7 +40,17 @@ func toContainer(component *types.ComponentWithConfig) (*ctrtypes.Container, err
 	var (
 		env            []string
 		cmd            []string
+		entrypoint     []string
+		groups         []string
+		labels         map[string]string
 		extraHosts     []string
+		droppedCaps    []string
+		dns            []string
+		dnsSearch      []string
+		dnsOptions     []string
+		ulimits        []ctrtypes.Ulimit
+		sysctls        map[string]string
+		endpoints      map[string]*ctrtypes.EndpointConfig
 		mountPoints    []ctrtypes.MountPoint
 		portMappings   []ctrtypes.PortMapping
 		deviceMappings []ctrtypes.DeviceMapping
@@ -63,8 +73,45 @@ func toContainer(component *types.ComponentWithConfig) (*ctrtypes.Container, err
 			} else {
 				portMappings = append(portMappings, *portMapping)
 			}
+		case keyNetworkAddress:
+			network, endpoint, err := util.ParseEndpointConfig(keyValuePair.Value)
+			if err != nil {
+				log.WarnErr(err, "Ignoring invalid network address")
+			} else {
+				if endpoints == nil {
+					endpoints = map[string]*ctrtypes.EndpointConfig{}
+				}
+				endpoints[network] = endpoint
+			}
 		case keyHost:
 			extraHosts = append(extraHosts, keyValuePair.Value)
+		case keyCapDrop:
+			droppedCaps = append(droppedCaps, keyValuePair.Value)
+		case keyDNS:
+			dns = append(dns, keyValuePair.Value)
+		case keyDNSSearch:
+			dnsSearch = append(dnsSearch, keyValuePair.Value)
+		case keyDNSOption:
+			dnsOptions = append(dnsOptions, keyValuePair.Value)
+		case keyUlimit:
+			ulimit, err := util.ParseUlimit(keyValuePair.Value)
+			if err != nil {
+				log.WarnErr(err, "Ignoring invalid ulimit")
+			} else {
+				ulimits = append(ulimits, *ulimit)
+			}
+		case keySysctl:
+			sysctl, err := util.ParseSysctls([]string{keyValuePair.Value})
+			if err != nil {
+				log.WarnErr(err, "Ignoring invalid sysctl")
+			} else {
+				if sysctls == nil {
+					sysctls = map[string]string{}
+				}
+				for key, value := range sysctl {
+					sysctls[key] = value
+				}
+			}
 		case keyMount:
 			mountPoint, err := util.ParseMountPoint(keyValuePair.Value)
 			if err != nil {
@@ -76,6 +123,22 @@ func toContainer(component *types.ComponentWithConfig) (*ctrtypes.Container, err
 			env = append(env, keyValuePair.Value)
 		case keyCmd:
 			cmd = append(cmd, keyValuePair.Value)
+		case keyEntrypoint:
+			entrypoint = append(entrypoint, keyValuePair.Value)
+		case keyGroup:
+			groups = append(groups, keyValuePair.Value)
+		case keyLabel:
+			label, err := util.ParseLabels([]string{keyValuePair.Value})
+			if err != nil {
+				log.WarnErr(err, "Ignoring invalid label")
+			} else {
+				if labels == nil {
+					labels = map[string]string{}
+				}
+				for key, value := range label {
+					labels[key] = value
+				}
+			}
 		default:
 			config[keyValuePair.Key] = keyValuePair.Value
 		}
@@ -96,17 +159,35 @@ func toContainer(component *types.ComponentWithConfig) (*ctrtypes.Container, err
 		},
 		Mounts: mountPoints,
 		HostConfig: &ctrtypes.HostConfig{
-			Privileged:   parseBool(keyPrivileged, config),
-			NetworkMode:  ctrtypes.NetworkMode(config[keyNetwork]),
-			Devices:      deviceMappings,
-			ExtraHosts:   extraHosts,
-			PortMappings: portMappings,
+			Privileged:          parseBool(keyPrivileged, config),
+			ReadOnlyRootfs:      parseBool(keyReadOnlyRootfs, config),
+			UsernsMode:          ctrtypes.UsernsMode(config[keyUsernsMode]),
+			IpcMode:             ctrtypes.NamespaceMode(config[keyIpcMode]),
+			PidMode:             ctrtypes.NamespaceMode(config[keyPidMode]),
+			ShmSize:             config[keyShmSize],
+			RuntimeHandler:      config[keyRuntimeHandler],
+			Ulimits:             ulimits,
+			Sysctls:             sysctls,
+			NetworkMode:         ctrtypes.NetworkMode(config[keyNetwork]),
+			Devices:             deviceMappings,
+			ExtraHosts:          extraHosts,
+			DroppedCapabilities: droppedCaps,
+			DNS:                 dns,
+			DNSSearch:           dnsSearch,
+			DNSOptions:          dnsOptions,
+			PortMappings:        portMappings,
+			EndpointsConfig:     endpoints,
 			LogConfig: &ctrtypes.LogConfiguration{
 				DriverConfig: &ctrtypes.LogDriverConfiguration{
 					Type:     ctrtypes.LogDriver(config[keyLogDriver]),
 					MaxFiles: parseInt(keyLogMaxFiles, config),
 					MaxSize:  config[keyLogMaxSize],
 					RootDir:  config[keyLogPath],
+					Compress: ctrtypes.LogCompression(config[keyLogCompress]),
+					MaxAge:   config[keyLogMaxAge],
+					Address:  config[keyLogAddress],
+					Facility: config[keyLogFacility],
+					Tag:      config[keyLogTag],
 				},
 				ModeConfig: &ctrtypes.LogModeConfiguration{
 					Mode:          ctrtypes.LogMode(config[keyLogMode]),
@@ -123,10 +204,24 @@ func toContainer(component *types.ComponentWithConfig) (*ctrtypes.Container, err
 		}
 	}
 
-	if env != nil || cmd != nil {
+	if config[keySeccomp] != "" || config[keyAppArmorProfile] != "" || config[keySELinuxLabel] != "" || parseBool(keyNoNewPrivileges, config) {
+		container.HostConfig.SecurityOpts = &ctrtypes.SecurityOptions{
+			Seccomp:         config[keySeccomp],
+			AppArmorProfile: config[keyAppArmorProfile],
+			SELinuxLabel:    config[keySELinuxLabel],
+			NoNewPrivileges: parseBool(keyNoNewPrivileges, config),
+		}
+	}
+
+	if env != nil || cmd != nil || entrypoint != nil || groups != nil || labels != nil || config[keyWorkingDir] != "" || config[keyUser] != "" {
 		container.Config = &ctrtypes.ContainerConfiguration{
-			Env: env,
-			Cmd: cmd,
+			Env:        env,
+			Cmd:        cmd,
+			Entrypoint: entrypoint,
+			WorkingDir: config[keyWorkingDir],
+			User:       config[keyUser],
+			Groups:     groups,
+			Labels:     labels,
 		}
 	}
 
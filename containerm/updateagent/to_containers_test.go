@@ -61,9 +61,34 @@ func TestToContainer(t *testing.T) {
 			// mounts
 			{Key: "mount", Value: "/tmp:/var/tmp"}, // valid setting
 			{Key: "mount", Value: "/TMP"},          // invalid setting, shall be ignored
+			{Key: "mount", Value: "/etc/config:/config:ro,nosuid"},
 			// extra hosts
 			{Key: "host", Value: "ctr_host"},
 			{Key: "host", Value: "testhost"},
+			// dropped capabilities
+			{Key: "capDrop", Value: "CAP_NET_RAW"},
+			{Key: "capDrop", Value: "CAP_MKNOD"},
+			// DNS
+			{Key: "dns", Value: "8.8.8.8"},
+			{Key: "dns", Value: "1.1.1.1"},
+			{Key: "dnsSearch", Value: "example.com"},
+			{Key: "dnsOption", Value: "ndots:2"},
+			// namespaces, ulimits & sysctls
+			{Key: "ipcMode", Value: "private"},
+			{Key: "pidMode", Value: "private"},
+			{Key: "shmSize", Value: "128M"},
+			{Key: "ulimit", Value: "nofile=1024:4096"},
+			{Key: "ulimit", Value: "nofile"}, // invalid setting, shall be ignored
+			{Key: "sysctl", Value: "net.ipv4.ip_forward=1"},
+			{Key: "sysctl", Value: "kernel.msgmax"}, // invalid setting, shall be ignored
+			// security options
+			{Key: "seccomp", Value: "default"},
+			{Key: "apparmorProfile", Value: "kanto-vendor"},
+			{Key: "selinuxLabel", Value: "system_u:system_r:container_t:s0"},
+			{Key: "noNewPrivileges", Value: "true"},
+			// static network addresses
+			{Key: "networkAddress", Value: "bridge,ip=172.17.0.10,mac=02:42:ac:11:00:0a"}, // valid setting
+			{Key: "networkAddress", Value: "bridge"},                                      // invalid setting, shall be ignored
 			// env & cmd
 			{Key: "env", Value: "DEBUG=true"},
 			{Key: "cmd", Value: "arg1"},
@@ -77,6 +102,22 @@ func TestToContainer(t *testing.T) {
 			{Key: "terminal", Value: "YES"},
 			{Key: "interactive", Value: "1"},
 			{Key: "memory", Value: "50M"},
+			{Key: "readOnlyRootfs", Value: "true"},
+			{Key: "usernsMode", Value: "remap"},
+			{Key: "runtimeHandler", Value: "crun"},
+			// process config & labels
+			{Key: "entrypoint", Value: "/bin/app"},
+			{Key: "workingDir", Value: "/app"},
+			{Key: "user", Value: "app:1000"},
+			{Key: "group", Value: "audio"},
+			{Key: "group", Value: "44"},
+			{Key: "label", Value: "app=web"},
+			{Key: "label", Value: "invalid"}, // invalid setting, shall be ignored
+			// log config
+			{Key: "logDriver", Value: "syslog"},
+			{Key: "logAddress", Value: "udp://192.168.1.10:514"},
+			{Key: "logFacility", Value: "local0"},
+			{Key: "logTag", Value: "app"},
 		},
 	}
 	container, err := toContainer(containerConfig)
@@ -93,15 +134,66 @@ func TestToContainer(t *testing.T) {
 	testutil.AssertEqual(t, uint16(8888), container.HostConfig.PortMappings[0].ContainerPort)
 	testutil.AssertEqual(t, "tcp", container.HostConfig.PortMappings[0].Proto)
 
-	testutil.AssertEqual(t, 1, len(container.Mounts))
+	testutil.AssertEqual(t, 2, len(container.Mounts))
 	testutil.AssertEqual(t, "/tmp", container.Mounts[0].Source)
 	testutil.AssertEqual(t, "/var/tmp", container.Mounts[0].Destination)
+	testutil.AssertEqual(t, "/etc/config", container.Mounts[1].Source)
+	testutil.AssertEqual(t, "/config", container.Mounts[1].Destination)
+	testutil.AssertEqual(t, []string{ctrtypes.MountOptionReadOnly, ctrtypes.MountOptionNoSuid}, container.Mounts[1].Options)
+	testutil.AssertTrue(t, container.HostConfig.ReadOnlyRootfs)
+	testutil.AssertEqual(t, ctrtypes.UsernsModeRemap, container.HostConfig.UsernsMode)
+	testutil.AssertEqual(t, "crun", container.HostConfig.RuntimeHandler)
 
 	testutil.AssertEqual(t, []string{"ctr_host", "testhost"}, container.HostConfig.ExtraHosts)
+	testutil.AssertEqual(t, []string{"CAP_NET_RAW", "CAP_MKNOD"}, container.HostConfig.DroppedCapabilities)
+	testutil.AssertEqual(t, []string{"8.8.8.8", "1.1.1.1"}, container.HostConfig.DNS)
+	testutil.AssertEqual(t, []string{"example.com"}, container.HostConfig.DNSSearch)
+	testutil.AssertEqual(t, []string{"ndots:2"}, container.HostConfig.DNSOptions)
+	testutil.AssertEqual(t, ctrtypes.NamespaceModePrivate, container.HostConfig.IpcMode)
+	testutil.AssertEqual(t, ctrtypes.NamespaceModePrivate, container.HostConfig.PidMode)
+	testutil.AssertEqual(t, "128M", container.HostConfig.ShmSize)
+	testutil.AssertEqual(t, []ctrtypes.Ulimit{{Name: "nofile", Soft: 1024, Hard: 4096}}, container.HostConfig.Ulimits)
+	testutil.AssertEqual(t, map[string]string{"net.ipv4.ip_forward": "1"}, container.HostConfig.Sysctls)
+	testutil.AssertEqual(t, &ctrtypes.SecurityOptions{
+		Seccomp:         ctrtypes.SeccompProfileDefault,
+		AppArmorProfile: "kanto-vendor",
+		SELinuxLabel:    "system_u:system_r:container_t:s0",
+		NoNewPrivileges: true,
+	}, container.HostConfig.SecurityOpts)
+	testutil.AssertEqual(t, map[string]*ctrtypes.EndpointConfig{
+		"bridge": {IPv4Address: "172.17.0.10", MacAddress: "02:42:ac:11:00:0a"},
+	}, container.HostConfig.EndpointsConfig)
 
 	testutil.AssertEqual(t, []string{"arg1", "arg2"}, container.Config.Cmd)
 	testutil.AssertEqual(t, []string{"DEBUG=true", "ENV1="}, container.Config.Env)
+	testutil.AssertEqual(t, []string{"/bin/app"}, container.Config.Entrypoint)
+	testutil.AssertEqual(t, "/app", container.Config.WorkingDir)
+	testutil.AssertEqual(t, "app:1000", container.Config.User)
+	testutil.AssertEqual(t, []string{"audio", "44"}, container.Config.Groups)
+	testutil.AssertEqual(t, map[string]string{"app": "web"}, container.Config.Labels)
 	testutil.AssertEqual(t, &ctrtypes.RestartPolicy{Type: ctrtypes.OnFailure, MaximumRetryCount: 5}, container.HostConfig.RestartPolicy)
 	testutil.AssertEqual(t, &ctrtypes.IOConfig{Tty: false, OpenStdin: true}, container.IOConfig)
 	testutil.AssertEqual(t, &ctrtypes.Resources{Memory: "50M"}, container.HostConfig.Resources)
+	testutil.AssertEqual(t, &ctrtypes.LogDriverConfiguration{
+		Type:     ctrtypes.LogConfigDriverSyslog,
+		Address:  "udp://192.168.1.10:514",
+		Facility: "local0",
+		Tag:      "app",
+	}, container.HostConfig.LogConfig.DriverConfig)
+}
+
+func TestToContainerLogRetention(t *testing.T) {
+	containerConfig := &types.ComponentWithConfig{
+		Component: types.Component{ID: testContainerName, Version: testContainerVersion},
+		Config: []*types.KeyValuePair{
+			{Key: "logCompress", Value: "zstd"},
+			{Key: "logMaxAge", Value: "72h"},
+		},
+	}
+	container, err := toContainer(containerConfig)
+	testutil.AssertNil(t, err)
+
+	testutil.AssertEqual(t, ctrtypes.LogConfigDriverJSONFile, container.HostConfig.LogConfig.DriverConfig.Type)
+	testutil.AssertEqual(t, ctrtypes.LogCompressionZstd, container.HostConfig.LogConfig.DriverConfig.Compress)
+	testutil.AssertEqual(t, "72h", container.HostConfig.LogConfig.DriverConfig.MaxAge)
 }
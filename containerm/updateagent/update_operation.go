@@ -583,6 +583,7 @@ func (o *operation) updateContainer(current *ctrtypes.Container, desired *ctrtyp
 	updateOpts := &ctrtypes.UpdateOpts{
 		RestartPolicy: desired.HostConfig.RestartPolicy,
 		Resources:     desired.HostConfig.Resources,
+		NetworkPolicy: desired.HostConfig.NetworkPolicy,
 	}
 	if err := o.updateManager.mgr.Update(o.ctx, current.ID, updateOpts); err != nil {
 		log.ErrorErr(err, "could not update configuration for container [%s]", desired.Name)
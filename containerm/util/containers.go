@@ -67,6 +67,11 @@ func SetContainerStatusRunning(c *types.Container, pid int64) {
 	c.State.Exited = false
 	c.State.Error = ""
 	c.State.OOMKilled = false
+	if c.HealthCheck != nil {
+		c.State.Health = &types.Health{Status: types.HealthStarting}
+	} else {
+		c.State.Health = nil
+	}
 }
 
 // SetContainerStatusStopped sets the container state to stopped updating all required fields and flags
@@ -81,6 +86,7 @@ func SetContainerStatusStopped(c *types.Container, exitCode int64, errMsg string
 	c.State.Running = false
 	c.State.Restarting = false
 	c.State.Exited = false
+	c.State.Health = nil
 }
 
 // SetContainerStatusExited sets the container state to exited updating all required fields and flags
@@ -95,6 +101,7 @@ func SetContainerStatusExited(c *types.Container, exitCode int64, errMsg string,
 	c.State.Running = false
 	c.State.Restarting = false
 	c.State.Exited = true
+	c.State.Health = nil
 	if oomKilled {
 		c.State.OOMKilled = true
 		if c.State.Error == "" {
@@ -158,6 +165,10 @@ const (
 	jsonFileLogConfigDefaultMaxFile = 2
 
 	logNonBlockingDefaultMaxBufferSize = "1M"
+
+	healthCheckDefaultInterval = 30 * time.Second
+	healthCheckDefaultTimeout  = 30 * time.Second
+	healthCheckDefaultRetries  = 3
 )
 
 // FillDefaults sets all default configurations which are not required as an input but are required for processing the container's configuration
@@ -239,6 +250,10 @@ func FillDefaults(container *types.Container) bool {
 		changesMade = true
 	}
 
+	if container.HealthCheck != nil {
+		changesMade = fillHealthCheck(container) || changesMade
+	}
+
 	if container.Mounts != nil {
 		for idx, mount := range container.Mounts {
 			propMode := mount.PropagationMode
@@ -251,6 +266,9 @@ func FillDefaults(container *types.Container) bool {
 				Destination:     mount.Destination,
 				Source:          mount.Source,
 				PropagationMode: propMode,
+				Type:            mount.Type,
+				VolumePath:      mount.VolumePath,
+				Options:         mount.Options,
 			}
 		}
 	}
@@ -321,7 +339,7 @@ func fillLogDriverConfig(container *types.Container) bool {
 		logCfg.DriverConfig.Type = types.LogConfigDriverJSONFile
 		changesMade = true
 	}
-	if logCfg.DriverConfig.Type == types.LogConfigDriverJSONFile {
+	if logCfg.DriverConfig.Type == types.LogConfigDriverJSONFile || logCfg.DriverConfig.Type == types.LogConfigDriverLocal {
 		if logCfg.DriverConfig.MaxFiles == 0 {
 			log.Debug("log driver max files configuration is not set - setting it to default - %v", jsonFileLogConfigDefaultMaxFile)
 			logCfg.DriverConfig.MaxFiles = jsonFileLogConfigDefaultMaxFile
@@ -332,9 +350,9 @@ func fillLogDriverConfig(container *types.Container) bool {
 			logCfg.DriverConfig.MaxSize = jsonFileLogConfigDefaultMaxSize
 			changesMade = true
 		}
-	} else if logCfg.DriverConfig.Type == types.LogConfigDriverNone {
+	} else if logCfg.DriverConfig.Type == types.LogConfigDriverNone || logCfg.DriverConfig.Type == types.LogConfigDriverSyslog || logCfg.DriverConfig.Type == types.LogConfigDriverJournald {
 		if logCfg.DriverConfig.MaxSize != "" || logCfg.DriverConfig.MaxFiles != 0 {
-			log.Debug("log driver configuration none is not set - discarding file options")
+			log.Debug("log driver configuration %s does not store log files - discarding file options", logCfg.DriverConfig.Type)
 			logCfg.DriverConfig.MaxSize = ""
 			logCfg.DriverConfig.MaxFiles = 0
 			changesMade = true
@@ -405,6 +423,32 @@ func fillPortMappings(container *types.Container) bool {
 	return changesMade
 }
 
+func fillHealthCheck(container *types.Container) bool {
+	changesMade := false
+	healthCheck := container.HealthCheck
+	if healthCheck.Interval == 0 {
+		log.Debug("health check interval is not set - setting it to default - %s", healthCheckDefaultInterval)
+		healthCheck.Interval = healthCheckDefaultInterval
+		changesMade = true
+	}
+	if healthCheck.Timeout == 0 {
+		log.Debug("health check timeout is not set - setting it to default - %s", healthCheckDefaultTimeout)
+		healthCheck.Timeout = healthCheckDefaultTimeout
+		changesMade = true
+	}
+	if healthCheck.Retries == 0 {
+		log.Debug("health check retries are not set - setting them to default - %d", healthCheckDefaultRetries)
+		healthCheck.Retries = healthCheckDefaultRetries
+		changesMade = true
+	}
+	if healthCheck.Type == types.HealthCheckHTTP && healthCheck.Path == "" {
+		log.Debug("health check HTTP path is not set - setting it to default - /")
+		healthCheck.Path = "/"
+		changesMade = true
+	}
+	return changesMade
+}
+
 // CalculateUptime calculates the uptime of a container instance
 func CalculateUptime(container *types.Container) time.Duration {
 	zeroDuration := 0 * time.Second
@@ -441,9 +485,10 @@ func CalculateParallelLimit(n int, limit int) int {
 	return softRlim / overhead
 }
 
-// IsContainerNetworkBridge returns true if the network mode is bridge
+// IsContainerNetworkBridge returns true if the container is connected to the default bridge network or to a user-defined one
 func IsContainerNetworkBridge(container *types.Container) bool {
-	return container.HostConfig != nil && container.HostConfig.NetworkMode == types.NetworkModeBridge
+	return container.HostConfig != nil && container.HostConfig.NetworkMode != "" && container.HostConfig.NetworkMode != types.NetworkModeHost &&
+		container.HostConfig.NetworkMode != types.NetworkModeNone && !IsContainerNetworkContainer(container)
 }
 
 // IsContainerNetworkHost returns true if the network mode is host
@@ -451,6 +496,37 @@ func IsContainerNetworkHost(container *types.Container) bool {
 	return container.HostConfig != nil && container.HostConfig.NetworkMode == types.NetworkModeHost
 }
 
+// IsContainerNetworkNone returns true if the network mode is none
+func IsContainerNetworkNone(container *types.Container) bool {
+	return container.HostConfig != nil && container.HostConfig.NetworkMode == types.NetworkModeNone
+}
+
+// IsContainerNetworkContainer returns true if the network mode is container:<name|id>
+func IsContainerNetworkContainer(container *types.Container) bool {
+	return container.HostConfig != nil && strings.HasPrefix(string(container.HostConfig.NetworkMode), types.NetworkModeContainerPrefix)
+}
+
+// GetContainerNetworkModeTarget returns the name or ID of the container whose network stack is shared when the network mode is container:<name|id>
+func GetContainerNetworkModeTarget(container *types.Container) string {
+	if !IsContainerNetworkContainer(container) {
+		return ""
+	}
+	return strings.TrimPrefix(string(container.HostConfig.NetworkMode), types.NetworkModeContainerPrefix)
+}
+
+// GetNamespaceModeTarget returns the name or ID of the container whose namespace is shared when the namespace mode is container:<name|id>
+func GetNamespaceModeTarget(mode types.NamespaceMode) string {
+	if !strings.HasPrefix(string(mode), types.NamespaceModeContainerPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(string(mode), types.NamespaceModeContainerPrefix)
+}
+
+// IsNamespaceModeShared returns true if the namespace mode is host or container:<name|id>
+func IsNamespaceModeShared(mode types.NamespaceMode) bool {
+	return mode == types.NamespaceModeHost || strings.HasPrefix(string(mode), types.NamespaceModeContainerPrefix)
+}
+
 // CopyContainer creates a new container instance from the provided parameter
 func CopyContainer(source *types.Container) types.Container {
 	return types.Container{
@@ -465,6 +541,7 @@ func CopyContainer(source *types.Container) types.Container {
 		Mounts:                    source.Mounts,
 		Hooks:                     source.Hooks,
 		Config:                    source.Config,
+		HealthCheck:               source.HealthCheck,
 		HostConfig:                source.HostConfig,
 		IOConfig:                  source.IOConfig,
 		NetworkSettings:           source.NetworkSettings,
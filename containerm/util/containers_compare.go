@@ -15,6 +15,7 @@ package util
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 )
@@ -43,7 +44,7 @@ func DetermineUpdateAction(current *types.Container, desired *types.Container) A
 	if !isEqualImage(current.Image, desired.Image) {
 		return ActionRecreate
 	}
-	if !compareSliceSet(current.Mounts, desired.Mounts) {
+	if !isEqualMounts(current.Mounts, desired.Mounts) {
 		return ActionRecreate
 	}
 	if !isEqualContainerConfig(current.Config, desired.Config) {
@@ -82,6 +83,25 @@ func isEqualImage(currentImage types.Image, newImage types.Image) bool {
 	return currentImage.Name == newImage.Name
 }
 
+// isEqualMounts compares the configured mount points only, ignoring the data set by the system such as the paths of the volumes
+func isEqualMounts(currentMounts []types.MountPoint, newMounts []types.MountPoint) bool {
+	return compareSliceSet(mountPointKeys(currentMounts), mountPointKeys(newMounts))
+}
+
+func mountPointKeys(mounts []types.MountPoint) []string {
+	keys := make([]string, len(mounts))
+	for i, mount := range mounts {
+		options := append([]string{}, mount.Options...)
+		sort.Strings(options)
+		mountType := mount.Type
+		if mountType == "" {
+			mountType = types.MountTypeBind
+		}
+		keys[i] = mountType + "|" + MountPointToString(&types.MountPoint{Source: mount.Source, Destination: mount.Destination, PropagationMode: mount.PropagationMode, Options: options})
+	}
+	return keys
+}
+
 func isEqualContainerConfig(currentContainerCfg *types.ContainerConfiguration, newContainerCfg *types.ContainerConfiguration) bool {
 	if currentContainerCfg == nil {
 		return newContainerCfg == nil
@@ -93,8 +113,18 @@ func isEqualContainerConfig(currentContainerCfg *types.ContainerConfiguration, n
 	if !(len(currentContainerCfg.Cmd) == 0 && len(newContainerCfg.Cmd) == 0) && !reflect.DeepEqual(currentContainerCfg.Cmd, newContainerCfg.Cmd) {
 		return false
 	}
+	// the same applies for the Entrypoint
+	if !(len(currentContainerCfg.Entrypoint) == 0 && len(newContainerCfg.Entrypoint) == 0) && !reflect.DeepEqual(currentContainerCfg.Entrypoint, newContainerCfg.Entrypoint) {
+		return false
+	}
+	if currentContainerCfg.WorkingDir != newContainerCfg.WorkingDir || currentContainerCfg.User != newContainerCfg.User {
+		return false
+	}
+	if !(len(currentContainerCfg.Labels) == 0 && len(newContainerCfg.Labels) == 0) && !reflect.DeepEqual(currentContainerCfg.Labels, newContainerCfg.Labels) {
+		return false
+	}
 
-	return compareSliceSet(currentContainerCfg.Env, newContainerCfg.Env)
+	return compareSliceSet(currentContainerCfg.Env, newContainerCfg.Env) && compareSliceSet(currentContainerCfg.Groups, newContainerCfg.Groups)
 }
 
 func isEqualHostConfig0(currentHostConfig *types.HostConfig, newHostConfig *types.HostConfig) bool {
@@ -110,21 +140,64 @@ func isEqualHostConfig0(currentHostConfig *types.HostConfig, newHostConfig *type
 	if currentHostConfig.NetworkMode != newHostConfig.NetworkMode {
 		return false
 	}
+	if currentHostConfig.ReadOnlyRootfs != newHostConfig.ReadOnlyRootfs {
+		return false
+	}
 	if !compareSliceSet(currentHostConfig.Devices, newHostConfig.Devices) {
 		return false
 	}
+	if !compareSliceSet(currentHostConfig.Networks, newHostConfig.Networks) {
+		return false
+	}
+	if !(len(currentHostConfig.EndpointsConfig) == 0 && len(newHostConfig.EndpointsConfig) == 0) && !reflect.DeepEqual(currentHostConfig.EndpointsConfig, newHostConfig.EndpointsConfig) {
+		return false
+	}
 	if !compareSliceSet(currentHostConfig.ExtraHosts, newHostConfig.ExtraHosts) {
 		return false
 	}
+	// the DNS servers and search domains are order-sensitive, that's why compare them with reflect.DeepEqual
+	if !(len(currentHostConfig.DNS) == 0 && len(newHostConfig.DNS) == 0) && !reflect.DeepEqual(currentHostConfig.DNS, newHostConfig.DNS) {
+		return false
+	}
+	if !(len(currentHostConfig.DNSSearch) == 0 && len(newHostConfig.DNSSearch) == 0) && !reflect.DeepEqual(currentHostConfig.DNSSearch, newHostConfig.DNSSearch) {
+		return false
+	}
+	if !compareSliceSet(currentHostConfig.DNSOptions, newHostConfig.DNSOptions) {
+		return false
+	}
 	if !compareSliceSet(currentHostConfig.ExtraCapabilities, newHostConfig.ExtraCapabilities) {
 		return false
 	}
+	if !compareSliceSet(currentHostConfig.DroppedCapabilities, newHostConfig.DroppedCapabilities) {
+		return false
+	}
 	if !compareSliceSet(currentHostConfig.PortMappings, newHostConfig.PortMappings) {
 		return false
 	}
 	if !isEqualLog(currentHostConfig.LogConfig, newHostConfig.LogConfig) {
 		return false
 	}
+	if !isEqualSecurityOptions(currentHostConfig.SecurityOpts, newHostConfig.SecurityOpts) {
+		return false
+	}
+	if currentHostConfig.UsernsMode != newHostConfig.UsernsMode {
+		return false
+	}
+	if currentHostConfig.IpcMode != newHostConfig.IpcMode || currentHostConfig.PidMode != newHostConfig.PidMode {
+		return false
+	}
+	if currentHostConfig.RuntimeHandler != newHostConfig.RuntimeHandler {
+		return false
+	}
+	if currentHostConfig.ShmSize != newHostConfig.ShmSize {
+		return false
+	}
+	if !compareSliceSet(currentHostConfig.Ulimits, newHostConfig.Ulimits) {
+		return false
+	}
+	if !(len(currentHostConfig.Sysctls) == 0 && len(newHostConfig.Sysctls) == 0) && !reflect.DeepEqual(currentHostConfig.Sysctls, newHostConfig.Sysctls) {
+		return false
+	}
 
 	return true
 }
@@ -142,6 +215,10 @@ func isEqualHostConfig1(currentHostConfig *types.HostConfig, newHostConfig *type
 	if !isEqualRestartPolicy(currentHostConfig.RestartPolicy, newHostConfig.RestartPolicy) {
 		return false
 	}
+	if IsNetworkPolicySet(currentHostConfig.NetworkPolicy) != IsNetworkPolicySet(newHostConfig.NetworkPolicy) ||
+		(IsNetworkPolicySet(currentHostConfig.NetworkPolicy) && !reflect.DeepEqual(currentHostConfig.NetworkPolicy, newHostConfig.NetworkPolicy)) {
+		return false
+	}
 	return true
 }
 
@@ -152,7 +229,7 @@ func isEqualResources(currentResources *types.Resources, newResources *types.Res
 	if newResources == nil {
 		return false
 	}
-	return *currentResources == *newResources
+	return reflect.DeepEqual(currentResources, newResources)
 }
 
 func isEqualRestartPolicy(currentRestartPolicy *types.RestartPolicy, newRestartPolicy *types.RestartPolicy) bool {
@@ -165,6 +242,17 @@ func isEqualRestartPolicy(currentRestartPolicy *types.RestartPolicy, newRestartP
 	return *currentRestartPolicy == *newRestartPolicy
 }
 
+// isEqualSecurityOptions compares the security options considering the missing ones equal to the empty ones
+func isEqualSecurityOptions(currentSecurityOpts *types.SecurityOptions, newSecurityOpts *types.SecurityOptions) bool {
+	if currentSecurityOpts == nil {
+		currentSecurityOpts = &types.SecurityOptions{}
+	}
+	if newSecurityOpts == nil {
+		newSecurityOpts = &types.SecurityOptions{}
+	}
+	return *currentSecurityOpts == *newSecurityOpts
+}
+
 func isEqualLog(currentLogConfig *types.LogConfiguration, newLogConfig *types.LogConfiguration) bool {
 	if currentLogConfig == nil {
 		return newLogConfig == nil
@@ -43,16 +43,32 @@ func createContainerWithHostConfig(hostConfig *types.HostConfig) *types.Containe
 
 func copyHostConfig(source *types.HostConfig) *types.HostConfig {
 	return &types.HostConfig{
-		Devices:           source.Devices,
-		NetworkMode:       source.NetworkMode,
-		Privileged:        source.Privileged,
-		RestartPolicy:     source.RestartPolicy,
-		Runtime:           source.Runtime,
-		ExtraHosts:        source.ExtraHosts,
-		ExtraCapabilities: source.ExtraCapabilities,
-		PortMappings:      source.PortMappings,
-		LogConfig:         source.LogConfig,
-		Resources:         source.Resources,
+		Devices:             source.Devices,
+		NetworkMode:         source.NetworkMode,
+		Privileged:          source.Privileged,
+		RestartPolicy:       source.RestartPolicy,
+		Runtime:             source.Runtime,
+		ExtraHosts:          source.ExtraHosts,
+		ExtraCapabilities:   source.ExtraCapabilities,
+		DroppedCapabilities: source.DroppedCapabilities,
+		PortMappings:        source.PortMappings,
+		LogConfig:           source.LogConfig,
+		Resources:           source.Resources,
+		ReadOnlyRootfs:      source.ReadOnlyRootfs,
+		Networks:            source.Networks,
+		EndpointsConfig:     source.EndpointsConfig,
+		NetworkPolicy:       source.NetworkPolicy,
+		DNS:                 source.DNS,
+		DNSSearch:           source.DNSSearch,
+		DNSOptions:          source.DNSOptions,
+		SecurityOpts:        source.SecurityOpts,
+		UsernsMode:          source.UsernsMode,
+		Ulimits:             source.Ulimits,
+		Sysctls:             source.Sysctls,
+		ShmSize:             source.ShmSize,
+		IpcMode:             source.IpcMode,
+		PidMode:             source.PidMode,
+		RuntimeHandler:      source.RuntimeHandler,
 	}
 }
 
@@ -87,6 +103,26 @@ func TestDetermineUpdateAction(t *testing.T) {
 			desired:        createContainerWithMounts("notequal"),
 			expectedResult: ActionRecreate,
 		},
+		"test_mounts_options_equal": {
+			current:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "/host/data", PropagationMode: "private", Options: []string{"ro", "nosuid"}}}},
+			desired:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "/host/data", PropagationMode: "private", Options: []string{"nosuid", "ro"}}}},
+			expectedResult: ActionCheck,
+		},
+		"test_mounts_options_not_equal": {
+			current:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "/host/data", PropagationMode: "private"}}},
+			desired:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "/host/data", PropagationMode: "private", Options: []string{"ro"}}}},
+			expectedResult: ActionRecreate,
+		},
+		"test_mounts_volume_path_ignored": {
+			current:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "data", PropagationMode: "private", Type: types.MountTypeVolume, VolumePath: "/var/lib/volumes/data/_data"}}},
+			desired:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "data", PropagationMode: "private", Type: types.MountTypeVolume}}},
+			expectedResult: ActionCheck,
+		},
+		"test_mounts_type_not_equal": {
+			current:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "data", PropagationMode: "private", Type: types.MountTypeVolume}}},
+			desired:        &types.Container{Mounts: []types.MountPoint{{Destination: "/data", Source: "data", PropagationMode: "private"}}},
+			expectedResult: ActionRecreate,
+		},
 		"test_container_config_equal": {
 			current:        createContainerWithConfig([]string{"testCmd"}),
 			desired:        createContainerWithConfig([]string{"testCmd"}),
@@ -121,6 +157,40 @@ func TestDetermineUpdateAction(t *testing.T) {
 			},
 			expectedResult: ActionRecreate,
 		},
+		"test_container_config_process_equal": {
+			current: &types.Container{
+				Config: &types.ContainerConfiguration{Entrypoint: []string{"/bin/app"}, WorkingDir: "/app", User: "1000:1000", Groups: []string{"audio", "video"}, Labels: map[string]string{"app": "test"}},
+			},
+			desired: &types.Container{
+				Config: &types.ContainerConfiguration{Entrypoint: []string{"/bin/app"}, WorkingDir: "/app", User: "1000:1000", Groups: []string{"video", "audio"}, Labels: map[string]string{"app": "test"}},
+			},
+			expectedResult: ActionCheck,
+		},
+		"test_container_config_entrypoint_not_equal": {
+			current:        &types.Container{Config: &types.ContainerConfiguration{Entrypoint: []string{"/bin/app", "-v"}}},
+			desired:        &types.Container{Config: &types.ContainerConfiguration{Entrypoint: []string{"-v", "/bin/app"}}},
+			expectedResult: ActionRecreate,
+		},
+		"test_container_config_working_dir_not_equal": {
+			current:        &types.Container{Config: &types.ContainerConfiguration{WorkingDir: "/app"}},
+			desired:        &types.Container{Config: &types.ContainerConfiguration{WorkingDir: "/"}},
+			expectedResult: ActionRecreate,
+		},
+		"test_container_config_user_not_equal": {
+			current:        &types.Container{Config: &types.ContainerConfiguration{User: "root"}},
+			desired:        &types.Container{Config: &types.ContainerConfiguration{User: "1000"}},
+			expectedResult: ActionRecreate,
+		},
+		"test_container_config_groups_not_equal": {
+			current:        &types.Container{Config: &types.ContainerConfiguration{Groups: []string{"audio"}}},
+			desired:        &types.Container{Config: &types.ContainerConfiguration{Groups: []string{"audio", "video"}}},
+			expectedResult: ActionRecreate,
+		},
+		"test_container_config_labels_not_equal": {
+			current:        &types.Container{Config: &types.ContainerConfiguration{Labels: map[string]string{"app": "test"}}},
+			desired:        &types.Container{Config: &types.ContainerConfiguration{Labels: map[string]string{"app": "notequal"}}},
+			expectedResult: ActionRecreate,
+		},
 		"test_ioconfig_equal": {
 			current:        createContainerWithIOConfig(true),
 			desired:        createContainerWithIOConfig(true),
@@ -294,6 +364,13 @@ func TestIsEqualHostConfig0(t *testing.T) {
 				return copy
 			}(copyHostConfig(internalHostConfig)),
 		},
+		"test_read_only_rootfs_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.ReadOnlyRootfs = !copy.ReadOnlyRootfs
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
 		"test_networkmode_not_equal": {
 			current: internalHostConfig,
 			desired: func(copy *types.HostConfig) *types.HostConfig {
@@ -301,6 +378,20 @@ func TestIsEqualHostConfig0(t *testing.T) {
 				return copy
 			}(copyHostConfig(internalHostConfig)),
 		},
+		"test_networks_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.Networks = append(copy.Networks, "backend")
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_endpoints_config_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.EndpointsConfig = map[string]*types.EndpointConfig{"bridge": {IPv4Address: "172.17.0.10"}}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
 		"test_devices_not_equal": {
 			current: internalHostConfig,
 			desired: func(copy *types.HostConfig) *types.HostConfig {
@@ -315,6 +406,24 @@ func TestIsEqualHostConfig0(t *testing.T) {
 				return copy
 			}(copyHostConfig(internalHostConfig)),
 		},
+		"test_droppedcapabilities_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DroppedCapabilities = []string{types.CapabilityAll}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_droppedcapabilities_order_equal": {
+			current: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DroppedCapabilities = []string{"CAP_NET_RAW", "CAP_MKNOD"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DroppedCapabilities = []string{"CAP_MKNOD", "CAP_NET_RAW"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			expectedResult: true,
+		},
 		"test_extrahosts_not_equal": {
 			current: internalHostConfig,
 			desired: func(copy *types.HostConfig) *types.HostConfig {
@@ -322,6 +431,114 @@ func TestIsEqualHostConfig0(t *testing.T) {
 				return copy
 			}(copyHostConfig(internalHostConfig)),
 		},
+		"test_dns_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNS = []string{"8.8.8.8"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_dns_order_not_equal": {
+			current: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNS = []string{"8.8.8.8", "1.1.1.1"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNS = []string{"1.1.1.1", "8.8.8.8"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_dns_search_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNSSearch = []string{"example.com"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_dns_options_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNSOptions = []string{"ndots:2"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_security_opts_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.SecurityOpts = &types.SecurityOptions{Seccomp: types.SeccompProfileDefault, NoNewPrivileges: true}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_security_opts_changed_not_equal": {
+			current: func(copy *types.HostConfig) *types.HostConfig {
+				copy.SecurityOpts = &types.SecurityOptions{Seccomp: types.SeccompProfileDefault}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.SecurityOpts = &types.SecurityOptions{Seccomp: "/etc/kanto/seccomp/vendor.json"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_security_opts_empty_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.SecurityOpts = &types.SecurityOptions{}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			expectedResult: true,
+		},
+		"test_userns_mode_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.UsernsMode = types.UsernsModeRemap
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_ipc_mode_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.IpcMode = types.NamespaceModeHost
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_runtime_handler_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.RuntimeHandler = "runsc"
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_ulimits_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.Ulimits = []types.Ulimit{{Name: "memlock", Soft: -1, Hard: -1}}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_sysctls_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.Sysctls = map[string]string{"kernel.shmmax": "68719476736"}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
+		"test_sysctls_empty_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.Sysctls = map[string]string{}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			expectedResult: true,
+		},
+		"test_dns_empty_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.DNS = []string{}
+				copy.DNSSearch = []string{}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			expectedResult: true,
+		},
 		"test_portmappings_not_equal": {
 			current: internalHostConfig,
 			desired: func(copy *types.HostConfig) *types.HostConfig {
@@ -390,6 +607,23 @@ func TestIsEqualHostConfig1(t *testing.T) {
 				return copy
 			}(copyHostConfig(internalHostConfig)),
 		},
+		"test_network_policy_empty_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.NetworkPolicy = &types.NetworkPolicy{}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+			expectedResult: true,
+		},
+		"test_network_policy_not_equal": {
+			current: internalHostConfig,
+			desired: func(copy *types.HostConfig) *types.HostConfig {
+				copy.NetworkPolicy = &types.NetworkPolicy{
+					EgressDefault: types.EgressActionDeny,
+				}
+				return copy
+			}(copyHostConfig(internalHostConfig)),
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -14,6 +14,7 @@ package util
 
 import (
 	"net"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,17 @@ import (
 	"github.com/eclipse-kanto/container-management/containerm/log"
 )
 
+const (
+	endpointConfigKeyIPv4 = "ip"
+	endpointConfigKeyIPv6 = "ip6"
+	endpointConfigKeyMac  = "mac"
+
+	egressRuleKeyProtocol = "proto"
+	egressRuleKeyPorts    = "ports"
+
+	ulimitUnlimited = "unlimited"
+)
+
 // ParseDeviceMappings converts string representations of container's device mappings to structured DeviceMapping instances.
 // The string representation format for a device mapping is defined with ParseDeviceMapping function.
 func ParseDeviceMappings(devices []string) ([]types.DeviceMapping, error) {
@@ -83,9 +95,12 @@ func ParseMountPoints(mps []string) ([]types.MountPoint, error) {
 }
 
 // ParseMountPoint converts a single string representation of a container's mount to a structured MountPoint instance.
-// Format: source:destination[:propagation_mode].
-// If the propagation mode parameter is omitted, rprivate will be set by default.
+// Format: source:destination[:options].
+// If the source is not an absolute path, it is considered to be the name of a named volume.
+// The options are a comma-separated list of a propagation mode and mount options.
+// If the propagation mode is omitted, rprivate will be set by default.
 // Available propagation modes are: rprivate, private, rshared, shared, rslave, slave.
+// Available mount options are: ro, rw, nosuid, suid, noexec, exec, nodev, dev.
 func ParseMountPoint(mp string) (*types.MountPoint, error) {
 	mount := strings.Split(strings.TrimSpace(mp), ":")
 	if len(mount) < 2 || len(mount) > 3 {
@@ -95,15 +110,51 @@ func ParseMountPoint(mp string) (*types.MountPoint, error) {
 		Destination: mount[1],
 		Source:      mount[0],
 	}
-	if len(mount) == 2 {
+	if !filepath.IsAbs(mountPoint.Source) {
+		mountPoint.Type = types.MountTypeVolume
+	}
+	if len(mount) == 3 {
+		for _, option := range strings.Split(mount[2], ",") {
+			option = strings.TrimSpace(option)
+			if _, ok := mountOptions[option]; ok {
+				mountPoint.Options = append(mountPoint.Options, option)
+			} else if mountPoint.PropagationMode == "" {
+				mountPoint.PropagationMode = option
+			} else {
+				return nil, log.NewErrorf("Incorrect options of the mount point %s", mp)
+			}
+		}
+	}
+	if mountPoint.PropagationMode == "" {
 		// if propagation mode is omitted, "rprivate" is set as default
 		mountPoint.PropagationMode = types.RPrivatePropagationMode
-	} else {
-		mountPoint.PropagationMode = mount[2]
 	}
 	return mountPoint, nil
 }
 
+// ParseLabels converts string representations of container's labels to a labels map.
+// The string representation format for a label is <key>=<value>, the value can be empty.
+func ParseLabels(labels []string) (map[string]string, error) {
+	var res map[string]string
+	for _, label := range labels {
+		key, value, found := strings.Cut(label, "=")
+		if !found || key == "" {
+			return nil, log.NewErrorf("Incorrect label configuration %s", label)
+		}
+		if res == nil {
+			res = map[string]string{}
+		}
+		res[key] = value
+	}
+	return res, nil
+}
+
+// LabelToString returns the string representation of the given label.
+// The string representation format for a label is defined with ParseLabels function.
+func LabelToString(key, value string) string {
+	return key + "=" + value
+}
+
 // ParsePortMappings converts string representations of container's port mappings to structured PortMapping instances.
 // The string representation format for a port mapping is defined with ParsePortMapping function.
 func ParsePortMappings(mappings []string) ([]types.PortMapping, error) {
@@ -124,6 +175,7 @@ func ParsePortMappings(mappings []string) ([]types.PortMapping, error) {
 // Mapping the container’s 80 port to a host port in the 5000-6000 range: 5000-6000:80/udp
 // Specifying port protocol (default is tcp): 80:80/udp
 // By default the port mapping will set on all network interfaces, but this is also manageable: 0.0.0.0:80-100:80/udp
+// An IPv6 host ip must be enclosed in square brackets: [2001:db8::1]:80:80
 func ParsePortMapping(mapping string) (*types.PortMapping, error) {
 	var (
 		err           error
@@ -141,7 +193,20 @@ func ParsePortMapping(mapping string) (*types.PortMapping, error) {
 		// port is specified, e.g.80:80/tcp
 		protocol = mappingWithProto[1]
 	}
-	addressAndPorts := strings.Split(strings.TrimSpace(mapping), ":")
+	var addressAndPorts []string
+	if mapping = strings.TrimSpace(mapping); strings.HasPrefix(mapping, "[") {
+		// IPv6 host address, e.g. [::1]:80:80
+		ipEnd := strings.Index(mapping, "]:")
+		if ipEnd == -1 {
+			return nil, log.NewErrorf("Incorrect host ip port mapping configuration %s", mapping0)
+		}
+		addressAndPorts = append([]string{mapping[1:ipEnd]}, strings.Split(mapping[ipEnd+2:], ":")...)
+		if ip := net.ParseIP(addressAndPorts[0]); ip == nil || ip.To4() != nil {
+			return nil, log.NewErrorf("Incorrect host ip port mapping configuration %s", mapping0)
+		}
+	} else {
+		addressAndPorts = strings.Split(mapping, ":")
+	}
 	hostPortIdx := 0 // if host ip not set
 	if len(addressAndPorts) == 3 {
 		hostPortIdx = 1
@@ -179,6 +244,183 @@ func ParsePortMapping(mapping string) (*types.PortMapping, error) {
 	}, nil
 }
 
+// ParseEndpointsConfig converts string representations of container's static endpoint addresses to a map of structured EndpointConfig instances per network name.
+// The string representation format for an endpoint configuration is defined with ParseEndpointConfig function.
+func ParseEndpointsConfig(endpoints []string) (map[string]*types.EndpointConfig, error) {
+	var endpointsConfig map[string]*types.EndpointConfig
+	for _, endpoint := range endpoints {
+		network, epConfig, err := ParseEndpointConfig(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := endpointsConfig[network]; ok {
+			return nil, log.NewErrorf("duplicate endpoint configuration for network %s", network)
+		}
+		if endpointsConfig == nil {
+			endpointsConfig = map[string]*types.EndpointConfig{}
+		}
+		endpointsConfig[network] = epConfig
+	}
+	return endpointsConfig, nil
+}
+
+// ParseEndpointConfig converts a single string representation of a container's static endpoint addresses to the network name and a structured EndpointConfig instance.
+// Format: <network>[,ip=<ipv4>][,ip6=<ipv6>][,mac=<mac>].
+// At least one of the addresses must be set.
+// Example: backend,ip=172.20.0.10,mac=02:42:ac:14:00:0a.
+func ParseEndpointConfig(endpoint string) (string, *types.EndpointConfig, error) {
+	fields := strings.Split(strings.TrimSpace(endpoint), ",")
+	network := strings.TrimSpace(fields[0])
+	if network == "" || len(fields) < 2 {
+		return "", nil, log.NewErrorf("incorrect endpoint configuration %s", endpoint)
+	}
+	epConfig := &types.EndpointConfig{}
+	for _, field := range fields[1:] {
+		key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+		if !found || value == "" {
+			return "", nil, log.NewErrorf("incorrect endpoint configuration %s", endpoint)
+		}
+		switch key {
+		case endpointConfigKeyIPv4:
+			epConfig.IPv4Address = value
+		case endpointConfigKeyIPv6:
+			epConfig.IPv6Address = value
+		case endpointConfigKeyMac:
+			epConfig.MacAddress = value
+		default:
+			return "", nil, log.NewErrorf("unsupported key %s in endpoint configuration %s", key, endpoint)
+		}
+	}
+	return network, epConfig, nil
+}
+
+// ParseEgressRules converts string representations of container's egress rules to structured EgressRule instances preserving their order.
+// The string representation format for an egress rule is defined with ParseEgressRule function.
+func ParseEgressRules(rules []string) ([]types.EgressRule, error) {
+	var egressRules []types.EgressRule
+	for _, rule := range rules {
+		egressRule, err := ParseEgressRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		egressRules = append(egressRules, *egressRule)
+	}
+	return egressRules, nil
+}
+
+// ParseEgressRule converts a single string representation of a container's egress rule to a structured EgressRule instance.
+// Format: <allow|deny>,<cidr>[,proto=<tcp|udp>][,ports=<port>[-<port>]].
+// Example: allow,10.0.0.0/8,proto=tcp,ports=443.
+func ParseEgressRule(rule string) (*types.EgressRule, error) {
+	fields := strings.Split(strings.TrimSpace(rule), ",")
+	if len(fields) < 2 {
+		return nil, log.NewErrorf("incorrect egress rule %s", rule)
+	}
+	egressRule := &types.EgressRule{
+		Action: types.EgressAction(strings.TrimSpace(fields[0])),
+		CIDR:   strings.TrimSpace(fields[1]),
+	}
+	for _, field := range fields[2:] {
+		key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+		if !found || value == "" {
+			return nil, log.NewErrorf("incorrect egress rule %s", rule)
+		}
+		switch key {
+		case egressRuleKeyProtocol:
+			egressRule.Protocol = value
+		case egressRuleKeyPorts:
+			egressRule.Ports = value
+		default:
+			return nil, log.NewErrorf("unsupported key %s in egress rule %s", key, rule)
+		}
+	}
+	return egressRule, nil
+}
+
+// ParseUlimits converts string representations of container's resource limits to structured Ulimit instances.
+// The string representation format for a resource limit is defined with ParseUlimit function.
+func ParseUlimits(ulimits []string) ([]types.Ulimit, error) {
+	var res []types.Ulimit
+	for _, ulimit := range ulimits {
+		parsed, err := ParseUlimit(ulimit)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *parsed)
+	}
+	return res, nil
+}
+
+// ParseUlimit converts a single string representation of a container's resource limit to a structured Ulimit instance.
+// Format: <name>=<soft>[:<hard>], where unlimited or -1 means no limit and the hard limit equals the soft one if not set.
+// Examples: nofile=1024:4096, memlock=unlimited.
+func ParseUlimit(ulimit string) (*types.Ulimit, error) {
+	name, limits, found := strings.Cut(strings.TrimSpace(ulimit), "=")
+	if !found || name == "" {
+		return nil, log.NewErrorf("incorrect ulimit %s", ulimit)
+	}
+	soft, hard, found := strings.Cut(limits, ":")
+	if !found {
+		hard = soft
+	}
+	parse := func(limit string) (int64, error) {
+		if limit == ulimitUnlimited {
+			return -1, nil
+		}
+		return strconv.ParseInt(limit, 10, 64)
+	}
+	softLimit, err := parse(soft)
+	if err != nil {
+		return nil, log.NewErrorf("incorrect soft limit in ulimit %s", ulimit)
+	}
+	hardLimit, err := parse(hard)
+	if err != nil {
+		return nil, log.NewErrorf("incorrect hard limit in ulimit %s", ulimit)
+	}
+	return &types.Ulimit{Name: name, Soft: softLimit, Hard: hardLimit}, nil
+}
+
+// UlimitToString returns the string representation of the given resource limit.
+// The string representation format for a resource limit is defined with ParseUlimit function.
+func UlimitToString(ulimit *types.Ulimit) string {
+	format := func(limit int64) string {
+		if limit == -1 {
+			return ulimitUnlimited
+		}
+		return strconv.FormatInt(limit, 10)
+	}
+	return ulimit.Name + "=" + format(ulimit.Soft) + ":" + format(ulimit.Hard)
+}
+
+// ParseSysctls converts string representations of container's kernel parameters in the format <key>=<value> to a map.
+func ParseSysctls(sysctls []string) (map[string]string, error) {
+	var res map[string]string
+	for _, sysctl := range sysctls {
+		key, value, found := strings.Cut(strings.TrimSpace(sysctl), "=")
+		if !found || key == "" {
+			return nil, log.NewErrorf("incorrect sysctl %s", sysctl)
+		}
+		if res == nil {
+			res = map[string]string{}
+		}
+		res[key] = value
+	}
+	return res, nil
+}
+
+// EgressRuleToString returns the string representation of the given egress rule.
+// The string representation format for an egress rule is defined with ParseEgressRule function.
+func EgressRuleToString(rule *types.EgressRule) string {
+	fields := []string{string(rule.Action), rule.CIDR}
+	if rule.Protocol != "" {
+		fields = append(fields, egressRuleKeyProtocol+"="+rule.Protocol)
+	}
+	if rule.Ports != "" {
+		fields = append(fields, egressRuleKeyPorts+"="+rule.Ports)
+	}
+	return strings.Join(fields, ",")
+}
+
 // DeviceMappingToString returns the string representation of the given device mapping.
 // The string representation format for a device mapping is defined with ParseDeviceMapping function.
 func DeviceMappingToString(deviceMapping *types.DeviceMapping) string {
@@ -204,15 +446,20 @@ func DeviceMappingToString(deviceMapping *types.DeviceMapping) string {
 // MountPointToString returns the string representation of the given mount point.
 // The string representation format for a mount point is defined with ParseMountPoint function.
 func MountPointToString(mountPoint *types.MountPoint) string {
-	return mountPoint.Source + ":" + mountPoint.Destination + ":" + mountPoint.PropagationMode
+	options := append([]string{mountPoint.PropagationMode}, mountPoint.Options...)
+	return mountPoint.Source + ":" + mountPoint.Destination + ":" + strings.Join(options, ",")
 }
 
 // PortMappingToString returns the string representation of the given port mapping.
 // The string representation format for a port mapping is defined with ParsePortMapping function.
 func PortMappingToString(portMapping *types.PortMapping) string {
 	var ports strings.Builder
-	if len(portMapping.HostIP) > 0 && portMapping.HostIP != "0.0.0.0" { //ex. 1.2.3.4:80:80
-		ports.WriteString(portMapping.HostIP)
+	if len(portMapping.HostIP) > 0 && portMapping.HostIP != "0.0.0.0" { //ex. 1.2.3.4:80:80 or [2001:db8::1]:80:80
+		if strings.Contains(portMapping.HostIP, ":") {
+			ports.WriteString("[" + portMapping.HostIP + "]")
+		} else {
+			ports.WriteString(portMapping.HostIP)
+		}
 		ports.WriteRune(':')
 	}
 	if portMapping.HostPort != 0 {
@@ -232,3 +479,20 @@ func PortMappingToString(portMapping *types.PortMapping) string {
 	}
 	return ports.String()
 }
+
+// EndpointConfigToString returns the string representation of the given static endpoint addresses in the given network.
+// The string representation format for an endpoint configuration is defined with ParseEndpointConfig function.
+func EndpointConfigToString(network string, epConfig *types.EndpointConfig) string {
+	var endpoint strings.Builder
+	endpoint.WriteString(network)
+	if len(epConfig.IPv4Address) > 0 {
+		endpoint.WriteString("," + endpointConfigKeyIPv4 + "=" + epConfig.IPv4Address)
+	}
+	if len(epConfig.IPv6Address) > 0 {
+		endpoint.WriteString("," + endpointConfigKeyIPv6 + "=" + epConfig.IPv6Address)
+	}
+	if len(epConfig.MacAddress) > 0 {
+		endpoint.WriteString("," + endpointConfigKeyMac + "=" + epConfig.MacAddress)
+	}
+	return endpoint.String()
+}
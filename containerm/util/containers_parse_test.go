@@ -190,6 +190,33 @@ func TestParseMountPoints(t *testing.T) {
 				PropagationMode: types.RSlavePropagationMode,
 			},
 		},
+		"test_parse_mount_point_valid_input_volume": {
+			inputString: "data-volume:/data",
+			expectedMount: &types.MountPoint{
+				Source:          "data-volume",
+				Destination:     "/data",
+				PropagationMode: types.RPrivatePropagationMode,
+				Type:            types.MountTypeVolume,
+			},
+		},
+		"test_parse_mount_point_valid_input_options": {
+			inputString: "/data:/data:ro",
+			expectedMount: &types.MountPoint{
+				Source:          "/data",
+				Destination:     "/data",
+				PropagationMode: types.RPrivatePropagationMode,
+				Options:         []string{types.MountOptionReadOnly},
+			},
+		},
+		"test_parse_mount_point_valid_input_propagation_mode_and_options": {
+			inputString: "/data:/data:nosuid,rshared,ro,noexec",
+			expectedMount: &types.MountPoint{
+				Source:          "/data",
+				Destination:     "/data",
+				PropagationMode: types.RSharedPropagationMode,
+				Options:         []string{types.MountOptionNoSuid, types.MountOptionReadOnly, types.MountOptionNoExec},
+			},
+		},
 	}
 
 	index := 0
@@ -232,6 +259,10 @@ func TestParseMountPointsError(t *testing.T) {
 			inputString: "/data:/data:private:shared",
 			errMessage:  "Incorrect number of parameters of the mount point",
 		},
+		"test_parse_mount_point_input_multiple_propagation_modes": {
+			inputString: "/data:/data:private,shared",
+			errMessage:  "Incorrect options of the mount point",
+		},
 		"test_parse_mount_point_input_too_short": {
 			inputString: "/home",
 			errMessage:  "Incorrect number of parameters of the mount point",
@@ -291,6 +322,16 @@ func TestParsePortMappings(t *testing.T) {
 				HostIP:        "192.168.0.1",
 			},
 		},
+		"test_parse_port_mapping_input_ipv6_host_ip_included": {
+			inputString: "[2001:db8::1]:7000-8000:8081/tcp",
+			expectedPort: &types.PortMapping{
+				Proto:         "tcp",
+				ContainerPort: 8081,
+				HostPort:      7000,
+				HostPortEnd:   8000,
+				HostIP:        "2001:db8::1",
+			},
+		},
 	}
 
 	index := 0
@@ -340,6 +381,18 @@ func TestParsePortMappingsError(t *testing.T) {
 			inputString: "192.168.1.300:8080:8080",
 			errMessage:  "Incorrect host ip port mapping configuration",
 		},
+		"test_parse_port_mapping_invalid_ipv6_host_ip": {
+			inputString: "[2001:db8::zz]:8080:8080",
+			errMessage:  "Incorrect host ip port mapping configuration",
+		},
+		"test_parse_port_mapping_ipv4_host_ip_in_brackets": {
+			inputString: "[192.168.1.1]:8080:8080",
+			errMessage:  "Incorrect host ip port mapping configuration",
+		},
+		"test_parse_port_mapping_ipv6_host_ip_without_brackets": {
+			inputString: "2001:db8::1:8080:8080",
+			errMessage:  "Incorrect port mapping configuration",
+		},
 		"test_parse_port_mapping_invalid_host_port": {
 			inputString: "FF00:8080",
 			errMessage:  "Incorrect host port mapping configuration",
@@ -369,3 +422,205 @@ func TestParsePortMappingsError(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLabels(t *testing.T) {
+	testCases := map[string]struct {
+		input          []string
+		expectedLabels map[string]string
+		errMessage     string
+	}{
+		"test_parse_labels_nil": {},
+		"test_parse_labels_valid": {
+			input:          []string{"app=test", "empty=", "url=http://host?a=b"},
+			expectedLabels: map[string]string{"app": "test", "empty": "", "url": "http://host?a=b"},
+		},
+		"test_parse_labels_no_separator": {
+			input:      []string{"app=test", "app"},
+			errMessage: "Incorrect label configuration app",
+		},
+		"test_parse_labels_empty_key": {
+			input:      []string{"=test"},
+			errMessage: "Incorrect label configuration =test",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			res, err := ParseLabels(testCase.input)
+			if testCase.errMessage != "" {
+				testutil.AssertError(t, log.NewError(testCase.errMessage), err)
+				testutil.AssertNil(t, res)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedLabels, res)
+			for key, value := range res {
+				parsed, err := ParseLabels([]string{LabelToString(key, value)})
+				testutil.AssertNil(t, err)
+				testutil.AssertEqual(t, map[string]string{key: value}, parsed)
+			}
+		})
+	}
+}
+
+func TestParseEndpointsConfig(t *testing.T) {
+	testCases := map[string]struct {
+		input             []string
+		expectedEndpoints map[string]*types.EndpointConfig
+		errMessage        string
+	}{
+		"test_parse_endpoints_config_nil": {},
+		"test_parse_endpoints_config_valid": {
+			input: []string{"bridge,ip=172.17.0.10", "backend, ip=172.20.0.10, ip6=fd00::10, mac=02:42:ac:14:00:0a"},
+			expectedEndpoints: map[string]*types.EndpointConfig{
+				"bridge":  {IPv4Address: "172.17.0.10"},
+				"backend": {IPv4Address: "172.20.0.10", IPv6Address: "fd00::10", MacAddress: "02:42:ac:14:00:0a"},
+			},
+		},
+		"test_parse_endpoints_config_no_addresses": {
+			input:      []string{"bridge"},
+			errMessage: "incorrect endpoint configuration bridge",
+		},
+		"test_parse_endpoints_config_no_network": {
+			input:      []string{",ip=172.17.0.10"},
+			errMessage: "incorrect endpoint configuration ,ip=172.17.0.10",
+		},
+		"test_parse_endpoints_config_empty_value": {
+			input:      []string{"bridge,ip="},
+			errMessage: "incorrect endpoint configuration bridge,ip=",
+		},
+		"test_parse_endpoints_config_unsupported_key": {
+			input:      []string{"bridge,gateway=172.17.0.1"},
+			errMessage: "unsupported key gateway in endpoint configuration bridge,gateway=172.17.0.1",
+		},
+		"test_parse_endpoints_config_duplicate_network": {
+			input:      []string{"bridge,ip=172.17.0.10", "bridge,mac=02:42:ac:11:00:0a"},
+			errMessage: "duplicate endpoint configuration for network bridge",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			res, err := ParseEndpointsConfig(testCase.input)
+			if testCase.errMessage != "" {
+				testutil.AssertError(t, log.NewError(testCase.errMessage), err)
+				testutil.AssertNil(t, res)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedEndpoints, res)
+			for network, epConfig := range res {
+				parsedNetwork, parsed, err := ParseEndpointConfig(EndpointConfigToString(network, epConfig))
+				testutil.AssertNil(t, err)
+				testutil.AssertEqual(t, network, parsedNetwork)
+				testutil.AssertEqual(t, epConfig, parsed)
+			}
+		})
+	}
+}
+
+func TestParseEgressRules(t *testing.T) {
+	testCases := map[string]struct {
+		input         []string
+		expectedRules []types.EgressRule
+		errMessage    string
+	}{
+		"test_parse_egress_rules_nil": {},
+		"test_parse_egress_rules_valid": {
+			input: []string{"allow,10.0.0.0/8,proto=tcp,ports=443", "allow, 2001:db8::/32, proto=udp", "deny,0.0.0.0/0"},
+			expectedRules: []types.EgressRule{
+				{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "443"},
+				{Action: types.EgressActionAllow, CIDR: "2001:db8::/32", Protocol: "udp"},
+				{Action: types.EgressActionDeny, CIDR: "0.0.0.0/0"},
+			},
+		},
+		"test_parse_egress_rules_no_destination": {
+			input:      []string{"deny"},
+			errMessage: "incorrect egress rule deny",
+		},
+		"test_parse_egress_rules_empty_value": {
+			input:      []string{"deny,10.0.0.0/8,ports="},
+			errMessage: "incorrect egress rule deny,10.0.0.0/8,ports=",
+		},
+		"test_parse_egress_rules_unsupported_key": {
+			input:      []string{"deny,10.0.0.0/8,port=80"},
+			errMessage: "unsupported key port in egress rule deny,10.0.0.0/8,port=80",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			res, err := ParseEgressRules(testCase.input)
+			if testCase.errMessage != "" {
+				testutil.AssertError(t, log.NewError(testCase.errMessage), err)
+				testutil.AssertNil(t, res)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedRules, res)
+			for _, rule := range res {
+				parsed, err := ParseEgressRule(EgressRuleToString(&rule))
+				testutil.AssertNil(t, err)
+				testutil.AssertEqual(t, &rule, parsed)
+			}
+		})
+	}
+}
+
+func TestParseUlimits(t *testing.T) {
+	testCases := map[string]struct {
+		input           []string
+		expectedUlimits []types.Ulimit
+		errMessage      string
+	}{
+		"test_parse_ulimits_nil": {},
+		"test_parse_ulimits_valid": {
+			input: []string{"nofile=1024:4096", "memlock=unlimited", "nproc=512:-1"},
+			expectedUlimits: []types.Ulimit{
+				{Name: "nofile", Soft: 1024, Hard: 4096},
+				{Name: "memlock", Soft: -1, Hard: -1},
+				{Name: "nproc", Soft: 512, Hard: -1},
+			},
+		},
+		"test_parse_ulimits_no_limits": {
+			input:      []string{"nofile"},
+			errMessage: "incorrect ulimit nofile",
+		},
+		"test_parse_ulimits_invalid_soft": {
+			input:      []string{"nofile=many:4096"},
+			errMessage: "incorrect soft limit in ulimit nofile=many:4096",
+		},
+		"test_parse_ulimits_invalid_hard": {
+			input:      []string{"nofile=1024:"},
+			errMessage: "incorrect hard limit in ulimit nofile=1024:",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			res, err := ParseUlimits(testCase.input)
+			if testCase.errMessage != "" {
+				testutil.AssertError(t, log.NewError(testCase.errMessage), err)
+				testutil.AssertNil(t, res)
+				return
+			}
+			testutil.AssertNil(t, err)
+			testutil.AssertEqual(t, testCase.expectedUlimits, res)
+			for _, ulimit := range res {
+				parsed, err := ParseUlimit(UlimitToString(&ulimit))
+				testutil.AssertNil(t, err)
+				testutil.AssertEqual(t, &ulimit, parsed)
+			}
+		})
+	}
+}
+
+func TestParseSysctls(t *testing.T) {
+	res, err := ParseSysctls([]string{"kernel.shmmax=68719476736", "net.ipv4.ping_group_range=0 2147483647"})
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, map[string]string{"kernel.shmmax": "68719476736", "net.ipv4.ping_group_range": "0 2147483647"}, res)
+
+	res, err = ParseSysctls([]string{"kernel.shmmax"})
+	testutil.AssertError(t, log.NewError("incorrect sysctl kernel.shmmax"), err)
+	testutil.AssertNil(t, res)
+}
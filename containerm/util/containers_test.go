@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
@@ -525,6 +526,34 @@ func TestFillDefaultsOnCleanContainer(t *testing.T) {
 		}
 	})
 
+	t.Run("test_fill_defaults_host_config_log_config_drivers", func(t *testing.T) {
+		ctrLocal := &types.Container{
+			HostConfig: &types.HostConfig{
+				LogConfig: &types.LogConfiguration{
+					DriverConfig: &types.LogDriverConfiguration{Type: types.LogConfigDriverLocal},
+				},
+			},
+		}
+		FillDefaults(ctrLocal)
+		if ctrLocal.HostConfig.LogConfig.DriverConfig.MaxFiles != jsonFileLogConfigDefaultMaxFile || ctrLocal.HostConfig.LogConfig.DriverConfig.MaxSize != jsonFileLogConfigDefaultMaxSize {
+			t.Errorf("container host config local log config unexpected file options: %+v", ctrLocal.HostConfig.LogConfig.DriverConfig)
+		}
+		ctrSyslog := &types.Container{
+			HostConfig: &types.HostConfig{
+				LogConfig: &types.LogConfiguration{
+					DriverConfig: &types.LogDriverConfiguration{Type: types.LogConfigDriverSyslog, MaxFiles: 3, MaxSize: "1M", Tag: "tag"},
+				},
+			},
+		}
+		FillDefaults(ctrSyslog)
+		if ctrSyslog.HostConfig.LogConfig.DriverConfig.MaxFiles != 0 || ctrSyslog.HostConfig.LogConfig.DriverConfig.MaxSize != "" {
+			t.Errorf("container host config syslog log config file options not discarded: %+v", ctrSyslog.HostConfig.LogConfig.DriverConfig)
+		}
+		if ctrSyslog.HostConfig.LogConfig.DriverConfig.Tag != "tag" {
+			t.Errorf("container host config syslog log config unexpected tag: %s", ctrSyslog.HostConfig.LogConfig.DriverConfig.Tag)
+		}
+	})
+
 	t.Run("test_fill_defaults_host_config_port_mappings", func(t *testing.T) {
 		ctrPorts := &types.Container{
 			HostConfig: &types.HostConfig{
@@ -663,6 +692,49 @@ func TestFillResources(t *testing.T) {
 	})
 }
 
+func TestFillHealthCheck(t *testing.T) {
+	t.Run("test_fill_health_check_defaults", func(t *testing.T) {
+		ctr := &types.Container{
+			HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckHTTP, Port: 80},
+		}
+		testutil.AssertTrue(t, fillHealthCheck(ctr))
+		testutil.AssertEqual(t, &types.HealthCheckConfig{
+			Type:     types.HealthCheckHTTP,
+			Port:     80,
+			Path:     "/",
+			Interval: healthCheckDefaultInterval,
+			Timeout:  healthCheckDefaultTimeout,
+			Retries:  healthCheckDefaultRetries,
+		}, ctr.HealthCheck)
+	})
+	t.Run("test_fill_health_check_already_filled", func(t *testing.T) {
+		ctr := &types.Container{
+			HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Interval: time.Second, Timeout: time.Second, Retries: 1},
+		}
+		testutil.AssertFalse(t, fillHealthCheck(ctr))
+	})
+}
+
+func TestSetContainerStatusHealth(t *testing.T) {
+	ctr := &types.Container{
+		HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80},
+		State:       &types.State{},
+	}
+	SetContainerStatusRunning(ctr, 1)
+	testutil.AssertEqual(t, &types.Health{Status: types.HealthStarting}, ctr.State.Health)
+
+	SetContainerStatusStopped(ctr, 0, "")
+	testutil.AssertNil(t, ctr.State.Health)
+
+	SetContainerStatusRunning(ctr, 1)
+	SetContainerStatusExited(ctr, 1, "", false)
+	testutil.AssertNil(t, ctr.State.Health)
+
+	ctr.HealthCheck = nil
+	SetContainerStatusRunning(ctr, 1)
+	testutil.AssertNil(t, ctr.State.Health)
+}
+
 func TestCalculateUptime(t *testing.T) {
 	t.Run("test_calculate_uptime_no_state", func(t *testing.T) {
 		ctr := &types.Container{}
@@ -751,6 +823,49 @@ func TestGetImageHost(t *testing.T) {
 	}
 }
 
+func TestContainerNetworkModes(t *testing.T) {
+	testCases := map[string]struct {
+		networkMode    types.NetworkMode
+		expectedBridge bool
+		expectedHost   bool
+		expectedNone   bool
+		expectedTarget string
+	}{
+		"test_bridge": {
+			networkMode:    types.NetworkModeBridge,
+			expectedBridge: true,
+		},
+		"test_user_defined": {
+			networkMode:    "backend",
+			expectedBridge: true,
+		},
+		"test_host": {
+			networkMode:  types.NetworkModeHost,
+			expectedHost: true,
+		},
+		"test_none": {
+			networkMode:  types.NetworkModeNone,
+			expectedNone: true,
+		},
+		"test_container": {
+			networkMode:    types.NetworkModeContainerPrefix + "web",
+			expectedTarget: "web",
+		},
+	}
+
+	for testName, testData := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Log(testName)
+			ctr := &types.Container{HostConfig: &types.HostConfig{NetworkMode: testData.networkMode}}
+			testutil.AssertEqual(t, testData.expectedBridge, IsContainerNetworkBridge(ctr))
+			testutil.AssertEqual(t, testData.expectedHost, IsContainerNetworkHost(ctr))
+			testutil.AssertEqual(t, testData.expectedNone, IsContainerNetworkNone(ctr))
+			testutil.AssertEqual(t, testData.expectedTarget != "", IsContainerNetworkContainer(ctr))
+			testutil.AssertEqual(t, testData.expectedTarget, GetContainerNetworkModeTarget(ctr))
+		})
+	}
+}
+
 func TestReadContainer(t *testing.T) {
 	const prefix = "container-management-test-"
 
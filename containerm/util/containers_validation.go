@@ -13,22 +13,96 @@
 package util
 
 import (
+	"math"
+	"net"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/containerd/containerd/pkg/cap"
 	"github.com/eclipse-kanto/container-management/containerm/containers/types"
 	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/logger/syslog"
 )
 
 const (
 	containerNameRegexp      = "^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 	extraHostsReservedRegexp = "^(.+):host_ip(_(.+))?$"
 	envVarRegexp             = "^[a-zA-Z_]([a-zA-Z0-9_]*)(|=(.*))$"
+	cpuSetRegexp             = "^[0-9]+(-[0-9]+)?(,[0-9]+(-[0-9]+)?)*$"
+	volumeNameRegexp         = "^[a-zA-Z0-9][a-zA-Z0-9_.-]*$"
+	networkNameRegexp        = "^[a-zA-Z0-9][a-zA-Z0-9_.-]*$"
+	userRegexp               = "^[^:\\s]+(:[^:\\s]+)?$"
+	groupRegexp              = "^[^:\\s]+$"
+	portRangeRegexp          = "^([0-9]+)(-([0-9]+))?$"
+	dnsSearchDomainRegexp    = "^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*\\.?$"
+	dnsOptionRegexp          = "^[a-zA-Z0-9_-]+(:[0-9]+)?$"
+	appArmorProfileRegexp    = "^\\S+$"
+	seLinuxLabelRegexp       = "^[^:\\s]+:[^:\\s]+:[^:\\s]+:\\S+$"
+
+	// limits of the CPU CFS quota and period in microseconds as accepted by the kernel
+	cpuCFSMin = 1000
+	cpuCFSMax = 1000000
+	// limits of the CPU shares as accepted by the kernel
+	cpuSharesMin = 2
+	cpuSharesMax = 262144
+	// limits of the block IO weight as accepted by the kernel
+	blkioWeightMin = 10
+	blkioWeightMax = 1000
+	// max length of a domain name
+	dnsSearchDomainMaxLength = 253
+	// default max number of open files per process accepted by the kernel (fs.nr_open)
+	nofileMax = 1048576
 )
 
 var (
 	containerNameRegex      = regexp.MustCompile(containerNameRegexp)
 	extraHostsReservedRegex = regexp.MustCompile(extraHostsReservedRegexp)
 	envVarRegex             = regexp.MustCompile(envVarRegexp)
+	cpuSetRegex             = regexp.MustCompile(cpuSetRegexp)
+	volumeNameRegex         = regexp.MustCompile(volumeNameRegexp)
+	networkNameRegex        = regexp.MustCompile(networkNameRegexp)
+	userRegex               = regexp.MustCompile(userRegexp)
+	groupRegex              = regexp.MustCompile(groupRegexp)
+	portRangeRegex          = regexp.MustCompile(portRangeRegexp)
+	dnsSearchDomainRegex    = regexp.MustCompile(dnsSearchDomainRegexp)
+	dnsOptionRegex          = regexp.MustCompile(dnsOptionRegexp)
+	appArmorProfileRegex    = regexp.MustCompile(appArmorProfileRegexp)
+	seLinuxLabelRegex       = regexp.MustCompile(seLinuxLabelRegexp)
+
+	knownCapabilities = func() map[string]bool {
+		capabilities := map[string]bool{}
+		for _, capability := range cap.Known() {
+			capabilities[capability] = true
+		}
+		return capabilities
+	}()
+
+	knownUlimits = map[string]bool{
+		"as": true, "core": true, "cpu": true, "data": true, "fsize": true, "locks": true, "memlock": true, "msgqueue": true,
+		"nice": true, "nofile": true, "nproc": true, "rss": true, "rtprio": true, "rttime": true, "sigpending": true, "stack": true,
+	}
+
+	// the sysctls of the IPC namespace, the ones of the network namespace are the net.* ones
+	ipcNamespaceSysctls = map[string]bool{
+		"kernel.msgmax": true, "kernel.msgmnb": true, "kernel.msgmni": true, "kernel.sem": true,
+		"kernel.shmall": true, "kernel.shmmax": true, "kernel.shmmni": true, "kernel.shm_rmid_forced": true,
+	}
+
+	// supported mount options mapped to the ones they conflict with
+	mountOptions = map[string]string{
+		types.MountOptionReadOnly:  types.MountOptionReadWrite,
+		types.MountOptionReadWrite: types.MountOptionReadOnly,
+		types.MountOptionNoSuid:    types.MountOptionSuid,
+		types.MountOptionSuid:      types.MountOptionNoSuid,
+		types.MountOptionNoExec:    types.MountOptionExec,
+		types.MountOptionExec:      types.MountOptionNoExec,
+		types.MountOptionNoDev:     types.MountOptionDev,
+		types.MountOptionDev:       types.MountOptionNoDev,
+	}
 )
 
 // ValidateContainer validats all container properties
@@ -54,6 +128,9 @@ func ValidateContainer(container *types.Container) error {
 	if err := ValidateConfig(container.Config); err != nil {
 		return err
 	}
+	if err := ValidateHealthCheck(container.HealthCheck); err != nil {
+		return err
+	}
 	if container.IOConfig == nil {
 		return log.NewError("the container's IO config is missing")
 	}
@@ -87,6 +164,9 @@ func ValidatePrivileged(hostConfig *types.HostConfig) error {
 		if hostConfig.ExtraCapabilities != nil {
 			return log.NewError("cannot create the container as privileged and with extra capabilities at the same time - choose one of the options")
 		}
+		if hostConfig.DroppedCapabilities != nil {
+			return log.NewError("cannot create the container as privileged and with dropped capabilities at the same time - choose one of the options")
+		}
 	}
 	return nil
 }
@@ -96,9 +176,30 @@ func ValidateHostConfig(hostConfig *types.HostConfig) error {
 	if err := ValidatePrivileged(hostConfig); err != nil {
 		return err
 	}
+	if err := ValidateDroppedCapabilities(hostConfig.DroppedCapabilities); err != nil {
+		return err
+	}
+	if err := ValidateUsernsMode(hostConfig); err != nil {
+		return err
+	}
+	if err := ValidateNamespaceModes(hostConfig); err != nil {
+		return err
+	}
+	if err := ValidateUlimits(hostConfig.Ulimits); err != nil {
+		return err
+	}
+	if err := ValidateSysctls(hostConfig); err != nil {
+		return err
+	}
+	if err := ValidateShmSize(hostConfig); err != nil {
+		return err
+	}
 	if err := ValidateNetworking(hostConfig); err != nil {
 		return err
 	}
+	if err := ValidateSecurityOptions(hostConfig); err != nil {
+		return err
+	}
 	if err := ValidateDeviceMappings(hostConfig.Devices); err != nil {
 		return err
 	}
@@ -114,6 +215,144 @@ func ValidateHostConfig(hostConfig *types.HostConfig) error {
 	return nil
 }
 
+// ValidateDroppedCapabilities validates that the dropped capabilities are known ones or the ALL keyword
+func ValidateDroppedCapabilities(capabilities []string) error {
+	for _, capability := range capabilities {
+		if capability != types.CapabilityAll && !knownCapabilities[capability] {
+			return log.NewErrorf("unknown capability %s, must be a known capability, e.g. CAP_NET_RAW, or %s", capability, types.CapabilityAll)
+		}
+	}
+	return nil
+}
+
+// ValidateUsernsMode validates the user namespace mode of the container
+func ValidateUsernsMode(hostConfig *types.HostConfig) error {
+	switch hostConfig.UsernsMode {
+	case "", types.UsernsModeHost:
+		return nil
+	case types.UsernsModeRemap:
+		if hostConfig.Privileged {
+			return log.NewError("cannot create the container as privileged and with a remapped user namespace at the same time - choose one of the options")
+		}
+		return nil
+	default:
+		return log.NewErrorf("unsupported user namespace mode %s, must be %s or %s", hostConfig.UsernsMode, types.UsernsModeHost, types.UsernsModeRemap)
+	}
+}
+
+// ValidateNamespaceModes validates the IPC and PID namespace modes of the container
+func ValidateNamespaceModes(hostConfig *types.HostConfig) error {
+	if err := validateNamespaceMode(hostConfig, "IPC", hostConfig.IpcMode); err != nil {
+		return err
+	}
+	return validateNamespaceMode(hostConfig, "PID", hostConfig.PidMode)
+}
+
+func validateNamespaceMode(hostConfig *types.HostConfig, namespace string, mode types.NamespaceMode) error {
+	switch {
+	case mode == "" || mode == types.NamespaceModePrivate:
+		return nil
+	case mode == types.NamespaceModeHost:
+	case strings.HasPrefix(string(mode), types.NamespaceModeContainerPrefix):
+		if GetNamespaceModeTarget(mode) == "" {
+			return log.NewErrorf("the name or ID of the container must be provided for %s namespace mode %s<name|id>", namespace, types.NamespaceModeContainerPrefix)
+		}
+	default:
+		return log.NewErrorf("unsupported %s namespace mode %s, must be %s, %s or %s<name|id>", namespace, mode, types.NamespaceModePrivate, types.NamespaceModeHost, types.NamespaceModeContainerPrefix)
+	}
+	if hostConfig.UsernsMode == types.UsernsModeRemap {
+		return log.NewErrorf("cannot share the %s namespace of the host or of another container with a remapped user namespace - choose one of the options", namespace)
+	}
+	return nil
+}
+
+// ValidateUlimits validates that the resource limits of the container's process are known ones and within the limits accepted by the kernel
+func ValidateUlimits(ulimits []types.Ulimit) error {
+	names := map[string]bool{}
+	for _, ulimit := range ulimits {
+		if !knownUlimits[ulimit.Name] {
+			return log.NewErrorf("unsupported ulimit %s", ulimit.Name)
+		}
+		if names[ulimit.Name] {
+			return log.NewErrorf("ulimit %s is set more than once", ulimit.Name)
+		}
+		names[ulimit.Name] = true
+		if ulimit.Soft < -1 || ulimit.Hard < -1 {
+			return log.NewErrorf("invalid %s ulimit, the soft and hard limits must be positive numbers or -1 for unlimited", ulimit.Name)
+		}
+		if ulimit.Hard != -1 && (ulimit.Soft == -1 || ulimit.Soft > ulimit.Hard) {
+			return log.NewErrorf("invalid %s ulimit, the soft limit must not exceed the hard one", ulimit.Name)
+		}
+		if ulimit.Name == "nofile" && (ulimit.Hard == -1 || ulimit.Hard > nofileMax) {
+			return log.NewErrorf("invalid nofile ulimit, the hard limit must not exceed %d", nofileMax)
+		}
+	}
+	return nil
+}
+
+// ValidateSysctls validates that the kernel parameters of the container are namespaced ones and that their namespaces are not shared
+func ValidateSysctls(hostConfig *types.HostConfig) error {
+	for key, value := range hostConfig.Sysctls {
+		if value == "" {
+			return log.NewErrorf("no value provided for sysctl %s", key)
+		}
+		switch {
+		case ipcNamespaceSysctls[key] || strings.HasPrefix(key, "fs.mqueue."):
+			if IsNamespaceModeShared(hostConfig.IpcMode) {
+				return log.NewErrorf("cannot set sysctl %s when sharing the IPC namespace of the host or of another container", key)
+			}
+		case strings.HasPrefix(key, "net."):
+			if hostConfig.NetworkMode == types.NetworkModeHost || strings.HasPrefix(string(hostConfig.NetworkMode), types.NetworkModeContainerPrefix) {
+				return log.NewErrorf("cannot set sysctl %s when sharing the network stack of the host or of another container", key)
+			}
+		default:
+			return log.NewErrorf("sysctl %s is not namespaced and cannot be set for a container", key)
+		}
+	}
+	return nil
+}
+
+// ValidateShmSize validates the size of the container's /dev/shm
+func ValidateShmSize(hostConfig *types.HostConfig) error {
+	if hostConfig.ShmSize == "" {
+		return nil
+	}
+	size, err := SizeToBytes(hostConfig.ShmSize)
+	if err != nil {
+		return log.NewErrorf("invalid format of /dev/shm size - %s", hostConfig.ShmSize)
+	}
+	if size <= 0 {
+		return log.NewErrorf("invalid /dev/shm size - %s, must be a positive size", hostConfig.ShmSize)
+	}
+	if IsNamespaceModeShared(hostConfig.IpcMode) {
+		return log.NewError("cannot set the /dev/shm size when sharing the IPC namespace of the host or of another container")
+	}
+	return nil
+}
+
+// ValidateSecurityOptions validates the seccomp profile, AppArmor profile and SELinux label of the container
+func ValidateSecurityOptions(hostConfig *types.HostConfig) error {
+	securityOpts := hostConfig.SecurityOpts
+	if securityOpts == nil {
+		return nil
+	}
+	if securityOpts.Seccomp != "" && securityOpts.Seccomp != types.SecurityProfileUnconfined {
+		if hostConfig.Privileged {
+			return log.NewError("cannot create the container as privileged and with a seccomp profile at the same time - choose one of the options")
+		}
+		if securityOpts.Seccomp != types.SeccompProfileDefault && !filepath.IsAbs(securityOpts.Seccomp) {
+			return log.NewErrorf("invalid seccomp profile %s, must be default, unconfined or an absolute path to a JSON profile", securityOpts.Seccomp)
+		}
+	}
+	if securityOpts.AppArmorProfile != "" && !appArmorProfileRegex.MatchString(securityOpts.AppArmorProfile) {
+		return log.NewErrorf("invalid AppArmor profile %s", securityOpts.AppArmorProfile)
+	}
+	if securityOpts.SELinuxLabel != "" && !seLinuxLabelRegex.MatchString(securityOpts.SELinuxLabel) {
+		return log.NewErrorf("invalid SELinux label %s, must be in the format user:role:type:level", securityOpts.SELinuxLabel)
+	}
+	return nil
+}
+
 // ValidateResources validates the container resources limitations
 func ValidateResources(resources *types.Resources) error {
 	if resources == nil {
@@ -164,6 +403,45 @@ func ValidateResources(resources *types.Resources) error {
 		return log.NewErrorf("reservation memory - %s must be lower than memory - %s", resources.MemoryReservation, resources.Memory)
 	}
 
+	if err = validateCPUResources(resources); err != nil {
+		return err
+	}
+	if resources.PidsLimit < 0 {
+		return log.NewErrorf("invalid pids limit - %d, must be a positive number", resources.PidsLimit)
+	}
+	return validateBlkioResources(resources)
+}
+
+func validateCPUResources(resources *types.Resources) error {
+	if resources.CPUQuota < 0 || (resources.CPUQuota > 0 && resources.CPUQuota < cpuCFSMin) {
+		return log.NewErrorf("invalid CPU quota - %d, must be at least %d microseconds", resources.CPUQuota, cpuCFSMin)
+	}
+	if resources.CPUPeriod > 0 && (resources.CPUPeriod < cpuCFSMin || resources.CPUPeriod > cpuCFSMax) {
+		return log.NewErrorf("invalid CPU period - %d, must be in the range of %d to %d microseconds", resources.CPUPeriod, cpuCFSMin, cpuCFSMax)
+	}
+	if resources.CPUShares > 0 && (resources.CPUShares < cpuSharesMin || resources.CPUShares > cpuSharesMax) {
+		return log.NewErrorf("invalid CPU shares - %d, must be in the range of %d to %d", resources.CPUShares, cpuSharesMin, cpuSharesMax)
+	}
+	if resources.CPUSetCPUs != "" && !cpuSetRegex.MatchString(resources.CPUSetCPUs) {
+		return log.NewErrorf("invalid format of cpuset CPUs - %s", resources.CPUSetCPUs)
+	}
+	if resources.CPUSetMems != "" && !cpuSetRegex.MatchString(resources.CPUSetMems) {
+		return log.NewErrorf("invalid format of cpuset memory nodes - %s", resources.CPUSetMems)
+	}
+	return nil
+}
+
+func validateBlkioResources(resources *types.Resources) error {
+	if resources.BlkioWeight > 0 && (resources.BlkioWeight < blkioWeightMin || resources.BlkioWeight > blkioWeightMax) {
+		return log.NewErrorf("invalid block IO weight - %d, must be in the range of %d to %d", resources.BlkioWeight, blkioWeightMin, blkioWeightMax)
+	}
+	for _, throttleDevices := range [][]types.ThrottleDevice{resources.BlkioDeviceReadBps, resources.BlkioDeviceWriteBps, resources.BlkioDeviceReadIOps, resources.BlkioDeviceWriteIOps} {
+		for _, throttleDevice := range throttleDevices {
+			if !filepath.IsAbs(throttleDevice.Path) {
+				return log.NewErrorf("invalid block IO throttle device path - %s, must be an absolute path", throttleDevice.Path)
+			}
+		}
+	}
 	return nil
 }
 
@@ -172,10 +450,31 @@ func ValidateNetworking(hostConfig *types.HostConfig) error {
 	if hostConfig.NetworkMode == "" {
 		return log.NewError("network mode is not set")
 	}
-	if hostConfig.NetworkMode != types.NetworkModeHost && hostConfig.NetworkMode != types.NetworkModeBridge {
+	if strings.HasPrefix(string(hostConfig.NetworkMode), types.NetworkModeContainerPrefix) {
+		return validateNetworkModeContainer(hostConfig)
+	}
+	// any network mode other than host, bridge and none is the name of a user-defined network
+	if hostConfig.NetworkMode != types.NetworkModeHost && hostConfig.NetworkMode != types.NetworkModeBridge && hostConfig.NetworkMode != types.NetworkModeNone &&
+		!networkNameRegex.MatchString(string(hostConfig.NetworkMode)) {
 		return log.NewErrorf("unsupported network mode %s", hostConfig.NetworkMode)
 	}
+	networks := map[string]bool{string(hostConfig.NetworkMode): true}
+	for _, network := range hostConfig.Networks {
+		if err := ValidateNetworkName(network); err != nil {
+			return err
+		}
+		if network == string(types.NetworkModeHost) || network == string(types.NetworkModeNone) {
+			return log.NewErrorf("cannot connect to the %s network as an additional network", network)
+		}
+		if networks[network] {
+			return log.NewErrorf("the container is connected to network %s more than once", network)
+		}
+		networks[network] = true
+	}
 	if hostConfig.NetworkMode == types.NetworkModeHost {
+		if len(hostConfig.Networks) != 0 {
+			return log.NewError("cannot connect to additional networks when in host network mode")
+		}
 		if len(hostConfig.PortMappings) != 0 {
 			return log.NewError("cannot use port mappings when in host network mode")
 		}
@@ -185,6 +484,164 @@ func ValidateNetworking(hostConfig *types.HostConfig) error {
 			}
 		}
 	}
+	if hostConfig.NetworkMode == types.NetworkModeNone {
+		if len(hostConfig.Networks) != 0 {
+			return log.NewError("cannot connect to additional networks when in none network mode")
+		}
+		if len(hostConfig.PortMappings) != 0 {
+			return log.NewError("cannot use port mappings when in none network mode")
+		}
+	}
+	if len(hostConfig.EndpointsConfig) != 0 && (hostConfig.NetworkMode == types.NetworkModeHost || hostConfig.NetworkMode == types.NetworkModeNone) {
+		return log.NewErrorf("cannot use static addresses when in %s network mode", hostConfig.NetworkMode)
+	}
+	if IsNetworkPolicySet(hostConfig.NetworkPolicy) && (hostConfig.NetworkMode == types.NetworkModeHost || hostConfig.NetworkMode == types.NetworkModeNone) {
+		return log.NewErrorf("cannot use a network policy when in %s network mode", hostConfig.NetworkMode)
+	}
+	if err := ValidateNetworkPolicy(hostConfig.NetworkPolicy); err != nil {
+		return err
+	}
+	if err := ValidateDNS(hostConfig); err != nil {
+		return err
+	}
+	for network, epConfig := range hostConfig.EndpointsConfig {
+		if !networks[network] {
+			return log.NewErrorf("cannot set static addresses for network %s as the container is not connected to it", network)
+		}
+		if err := ValidateEndpointConfig(network, epConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateDNS validates the DNS servers, search domains and resolver options of the container
+func ValidateDNS(hostConfig *types.HostConfig) error {
+	for _, server := range hostConfig.DNS {
+		if net.ParseIP(server) == nil {
+			return log.NewErrorf("invalid DNS server %s, must be an IPv4 or IPv6 address", server)
+		}
+	}
+	for _, search := range hostConfig.DNSSearch {
+		if len(search) > dnsSearchDomainMaxLength || !dnsSearchDomainRegex.MatchString(search) {
+			return log.NewErrorf("invalid DNS search domain %s", search)
+		}
+	}
+	for _, option := range hostConfig.DNSOptions {
+		if !dnsOptionRegex.MatchString(option) {
+			return log.NewErrorf("invalid DNS option %s, must be in the format name[:value]", option)
+		}
+	}
+	return nil
+}
+
+// ValidateEndpointConfig validates the static addresses of the container's endpoint in the given network
+func ValidateEndpointConfig(network string, epConfig *types.EndpointConfig) error {
+	if epConfig == nil || (epConfig.IPv4Address == "" && epConfig.IPv6Address == "" && epConfig.MacAddress == "") {
+		return log.NewErrorf("no static addresses are set for network %s", network)
+	}
+	if epConfig.IPv4Address != "" {
+		if ip := net.ParseIP(epConfig.IPv4Address); ip == nil || ip.To4() == nil {
+			return log.NewErrorf("invalid IPv4 address %s for network %s", epConfig.IPv4Address, network)
+		}
+	}
+	if epConfig.IPv6Address != "" {
+		if ip := net.ParseIP(epConfig.IPv6Address); ip == nil || ip.To4() != nil {
+			return log.NewErrorf("invalid IPv6 address %s for network %s", epConfig.IPv6Address, network)
+		}
+	}
+	if epConfig.MacAddress != "" {
+		mac, err := net.ParseMAC(epConfig.MacAddress)
+		if err != nil || len(mac) != 6 {
+			return log.NewErrorf("invalid MAC address %s for network %s", epConfig.MacAddress, network)
+		}
+		if mac[0]&0x01 != 0 {
+			return log.NewErrorf("the MAC address %s for network %s must be a unicast one", epConfig.MacAddress, network)
+		}
+	}
+	return nil
+}
+
+// IsNetworkPolicySet checks whether any rate limit or egress rule is set in the network policy
+func IsNetworkPolicySet(policy *types.NetworkPolicy) bool {
+	return policy != nil && !reflect.DeepEqual(*policy, types.NetworkPolicy{})
+}
+
+// ValidateNetworkPolicy validates the rate limits and egress rules of the container's network policy
+func ValidateNetworkPolicy(policy *types.NetworkPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	// the egress traffic of the container is policed on the host and the kernel supports 32-bit rates only
+	if policy.EgressRate > math.MaxUint32 {
+		return log.NewErrorf("invalid egress rate - %d, must not exceed %d bytes per second", policy.EgressRate, uint64(math.MaxUint32))
+	}
+	if policy.EgressDefault != "" && policy.EgressDefault != types.EgressActionAllow && policy.EgressDefault != types.EgressActionDeny {
+		return log.NewErrorf("unsupported default egress action %s", policy.EgressDefault)
+	}
+	for _, rule := range policy.EgressRules {
+		if err := ValidateEgressRule(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateEgressRule validates the action, destination and ports of an egress rule
+func ValidateEgressRule(rule types.EgressRule) error {
+	if rule.Action != types.EgressActionAllow && rule.Action != types.EgressActionDeny {
+		return log.NewErrorf("unsupported egress action %s for destination %s", rule.Action, rule.CIDR)
+	}
+	if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+		return log.NewErrorf("invalid egress destination %s, must be in CIDR notation", rule.CIDR)
+	}
+	if rule.Protocol != "" && rule.Protocol != "tcp" && rule.Protocol != "udp" {
+		return log.NewErrorf("unsupported egress protocol %s for destination %s", rule.Protocol, rule.CIDR)
+	}
+	if rule.Ports == "" {
+		return nil
+	}
+	if rule.Protocol == "" {
+		return log.NewErrorf("the protocol must be set to use egress ports for destination %s", rule.CIDR)
+	}
+	ports := portRangeRegex.FindStringSubmatch(rule.Ports)
+	if ports == nil {
+		return log.NewErrorf("invalid egress ports %s for destination %s", rule.Ports, rule.CIDR)
+	}
+	start, err := strconv.ParseUint(ports[1], 10, 16)
+	end := start
+	if err == nil && ports[3] != "" {
+		end, err = strconv.ParseUint(ports[3], 10, 16)
+	}
+	if err != nil || start == 0 || start > end {
+		return log.NewErrorf("invalid egress ports %s for destination %s", rule.Ports, rule.CIDR)
+	}
+	return nil
+}
+
+// validateNetworkModeContainer validates the container networking when the network stack of another container is shared
+func validateNetworkModeContainer(hostConfig *types.HostConfig) error {
+	if strings.TrimPrefix(string(hostConfig.NetworkMode), types.NetworkModeContainerPrefix) == "" {
+		return log.NewErrorf("the name or ID of the container must be provided for network mode %s<name|id>", types.NetworkModeContainerPrefix)
+	}
+	if len(hostConfig.Networks) != 0 {
+		return log.NewError("cannot connect to additional networks when sharing the network stack of another container")
+	}
+	if len(hostConfig.PortMappings) != 0 {
+		return log.NewError("cannot use port mappings when sharing the network stack of another container")
+	}
+	if len(hostConfig.ExtraHosts) != 0 {
+		return log.NewError("cannot use extra hosts when sharing the network stack of another container")
+	}
+	if len(hostConfig.EndpointsConfig) != 0 {
+		return log.NewError("cannot use static addresses when sharing the network stack of another container")
+	}
+	if IsNetworkPolicySet(hostConfig.NetworkPolicy) {
+		return log.NewError("cannot use a network policy when sharing the network stack of another container")
+	}
+	if len(hostConfig.DNS) != 0 || len(hostConfig.DNSSearch) != 0 || len(hostConfig.DNSOptions) != 0 {
+		return log.NewError("cannot set the DNS configuration when sharing the network stack of another container")
+	}
 	return nil
 }
 
@@ -230,6 +687,15 @@ func ValidateMountPoint(mp types.MountPoint) error {
 	if mp.Source == "" || mp.Destination == "" {
 		return log.NewError("source and destination must be set for a mount point")
 	}
+	switch mp.Type {
+	case "", types.MountTypeBind:
+	case types.MountTypeVolume:
+		if err := ValidateVolumeName(mp.Source); err != nil {
+			return err
+		}
+	default:
+		return log.NewErrorf("unsupported mount point type %s", mp.Type)
+	}
 	propMode := mp.PropagationMode
 	isPrivate := propMode == types.RPrivatePropagationMode || propMode == types.PrivatePropagationMode
 	isShared := propMode == types.RSharedPropagationMode || propMode == types.SharedPropagationMode
@@ -237,6 +703,41 @@ func ValidateMountPoint(mp types.MountPoint) error {
 	if !(isPrivate || isShared || isSlave) {
 		return log.NewError("propagation mode must be set to one of the supported modes")
 	}
+	return ValidateMountOptions(mp.Options)
+}
+
+// ValidateMountOptions validates the additional options of a mount point
+func ValidateMountOptions(options []string) error {
+	for i, option := range options {
+		conflicting, ok := mountOptions[option]
+		if !ok {
+			return log.NewErrorf("unsupported mount option %s", option)
+		}
+		for _, previous := range options[:i] {
+			if previous == option {
+				return log.NewErrorf("duplicate mount option %s", option)
+			}
+			if previous == conflicting {
+				return log.NewErrorf("conflicting mount options %s and %s", previous, option)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateVolumeName validates the name of a named volume
+func ValidateVolumeName(name string) error {
+	if !volumeNameRegex.MatchString(name) {
+		return log.NewErrorf("invalid volume name %s, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", name)
+	}
+	return nil
+}
+
+// ValidateNetworkName validates the name of a network
+func ValidateNetworkName(name string) error {
+	if !networkNameRegex.MatchString(name) {
+		return log.NewErrorf("invalid network name %s, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", name)
+	}
 	return nil
 }
 
@@ -246,10 +747,11 @@ func ValidateLogConfig(logCfg *types.LogConfiguration) error {
 		return nil
 	}
 	if logCfg.DriverConfig != nil {
-		if !(logCfg.DriverConfig.Type == types.LogConfigDriverJSONFile || logCfg.DriverConfig.Type == types.LogConfigDriverNone) {
-			return log.NewError("unsupported log driver configuration")
+		if err := validateLogRetention(logCfg.DriverConfig); err != nil {
+			return err
 		}
-		if logCfg.DriverConfig.Type == types.LogConfigDriverJSONFile {
+		switch logCfg.DriverConfig.Type {
+		case types.LogConfigDriverJSONFile, types.LogConfigDriverLocal:
 			if logCfg.DriverConfig.MaxFiles < 1 {
 				return log.NewError("max log files cannot be < 1")
 			}
@@ -259,6 +761,21 @@ func ValidateLogConfig(logCfg *types.LogConfiguration) error {
 			if _, err := SizeToBytes(logCfg.DriverConfig.MaxSize); err != nil {
 				return log.NewErrorf("invalid format of max logs size - %s", logCfg.DriverConfig.MaxSize)
 			}
+		case types.LogConfigDriverSyslog:
+			if _, _, err := syslog.ParseAddress(logCfg.DriverConfig.Address); err != nil {
+				return err
+			}
+			if logCfg.DriverConfig.Facility != "" {
+				if _, err := syslog.ParseFacility(logCfg.DriverConfig.Facility); err != nil {
+					return err
+				}
+			}
+			if err := syslog.ValidateTag(logCfg.DriverConfig.Tag); err != nil {
+				return err
+			}
+		case types.LogConfigDriverJournald, types.LogConfigDriverNone:
+		default:
+			return log.NewError("unsupported log driver configuration")
 		}
 	}
 	if logCfg.ModeConfig != nil {
@@ -277,6 +794,28 @@ func ValidateLogConfig(logCfg *types.LogConfiguration) error {
 	return nil
 }
 
+// validateLogRetention validates the compression and the max age of the rotated json-file logs
+func validateLogRetention(driverCfg *types.LogDriverConfiguration) error {
+	if driverCfg.Compress == "" && driverCfg.MaxAge == "" {
+		return nil
+	}
+	if driverCfg.Type != types.LogConfigDriverJSONFile {
+		return log.NewErrorf("log compression and max age are not supported for log driver %s", driverCfg.Type)
+	}
+	switch driverCfg.Compress {
+	case "", types.LogCompressionNone, types.LogCompressionGzip, types.LogCompressionZstd:
+	default:
+		return log.NewErrorf("unsupported log compression %s", driverCfg.Compress)
+	}
+	if driverCfg.MaxAge != "" {
+		maxAge, err := time.ParseDuration(driverCfg.MaxAge)
+		if err != nil || maxAge < 0 {
+			return log.NewErrorf("invalid format of max logs age - %s", driverCfg.MaxAge)
+		}
+	}
+	return nil
+}
+
 // ValidateRestartPolicy validates the container restart policy
 func ValidateRestartPolicy(rsPolicy *types.RestartPolicy) error {
 	if rsPolicy == nil {
@@ -305,6 +844,9 @@ func ValidateRestartPolicy(rsPolicy *types.RestartPolicy) error {
 			return log.NewErrorf("cannot use max retry count when the restart policy is %s", rsPolicy.Type)
 		}
 	}
+	if rsPolicy.RestartOnUnhealthy && rsPolicy.Type == types.No {
+		return log.NewErrorf("cannot restart unhealthy containers when the restart policy is %s", rsPolicy.Type)
+	}
 	return nil
 }
 
@@ -316,6 +858,89 @@ func ValidateConfig(config *types.ContainerConfiguration) error {
 				return log.NewErrorf("invalid environmental variable declaration provided : %s", envVar)
 			}
 		}
+		if len(config.Entrypoint) > 0 && config.Entrypoint[0] == "" {
+			return log.NewError("the entrypoint executable must not be empty")
+		}
+		if config.WorkingDir != "" && !filepath.IsAbs(config.WorkingDir) {
+			return log.NewErrorf("the working directory %s must be an absolute path", config.WorkingDir)
+		}
+		if config.User != "" && !userRegex.MatchString(config.User) {
+			return log.NewErrorf("invalid user %s, the supported format is user[:group]", config.User)
+		}
+		for _, group := range config.Groups {
+			if !groupRegex.MatchString(group) {
+				return log.NewErrorf("invalid group %s", group)
+			}
+		}
+		for key := range config.Labels {
+			if key == "" {
+				return log.NewError("label keys must not be empty")
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateHealthCheck validates the configuration of the container's health check
+func ValidateHealthCheck(healthCheck *types.HealthCheckConfig) error {
+	if healthCheck == nil {
+		return nil
+	}
+	switch healthCheck.Type {
+	case types.HealthCheckExec:
+		if len(healthCheck.Cmd) == 0 || healthCheck.Cmd[0] == "" {
+			return log.NewError("the command of the exec health check must be provided")
+		}
+	case types.HealthCheckHTTP, types.HealthCheckTCP:
+		if healthCheck.Port <= 0 || healthCheck.Port > 65535 {
+			return log.NewErrorf("invalid port %d for %s health check", healthCheck.Port, healthCheck.Type)
+		}
+	default:
+		return log.NewErrorf("unsupported health check type %s", healthCheck.Type)
+	}
+	if healthCheck.Interval < 0 {
+		return log.NewError("health check interval cannot be negative")
+	}
+	if healthCheck.Timeout < 0 {
+		return log.NewError("health check timeout cannot be negative")
+	}
+	if healthCheck.Retries < 0 {
+		return log.NewError("health check retries cannot be negative")
+	}
+	if healthCheck.StartPeriod < 0 {
+		return log.NewError("health check start period cannot be negative")
+	}
+	return nil
+}
+
+// ValidateExecConfig validates the configuration of a process to be executed inside a container
+func ValidateExecConfig(execConfig *types.ExecConfig) error {
+	if execConfig == nil || len(execConfig.Cmd) == 0 || execConfig.Cmd[0] == "" {
+		return log.NewError("the command to be executed must be provided")
+	}
+	for _, envVar := range execConfig.Env {
+		if !envVarRegex.MatchString(envVar) {
+			return log.NewErrorf("invalid environmental variable declaration provided : %s", envVar)
+		}
+	}
+	return nil
+}
+
+// ValidateCheckpointOpts validates the options for creating a container checkpoint
+func ValidateCheckpointOpts(checkpointOpts *types.CheckpointOpts) error {
+	if checkpointOpts == nil {
+		return log.NewError("the checkpoint options must be provided")
+	}
+	return ValidateCheckpointID(checkpointOpts.CheckpointID)
+}
+
+// ValidateCheckpointID validates the identifier of a container checkpoint
+func ValidateCheckpointID(checkpointID string) error {
+	if checkpointID == "" {
+		return log.NewError("the checkpoint ID must be provided")
+	}
+	if !containerNameRegex.MatchString(checkpointID) {
+		return log.NewErrorf("invalid checkpoint ID format : %s", checkpointID)
 	}
 	return nil
 }
@@ -13,6 +13,8 @@
 package util
 
 import (
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -114,6 +116,81 @@ func TestNegativeContainerValidations(t *testing.T) {
 			},
 			expectedErr: log.NewErrorf("propagation mode must be set to one of the supported modes"),
 		},
+		"test_validate_mounts_invalid_mount_type": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				Mounts: []types.MountPoint{{
+					Destination:     mountDest,
+					Source:          mountSrc,
+					PropagationMode: mountPropagationMode,
+					Type:            "invalid_type",
+				}},
+			},
+			expectedErr: log.NewErrorf("unsupported mount point type invalid_type"),
+		},
+		"test_validate_mounts_invalid_volume_name": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				Mounts: []types.MountPoint{{
+					Destination:     mountDest,
+					Source:          ".volume",
+					PropagationMode: mountPropagationMode,
+					Type:            types.MountTypeVolume,
+				}},
+			},
+			expectedErr: log.NewErrorf("invalid volume name .volume, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed"),
+		},
+		"test_validate_mounts_options": {
+			ctr: func() *types.Container {
+				ctr := &types.Container{
+					Image: types.Image{Name: "image"},
+					Mounts: []types.MountPoint{{
+						Destination:     mountDest,
+						Source:          mountSrc,
+						PropagationMode: mountPropagationMode,
+						Options:         []string{types.MountOptionReadOnly, types.MountOptionNoSuid, types.MountOptionNoExec, types.MountOptionNoDev},
+					}},
+				}
+				FillDefaults(ctr)
+				return ctr
+			}(),
+		},
+		"test_validate_mounts_unsupported_option": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				Mounts: []types.MountPoint{{
+					Destination:     mountDest,
+					Source:          mountSrc,
+					PropagationMode: mountPropagationMode,
+					Options:         []string{"invalid"},
+				}},
+			},
+			expectedErr: log.NewErrorf("unsupported mount option invalid"),
+		},
+		"test_validate_mounts_duplicate_option": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				Mounts: []types.MountPoint{{
+					Destination:     mountDest,
+					Source:          mountSrc,
+					PropagationMode: mountPropagationMode,
+					Options:         []string{types.MountOptionNoExec, types.MountOptionNoExec},
+				}},
+			},
+			expectedErr: log.NewErrorf("duplicate mount option noexec"),
+		},
+		"test_validate_mounts_conflicting_options": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				Mounts: []types.MountPoint{{
+					Destination:     mountDest,
+					Source:          mountSrc,
+					PropagationMode: mountPropagationMode,
+					Options:         []string{types.MountOptionReadOnly, types.MountOptionReadWrite},
+				}},
+			},
+			expectedErr: log.NewErrorf("conflicting mount options ro and rw"),
+		},
 		"test_validate_host_config_nil": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
@@ -219,6 +296,140 @@ func TestNegativeContainerValidations(t *testing.T) {
 			},
 			expectedErr: log.NewErrorf("max log files cannot be < 1"),
 		},
+		"test_validate_host_config_log_config_local_invalid_max_files": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverLocal,
+							MaxFiles: 0,
+							MaxSize:  hostConfigLogConfigMaxSize,
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewErrorf("max log files cannot be < 1"),
+		},
+		"test_validate_host_config_log_config_syslog_invalid_address": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:    types.LogConfigDriverSyslog,
+							Address: "http://localhost:514",
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("unsupported network in syslog address http://localhost:514 - use one of unix, unixgram, tcp or udp"),
+		},
+		"test_validate_host_config_log_config_syslog_invalid_facility": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverSyslog,
+							Address:  "udp://localhost",
+							Facility: "local8",
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("unsupported syslog facility local8"),
+		},
+		"test_validate_host_config_log_config_syslog_invalid_tag": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type: types.LogConfigDriverSyslog,
+							Tag:  "my tag",
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("syslog tag my tag must contain only printable ASCII characters without spaces"),
+		},
+		"test_validate_host_config_log_config_unsupported_compression": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverJSONFile,
+							MaxFiles: hostConfigLogConfigMaxFiles,
+							MaxSize:  hostConfigLogConfigMaxSize,
+							Compress: "lz4",
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("unsupported log compression lz4"),
+		},
+		"test_validate_host_config_log_config_invalid_max_age": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverJSONFile,
+							MaxFiles: hostConfigLogConfigMaxFiles,
+							MaxSize:  hostConfigLogConfigMaxSize,
+							MaxAge:   "3 days",
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid format of max logs age - 3 days"),
+		},
+		"test_validate_host_config_log_config_compression_not_json_file": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					LogConfig: &types.LogConfiguration{
+						DriverConfig: &types.LogDriverConfiguration{
+							Type:     types.LogConfigDriverLocal,
+							MaxFiles: hostConfigLogConfigMaxFiles,
+							MaxSize:  hostConfigLogConfigMaxSize,
+							Compress: types.LogCompressionGzip,
+						},
+						ModeConfig: &types.LogModeConfiguration{
+							Mode: hostConfigLogConfigMode,
+						},
+					},
+				},
+			},
+			expectedErr: log.NewError("log compression and max age are not supported for log driver local"),
+		},
 		"test_validate_host_config_log_config_empty_buffer_size": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
@@ -331,191 +542,1061 @@ func TestNegativeContainerValidations(t *testing.T) {
 			},
 			expectedErr: log.NewErrorf("cannot create the container as privileged and with extra capabilities at the same time - choose one of the options"),
 		},
-		"test_validate_host_config_host_mode_unsupported": {
+		"test_validate_host_config_privileged_with_dropped_capabilities": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
-					NetworkMode: "custom",
+					NetworkMode:         types.NetworkModeBridge,
+					Privileged:          true,
+					DroppedCapabilities: []string{"CAP_NET_RAW"},
 				},
 			},
-			expectedErr: log.NewError("unsupported network mode custom"),
+			expectedErr: log.NewErrorf("cannot create the container as privileged and with dropped capabilities at the same time - choose one of the options"),
 		},
-		"test_validate_host_config_invalid_restart_policy_type": {
+		"test_validate_host_config_unknown_dropped_capability": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
-					NetworkMode: types.NetworkModeBridge,
-					RestartPolicy: &types.RestartPolicy{
-						Type: types.PolicyType("unsupported"),
-					},
+					NetworkMode:         types.NetworkModeBridge,
+					DroppedCapabilities: []string{"CAP_NET_RAW", "NET_ADMIN"},
 				},
 			},
-			expectedErr: log.NewErrorf("unsupported restart policy type %s", types.PolicyType("unsupported")),
+			expectedErr: log.NewErrorf("unknown capability NET_ADMIN, must be a known capability, e.g. CAP_NET_RAW, or ALL"),
 		},
-		"test_validate_host_config_restart_policy_negative_retry_timeout": {
+		"test_validate_host_config_privileged_userns_remap": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					RestartPolicy: &types.RestartPolicy{
-						Type:              types.OnFailure,
-						MaximumRetryCount: hostConfigRestartPolicyMaxRetry,
-						RetryTimeout:      -1 * time.Second,
-					},
+					Privileged:  true,
+					UsernsMode:  types.UsernsModeRemap,
 				},
 			},
-			expectedErr: log.NewError("restart policy retry timeout cannot be negative"),
+			expectedErr: log.NewErrorf("cannot create the container as privileged and with a remapped user namespace at the same time - choose one of the options"),
 		},
-		"test_validate_host_config_restart_policy_negative_max_retry_count": {
+		"test_validate_host_config_unsupported_userns_mode": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					RestartPolicy: &types.RestartPolicy{
-						Type:              types.OnFailure,
-						MaximumRetryCount: -3,
-						RetryTimeout:      hostConfigRestartPolicyTimeout,
-					},
+					UsernsMode:  "private",
 				},
 			},
-			expectedErr: log.NewError("restart policy max retry count cannot be negative"),
+			expectedErr: log.NewErrorf("unsupported user namespace mode private, must be host or remap"),
 		},
-		"test_validate_host_config_restart_policy_always_with_max_retry_count": {
+		"test_validate_host_config_unsupported_ipc_mode": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					RestartPolicy: &types.RestartPolicy{
-						Type:              types.Always,
-						MaximumRetryCount: hostConfigRestartPolicyMaxRetry,
-					},
+					IpcMode:     "shareable",
 				},
 			},
-			expectedErr: log.NewErrorf("cannot use max retry count when the restart policy is %s", types.Always),
+			expectedErr: log.NewErrorf("unsupported IPC namespace mode shareable, must be private, host or container:<name|id>"),
 		},
-		"test_validate_host_config_resources_memory_swap_is_less_than_limit": {
+		"test_validate_host_config_unsupported_ulimit": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						Memory:     "200M",
-						MemorySwap: "199M",
-					},
+					Ulimits:     []types.Ulimit{{Name: "files", Soft: 1024, Hard: 1024}},
 				},
 			},
-			expectedErr: log.NewError("swap memory - 199M is less than memory - 200M"),
+			expectedErr: log.NewErrorf("unsupported ulimit files"),
 		},
-		"test_validate_host_config_resources_memory_is_less_than_reservation": {
+		"test_validate_host_config_not_namespaced_sysctl": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						Memory:            "200M",
-						MemoryReservation: "300M",
-					},
+					Sysctls:     map[string]string{"vm.swappiness": "10"},
 				},
 			},
-			expectedErr: log.NewError("reservation memory - 300M must be lower than memory - 200M"),
+			expectedErr: log.NewErrorf("sysctl vm.swappiness is not namespaced and cannot be set for a container"),
 		},
-		"test_validate_host_config_resources_memory_swap_is_set_without_limit": {
+		"test_validate_host_config_invalid_shm_size": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						MemorySwap: hostConfigResourcesMemorySwap,
-					},
+					ShmSize:     "64",
 				},
 			},
-			expectedErr: log.NewErrorf("swap memory - %s, memory must be set as well", hostConfigResourcesMemorySwap),
+			expectedErr: log.NewErrorf("invalid format of /dev/shm size - 64"),
 		},
-		"test_validate_host_config_resources_memory_invalid": {
+		"test_validate_host_config_host_mode_unsupported": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
-					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						Memory: "invalid_value",
-					},
+					NetworkMode: "-custom",
 				},
 			},
-			expectedErr: log.NewError("invalid format of memory - invalid_value"),
+			expectedErr: log.NewError("unsupported network mode -custom"),
 		},
-		"test_validate_host_config_resources_memory_reservation_invalid": {
+		"test_validate_host_config_invalid_network_name": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						MemoryReservation: "invalid_value",
-					},
+					Networks:    []string{"back end"},
 				},
 			},
-			expectedErr: log.NewError("invalid format of memory reservation - invalid_value"),
+			expectedErr: log.NewError("invalid network name back end, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed"),
 		},
-		"test_validate_host_config_resources_memory_swap_invalid": {
+		"test_validate_host_config_host_as_additional_network": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
 					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						MemorySwap: "invalid_value",
-					},
+					Networks:    []string{"host"},
 				},
 			},
-			expectedErr: log.NewError("invalid format of swap memory - invalid_value"),
+			expectedErr: log.NewError("cannot connect to the host network as an additional network"),
 		},
-		"test_validate_host_config_resources_memory_too_low": {
+		"test_validate_host_config_duplicate_network": {
 			ctr: &types.Container{
 				Image: types.Image{Name: "image"},
 				HostConfig: &types.HostConfig{
-					NetworkMode: types.NetworkModeBridge,
-					Resources: &types.Resources{
-						Memory:     "2.8M",
-						MemorySwap: "-1",
-					},
+					NetworkMode: "backend",
+					Networks:    []string{"backend"},
 				},
 			},
-			expectedErr: log.NewErrorf("minimum memory allowed is 3M"),
+			expectedErr: log.NewError("the container is connected to network backend more than once"),
 		},
-		"test_validate_config_env_incorrect_format_start_digit": {
+		"test_validate_host_config_host_mode_with_networks": {
 			ctr: &types.Container{
-				Image:      types.Image{Name: "image"},
-				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
-				Config: &types.ContainerConfiguration{
-					Env: []string{"1VAR=1"},
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeHost,
+					Networks:    []string{"backend"},
 				},
 			},
-			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : 1VAR=1"),
+			expectedErr: log.NewError("cannot connect to additional networks when in host network mode"),
 		},
-		"test_validate_config_env_incorrect_format_start_other": {
+		"test_validate_host_config_none_mode_with_networks": {
 			ctr: &types.Container{
-				Image:      types.Image{Name: "image"},
-				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
-				Config: &types.ContainerConfiguration{
-					Env: []string{"$VAR=1"},
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeNone,
+					Networks:    []string{"backend"},
 				},
 			},
-			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : $VAR=1"),
+			expectedErr: log.NewError("cannot connect to additional networks when in none network mode"),
 		},
-		"test_validate_config_env_incorrect_format_contains_at_sign": {
+		"test_validate_host_config_none_mode_with_ports": {
 			ctr: &types.Container{
-				Image:      types.Image{Name: "image"},
-				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
-				Config: &types.ContainerConfiguration{
-					Env: []string{"V@R=1"},
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:  types.NetworkModeNone,
+					PortMappings: []types.PortMapping{{Proto: "tcp", ContainerPort: 80, HostPort: 80}},
 				},
 			},
-			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : V@R=1"),
+			expectedErr: log.NewError("cannot use port mappings when in none network mode"),
 		},
-	}
-
-	for testName, testCase := range tests {
-		t.Run(testName, func(t *testing.T) {
+		"test_validate_host_config_none_as_additional_network": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Networks:    []string{"none"},
+				},
+			},
+			expectedErr: log.NewError("cannot connect to the none network as an additional network"),
+		},
+		"test_validate_host_config_container_mode_no_target": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeContainerPrefix,
+				},
+			},
+			expectedErr: log.NewError("the name or ID of the container must be provided for network mode container:<name|id>"),
+		},
+		"test_validate_host_config_container_mode_with_networks": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeContainerPrefix + "web",
+					Networks:    []string{"backend"},
+				},
+			},
+			expectedErr: log.NewError("cannot connect to additional networks when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_container_mode_with_ports": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:  types.NetworkModeContainerPrefix + "web",
+					PortMappings: []types.PortMapping{{Proto: "tcp", ContainerPort: 80, HostPort: 80}},
+				},
+			},
+			expectedErr: log.NewError("cannot use port mappings when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_container_mode_with_extra_hosts": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeContainerPrefix + "web",
+					ExtraHosts:  []string{"ctrhost:10.0.0.1"},
+				},
+			},
+			expectedErr: log.NewError("cannot use extra hosts when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_static_addresses_host_mode": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeHost,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"host": {IPv4Address: "172.17.0.10"},
+					},
+				},
+			},
+			expectedErr: log.NewError("cannot use static addresses when in host network mode"),
+		},
+		"test_validate_host_config_static_addresses_not_connected": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"backend": {IPv4Address: "172.20.0.10"},
+					},
+				},
+			},
+			expectedErr: log.NewError("cannot set static addresses for network backend as the container is not connected to it"),
+		},
+		"test_validate_host_config_static_addresses_empty": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"bridge": {},
+					},
+				},
+			},
+			expectedErr: log.NewError("no static addresses are set for network bridge"),
+		},
+		"test_validate_host_config_static_addresses_invalid_ipv4": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"bridge": {IPv4Address: "fd00::10"},
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid IPv4 address fd00::10 for network bridge"),
+		},
+		"test_validate_host_config_static_addresses_invalid_ipv6": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: "backend",
+					Networks:    []string{"monitoring"},
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"monitoring": {IPv6Address: "172.20.0.10"},
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid IPv6 address 172.20.0.10 for network monitoring"),
+		},
+		"test_validate_host_config_static_addresses_invalid_mac": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"bridge": {MacAddress: "02:42:ac:11:00"},
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid MAC address 02:42:ac:11:00 for network bridge"),
+		},
+		"test_validate_host_config_static_addresses_multicast_mac": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"bridge": {MacAddress: "01:00:5e:00:00:01"},
+					},
+				},
+			},
+			expectedErr: log.NewError("the MAC address 01:00:5e:00:00:01 for network bridge must be a unicast one"),
+		},
+		"test_validate_host_config_container_mode_with_static_addresses": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeContainerPrefix + "web",
+					EndpointsConfig: map[string]*types.EndpointConfig{
+						"bridge": {IPv4Address: "172.17.0.10"},
+					},
+				},
+			},
+			expectedErr: log.NewError("cannot use static addresses when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_network_policy_host_mode": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:   types.NetworkModeHost,
+					NetworkPolicy: &types.NetworkPolicy{EgressRate: 1024},
+				},
+			},
+			expectedErr: log.NewError("cannot use a network policy when in host network mode"),
+		},
+		"test_validate_host_config_network_policy_container_mode": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:   types.NetworkModeContainerPrefix + "other",
+					NetworkPolicy: &types.NetworkPolicy{EgressDefault: types.EgressActionDeny},
+				},
+			},
+			expectedErr: log.NewError("cannot use a network policy when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_network_policy_invalid_rule": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:   types.NetworkModeBridge,
+					NetworkPolicy: &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionAllow, CIDR: "10.0.0.1"}}},
+				},
+			},
+			expectedErr: log.NewError("invalid egress destination 10.0.0.1, must be in CIDR notation"),
+		},
+		"test_validate_host_config_dns_container_mode": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeContainerPrefix + "other",
+					DNSSearch:   []string{"example.com"},
+				},
+			},
+			expectedErr: log.NewError("cannot set the DNS configuration when sharing the network stack of another container"),
+		},
+		"test_validate_host_config_invalid_dns_server": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					DNS:         []string{"dns.example.com"},
+				},
+			},
+			expectedErr: log.NewError("invalid DNS server dns.example.com, must be an IPv4 or IPv6 address"),
+		},
+		"test_validate_host_config_invalid_restart_policy_type": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					RestartPolicy: &types.RestartPolicy{
+						Type: types.PolicyType("unsupported"),
+					},
+				},
+			},
+			expectedErr: log.NewErrorf("unsupported restart policy type %s", types.PolicyType("unsupported")),
+		},
+		"test_validate_host_config_restart_policy_negative_retry_timeout": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					RestartPolicy: &types.RestartPolicy{
+						Type:              types.OnFailure,
+						MaximumRetryCount: hostConfigRestartPolicyMaxRetry,
+						RetryTimeout:      -1 * time.Second,
+					},
+				},
+			},
+			expectedErr: log.NewError("restart policy retry timeout cannot be negative"),
+		},
+		"test_validate_host_config_restart_policy_negative_max_retry_count": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					RestartPolicy: &types.RestartPolicy{
+						Type:              types.OnFailure,
+						MaximumRetryCount: -3,
+						RetryTimeout:      hostConfigRestartPolicyTimeout,
+					},
+				},
+			},
+			expectedErr: log.NewError("restart policy max retry count cannot be negative"),
+		},
+		"test_validate_host_config_restart_policy_always_with_max_retry_count": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					RestartPolicy: &types.RestartPolicy{
+						Type:              types.Always,
+						MaximumRetryCount: hostConfigRestartPolicyMaxRetry,
+					},
+				},
+			},
+			expectedErr: log.NewErrorf("cannot use max retry count when the restart policy is %s", types.Always),
+		},
+		"test_validate_host_config_resources_memory_swap_is_less_than_limit": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						Memory:     "200M",
+						MemorySwap: "199M",
+					},
+				},
+			},
+			expectedErr: log.NewError("swap memory - 199M is less than memory - 200M"),
+		},
+		"test_validate_host_config_resources_memory_is_less_than_reservation": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						Memory:            "200M",
+						MemoryReservation: "300M",
+					},
+				},
+			},
+			expectedErr: log.NewError("reservation memory - 300M must be lower than memory - 200M"),
+		},
+		"test_validate_host_config_resources_memory_swap_is_set_without_limit": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						MemorySwap: hostConfigResourcesMemorySwap,
+					},
+				},
+			},
+			expectedErr: log.NewErrorf("swap memory - %s, memory must be set as well", hostConfigResourcesMemorySwap),
+		},
+		"test_validate_host_config_resources_memory_invalid": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						Memory: "invalid_value",
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid format of memory - invalid_value"),
+		},
+		"test_validate_host_config_resources_memory_reservation_invalid": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						MemoryReservation: "invalid_value",
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid format of memory reservation - invalid_value"),
+		},
+		"test_validate_host_config_resources_memory_swap_invalid": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						MemorySwap: "invalid_value",
+					},
+				},
+			},
+			expectedErr: log.NewError("invalid format of swap memory - invalid_value"),
+		},
+		"test_validate_host_config_resources_memory_too_low": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode: types.NetworkModeBridge,
+					Resources: &types.Resources{
+						Memory:     "2.8M",
+						MemorySwap: "-1",
+					},
+				},
+			},
+			expectedErr: log.NewErrorf("minimum memory allowed is 3M"),
+		},
+		"test_validate_config_env_incorrect_format_start_digit": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Env: []string{"1VAR=1"},
+				},
+			},
+			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : 1VAR=1"),
+		},
+		"test_validate_config_env_incorrect_format_start_other": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Env: []string{"$VAR=1"},
+				},
+			},
+			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : $VAR=1"),
+		},
+		"test_validate_config_env_incorrect_format_contains_at_sign": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Env: []string{"V@R=1"},
+				},
+			},
+			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : V@R=1"),
+		},
+		"test_validate_config_empty_entrypoint": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Entrypoint: []string{""},
+				},
+			},
+			expectedErr: log.NewError("the entrypoint executable must not be empty"),
+		},
+		"test_validate_config_relative_working_dir": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					WorkingDir: "app",
+				},
+			},
+			expectedErr: log.NewErrorf("the working directory app must be an absolute path"),
+		},
+		"test_validate_config_invalid_user": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					User: "app:1000:1000",
+				},
+			},
+			expectedErr: log.NewErrorf("invalid user app:1000:1000, the supported format is user[:group]"),
+		},
+		"test_validate_config_invalid_group": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Groups: []string{"au dio"},
+				},
+			},
+			expectedErr: log.NewErrorf("invalid group au dio"),
+		},
+		"test_validate_config_empty_label_key": {
+			ctr: &types.Container{
+				Image:      types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				Config: &types.ContainerConfiguration{
+					Labels: map[string]string{"": "test"},
+				},
+			},
+			expectedErr: log.NewError("label keys must not be empty"),
+		},
+		"test_validate_health_check_unsupported_type": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: "udp"},
+			},
+			expectedErr: log.NewErrorf("unsupported health check type udp"),
+		},
+		"test_validate_health_check_exec_no_cmd": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckExec},
+			},
+			expectedErr: log.NewError("the command of the exec health check must be provided"),
+		},
+		"test_validate_health_check_http_invalid_port": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckHTTP, Port: 70000},
+			},
+			expectedErr: log.NewErrorf("invalid port %d for %s health check", 70000, types.HealthCheckHTTP),
+		},
+		"test_validate_health_check_tcp_no_port": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP},
+			},
+			expectedErr: log.NewErrorf("invalid port %d for %s health check", 0, types.HealthCheckTCP),
+		},
+		"test_validate_health_check_negative_interval": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Interval: -1},
+			},
+			expectedErr: log.NewError("health check interval cannot be negative"),
+		},
+		"test_validate_health_check_negative_timeout": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Timeout: -1},
+			},
+			expectedErr: log.NewError("health check timeout cannot be negative"),
+		},
+		"test_validate_health_check_negative_retries": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, Retries: -1},
+			},
+			expectedErr: log.NewError("health check retries cannot be negative"),
+		},
+		"test_validate_health_check_negative_start_period": {
+			ctr: &types.Container{
+				Image:       types.Image{Name: "image"},
+				HostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeBridge},
+				HealthCheck: &types.HealthCheckConfig{Type: types.HealthCheckTCP, Port: 80, StartPeriod: -1},
+			},
+			expectedErr: log.NewError("health check start period cannot be negative"),
+		},
+		"test_validate_restart_on_unhealthy_policy_no": {
+			ctr: &types.Container{
+				Image: types.Image{Name: "image"},
+				HostConfig: &types.HostConfig{
+					NetworkMode:   types.NetworkModeBridge,
+					RestartPolicy: &types.RestartPolicy{Type: types.No, RestartOnUnhealthy: true},
+				},
+			},
+			expectedErr: log.NewErrorf("cannot restart unhealthy containers when the restart policy is %s", types.No),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
 			err := ValidateContainer(testCase.ctr)
 			testutil.AssertError(t, testCase.expectedErr, err)
 		})
 	}
 }
+
+func TestValidateExecConfig(t *testing.T) {
+	tests := map[string]struct {
+		execConfig  *types.ExecConfig
+		expectedErr error
+	}{
+		"test_validate_exec_config_valid": {
+			execConfig: &types.ExecConfig{
+				Cmd: []string{"ls", "-la"},
+				Env: []string{"VAR=1"},
+			},
+		},
+		"test_validate_exec_config_nil": {
+			expectedErr: log.NewError("the command to be executed must be provided"),
+		},
+		"test_validate_exec_config_no_cmd": {
+			execConfig:  &types.ExecConfig{},
+			expectedErr: log.NewError("the command to be executed must be provided"),
+		},
+		"test_validate_exec_config_empty_cmd": {
+			execConfig:  &types.ExecConfig{Cmd: []string{""}},
+			expectedErr: log.NewError("the command to be executed must be provided"),
+		},
+		"test_validate_exec_config_env_incorrect_format": {
+			execConfig: &types.ExecConfig{
+				Cmd: []string{"ls"},
+				Env: []string{"V@R=1"},
+			},
+			expectedErr: log.NewErrorf("invalid environmental variable declaration provided : V@R=1"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateExecConfig(testCase.execConfig))
+		})
+	}
+}
+
+func TestValidateCheckpointOpts(t *testing.T) {
+	tests := map[string]struct {
+		checkpointOpts *types.CheckpointOpts
+		expectedErr    error
+	}{
+		"test_validate_checkpoint_opts_valid": {
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: "test-checkpoint_1.0", Exit: true},
+		},
+		"test_validate_checkpoint_opts_nil": {
+			expectedErr: log.NewError("the checkpoint options must be provided"),
+		},
+		"test_validate_checkpoint_opts_no_id": {
+			checkpointOpts: &types.CheckpointOpts{},
+			expectedErr:    log.NewError("the checkpoint ID must be provided"),
+		},
+		"test_validate_checkpoint_opts_invalid_id": {
+			checkpointOpts: &types.CheckpointOpts{CheckpointID: "../test"},
+			expectedErr:    log.NewErrorf("invalid checkpoint ID format : ../test"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateCheckpointOpts(testCase.checkpointOpts))
+		})
+	}
+}
+
+func TestValidateResources(t *testing.T) {
+	tests := map[string]struct {
+		resources   *types.Resources
+		expectedErr error
+	}{
+		"test_validate_resources_nil": {},
+		"test_validate_resources_cpu_pids_blkio_valid": {
+			resources: &types.Resources{
+				CPUQuota:             50000,
+				CPUPeriod:            100000,
+				CPUShares:            512,
+				CPUSetCPUs:           "0-2,4",
+				CPUSetMems:           "0",
+				PidsLimit:            100,
+				BlkioWeight:          500,
+				BlkioDeviceReadBps:   []types.ThrottleDevice{{Path: "/dev/sda", Rate: 1048576}},
+				BlkioDeviceWriteIOps: []types.ThrottleDevice{{Path: "/dev/sda", Rate: 100}},
+			},
+		},
+		"test_validate_resources_cpu_quota_too_low": {
+			resources:   &types.Resources{CPUQuota: 999},
+			expectedErr: log.NewErrorf("invalid CPU quota - %d, must be at least %d microseconds", 999, cpuCFSMin),
+		},
+		"test_validate_resources_cpu_quota_negative": {
+			resources:   &types.Resources{CPUQuota: -1},
+			expectedErr: log.NewErrorf("invalid CPU quota - %d, must be at least %d microseconds", -1, cpuCFSMin),
+		},
+		"test_validate_resources_cpu_period_too_high": {
+			resources:   &types.Resources{CPUPeriod: 1000001},
+			expectedErr: log.NewErrorf("invalid CPU period - %d, must be in the range of %d to %d microseconds", 1000001, cpuCFSMin, cpuCFSMax),
+		},
+		"test_validate_resources_cpu_shares_too_low": {
+			resources:   &types.Resources{CPUShares: 1},
+			expectedErr: log.NewErrorf("invalid CPU shares - %d, must be in the range of %d to %d", 1, cpuSharesMin, cpuSharesMax),
+		},
+		"test_validate_resources_cpuset_cpus_invalid": {
+			resources:   &types.Resources{CPUSetCPUs: "0-"},
+			expectedErr: log.NewErrorf("invalid format of cpuset CPUs - %s", "0-"),
+		},
+		"test_validate_resources_cpuset_mems_invalid": {
+			resources:   &types.Resources{CPUSetMems: "a"},
+			expectedErr: log.NewErrorf("invalid format of cpuset memory nodes - %s", "a"),
+		},
+		"test_validate_resources_pids_limit_negative": {
+			resources:   &types.Resources{PidsLimit: -1},
+			expectedErr: log.NewErrorf("invalid pids limit - %d, must be a positive number", -1),
+		},
+		"test_validate_resources_blkio_weight_out_of_range": {
+			resources:   &types.Resources{BlkioWeight: 1001},
+			expectedErr: log.NewErrorf("invalid block IO weight - %d, must be in the range of %d to %d", 1001, blkioWeightMin, blkioWeightMax),
+		},
+		"test_validate_resources_throttle_device_relative_path": {
+			resources:   &types.Resources{BlkioDeviceWriteBps: []types.ThrottleDevice{{Path: "sda", Rate: 1024}}},
+			expectedErr: log.NewErrorf("invalid block IO throttle device path - %s, must be an absolute path", "sda"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateResources(testCase.resources))
+		})
+	}
+}
+
+func TestValidateDNS(t *testing.T) {
+	tests := map[string]struct {
+		hostConfig  *types.HostConfig
+		expectedErr error
+	}{
+		"test_validate_dns_not_set": {
+			hostConfig: &types.HostConfig{},
+		},
+		"test_validate_dns_valid": {
+			hostConfig: &types.HostConfig{
+				DNS:        []string{"8.8.8.8", "2001:4860:4860::8888"},
+				DNSSearch:  []string{"example.com", "corp.example.com.", "local"},
+				DNSOptions: []string{"ndots:2", "timeout:1", "rotate", "single-request-reopen"},
+			},
+		},
+		"test_validate_dns_invalid_server": {
+			hostConfig:  &types.HostConfig{DNS: []string{"8.8.8"}},
+			expectedErr: log.NewError("invalid DNS server 8.8.8, must be an IPv4 or IPv6 address"),
+		},
+		"test_validate_dns_invalid_search_domain": {
+			hostConfig:  &types.HostConfig{DNSSearch: []string{"-example.com"}},
+			expectedErr: log.NewError("invalid DNS search domain -example.com"),
+		},
+		"test_validate_dns_empty_search_domain_label": {
+			hostConfig:  &types.HostConfig{DNSSearch: []string{"example..com"}},
+			expectedErr: log.NewError("invalid DNS search domain example..com"),
+		},
+		"test_validate_dns_search_domain_too_long": {
+			hostConfig:  &types.HostConfig{DNSSearch: []string{strings.Repeat("a.", 127)}},
+			expectedErr: log.NewErrorf("invalid DNS search domain %s", strings.Repeat("a.", 127)),
+		},
+		"test_validate_dns_invalid_option": {
+			hostConfig:  &types.HostConfig{DNSOptions: []string{"ndots 2"}},
+			expectedErr: log.NewError("invalid DNS option ndots 2, must be in the format name[:value]"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateDNS(testCase.hostConfig))
+		})
+	}
+}
+
+func TestValidateDroppedCapabilities(t *testing.T) {
+	testutil.AssertNil(t, ValidateDroppedCapabilities(nil))
+	testutil.AssertNil(t, ValidateDroppedCapabilities([]string{"CAP_NET_RAW", "CAP_MKNOD", "CAP_CHECKPOINT_RESTORE"}))
+	testutil.AssertNil(t, ValidateDroppedCapabilities([]string{types.CapabilityAll}))
+	testutil.AssertError(t, log.NewError("unknown capability cap_net_raw, must be a known capability, e.g. CAP_NET_RAW, or ALL"), ValidateDroppedCapabilities([]string{"cap_net_raw"}))
+}
+
+func TestValidateSecurityOptions(t *testing.T) {
+	tests := map[string]struct {
+		hostConfig  *types.HostConfig
+		expectedErr error
+	}{
+		"test_validate_security_opts_not_set": {
+			hostConfig: &types.HostConfig{},
+		},
+		"test_validate_security_opts_default": {
+			hostConfig: &types.HostConfig{SecurityOpts: &types.SecurityOptions{Seccomp: types.SeccompProfileDefault, NoNewPrivileges: true}},
+		},
+		"test_validate_security_opts_custom": {
+			hostConfig: &types.HostConfig{
+				SecurityOpts: &types.SecurityOptions{
+					Seccomp:         "/etc/kanto/seccomp/vendor.json",
+					AppArmorProfile: "kanto-vendor",
+					SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+				},
+			},
+		},
+		"test_validate_security_opts_privileged_unconfined": {
+			hostConfig: &types.HostConfig{Privileged: true, SecurityOpts: &types.SecurityOptions{Seccomp: types.SecurityProfileUnconfined}},
+		},
+		"test_validate_security_opts_privileged_seccomp": {
+			hostConfig:  &types.HostConfig{Privileged: true, SecurityOpts: &types.SecurityOptions{Seccomp: types.SeccompProfileDefault}},
+			expectedErr: log.NewError("cannot create the container as privileged and with a seccomp profile at the same time - choose one of the options"),
+		},
+		"test_validate_security_opts_relative_seccomp": {
+			hostConfig:  &types.HostConfig{SecurityOpts: &types.SecurityOptions{Seccomp: "seccomp/vendor.json"}},
+			expectedErr: log.NewError("invalid seccomp profile seccomp/vendor.json, must be default, unconfined or an absolute path to a JSON profile"),
+		},
+		"test_validate_security_opts_invalid_apparmor": {
+			hostConfig:  &types.HostConfig{SecurityOpts: &types.SecurityOptions{AppArmorProfile: "kanto vendor"}},
+			expectedErr: log.NewError("invalid AppArmor profile kanto vendor"),
+		},
+		"test_validate_security_opts_invalid_selinux": {
+			hostConfig:  &types.HostConfig{SecurityOpts: &types.SecurityOptions{SELinuxLabel: "container_t"}},
+			expectedErr: log.NewError("invalid SELinux label container_t, must be in the format user:role:type:level"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateSecurityOptions(testCase.hostConfig))
+		})
+	}
+}
+
+func TestValidateNetworkPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy      *types.NetworkPolicy
+		expectedErr error
+	}{
+		"test_validate_network_policy_nil": {},
+		"test_validate_network_policy_valid": {
+			policy: &types.NetworkPolicy{
+				IngressRate:   1048576,
+				EgressRate:    524288,
+				EgressDefault: types.EgressActionDeny,
+				EgressRules: []types.EgressRule{
+					{Action: types.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "443"},
+					{Action: types.EgressActionAllow, CIDR: "2001:db8::/32", Protocol: "udp", Ports: "5000-6000"},
+					{Action: types.EgressActionDeny, CIDR: "0.0.0.0/0"},
+				},
+			},
+		},
+		"test_validate_network_policy_egress_rate_too_high": {
+			policy:      &types.NetworkPolicy{EgressRate: math.MaxUint32 + 1},
+			expectedErr: log.NewErrorf("invalid egress rate - %d, must not exceed %d bytes per second", uint64(math.MaxUint32+1), uint64(math.MaxUint32)),
+		},
+		"test_validate_network_policy_unsupported_default": {
+			policy:      &types.NetworkPolicy{EgressDefault: "reject"},
+			expectedErr: log.NewError("unsupported default egress action reject"),
+		},
+		"test_validate_network_policy_unsupported_action": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{CIDR: "10.0.0.0/8"}}},
+			expectedErr: log.NewError("unsupported egress action  for destination 10.0.0.0/8"),
+		},
+		"test_validate_network_policy_unsupported_protocol": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "10.0.0.0/8", Protocol: "icmp"}}},
+			expectedErr: log.NewError("unsupported egress protocol icmp for destination 10.0.0.0/8"),
+		},
+		"test_validate_network_policy_ports_without_protocol": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "10.0.0.0/8", Ports: "80"}}},
+			expectedErr: log.NewError("the protocol must be set to use egress ports for destination 10.0.0.0/8"),
+		},
+		"test_validate_network_policy_invalid_ports": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "80,443"}}},
+			expectedErr: log.NewError("invalid egress ports 80,443 for destination 10.0.0.0/8"),
+		},
+		"test_validate_network_policy_invalid_ports_range": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "9000-8000"}}},
+			expectedErr: log.NewError("invalid egress ports 9000-8000 for destination 10.0.0.0/8"),
+		},
+		"test_validate_network_policy_ports_out_of_range": {
+			policy:      &types.NetworkPolicy{EgressRules: []types.EgressRule{{Action: types.EgressActionDeny, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "65536"}}},
+			expectedErr: log.NewError("invalid egress ports 65536 for destination 10.0.0.0/8"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateNetworkPolicy(testCase.policy))
+		})
+	}
+}
+
+func TestValidateNamespaceModes(t *testing.T) {
+	tests := map[string]struct {
+		hostConfig  *types.HostConfig
+		expectedErr error
+	}{
+		"test_validate_namespace_modes_not_set": {
+			hostConfig: &types.HostConfig{},
+		},
+		"test_validate_namespace_modes_valid": {
+			hostConfig: &types.HostConfig{IpcMode: types.NamespaceModeContainerPrefix + "sidecar", PidMode: types.NamespaceModeHost},
+		},
+		"test_validate_namespace_modes_private": {
+			hostConfig: &types.HostConfig{IpcMode: types.NamespaceModePrivate, PidMode: types.NamespaceModePrivate},
+		},
+		"test_validate_namespace_modes_container_not_provided": {
+			hostConfig:  &types.HostConfig{IpcMode: types.NamespaceModeContainerPrefix},
+			expectedErr: log.NewError("the name or ID of the container must be provided for IPC namespace mode container:<name|id>"),
+		},
+		"test_validate_namespace_modes_unsupported_pid_mode": {
+			hostConfig:  &types.HostConfig{PidMode: "none"},
+			expectedErr: log.NewError("unsupported PID namespace mode none, must be private, host or container:<name|id>"),
+		},
+		"test_validate_namespace_modes_host_userns_remap": {
+			hostConfig:  &types.HostConfig{PidMode: types.NamespaceModeHost, UsernsMode: types.UsernsModeRemap},
+			expectedErr: log.NewError("cannot share the PID namespace of the host or of another container with a remapped user namespace - choose one of the options"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateNamespaceModes(testCase.hostConfig))
+		})
+	}
+}
+
+func TestValidateUlimits(t *testing.T) {
+	tests := map[string]struct {
+		ulimits     []types.Ulimit
+		expectedErr error
+	}{
+		"test_validate_ulimits_not_set": {},
+		"test_validate_ulimits_valid": {
+			ulimits: []types.Ulimit{{Name: "memlock", Soft: -1, Hard: -1}, {Name: "nofile", Soft: 1024, Hard: 65536}, {Name: "nproc", Soft: 512, Hard: -1}},
+		},
+		"test_validate_ulimits_duplicated": {
+			ulimits:     []types.Ulimit{{Name: "nproc", Soft: 512, Hard: 512}, {Name: "nproc", Soft: 1024, Hard: 1024}},
+			expectedErr: log.NewError("ulimit nproc is set more than once"),
+		},
+		"test_validate_ulimits_negative": {
+			ulimits:     []types.Ulimit{{Name: "memlock", Soft: -2, Hard: -1}},
+			expectedErr: log.NewError("invalid memlock ulimit, the soft and hard limits must be positive numbers or -1 for unlimited"),
+		},
+		"test_validate_ulimits_soft_exceeds_hard": {
+			ulimits:     []types.Ulimit{{Name: "memlock", Soft: 65536, Hard: 8192}},
+			expectedErr: log.NewError("invalid memlock ulimit, the soft limit must not exceed the hard one"),
+		},
+		"test_validate_ulimits_unlimited_soft_exceeds_hard": {
+			ulimits:     []types.Ulimit{{Name: "memlock", Soft: -1, Hard: 8192}},
+			expectedErr: log.NewError("invalid memlock ulimit, the soft limit must not exceed the hard one"),
+		},
+		"test_validate_ulimits_nofile_unlimited": {
+			ulimits:     []types.Ulimit{{Name: "nofile", Soft: -1, Hard: -1}},
+			expectedErr: log.NewError("invalid nofile ulimit, the hard limit must not exceed 1048576"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateUlimits(testCase.ulimits))
+		})
+	}
+}
+
+func TestValidateSysctls(t *testing.T) {
+	tests := map[string]struct {
+		hostConfig  *types.HostConfig
+		expectedErr error
+	}{
+		"test_validate_sysctls_not_set": {
+			hostConfig: &types.HostConfig{},
+		},
+		"test_validate_sysctls_valid": {
+			hostConfig: &types.HostConfig{
+				NetworkMode: types.NetworkModeBridge,
+				Sysctls:     map[string]string{"kernel.shmmax": "68719476736", "fs.mqueue.msg_max": "100", "net.ipv4.tcp_keepalive_time": "60"},
+			},
+		},
+		"test_validate_sysctls_no_value": {
+			hostConfig:  &types.HostConfig{Sysctls: map[string]string{"kernel.sem": ""}},
+			expectedErr: log.NewError("no value provided for sysctl kernel.sem"),
+		},
+		"test_validate_sysctls_not_namespaced": {
+			hostConfig:  &types.HostConfig{Sysctls: map[string]string{"kernel.panic": "10"}},
+			expectedErr: log.NewError("sysctl kernel.panic is not namespaced and cannot be set for a container"),
+		},
+		"test_validate_sysctls_ipc_host": {
+			hostConfig:  &types.HostConfig{IpcMode: types.NamespaceModeHost, Sysctls: map[string]string{"fs.mqueue.msg_max": "100"}},
+			expectedErr: log.NewError("cannot set sysctl fs.mqueue.msg_max when sharing the IPC namespace of the host or of another container"),
+		},
+		"test_validate_sysctls_network_container": {
+			hostConfig:  &types.HostConfig{NetworkMode: types.NetworkModeContainerPrefix + "sidecar", Sysctls: map[string]string{"net.ipv4.ip_forward": "1"}},
+			expectedErr: log.NewError("cannot set sysctl net.ipv4.ip_forward when sharing the network stack of the host or of another container"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertError(t, testCase.expectedErr, ValidateSysctls(testCase.hostConfig))
+		})
+	}
+}
+
+func TestValidateShmSize(t *testing.T) {
+	testutil.AssertNil(t, ValidateShmSize(&types.HostConfig{}))
+	testutil.AssertNil(t, ValidateShmSize(&types.HostConfig{ShmSize: "256M"}))
+	testutil.AssertError(t, log.NewError("invalid /dev/shm size - 0M, must be a positive size"), ValidateShmSize(&types.HostConfig{ShmSize: "0M"}))
+	testutil.AssertError(t, log.NewError("cannot set the /dev/shm size when sharing the IPC namespace of the host or of another container"),
+		ValidateShmSize(&types.HostConfig{ShmSize: "256M", IpcMode: types.NamespaceModeContainerPrefix + "sidecar"}))
+}
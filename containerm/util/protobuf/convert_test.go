@@ -13,13 +13,18 @@
 package protobuf
 
 import (
+	"math"
 	"testing"
 	"time"
 
+	apitypescontainers "github.com/eclipse-kanto/container-management/containerm/api/types/containers"
 	internaltypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagesinternaltypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networksinternaltypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
 	sysinfointernaltypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
 	"github.com/eclipse-kanto/container-management/containerm/util"
+	volumesinternaltypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 )
 
 /*
@@ -61,6 +66,11 @@ const (
 	hostConfigLogConfigDriverType        = internaltypes.LogConfigDriverJSONFile
 	hostConfigLogConfigMaxFiles          = 2
 	hostConfigLogConfigMaxSize           = "100M"
+	hostConfigLogConfigAddress           = "udp://localhost:514"
+	hostConfigLogConfigFacility          = "daemon"
+	hostConfigLogConfigTag               = "test-tag"
+	hostConfigLogConfigCompress          = internaltypes.LogCompressionGzip
+	hostConfigLogConfigMaxAge            = "72h"
 	hostConfigLogConfigMode              = internaltypes.LogModeBlocking
 	hostConfigRuntime                    = "some-runtime-config"
 	hostConfigResourcesMemory            = "200M"
@@ -96,6 +106,13 @@ var (
 		Destination:     mountDest,
 		Source:          mountSrc,
 		PropagationMode: mountPropagationMode,
+		Options:         []string{internaltypes.MountOptionReadOnly, internaltypes.MountOptionNoExec},
+	}, {
+		Type:            internaltypes.MountTypeVolume,
+		Destination:     mountDest,
+		Source:          "test-volume",
+		PropagationMode: mountPropagationMode,
+		VolumePath:      "/var/lib/container-management/volumes/test-volume/_data",
 	}}
 
 	hookArgs = append([]string{}, hookArg1)
@@ -112,17 +129,53 @@ var (
 	configEnv               = []string{configEnv1}
 	configArg               = []string{"echo", "test", "command"}
 	internalContainerConfig = internaltypes.ContainerConfiguration{
-		Env: configEnv,
-		Cmd: configArg,
+		Env:        configEnv,
+		Cmd:        configArg,
+		Entrypoint: []string{"/bin/sh", "-c"},
+		WorkingDir: "/app",
+		User:       "app:1000",
+		Groups:     []string{"audio", "44"},
+		Labels:     map[string]string{"app": "test"},
 	}
 
 	hostConfigExtraHosts        = []string{"ctrhost:host_ip"}
 	hostConfigExtraCapabilities = []string{"CAP_NET_ADMIN"}
 	internalHostConfig          = &internaltypes.HostConfig{
-		Privileged:        hostConfigPrivileged,
-		ExtraHosts:        hostConfigExtraHosts,
-		ExtraCapabilities: hostConfigExtraCapabilities,
-		NetworkMode:       hostConfigNetType,
+		Privileged:          hostConfigPrivileged,
+		ExtraHosts:          hostConfigExtraHosts,
+		ExtraCapabilities:   hostConfigExtraCapabilities,
+		DroppedCapabilities: []string{"CAP_NET_RAW", "CAP_MKNOD"},
+		UsernsMode:          internaltypes.UsernsModeRemap,
+		Ulimits:             []internaltypes.Ulimit{{Name: "memlock", Soft: -1, Hard: -1}, {Name: "nofile", Soft: 1024, Hard: 4096}},
+		Sysctls:             map[string]string{"net.ipv4.ip_forward": "1", "kernel.shmmax": "68719476736"},
+		ShmSize:             "256M",
+		IpcMode:             internaltypes.NamespaceModeHost,
+		PidMode:             internaltypes.NamespaceModeContainerPrefix + "sidecar",
+		RuntimeHandler:      "crun",
+		ReadOnlyRootfs:      true,
+		NetworkMode:         hostConfigNetType,
+		Networks:            []string{"backend", "monitoring"},
+		EndpointsConfig: map[string]*internaltypes.EndpointConfig{
+			"backend": {IPv4Address: "172.20.0.10", IPv6Address: "fd00::10", MacAddress: "02:42:ac:14:00:0a"},
+		},
+		DNS:        []string{"8.8.8.8", "2001:4860:4860::8888"},
+		DNSSearch:  []string{"example.com"},
+		DNSOptions: []string{"ndots:2"},
+		SecurityOpts: &internaltypes.SecurityOptions{
+			Seccomp:         "/etc/kanto/seccomp/vendor.json",
+			AppArmorProfile: "kanto-vendor",
+			SELinuxLabel:    "system_u:system_r:container_t:s0:c1,c2",
+			NoNewPrivileges: true,
+		},
+		NetworkPolicy: &internaltypes.NetworkPolicy{
+			IngressRate:   1048576,
+			EgressRate:    524288,
+			EgressDefault: internaltypes.EgressActionDeny,
+			EgressRules: []internaltypes.EgressRule{
+				{Action: internaltypes.EgressActionAllow, CIDR: "10.0.0.0/8", Protocol: "tcp", Ports: "443"},
+				{Action: internaltypes.EgressActionAllow, CIDR: "2001:db8::/32"},
+			},
+		},
 		PortMappings: []internaltypes.PortMapping{{
 			ContainerPort: hostConfigContainerPort,
 			HostPort:      hostConfigHostPort,
@@ -135,15 +188,21 @@ var (
 			CgroupPermissions: hostConfigDevicePerm,
 		}},
 		RestartPolicy: &internaltypes.RestartPolicy{
-			MaximumRetryCount: hostConfigRestartPolicyMaxRetry,
-			RetryTimeout:      hostConfigRestartPolicyTimeout,
-			Type:              hostConfigRestartPolicyType,
+			MaximumRetryCount:  hostConfigRestartPolicyMaxRetry,
+			RetryTimeout:       hostConfigRestartPolicyTimeout,
+			Type:               hostConfigRestartPolicyType,
+			RestartOnUnhealthy: true,
 		},
 		LogConfig: &internaltypes.LogConfiguration{
 			DriverConfig: &internaltypes.LogDriverConfiguration{
 				Type:     hostConfigLogConfigDriverType,
 				MaxFiles: hostConfigLogConfigMaxFiles,
 				MaxSize:  hostConfigLogConfigMaxSize,
+				Address:  hostConfigLogConfigAddress,
+				Facility: hostConfigLogConfigFacility,
+				Tag:      hostConfigLogConfigTag,
+				Compress: hostConfigLogConfigCompress,
+				MaxAge:   hostConfigLogConfigMaxAge,
 			},
 			ModeConfig: &internaltypes.LogModeConfiguration{
 				Mode: hostConfigLogConfigMode,
@@ -154,6 +213,25 @@ var (
 			Memory:            hostConfigResourcesMemory,
 			MemoryReservation: hostConfigResourcesMemoryReservation,
 			MemorySwap:        hostConfigResourcesMemorySwap,
+			CPUQuota:          50000,
+			CPUPeriod:         100000,
+			CPUShares:         512,
+			CPUSetCPUs:        "0-1",
+			CPUSetMems:        "0",
+			PidsLimit:         100,
+			BlkioWeight:       300,
+			BlkioDeviceReadBps: []internaltypes.ThrottleDevice{
+				{Path: "/dev/sda", Rate: 1048576},
+			},
+			BlkioDeviceWriteBps: []internaltypes.ThrottleDevice{
+				{Path: "/dev/sda", Rate: 524288},
+			},
+			BlkioDeviceReadIOps: []internaltypes.ThrottleDevice{
+				{Path: "/dev/sdb", Rate: 1000},
+			},
+			BlkioDeviceWriteIOps: []internaltypes.ThrottleDevice{
+				{Path: "/dev/sdb", Rate: 500},
+			},
 		},
 	}
 
@@ -178,21 +256,32 @@ var (
 				NetworkID:  networkSettingNetworkID,
 			},
 		}}
+
+	internalHealthCheck = &internaltypes.HealthCheckConfig{
+		Type:        internaltypes.HealthCheckHTTP,
+		Port:        hostConfigContainerPort,
+		Path:        "/health",
+		Interval:    time.Duration(30) * time.Second,
+		Timeout:     time.Duration(10) * time.Second,
+		Retries:     3,
+		StartPeriod: time.Duration(5) * time.Second,
+	}
 )
 
 // Tests conversions for container fields, that are expected to be mapped 1:1 (all info exposed)
 func TestConvertContainer(t *testing.T) {
 	ctr := &internaltypes.Container{
-		ID:         id,
-		Name:       name,
-		Image:      internalImageWithDecryptConfig,
-		DomainName: domain,
-		HostName:   host,
-		Mounts:     internalMounts,
-		Hooks:      internalHooks,
-		Config:     &internalContainerConfig,
-		HostConfig: internalHostConfig,
-		IOConfig:   internalIOConfig,
+		ID:          id,
+		Name:        name,
+		Image:       internalImageWithDecryptConfig,
+		DomainName:  domain,
+		HostName:    host,
+		Mounts:      internalMounts,
+		Hooks:       internalHooks,
+		Config:      &internalContainerConfig,
+		HostConfig:  internalHostConfig,
+		IOConfig:    internalIOConfig,
+		HealthCheck: internalHealthCheck,
 		// not exposed:
 		//NetworkSettings: internalNetworkSettings,
 		ManuallyStopped: manuallyStopped,
@@ -240,6 +329,19 @@ func TestConvertContainerHidden(t *testing.T) {
 	})
 }
 
+func TestConvertState(t *testing.T) {
+	state := &internaltypes.State{
+		Health: &internaltypes.Health{
+			Status:        internaltypes.HealthUnhealthy,
+			FailingStreak: 3,
+			LastCheckAt:   "2026-01-01T00:00:00Z",
+			LastOutput:    "connection refused",
+		},
+	}
+
+	testutil.AssertEqual(t, state, ToInternalState(ToProtoState(state)))
+}
+
 func TestToInternalStatus(t *testing.T) {
 	tests := map[string]struct {
 		grpcStatus string
@@ -351,6 +453,108 @@ func TestToInternalProjectInfo(t *testing.T) {
 	})
 }
 
+func TestToInternalRuntimeInfo(t *testing.T) {
+	runtimeInfo := sysinfointernaltypes.RuntimeInfo{
+		DefaultRuntimeHandler: "crun",
+		RuntimeHandlers: []*internaltypes.RuntimeHandler{{
+			Name:        "crun",
+			RuntimeType: internaltypes.RuntimeTypeV2runcV2,
+			Options: &internaltypes.RuntimeOptions{
+				BinaryName:    "/usr/bin/crun",
+				Root:          "/run/crun",
+				SystemdCgroup: true,
+			},
+		}, {
+			Name:        "kata",
+			RuntimeType: internaltypes.RuntimeTypeV2kataV2,
+			BinaryPath:  "/opt/kata/bin/containerd-shim-kata-v2",
+		}},
+	}
+
+	t.Run("test_convert_runtime_info", func(t *testing.T) {
+		testutil.AssertEqual(t, runtimeInfo, ToInternalRuntimeInfo(ToProtoRuntimeInfo(runtimeInfo)))
+	})
+	t.Run("test_convert_runtime_info_nil", func(t *testing.T) {
+		testutil.AssertEqual(t, sysinfointernaltypes.RuntimeInfo{}, ToInternalRuntimeInfo(nil))
+	})
+}
+
+func TestToInternalImageInfos(t *testing.T) {
+	imageInfos := []*imagesinternaltypes.ImageInfo{{
+		Name:       "host/group/image:tag",
+		Digest:     "sha256:d8f8ab8f8bfa4b5b3b95a0b5f1c39e3f4b55e3c4c1d76e0e6b8c0bbd5b6aabb2",
+		Size:       1024,
+		Platform:   "linux/arm/v7",
+		Created:    "2026-01-02T15:04:05Z",
+		Expiry:     "2026-02-01T15:04:05Z",
+		Containers: []string{"test-ctr"},
+	}, {
+		Name: "host/group/image2:tag",
+	}}
+
+	t.Run("test_convert_image_infos", func(t *testing.T) {
+		testutil.AssertEqual(t, imageInfos, ToInternalImageInfos(ToProtoImageInfos(imageInfos)))
+	})
+	t.Run("test_convert_image_info_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalImageInfo(ToProtoImageInfo(nil)))
+	})
+	t.Run("test_convert_image_infos_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalImageInfos(ToProtoImageInfos(nil)))
+	})
+}
+
+func TestToInternalVolumes(t *testing.T) {
+	volumes := []*volumesinternaltypes.Volume{{
+		Name:       "test-volume",
+		Driver:     "tmpfs",
+		Size:       "64M",
+		Mountpoint: "/var/lib/container-management/volumes/test-volume/_data",
+		Created:    "2026-01-02T15:04:05Z",
+		Containers: []string{"test-ctr"},
+	}, {
+		Name: "test-volume2",
+	}}
+
+	t.Run("test_convert_volumes", func(t *testing.T) {
+		testutil.AssertEqual(t, volumes, ToInternalVolumes(ToProtoVolumes(volumes)))
+	})
+	t.Run("test_convert_volume_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalVolume(ToProtoVolume(nil)))
+	})
+	t.Run("test_convert_volumes_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalVolumes(ToProtoVolumes(nil)))
+	})
+}
+
+func TestToInternalNetworks(t *testing.T) {
+	networks := []*networksinternaltypes.Network{{
+		Name:         "backend",
+		ID:           "backend-id",
+		Driver:       networksinternaltypes.NetworkDriverBridge,
+		Subnet:       "172.30.0.0/16",
+		Gateway:      "172.30.0.1",
+		MTU:          1400,
+		ICC:          true,
+		IPMasquerade: true,
+		Created:      "2026-01-02T15:04:05Z",
+		Containers:   []string{"test-ctr"},
+	}, {
+		Name:    "host",
+		Driver:  networksinternaltypes.NetworkDriverHost,
+		BuiltIn: true,
+	}}
+
+	t.Run("test_convert_networks", func(t *testing.T) {
+		testutil.AssertEqual(t, networks, ToInternalNetworks(ToProtoNetworks(networks)))
+	})
+	t.Run("test_convert_network_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalNetwork(ToProtoNetwork(nil)))
+	})
+	t.Run("test_convert_networks_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalNetworks(ToProtoNetworks(nil)))
+	})
+}
+
 func TestToInternalUpdateOpts(t *testing.T) {
 	updateOpts := &internaltypes.UpdateOpts{
 		RestartPolicy: &internaltypes.RestartPolicy{
@@ -363,6 +567,10 @@ func TestToInternalUpdateOpts(t *testing.T) {
 			MemoryReservation: hostConfigResourcesMemoryReservation,
 			MemorySwap:        hostConfigResourcesMemorySwap,
 		},
+		NetworkPolicy: &internaltypes.NetworkPolicy{
+			EgressRate:  524288,
+			EgressRules: []internaltypes.EgressRule{{Action: internaltypes.EgressActionDeny, CIDR: "192.168.0.0/16", Protocol: "udp", Ports: "5000-6000"}},
+		},
 	}
 
 	t.Run("test_convert_update_options", func(t *testing.T) {
@@ -373,3 +581,113 @@ func TestToInternalUpdateOpts(t *testing.T) {
 		testutil.AssertEqual(t, &internaltypes.UpdateOpts{RestartPolicy: nil, Resources: nil}, ToInternalUpdateOptions(ToProtoUpdateOptions(nil)))
 	})
 }
+
+func TestToInternalResourcesBlkioWeight(t *testing.T) {
+	t.Run("test_convert_blkio_weight", func(t *testing.T) {
+		testutil.AssertEqual(t, uint16(500), ToInternalResources(&apitypescontainers.Resources{BlkioWeight: 500}).BlkioWeight)
+	})
+
+	t.Run("test_convert_blkio_weight_overflow", func(t *testing.T) {
+		internalResources := ToInternalResources(&apitypescontainers.Resources{BlkioWeight: 65546})
+		testutil.AssertEqual(t, uint16(math.MaxUint16), internalResources.BlkioWeight)
+		testutil.AssertNotNil(t, util.ValidateResources(internalResources))
+	})
+}
+
+func TestToInternalExecConfig(t *testing.T) {
+	execConfig := &internaltypes.ExecConfig{
+		Cmd:        []string{"sh", "-c", "echo test"},
+		Env:        []string{"VAR=value"},
+		WorkingDir: "/tmp",
+		Tty:        true,
+		OpenStdin:  true,
+	}
+
+	t.Run("test_convert_exec_config", func(t *testing.T) {
+		testutil.AssertEqual(t, execConfig, ToInternalExecConfig(ToProtoExecConfig(execConfig)))
+	})
+
+	t.Run("test_convert_exec_config_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalExecConfig(ToProtoExecConfig(nil)))
+	})
+}
+
+func TestToInternalCheckpointOptions(t *testing.T) {
+	checkpointOpts := &internaltypes.CheckpointOpts{
+		CheckpointID: "test-checkpoint",
+		Exit:         true,
+	}
+
+	t.Run("test_convert_checkpoint_options", func(t *testing.T) {
+		testutil.AssertEqual(t, checkpointOpts, ToInternalCheckpointOptions(ToProtoCheckpointOptions(checkpointOpts)))
+	})
+
+	t.Run("test_convert_checkpoint_options_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalCheckpointOptions(ToProtoCheckpointOptions(nil)))
+	})
+}
+
+func TestToInternalCheckpoints(t *testing.T) {
+	checkpoints := []*internaltypes.Checkpoint{
+		{ID: "test-checkpoint-1", Created: "2026-01-02T15:04:05Z"},
+		{ID: "test-checkpoint-2", Created: "2026-01-03T15:04:05Z"},
+	}
+
+	t.Run("test_convert_checkpoints", func(t *testing.T) {
+		testutil.AssertEqual(t, checkpoints, ToInternalCheckpoints(ToProtoCheckpoints(checkpoints)))
+	})
+
+	t.Run("test_convert_checkpoints_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalCheckpoints(ToProtoCheckpoints(nil)))
+	})
+}
+
+func TestToInternalContainerStats(t *testing.T) {
+	containerStats := []*internaltypes.ContainerStats{
+		{
+			ID:   id,
+			Name: name,
+			Metrics: &internaltypes.Metrics{
+				CPU:       &internaltypes.CPUStats{Total: 1000, Used: 100},
+				Memory:    &internaltypes.MemoryStats{Total: 2048, Used: 1024},
+				IO:        &internaltypes.IOStats{Read: 10, Write: 20},
+				Network:   &internaltypes.IOStats{Read: 30, Write: 40},
+				Timestamp: time.Date(2026, 1, 2, 15, 4, 5, 999, time.UTC),
+				PIDs:      5,
+			},
+		},
+		{ID: "test-id-not-running", Name: "not-running"},
+	}
+
+	t.Run("test_convert_container_stats", func(t *testing.T) {
+		testutil.AssertEqual(t, containerStats, ToInternalContainerStats(ToProtoContainerStats(containerStats)))
+	})
+
+	t.Run("test_convert_container_stats_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalContainerStats(ToProtoContainerStats(nil)))
+		testutil.AssertNil(t, ToInternalMetrics(ToProtoMetrics(nil)))
+	})
+}
+
+func TestToInternalEvent(t *testing.T) {
+	event := &internaltypes.Event{
+		Type:   internaltypes.EventTypeContainers,
+		Action: internaltypes.EventActionContainersRunning,
+		Source: internaltypes.Container{
+			ID:    id,
+			Name:  name,
+			Image: internalImage,
+			State: &internaltypes.State{},
+		},
+		Time: 1767366245,
+	}
+	util.SetContainerStatusRunning(&event.Source, 1234)
+
+	t.Run("test_convert_event", func(t *testing.T) {
+		testutil.AssertEqual(t, event, ToInternalEvent(ToProtoEvent(event)))
+	})
+
+	t.Run("test_convert_event_nil", func(t *testing.T) {
+		testutil.AssertNil(t, ToInternalEvent(ToProtoEvent(nil)))
+	})
+}
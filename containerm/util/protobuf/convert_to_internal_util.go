@@ -13,12 +13,20 @@
 package protobuf
 
 import (
+	"math"
 	"time"
 
 	apitypescontainers "github.com/eclipse-kanto/container-management/containerm/api/types/containers"
+	apitypesimages "github.com/eclipse-kanto/container-management/containerm/api/types/images"
+	apitypesnetworks "github.com/eclipse-kanto/container-management/containerm/api/types/networks"
 	apitypessysinfo "github.com/eclipse-kanto/container-management/containerm/api/types/sysinfo"
+	apitypesvolumes "github.com/eclipse-kanto/container-management/containerm/api/types/volumes"
 	internaltypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagesinternaltypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networksinternaltypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	sysinfointernaltypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	volumesinternaltypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 )
 
 // ToInternalContainer converts a types.Container instance to an internal Container one
@@ -64,6 +72,7 @@ func ToInternalContainer(grpcContainer *apitypescontainers.Container) *internalt
 		Created:         grpcContainer.Created,
 		ManuallyStopped: grpcContainer.ManuallyStopped,
 		RestartCount:    int(grpcContainer.RestartCount),
+		HealthCheck:     ToInternalHealthCheckConfig(grpcContainer.HealthCheck),
 	}
 }
 
@@ -79,11 +88,60 @@ func ToInternalDeviceMapping(grpcDevMapping *apitypescontainers.DeviceMapping) *
 // ToInternalEndpointSettings converts a types.EndpointSettings instance to an internal EndpointSettings one
 func ToInternalEndpointSettings(grpcEpSettings *apitypescontainers.EndpointSettings) *internaltypes.EndpointSettings {
 	return &internaltypes.EndpointSettings{
-		ID:         grpcEpSettings.Id,
-		Gateway:    grpcEpSettings.Gateway,
-		IPAddress:  grpcEpSettings.IpAddress,
-		MacAddress: grpcEpSettings.MacAddress,
-		NetworkID:  grpcEpSettings.NetworkId,
+		ID:          grpcEpSettings.Id,
+		Gateway:     grpcEpSettings.Gateway,
+		IPAddress:   grpcEpSettings.IpAddress,
+		MacAddress:  grpcEpSettings.MacAddress,
+		NetworkID:   grpcEpSettings.NetworkId,
+		IPv6Gateway: grpcEpSettings.Ipv6Gateway,
+		IPv6Address: grpcEpSettings.Ipv6Address,
+	}
+}
+
+// ToInternalEndpointConfig converts a types.EndpointConfig instance to an internal EndpointConfig one
+func ToInternalEndpointConfig(grpcEpConfig *apitypescontainers.EndpointConfig) *internaltypes.EndpointConfig {
+	if grpcEpConfig == nil {
+		return nil
+	}
+	return &internaltypes.EndpointConfig{
+		IPv4Address: grpcEpConfig.Ipv4Address,
+		IPv6Address: grpcEpConfig.Ipv6Address,
+		MacAddress:  grpcEpConfig.MacAddress,
+	}
+}
+
+// ToInternalNetworkPolicy converts a types.NetworkPolicy instance to an internal NetworkPolicy one
+func ToInternalNetworkPolicy(grpcNetPolicy *apitypescontainers.NetworkPolicy) *internaltypes.NetworkPolicy {
+	if grpcNetPolicy == nil {
+		return nil
+	}
+	var egressRules []internaltypes.EgressRule
+	for _, rule := range grpcNetPolicy.EgressRules {
+		egressRules = append(egressRules, internaltypes.EgressRule{
+			Action:   internaltypes.EgressAction(rule.Action),
+			CIDR:     rule.Cidr,
+			Protocol: rule.Protocol,
+			Ports:    rule.Ports,
+		})
+	}
+	return &internaltypes.NetworkPolicy{
+		IngressRate:   grpcNetPolicy.IngressRate,
+		EgressRate:    grpcNetPolicy.EgressRate,
+		EgressDefault: internaltypes.EgressAction(grpcNetPolicy.EgressDefault),
+		EgressRules:   egressRules,
+	}
+}
+
+// ToInternalSecurityOptions converts a types.SecurityOptions instance to an internal SecurityOptions one
+func ToInternalSecurityOptions(grpcSecurityOpts *apitypescontainers.SecurityOptions) *internaltypes.SecurityOptions {
+	if grpcSecurityOpts == nil {
+		return nil
+	}
+	return &internaltypes.SecurityOptions{
+		Seccomp:         grpcSecurityOpts.Seccomp,
+		AppArmorProfile: grpcSecurityOpts.ApparmorProfile,
+		SELinuxLabel:    grpcSecurityOpts.SelinuxLabel,
+		NoNewPrivileges: grpcSecurityOpts.NoNewPrivileges,
 	}
 }
 
@@ -129,6 +187,9 @@ func ToInternalMountPoint(grpcMountPoint *apitypescontainers.MountPoint) *intern
 		Destination:     grpcMountPoint.Destination,
 		Source:          grpcMountPoint.Source,
 		PropagationMode: grpcMountPoint.PropagationMode,
+		Type:            grpcMountPoint.Type,
+		VolumePath:      grpcMountPoint.VolumePath,
+		Options:         grpcMountPoint.Options,
 	}
 }
 
@@ -179,9 +240,10 @@ func ToInternalRestartPolicy(grpcRestartPolicy *apitypescontainers.RestartPolicy
 		return nil
 	}
 	return &internaltypes.RestartPolicy{
-		MaximumRetryCount: int(grpcRestartPolicy.MaximumRetryCount),
-		RetryTimeout:      time.Duration(grpcRestartPolicy.RetryTimeout) * time.Second,
-		Type:              internaltypes.PolicyType(grpcRestartPolicy.Type),
+		MaximumRetryCount:  int(grpcRestartPolicy.MaximumRetryCount),
+		RetryTimeout:       time.Duration(grpcRestartPolicy.RetryTimeout) * time.Second,
+		Type:               internaltypes.PolicyType(grpcRestartPolicy.Type),
+		RestartOnUnhealthy: grpcRestartPolicy.RestartOnUnhealthy,
 	}
 }
 
@@ -191,8 +253,13 @@ func ToInternalConfig(grpcConfig *apitypescontainers.ContainerConfiguration) *in
 		return nil
 	}
 	return &internaltypes.ContainerConfiguration{
-		Env: grpcConfig.Env,
-		Cmd: grpcConfig.Cmd,
+		Env:        grpcConfig.Env,
+		Cmd:        grpcConfig.Cmd,
+		Entrypoint: grpcConfig.Entrypoint,
+		WorkingDir: grpcConfig.WorkingDir,
+		User:       grpcConfig.User,
+		Groups:     grpcConfig.Groups,
+		Labels:     grpcConfig.Labels,
 	}
 }
 
@@ -209,19 +276,59 @@ func ToInternalHostConfig(grpcHostConfig *apitypescontainers.HostConfig) *intern
 			devices = append(devices, *ToInternalDeviceMapping(grpcDevMapping))
 		}
 	}
+	var endpointsConfig map[string]*internaltypes.EndpointConfig
+
+	if grpcHostConfig.EndpointsConfig != nil {
+		endpointsConfig = make(map[string]*internaltypes.EndpointConfig)
+		for netName, epConfig := range grpcHostConfig.EndpointsConfig {
+			endpointsConfig[netName] = ToInternalEndpointConfig(epConfig)
+		}
+	}
 
 	return &internaltypes.HostConfig{
-		Devices:           devices,
-		NetworkMode:       internaltypes.NetworkMode(grpcHostConfig.NetworkMode),
-		Privileged:        grpcHostConfig.Privileged,
-		RestartPolicy:     ToInternalRestartPolicy(grpcHostConfig.RestartPolicy),
-		Runtime:           internaltypes.Runtime(grpcHostConfig.Runtime),
-		ExtraHosts:        grpcHostConfig.ExtraHosts,
-		ExtraCapabilities: grpcHostConfig.ExtraCapabilities,
-		PortMappings:      ToInternalPortMappings(grpcHostConfig.PortMappings),
-		LogConfig:         ToInternalLogConfig(grpcHostConfig.LogConfig),
-		Resources:         ToInternalResources(grpcHostConfig.Resources),
+		Devices:             devices,
+		NetworkMode:         internaltypes.NetworkMode(grpcHostConfig.NetworkMode),
+		Privileged:          grpcHostConfig.Privileged,
+		RestartPolicy:       ToInternalRestartPolicy(grpcHostConfig.RestartPolicy),
+		Runtime:             internaltypes.Runtime(grpcHostConfig.Runtime),
+		ExtraHosts:          grpcHostConfig.ExtraHosts,
+		ExtraCapabilities:   grpcHostConfig.ExtraCapabilities,
+		DroppedCapabilities: grpcHostConfig.DroppedCapabilities,
+		PortMappings:        ToInternalPortMappings(grpcHostConfig.PortMappings),
+		LogConfig:           ToInternalLogConfig(grpcHostConfig.LogConfig),
+		Resources:           ToInternalResources(grpcHostConfig.Resources),
+		ReadOnlyRootfs:      grpcHostConfig.ReadOnlyRootfs,
+		Networks:            grpcHostConfig.Networks,
+		EndpointsConfig:     endpointsConfig,
+		NetworkPolicy:       ToInternalNetworkPolicy(grpcHostConfig.NetworkPolicy),
+		DNS:                 grpcHostConfig.Dns,
+		DNSSearch:           grpcHostConfig.DnsSearch,
+		DNSOptions:          grpcHostConfig.DnsOptions,
+		SecurityOpts:        ToInternalSecurityOptions(grpcHostConfig.SecurityOpts),
+		UsernsMode:          internaltypes.UsernsMode(grpcHostConfig.UsernsMode),
+		Ulimits:             ToInternalUlimits(grpcHostConfig.Ulimits),
+		Sysctls:             grpcHostConfig.Sysctls,
+		ShmSize:             grpcHostConfig.ShmSize,
+		IpcMode:             internaltypes.NamespaceMode(grpcHostConfig.IpcMode),
+		PidMode:             internaltypes.NamespaceMode(grpcHostConfig.PidMode),
+		RuntimeHandler:      grpcHostConfig.RuntimeHandler,
+	}
+}
+
+// ToInternalUlimits converts a types.Ulimit instance to an internal Ulimit one
+func ToInternalUlimits(grpcUlimits []*apitypescontainers.Ulimit) []internaltypes.Ulimit {
+	if grpcUlimits == nil {
+		return nil
+	}
+	ulimits := []internaltypes.Ulimit{}
+	for _, ulimit := range grpcUlimits {
+		ulimits = append(ulimits, internaltypes.Ulimit{
+			Name: ulimit.Name,
+			Soft: ulimit.Soft,
+			Hard: ulimit.Hard,
+		})
 	}
+	return ulimits
 }
 
 // ToInternalIOConfig converts a types.IOConfig instance to an internal IOConfig one
@@ -253,6 +360,33 @@ func ToInternalProjectInfo(grpcProjectInfo *apitypessysinfo.ProjectInfo) sysinfo
 	}
 }
 
+// ToInternalRuntimeInfo converts a types.RuntimeInfo instance to an internal RuntimeInfo one
+func ToInternalRuntimeInfo(grpcRuntimeInfo *apitypessysinfo.RuntimeInfo) sysinfointernaltypes.RuntimeInfo {
+	if grpcRuntimeInfo == nil {
+		return sysinfointernaltypes.RuntimeInfo{}
+	}
+
+	runtimeInfo := sysinfointernaltypes.RuntimeInfo{
+		DefaultRuntimeHandler: grpcRuntimeInfo.DefaultRuntimeHandler,
+	}
+	for _, grpcHandler := range grpcRuntimeInfo.RuntimeHandlers {
+		handler := &internaltypes.RuntimeHandler{
+			Name:        grpcHandler.Name,
+			RuntimeType: internaltypes.Runtime(grpcHandler.RuntimeType),
+			BinaryPath:  grpcHandler.BinaryPath,
+		}
+		if grpcHandler.Options != nil {
+			handler.Options = &internaltypes.RuntimeOptions{
+				BinaryName:    grpcHandler.Options.BinaryName,
+				Root:          grpcHandler.Options.Root,
+				SystemdCgroup: grpcHandler.Options.SystemdCgroup,
+			}
+		}
+		runtimeInfo.RuntimeHandlers = append(runtimeInfo.RuntimeHandlers, handler)
+	}
+	return runtimeInfo
+}
+
 // ToInternalState converts a types.State instance to an internal State one
 func ToInternalState(grpcState *apitypescontainers.State) *internaltypes.State {
 	if grpcState == nil {
@@ -271,6 +405,37 @@ func ToInternalState(grpcState *apitypescontainers.State) *internaltypes.State {
 		Running:    grpcState.Running,
 		Status:     ToInternalStatus(grpcState.Status),
 		OOMKilled:  grpcState.OomKilled,
+		Health:     ToInternalHealth(grpcState.Health),
+	}
+}
+
+// ToInternalHealth converts a types.Health instance to an internal Health one
+func ToInternalHealth(grpcHealth *apitypescontainers.Health) *internaltypes.Health {
+	if grpcHealth == nil {
+		return nil
+	}
+	return &internaltypes.Health{
+		Status:        internaltypes.HealthStatus(grpcHealth.Status),
+		FailingStreak: int(grpcHealth.FailingStreak),
+		LastCheckAt:   grpcHealth.LastCheckAt,
+		LastOutput:    grpcHealth.LastOutput,
+	}
+}
+
+// ToInternalHealthCheckConfig converts a types.HealthCheckConfig instance to an internal HealthCheckConfig one
+func ToInternalHealthCheckConfig(grpcHealthCheck *apitypescontainers.HealthCheckConfig) *internaltypes.HealthCheckConfig {
+	if grpcHealthCheck == nil {
+		return nil
+	}
+	return &internaltypes.HealthCheckConfig{
+		Type:        internaltypes.HealthCheckType(grpcHealthCheck.Type),
+		Cmd:         grpcHealthCheck.Cmd,
+		Port:        int(grpcHealthCheck.Port),
+		Path:        grpcHealthCheck.Path,
+		Interval:    time.Duration(grpcHealthCheck.Interval) * time.Second,
+		Timeout:     time.Duration(grpcHealthCheck.Timeout) * time.Second,
+		Retries:     int(grpcHealthCheck.Retries),
+		StartPeriod: time.Duration(grpcHealthCheck.StartPeriod) * time.Second,
 	}
 }
 
@@ -327,10 +492,44 @@ func ToInternalResources(resources *apitypescontainers.Resources) *internaltypes
 		return nil
 	}
 	return &internaltypes.Resources{
-		Memory:            resources.Memory,
-		MemoryReservation: resources.MemoryReservation,
-		MemorySwap:        resources.MemorySwap,
+		Memory:               resources.Memory,
+		MemoryReservation:    resources.MemoryReservation,
+		MemorySwap:           resources.MemorySwap,
+		CPUQuota:             resources.CpuQuota,
+		CPUPeriod:            resources.CpuPeriod,
+		CPUShares:            resources.CpuShares,
+		CPUSetCPUs:           resources.CpusetCpus,
+		CPUSetMems:           resources.CpusetMems,
+		PidsLimit:            resources.PidsLimit,
+		BlkioWeight:          toInternalBlkioWeight(resources.BlkioWeight),
+		BlkioDeviceReadBps:   ToInternalThrottleDevices(resources.BlkioDeviceReadBps),
+		BlkioDeviceWriteBps:  ToInternalThrottleDevices(resources.BlkioDeviceWriteBps),
+		BlkioDeviceReadIOps:  ToInternalThrottleDevices(resources.BlkioDeviceReadIops),
+		BlkioDeviceWriteIOps: ToInternalThrottleDevices(resources.BlkioDeviceWriteIops),
+	}
+}
+
+// toInternalBlkioWeight saturates block IO weights that do not fit in 16 bits so that they are rejected as out of range instead of being wrapped into a valid value
+func toInternalBlkioWeight(weight uint32) uint16 {
+	if weight > math.MaxUint16 {
+		return math.MaxUint16
 	}
+	return uint16(weight)
+}
+
+// ToInternalThrottleDevices converts a types.ThrottleDevice array to an internal ThrottleDevice array
+func ToInternalThrottleDevices(throttleDevices []*apitypescontainers.ThrottleDevice) []internaltypes.ThrottleDevice {
+	if throttleDevices == nil {
+		return nil
+	}
+	internalThrottleDevices := make([]internaltypes.ThrottleDevice, len(throttleDevices))
+	for i, throttleDevice := range throttleDevices {
+		internalThrottleDevices[i] = internaltypes.ThrottleDevice{
+			Path: throttleDevice.Path,
+			Rate: throttleDevice.Rate,
+		}
+	}
+	return internalThrottleDevices
 }
 
 // ToInternalLogDriverConfig converts a types.LogDriverConfiguration to an internal LogDriverConfiguration one
@@ -343,6 +542,11 @@ func ToInternalLogDriverConfig(grpcLogDriverConfig *apitypescontainers.LogDriver
 		MaxFiles: int(grpcLogDriverConfig.MaxFiles),
 		MaxSize:  grpcLogDriverConfig.MaxSize,
 		RootDir:  grpcLogDriverConfig.RootDir,
+		Address:  grpcLogDriverConfig.Address,
+		Facility: grpcLogDriverConfig.Facility,
+		Tag:      grpcLogDriverConfig.Tag,
+		Compress: internaltypes.LogCompression(grpcLogDriverConfig.Compress),
+		MaxAge:   grpcLogDriverConfig.MaxAge,
 	}
 }
 
@@ -377,5 +581,216 @@ func ToInternalUpdateOptions(grpcUpdateOptions *apitypescontainers.UpdateOptions
 	return &internaltypes.UpdateOpts{
 		RestartPolicy: ToInternalRestartPolicy(grpcUpdateOptions.RestartPolicy),
 		Resources:     ToInternalResources(grpcUpdateOptions.Resources),
+		NetworkPolicy: ToInternalNetworkPolicy(grpcUpdateOptions.NetworkPolicy),
+	}
+}
+
+// ToInternalExecConfig converts a types.ExecConfig to an internal ExecConfig
+func ToInternalExecConfig(grpcExecConfig *apitypescontainers.ExecConfig) *internaltypes.ExecConfig {
+	if grpcExecConfig == nil {
+		return nil
+	}
+	return &internaltypes.ExecConfig{
+		Cmd:        grpcExecConfig.Cmd,
+		Env:        grpcExecConfig.Env,
+		WorkingDir: grpcExecConfig.WorkingDir,
+		Tty:        grpcExecConfig.Tty,
+		OpenStdin:  grpcExecConfig.OpenStdin,
+	}
+}
+
+// ToInternalCheckpointOptions converts a types.CheckpointOptions to an internal CheckpointOpts
+func ToInternalCheckpointOptions(grpcCheckpointOptions *apitypescontainers.CheckpointOptions) *internaltypes.CheckpointOpts {
+	if grpcCheckpointOptions == nil {
+		return nil
+	}
+	return &internaltypes.CheckpointOpts{
+		CheckpointID: grpcCheckpointOptions.CheckpointId,
+		Exit:         grpcCheckpointOptions.Exit,
+	}
+}
+
+// ToInternalCheckpoints converts a types.Checkpoint array to an internal Checkpoint array
+func ToInternalCheckpoints(grpcCheckpoints []*apitypescontainers.Checkpoint) []*internaltypes.Checkpoint {
+	if grpcCheckpoints == nil {
+		return nil
+	}
+	checkpoints := make([]*internaltypes.Checkpoint, len(grpcCheckpoints))
+	for i, grpcCheckpoint := range grpcCheckpoints {
+		checkpoints[i] = &internaltypes.Checkpoint{
+			ID:      grpcCheckpoint.Id,
+			Created: grpcCheckpoint.Created,
+		}
+	}
+	return checkpoints
+}
+
+// ToInternalImageInfo converts a types.ImageInfo instance to an internal ImageInfo one
+func ToInternalImageInfo(grpcImageInfo *apitypesimages.ImageInfo) *imagesinternaltypes.ImageInfo {
+	if grpcImageInfo == nil {
+		return nil
+	}
+	return &imagesinternaltypes.ImageInfo{
+		Name:       grpcImageInfo.Name,
+		Digest:     grpcImageInfo.Digest,
+		Size:       grpcImageInfo.Size,
+		Platform:   grpcImageInfo.Platform,
+		Created:    grpcImageInfo.Created,
+		Expiry:     grpcImageInfo.Expiry,
+		Containers: grpcImageInfo.Containers,
+	}
+}
+
+// ToInternalImageInfos converts a types.ImageInfo array to an internal ImageInfo array
+func ToInternalImageInfos(grpcImageInfos []*apitypesimages.ImageInfo) []*imagesinternaltypes.ImageInfo {
+	if grpcImageInfos == nil {
+		return nil
+	}
+	imageInfos := make([]*imagesinternaltypes.ImageInfo, len(grpcImageInfos))
+	for i, grpcImageInfo := range grpcImageInfos {
+		imageInfos[i] = ToInternalImageInfo(grpcImageInfo)
+	}
+	return imageInfos
+}
+
+// ToInternalVolume converts a types.Volume instance to an internal Volume one
+func ToInternalVolume(grpcVolume *apitypesvolumes.Volume) *volumesinternaltypes.Volume {
+	if grpcVolume == nil {
+		return nil
+	}
+	return &volumesinternaltypes.Volume{
+		Name:       grpcVolume.Name,
+		Driver:     grpcVolume.Driver,
+		Size:       grpcVolume.Size,
+		Mountpoint: grpcVolume.Mountpoint,
+		Created:    grpcVolume.Created,
+		Containers: grpcVolume.Containers,
+	}
+}
+
+// ToInternalVolumes converts a types.Volume array to an internal Volume array
+func ToInternalVolumes(grpcVolumes []*apitypesvolumes.Volume) []*volumesinternaltypes.Volume {
+	if grpcVolumes == nil {
+		return nil
+	}
+	volumes := make([]*volumesinternaltypes.Volume, len(grpcVolumes))
+	for i, grpcVolume := range grpcVolumes {
+		volumes[i] = ToInternalVolume(grpcVolume)
+	}
+	return volumes
+}
+
+// ToInternalNetwork converts a types.Network instance to an internal Network one
+func ToInternalNetwork(grpcNetwork *apitypesnetworks.Network) *networksinternaltypes.Network {
+	if grpcNetwork == nil {
+		return nil
+	}
+	return &networksinternaltypes.Network{
+		Name:         grpcNetwork.Name,
+		ID:           grpcNetwork.Id,
+		Driver:       grpcNetwork.Driver,
+		Subnet:       grpcNetwork.Subnet,
+		Gateway:      grpcNetwork.Gateway,
+		MTU:          int(grpcNetwork.Mtu),
+		ICC:          grpcNetwork.Icc,
+		IPMasquerade: grpcNetwork.IpMasquerade,
+		BuiltIn:      grpcNetwork.BuiltIn,
+		Created:      grpcNetwork.Created,
+		Containers:   grpcNetwork.Containers,
+	}
+}
+
+// ToInternalNetworks converts a types.Network array to an internal Network array
+func ToInternalNetworks(grpcNetworks []*apitypesnetworks.Network) []*networksinternaltypes.Network {
+	if grpcNetworks == nil {
+		return nil
+	}
+	networks := make([]*networksinternaltypes.Network, len(grpcNetworks))
+	for i, grpcNetwork := range grpcNetworks {
+		networks[i] = ToInternalNetwork(grpcNetwork)
+	}
+	return networks
+}
+
+// ToInternalMetrics converts a types.Metrics instance to an internal Metrics one
+func ToInternalMetrics(grpcMetrics *apitypescontainers.Metrics) *internaltypes.Metrics {
+	if grpcMetrics == nil {
+		return nil
+	}
+	metrics := &internaltypes.Metrics{
+		CPU:     ToInternalCPUStats(grpcMetrics.Cpu),
+		Memory:  ToInternalMemoryStats(grpcMetrics.Memory),
+		IO:      ToInternalIOStats(grpcMetrics.Io),
+		Network: ToInternalIOStats(grpcMetrics.Network),
+		PIDs:    grpcMetrics.Pids,
+	}
+	if grpcMetrics.Timestamp != "" {
+		metrics.Timestamp, _ = time.Parse(time.RFC3339Nano, grpcMetrics.Timestamp)
+	}
+	return metrics
+}
+
+// ToInternalCPUStats converts a types.CPUStats instance to an internal CPUStats one
+func ToInternalCPUStats(grpcCPUStats *apitypescontainers.CPUStats) *internaltypes.CPUStats {
+	if grpcCPUStats == nil {
+		return nil
+	}
+	return &internaltypes.CPUStats{
+		Total: grpcCPUStats.Total,
+		Used:  grpcCPUStats.Used,
+	}
+}
+
+// ToInternalMemoryStats converts a types.MemoryStats instance to an internal MemoryStats one
+func ToInternalMemoryStats(grpcMemoryStats *apitypescontainers.MemoryStats) *internaltypes.MemoryStats {
+	if grpcMemoryStats == nil {
+		return nil
+	}
+	return &internaltypes.MemoryStats{
+		Total: grpcMemoryStats.Total,
+		Used:  grpcMemoryStats.Used,
+	}
+}
+
+// ToInternalIOStats converts a types.IOStats instance to an internal IOStats one
+func ToInternalIOStats(grpcIOStats *apitypescontainers.IOStats) *internaltypes.IOStats {
+	if grpcIOStats == nil {
+		return nil
+	}
+	return &internaltypes.IOStats{
+		Read:  grpcIOStats.Read,
+		Write: grpcIOStats.Write,
+	}
+}
+
+// ToInternalContainerStats converts a types.ContainerStats array to an internal ContainerStats array
+func ToInternalContainerStats(grpcContainerStats []*apitypescontainers.ContainerStats) []*internaltypes.ContainerStats {
+	if grpcContainerStats == nil {
+		return nil
+	}
+	containerStats := make([]*internaltypes.ContainerStats, len(grpcContainerStats))
+	for i, stats := range grpcContainerStats {
+		containerStats[i] = &internaltypes.ContainerStats{
+			ID:      stats.Id,
+			Name:    stats.Name,
+			Metrics: ToInternalMetrics(stats.Metrics),
+		}
+	}
+	return containerStats
+}
+
+// ToInternalEvent converts a types.Event instance to an internal Event one
+func ToInternalEvent(grpcEvent *apitypescontainers.Event) *internaltypes.Event {
+	if grpcEvent == nil {
+		return nil
+	}
+	event := &internaltypes.Event{
+		Type:   internaltypes.EventType(grpcEvent.Type),
+		Action: internaltypes.EventAction(grpcEvent.Action),
+		Time:   grpcEvent.Time,
+	}
+	if grpcEvent.Source != nil {
+		event.Source = util.CopyContainer(ToInternalContainer(grpcEvent.Source))
 	}
+	return event
 }
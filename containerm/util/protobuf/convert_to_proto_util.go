@@ -13,10 +13,18 @@
 package protobuf
 
 import (
+	"time"
+
 	apitypescontainers "github.com/eclipse-kanto/container-management/containerm/api/types/containers"
+	apitypesimages "github.com/eclipse-kanto/container-management/containerm/api/types/images"
+	apitypesnetworks "github.com/eclipse-kanto/container-management/containerm/api/types/networks"
 	apitypessysinfo "github.com/eclipse-kanto/container-management/containerm/api/types/sysinfo"
+	apitypesvolumes "github.com/eclipse-kanto/container-management/containerm/api/types/volumes"
 	internaltypes "github.com/eclipse-kanto/container-management/containerm/containers/types"
+	imagesinternaltypes "github.com/eclipse-kanto/container-management/containerm/images/types"
+	networksinternaltypes "github.com/eclipse-kanto/container-management/containerm/network/types"
 	sysinfointernaltypes "github.com/eclipse-kanto/container-management/containerm/sysinfo/types"
+	volumesinternaltypes "github.com/eclipse-kanto/container-management/containerm/volumes/types"
 )
 
 // ToProtoContainer converts an internal Container instance to a types.Container one
@@ -62,6 +70,7 @@ func ToProtoContainer(intenralContainer *internaltypes.Container) *apitypesconta
 		Created:         intenralContainer.Created,
 		ManuallyStopped: intenralContainer.ManuallyStopped,
 		RestartCount:    int64(intenralContainer.RestartCount),
+		HealthCheck:     ToProtoHealthCheckConfig(intenralContainer.HealthCheck),
 	}
 }
 
@@ -83,6 +92,37 @@ func ToProtoState(internState *internaltypes.State) *apitypescontainers.State {
 		Running:    internState.Running,
 		Status:     internState.Status.String(),
 		OomKilled:  internState.OOMKilled,
+		Health:     ToProtoHealth(internState.Health),
+	}
+}
+
+// ToProtoHealth converts an internal Health instance to a types.Health one
+func ToProtoHealth(internalHealth *internaltypes.Health) *apitypescontainers.Health {
+	if internalHealth == nil {
+		return nil
+	}
+	return &apitypescontainers.Health{
+		Status:        string(internalHealth.Status),
+		FailingStreak: int64(internalHealth.FailingStreak),
+		LastCheckAt:   internalHealth.LastCheckAt,
+		LastOutput:    internalHealth.LastOutput,
+	}
+}
+
+// ToProtoHealthCheckConfig converts an internal HealthCheckConfig instance to a types.HealthCheckConfig one
+func ToProtoHealthCheckConfig(internalHealthCheck *internaltypes.HealthCheckConfig) *apitypescontainers.HealthCheckConfig {
+	if internalHealthCheck == nil {
+		return nil
+	}
+	return &apitypescontainers.HealthCheckConfig{
+		Type:        string(internalHealthCheck.Type),
+		Cmd:         internalHealthCheck.Cmd,
+		Port:        int64(internalHealthCheck.Port),
+		Path:        internalHealthCheck.Path,
+		Interval:    (int64)(internalHealthCheck.Interval.Seconds()),
+		Timeout:     (int64)(internalHealthCheck.Timeout.Seconds()),
+		Retries:     int64(internalHealthCheck.Retries),
+		StartPeriod: (int64)(internalHealthCheck.StartPeriod.Seconds()),
 	}
 }
 
@@ -104,11 +144,60 @@ func ToProtoEndpointSettings(internEpSettings *internaltypes.EndpointSettings) *
 		return nil
 	}
 	return &apitypescontainers.EndpointSettings{
-		Id:         internEpSettings.ID,
-		Gateway:    internEpSettings.Gateway,
-		IpAddress:  internEpSettings.IPAddress,
-		MacAddress: internEpSettings.MacAddress,
-		NetworkId:  internEpSettings.NetworkID,
+		Id:          internEpSettings.ID,
+		Gateway:     internEpSettings.Gateway,
+		IpAddress:   internEpSettings.IPAddress,
+		MacAddress:  internEpSettings.MacAddress,
+		NetworkId:   internEpSettings.NetworkID,
+		Ipv6Gateway: internEpSettings.IPv6Gateway,
+		Ipv6Address: internEpSettings.IPv6Address,
+	}
+}
+
+// ToProtoEndpointConfig converts an internal EndpointConfig instance to a types.EndpointConfig one
+func ToProtoEndpointConfig(internEpConfig *internaltypes.EndpointConfig) *apitypescontainers.EndpointConfig {
+	if internEpConfig == nil {
+		return nil
+	}
+	return &apitypescontainers.EndpointConfig{
+		Ipv4Address: internEpConfig.IPv4Address,
+		Ipv6Address: internEpConfig.IPv6Address,
+		MacAddress:  internEpConfig.MacAddress,
+	}
+}
+
+// ToProtoNetworkPolicy converts an internal NetworkPolicy instance to a types.NetworkPolicy one
+func ToProtoNetworkPolicy(internalNetPolicy *internaltypes.NetworkPolicy) *apitypescontainers.NetworkPolicy {
+	if internalNetPolicy == nil {
+		return nil
+	}
+	var egressRules []*apitypescontainers.EgressRule
+	for _, rule := range internalNetPolicy.EgressRules {
+		egressRules = append(egressRules, &apitypescontainers.EgressRule{
+			Action:   string(rule.Action),
+			Cidr:     rule.CIDR,
+			Protocol: rule.Protocol,
+			Ports:    rule.Ports,
+		})
+	}
+	return &apitypescontainers.NetworkPolicy{
+		IngressRate:   internalNetPolicy.IngressRate,
+		EgressRate:    internalNetPolicy.EgressRate,
+		EgressDefault: string(internalNetPolicy.EgressDefault),
+		EgressRules:   egressRules,
+	}
+}
+
+// ToProtoSecurityOptions converts an internal SecurityOptions instance to a types.SecurityOptions one
+func ToProtoSecurityOptions(internalSecurityOpts *internaltypes.SecurityOptions) *apitypescontainers.SecurityOptions {
+	if internalSecurityOpts == nil {
+		return nil
+	}
+	return &apitypescontainers.SecurityOptions{
+		Seccomp:         internalSecurityOpts.Seccomp,
+		ApparmorProfile: internalSecurityOpts.AppArmorProfile,
+		SelinuxLabel:    internalSecurityOpts.SELinuxLabel,
+		NoNewPrivileges: internalSecurityOpts.NoNewPrivileges,
 	}
 }
 
@@ -157,6 +246,9 @@ func ToProtoMountPoint(internalMountPoint *internaltypes.MountPoint) *apitypesco
 		Destination:     internalMountPoint.Destination,
 		Source:          internalMountPoint.Source,
 		PropagationMode: internalMountPoint.PropagationMode,
+		Type:            internalMountPoint.Type,
+		VolumePath:      internalMountPoint.VolumePath,
+		Options:         internalMountPoint.Options,
 	}
 }
 
@@ -182,6 +274,22 @@ func ToProtoNetworkSettings(internalNetworkSettings *internaltypes.NetworkSettin
 	}
 }
 
+// ToProtoUlimits converts an internal Ulimit instance to a types.Ulimit one
+func ToProtoUlimits(internalUlimits []internaltypes.Ulimit) []*apitypescontainers.Ulimit {
+	if internalUlimits == nil {
+		return nil
+	}
+	protoUlimits := []*apitypescontainers.Ulimit{}
+	for _, ulimit := range internalUlimits {
+		protoUlimits = append(protoUlimits, &apitypescontainers.Ulimit{
+			Name: ulimit.Name,
+			Soft: ulimit.Soft,
+			Hard: ulimit.Hard,
+		})
+	}
+	return protoUlimits
+}
+
 // ToProtoPortMappings converts an internal PortMapping instance to a types.PortMapping one
 func ToProtoPortMappings(internalPortMappings []internaltypes.PortMapping) []*apitypescontainers.PortMapping {
 	if internalPortMappings == nil {
@@ -208,9 +316,10 @@ func ToProtoRestartPolicy(internalRestartPolicy *internaltypes.RestartPolicy) *a
 		return nil
 	}
 	return &apitypescontainers.RestartPolicy{
-		MaximumRetryCount: int64(internalRestartPolicy.MaximumRetryCount),
-		RetryTimeout:      (int64)(internalRestartPolicy.RetryTimeout.Seconds()),
-		Type:              string(internalRestartPolicy.Type),
+		MaximumRetryCount:  int64(internalRestartPolicy.MaximumRetryCount),
+		RetryTimeout:       (int64)(internalRestartPolicy.RetryTimeout.Seconds()),
+		Type:               string(internalRestartPolicy.Type),
+		RestartOnUnhealthy: internalRestartPolicy.RestartOnUnhealthy,
 	}
 }
 
@@ -220,8 +329,13 @@ func ToProtoConfig(internalConfig *internaltypes.ContainerConfiguration) *apityp
 		return nil
 	}
 	return &apitypescontainers.ContainerConfiguration{
-		Env: internalConfig.Env,
-		Cmd: internalConfig.Cmd,
+		Env:        internalConfig.Env,
+		Cmd:        internalConfig.Cmd,
+		Entrypoint: internalConfig.Entrypoint,
+		WorkingDir: internalConfig.WorkingDir,
+		User:       internalConfig.User,
+		Groups:     internalConfig.Groups,
+		Labels:     internalConfig.Labels,
 	}
 }
 
@@ -238,18 +352,42 @@ func ToProtoHostConfig(internalHostConfig *internaltypes.HostConfig) *apitypesco
 			devices = append(devices, ToProtoDeviceMapping(&grpcDevMapping))
 		}
 	}
+	var endpointsConfig map[string]*apitypescontainers.EndpointConfig
+
+	if internalHostConfig.EndpointsConfig != nil {
+		endpointsConfig = make(map[string]*apitypescontainers.EndpointConfig)
+		for netName, epConfig := range internalHostConfig.EndpointsConfig {
+			endpointsConfig[netName] = ToProtoEndpointConfig(epConfig)
+		}
+	}
 
 	return &apitypescontainers.HostConfig{
-		Devices:           devices,
-		NetworkMode:       string(internalHostConfig.NetworkMode),
-		Privileged:        internalHostConfig.Privileged,
-		RestartPolicy:     ToProtoRestartPolicy(internalHostConfig.RestartPolicy),
-		Runtime:           string(internalHostConfig.Runtime),
-		ExtraHosts:        internalHostConfig.ExtraHosts,
-		ExtraCapabilities: internalHostConfig.ExtraCapabilities,
-		PortMappings:      ToProtoPortMappings(internalHostConfig.PortMappings),
-		LogConfig:         ToProtoLogConfig(internalHostConfig.LogConfig),
-		Resources:         ToProtoResource(internalHostConfig.Resources),
+		Devices:             devices,
+		NetworkMode:         string(internalHostConfig.NetworkMode),
+		Privileged:          internalHostConfig.Privileged,
+		RestartPolicy:       ToProtoRestartPolicy(internalHostConfig.RestartPolicy),
+		Runtime:             string(internalHostConfig.Runtime),
+		ExtraHosts:          internalHostConfig.ExtraHosts,
+		ExtraCapabilities:   internalHostConfig.ExtraCapabilities,
+		DroppedCapabilities: internalHostConfig.DroppedCapabilities,
+		PortMappings:        ToProtoPortMappings(internalHostConfig.PortMappings),
+		LogConfig:           ToProtoLogConfig(internalHostConfig.LogConfig),
+		Resources:           ToProtoResource(internalHostConfig.Resources),
+		ReadOnlyRootfs:      internalHostConfig.ReadOnlyRootfs,
+		Networks:            internalHostConfig.Networks,
+		EndpointsConfig:     endpointsConfig,
+		NetworkPolicy:       ToProtoNetworkPolicy(internalHostConfig.NetworkPolicy),
+		Dns:                 internalHostConfig.DNS,
+		DnsSearch:           internalHostConfig.DNSSearch,
+		DnsOptions:          internalHostConfig.DNSOptions,
+		SecurityOpts:        ToProtoSecurityOptions(internalHostConfig.SecurityOpts),
+		UsernsMode:          string(internalHostConfig.UsernsMode),
+		Ulimits:             ToProtoUlimits(internalHostConfig.Ulimits),
+		Sysctls:             internalHostConfig.Sysctls,
+		ShmSize:             internalHostConfig.ShmSize,
+		IpcMode:             string(internalHostConfig.IpcMode),
+		PidMode:             string(internalHostConfig.PidMode),
+		RuntimeHandler:      internalHostConfig.RuntimeHandler,
 	}
 }
 
@@ -278,6 +416,29 @@ func ToProtoProjectInfo(projectInfo sysinfointernaltypes.ProjectInfo) *apitypess
 	}
 }
 
+// ToProtoRuntimeInfo converts an internal RuntimeInfo instance to a types.RuntimeInfo one
+func ToProtoRuntimeInfo(runtimeInfo sysinfointernaltypes.RuntimeInfo) *apitypessysinfo.RuntimeInfo {
+	protoRuntimeInfo := &apitypessysinfo.RuntimeInfo{
+		DefaultRuntimeHandler: runtimeInfo.DefaultRuntimeHandler,
+	}
+	for _, handler := range runtimeInfo.RuntimeHandlers {
+		protoHandler := &apitypessysinfo.RuntimeHandler{
+			Name:        handler.Name,
+			RuntimeType: string(handler.RuntimeType),
+			BinaryPath:  handler.BinaryPath,
+		}
+		if handler.Options != nil {
+			protoHandler.Options = &apitypessysinfo.RuntimeOptions{
+				BinaryName:    handler.Options.BinaryName,
+				Root:          handler.Options.Root,
+				SystemdCgroup: handler.Options.SystemdCgroup,
+			}
+		}
+		protoRuntimeInfo.RuntimeHandlers = append(protoRuntimeInfo.RuntimeHandlers, protoHandler)
+	}
+	return protoRuntimeInfo
+}
+
 // ToProtoLogConfig converts an internal LogConfiguration instance to a types.LogConfiguration one
 func ToProtoLogConfig(internalLogConfig *internaltypes.LogConfiguration) *apitypescontainers.LogConfiguration {
 	if internalLogConfig == nil {
@@ -295,10 +456,36 @@ func ToProtoResource(internalResource *internaltypes.Resources) *apitypescontain
 		return nil
 	}
 	return &apitypescontainers.Resources{
-		Memory:            internalResource.Memory,
-		MemoryReservation: internalResource.MemoryReservation,
-		MemorySwap:        internalResource.MemorySwap,
+		Memory:               internalResource.Memory,
+		MemoryReservation:    internalResource.MemoryReservation,
+		MemorySwap:           internalResource.MemorySwap,
+		CpuQuota:             internalResource.CPUQuota,
+		CpuPeriod:            internalResource.CPUPeriod,
+		CpuShares:            internalResource.CPUShares,
+		CpusetCpus:           internalResource.CPUSetCPUs,
+		CpusetMems:           internalResource.CPUSetMems,
+		PidsLimit:            internalResource.PidsLimit,
+		BlkioWeight:          uint32(internalResource.BlkioWeight),
+		BlkioDeviceReadBps:   ToProtoThrottleDevices(internalResource.BlkioDeviceReadBps),
+		BlkioDeviceWriteBps:  ToProtoThrottleDevices(internalResource.BlkioDeviceWriteBps),
+		BlkioDeviceReadIops:  ToProtoThrottleDevices(internalResource.BlkioDeviceReadIOps),
+		BlkioDeviceWriteIops: ToProtoThrottleDevices(internalResource.BlkioDeviceWriteIOps),
+	}
+}
+
+// ToProtoThrottleDevices converts an internal ThrottleDevice array to a types.ThrottleDevice array
+func ToProtoThrottleDevices(internalThrottleDevices []internaltypes.ThrottleDevice) []*apitypescontainers.ThrottleDevice {
+	if internalThrottleDevices == nil {
+		return nil
+	}
+	throttleDevices := make([]*apitypescontainers.ThrottleDevice, len(internalThrottleDevices))
+	for i, throttleDevice := range internalThrottleDevices {
+		throttleDevices[i] = &apitypescontainers.ThrottleDevice{
+			Path: throttleDevice.Path,
+			Rate: throttleDevice.Rate,
+		}
 	}
+	return throttleDevices
 }
 
 // ToProtoLogDriverConfig converts an internal LogDriverConfiguration instance to a types.LogDriverConfiguration one
@@ -311,6 +498,11 @@ func ToProtoLogDriverConfig(internalLogDriverConfig *internaltypes.LogDriverConf
 		MaxFiles: int64(internalLogDriverConfig.MaxFiles),
 		MaxSize:  internalLogDriverConfig.MaxSize,
 		RootDir:  internalLogDriverConfig.RootDir,
+		Address:  internalLogDriverConfig.Address,
+		Facility: internalLogDriverConfig.Facility,
+		Tag:      internalLogDriverConfig.Tag,
+		Compress: string(internalLogDriverConfig.Compress),
+		MaxAge:   internalLogDriverConfig.MaxAge,
 	}
 }
 
@@ -345,5 +537,213 @@ func ToProtoUpdateOptions(intenralUpdateOpts *internaltypes.UpdateOpts) *apitype
 	return &apitypescontainers.UpdateOptions{
 		RestartPolicy: ToProtoRestartPolicy(intenralUpdateOpts.RestartPolicy),
 		Resources:     ToProtoResource(intenralUpdateOpts.Resources),
+		NetworkPolicy: ToProtoNetworkPolicy(intenralUpdateOpts.NetworkPolicy),
+	}
+}
+
+// ToProtoExecConfig converts an internal ExecConfig instance to a types.ExecConfig one
+func ToProtoExecConfig(internalExecConfig *internaltypes.ExecConfig) *apitypescontainers.ExecConfig {
+	if internalExecConfig == nil {
+		return nil
+	}
+	return &apitypescontainers.ExecConfig{
+		Cmd:        internalExecConfig.Cmd,
+		Env:        internalExecConfig.Env,
+		WorkingDir: internalExecConfig.WorkingDir,
+		Tty:        internalExecConfig.Tty,
+		OpenStdin:  internalExecConfig.OpenStdin,
+	}
+}
+
+// ToProtoCheckpointOptions converts an internal CheckpointOpts instance to a types.CheckpointOptions one
+func ToProtoCheckpointOptions(internalCheckpointOpts *internaltypes.CheckpointOpts) *apitypescontainers.CheckpointOptions {
+	if internalCheckpointOpts == nil {
+		return nil
+	}
+	return &apitypescontainers.CheckpointOptions{
+		CheckpointId: internalCheckpointOpts.CheckpointID,
+		Exit:         internalCheckpointOpts.Exit,
+	}
+}
+
+// ToProtoCheckpoints converts an internal Checkpoint array to a types.Checkpoint array
+func ToProtoCheckpoints(internalCheckpoints []*internaltypes.Checkpoint) []*apitypescontainers.Checkpoint {
+	if internalCheckpoints == nil {
+		return nil
+	}
+	checkpoints := make([]*apitypescontainers.Checkpoint, len(internalCheckpoints))
+	for i, internalCheckpoint := range internalCheckpoints {
+		checkpoints[i] = &apitypescontainers.Checkpoint{
+			Id:      internalCheckpoint.ID,
+			Created: internalCheckpoint.Created,
+		}
+	}
+	return checkpoints
+}
+
+// ToProtoImageInfo converts an internal ImageInfo instance to a types.ImageInfo one
+func ToProtoImageInfo(internalImageInfo *imagesinternaltypes.ImageInfo) *apitypesimages.ImageInfo {
+	if internalImageInfo == nil {
+		return nil
+	}
+	return &apitypesimages.ImageInfo{
+		Name:       internalImageInfo.Name,
+		Digest:     internalImageInfo.Digest,
+		Size:       internalImageInfo.Size,
+		Platform:   internalImageInfo.Platform,
+		Created:    internalImageInfo.Created,
+		Expiry:     internalImageInfo.Expiry,
+		Containers: internalImageInfo.Containers,
+	}
+}
+
+// ToProtoImageInfos converts an internal ImageInfo array to a types.ImageInfo array
+func ToProtoImageInfos(internalImageInfos []*imagesinternaltypes.ImageInfo) []*apitypesimages.ImageInfo {
+	if internalImageInfos == nil {
+		return nil
+	}
+	imageInfos := make([]*apitypesimages.ImageInfo, len(internalImageInfos))
+	for i, internalImageInfo := range internalImageInfos {
+		imageInfos[i] = ToProtoImageInfo(internalImageInfo)
+	}
+	return imageInfos
+}
+
+// ToProtoVolume converts an internal Volume instance to a types.Volume one
+func ToProtoVolume(internalVolume *volumesinternaltypes.Volume) *apitypesvolumes.Volume {
+	if internalVolume == nil {
+		return nil
+	}
+	return &apitypesvolumes.Volume{
+		Name:       internalVolume.Name,
+		Driver:     internalVolume.Driver,
+		Size:       internalVolume.Size,
+		Mountpoint: internalVolume.Mountpoint,
+		Created:    internalVolume.Created,
+		Containers: internalVolume.Containers,
+	}
+}
+
+// ToProtoVolumes converts an internal Volume array to a types.Volume array
+func ToProtoVolumes(internalVolumes []*volumesinternaltypes.Volume) []*apitypesvolumes.Volume {
+	if internalVolumes == nil {
+		return nil
+	}
+	volumes := make([]*apitypesvolumes.Volume, len(internalVolumes))
+	for i, internalVolume := range internalVolumes {
+		volumes[i] = ToProtoVolume(internalVolume)
+	}
+	return volumes
+}
+
+// ToProtoNetwork converts an internal Network instance to a types.Network one
+func ToProtoNetwork(internalNetwork *networksinternaltypes.Network) *apitypesnetworks.Network {
+	if internalNetwork == nil {
+		return nil
+	}
+	return &apitypesnetworks.Network{
+		Name:         internalNetwork.Name,
+		Id:           internalNetwork.ID,
+		Driver:       internalNetwork.Driver,
+		Subnet:       internalNetwork.Subnet,
+		Gateway:      internalNetwork.Gateway,
+		Mtu:          int64(internalNetwork.MTU),
+		Icc:          internalNetwork.ICC,
+		IpMasquerade: internalNetwork.IPMasquerade,
+		BuiltIn:      internalNetwork.BuiltIn,
+		Created:      internalNetwork.Created,
+		Containers:   internalNetwork.Containers,
+	}
+}
+
+// ToProtoNetworks converts an internal Network array to a types.Network array
+func ToProtoNetworks(internalNetworks []*networksinternaltypes.Network) []*apitypesnetworks.Network {
+	if internalNetworks == nil {
+		return nil
+	}
+	networks := make([]*apitypesnetworks.Network, len(internalNetworks))
+	for i, internalNetwork := range internalNetworks {
+		networks[i] = ToProtoNetwork(internalNetwork)
+	}
+	return networks
+}
+
+// ToProtoMetrics converts an internal Metrics instance to a types.Metrics one
+func ToProtoMetrics(internalMetrics *internaltypes.Metrics) *apitypescontainers.Metrics {
+	if internalMetrics == nil {
+		return nil
+	}
+	metrics := &apitypescontainers.Metrics{
+		Cpu:     ToProtoCPUStats(internalMetrics.CPU),
+		Memory:  ToProtoMemoryStats(internalMetrics.Memory),
+		Io:      ToProtoIOStats(internalMetrics.IO),
+		Network: ToProtoIOStats(internalMetrics.Network),
+		Pids:    internalMetrics.PIDs,
+	}
+	if !internalMetrics.Timestamp.IsZero() {
+		metrics.Timestamp = internalMetrics.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+	return metrics
+}
+
+// ToProtoCPUStats converts an internal CPUStats instance to a types.CPUStats one
+func ToProtoCPUStats(internalCPUStats *internaltypes.CPUStats) *apitypescontainers.CPUStats {
+	if internalCPUStats == nil {
+		return nil
+	}
+	return &apitypescontainers.CPUStats{
+		Total: internalCPUStats.Total,
+		Used:  internalCPUStats.Used,
+	}
+}
+
+// ToProtoMemoryStats converts an internal MemoryStats instance to a types.MemoryStats one
+func ToProtoMemoryStats(internalMemoryStats *internaltypes.MemoryStats) *apitypescontainers.MemoryStats {
+	if internalMemoryStats == nil {
+		return nil
+	}
+	return &apitypescontainers.MemoryStats{
+		Total: internalMemoryStats.Total,
+		Used:  internalMemoryStats.Used,
+	}
+}
+
+// ToProtoIOStats converts an internal IOStats instance to a types.IOStats one
+func ToProtoIOStats(internalIOStats *internaltypes.IOStats) *apitypescontainers.IOStats {
+	if internalIOStats == nil {
+		return nil
+	}
+	return &apitypescontainers.IOStats{
+		Read:  internalIOStats.Read,
+		Write: internalIOStats.Write,
+	}
+}
+
+// ToProtoContainerStats converts an internal ContainerStats array to a types.ContainerStats array
+func ToProtoContainerStats(internalContainerStats []*internaltypes.ContainerStats) []*apitypescontainers.ContainerStats {
+	if internalContainerStats == nil {
+		return nil
+	}
+	containerStats := make([]*apitypescontainers.ContainerStats, len(internalContainerStats))
+	for i, stats := range internalContainerStats {
+		containerStats[i] = &apitypescontainers.ContainerStats{
+			Id:      stats.ID,
+			Name:    stats.Name,
+			Metrics: ToProtoMetrics(stats.Metrics),
+		}
+	}
+	return containerStats
+}
+
+// ToProtoEvent converts an internal Event instance to a types.Event one
+func ToProtoEvent(internalEvent *internaltypes.Event) *apitypescontainers.Event {
+	if internalEvent == nil {
+		return nil
+	}
+	return &apitypescontainers.Event{
+		Type:   string(internalEvent.Type),
+		Action: string(internalEvent.Action),
+		Source: ToProtoContainer(&internalEvent.Source),
+		Time:   internalEvent.Time,
 	}
 }
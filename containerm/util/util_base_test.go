@@ -92,7 +92,14 @@ var (
 	}}
 
 	configEnv               = []string{configEnv1, configEnv2, configEnv3, configEnv4, configEnv5, configEnv6}
-	internalContainerConfig = internaltypes.ContainerConfiguration{Env: configEnv}
+	internalContainerConfig = internaltypes.ContainerConfiguration{
+		Env:        configEnv,
+		Entrypoint: []string{"/bin/app"},
+		WorkingDir: "/app",
+		User:       "app:1000",
+		Groups:     []string{"audio", "44"},
+		Labels:     map[string]string{"app": "test"},
+	}
 
 	hostConfigExtraHosts        = []string{"ctrhost:host_ip"}
 	hostConfigExtraCapabilities = []string{"CAP_NET_ADMIN"}
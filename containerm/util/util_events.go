@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package util
+
+import (
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+)
+
+// MatchesEventsFilter checks if the provided event matches all criteria of the provided filter.
+// A nil filter matches all events.
+func MatchesEventsFilter(event *types.Event, filter *types.EventsFilter) bool {
+	if event == nil {
+		return false
+	}
+	if filter == nil {
+		return true
+	}
+	if len(filter.IDs) > 0 && !containsString(filter.IDs, event.Source.ID) {
+		return false
+	}
+	if len(filter.Names) > 0 && !containsString(filter.Names, event.Source.Name) {
+		return false
+	}
+	if len(filter.Actions) > 0 && !containsAction(filter.Actions, event.Action) {
+		return false
+	}
+	if filter.Since > 0 && event.Time < filter.Since {
+		return false
+	}
+	if filter.Until > 0 && event.Time > filter.Until {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAction(actions []types.EventAction, action types.EventAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
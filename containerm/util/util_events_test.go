@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package util
+
+import (
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/containers/types"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+)
+
+func TestMatchesEventsFilter(t *testing.T) {
+	testEvent := &types.Event{
+		Type:   types.EventTypeContainers,
+		Action: types.EventActionContainersRunning,
+		Source: types.Container{ID: "test-ctr", Name: "test-ctr-name"},
+		Time:   1000,
+	}
+	tests := map[string]struct {
+		event    *types.Event
+		filter   *types.EventsFilter
+		expected bool
+	}{
+		"test_nil_event": {
+			filter: &types.EventsFilter{},
+		},
+		"test_nil_filter": {
+			event:    testEvent,
+			expected: true,
+		},
+		"test_empty_filter": {
+			event:    testEvent,
+			filter:   &types.EventsFilter{},
+			expected: true,
+		},
+		"test_all_criteria_matching": {
+			event: testEvent,
+			filter: &types.EventsFilter{
+				IDs:     []string{"other-ctr", "test-ctr"},
+				Names:   []string{"test-ctr-name"},
+				Actions: []types.EventAction{types.EventActionContainersCreated, types.EventActionContainersRunning},
+				Since:   1000,
+				Until:   1000,
+			},
+			expected: true,
+		},
+		"test_id_not_matching": {
+			event:  testEvent,
+			filter: &types.EventsFilter{IDs: []string{"other-ctr"}},
+		},
+		"test_name_not_matching": {
+			event:  testEvent,
+			filter: &types.EventsFilter{Names: []string{"other-ctr-name"}},
+		},
+		"test_action_not_matching": {
+			event:  testEvent,
+			filter: &types.EventsFilter{Actions: []types.EventAction{types.EventActionContainersStopped}},
+		},
+		"test_before_since": {
+			event:  testEvent,
+			filter: &types.EventsFilter{Since: 1001},
+		},
+		"test_after_until": {
+			event:  testEvent,
+			filter: &types.EventsFilter{Until: 999},
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutil.AssertEqual(t, testCase.expected, MatchesEventsFilter(testCase.event, testCase.filter))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package types
+
+const (
+	// VolumeDriverLocal stores the volume's data in a directory on the host's file system
+	VolumeDriverLocal = "local"
+	// VolumeDriverTmpfs stores the volume's data in memory - the data is lost when the volume is unmounted
+	VolumeDriverTmpfs = "tmpfs"
+)
+
+// Volume contains the information about a named volume managed by the system
+type Volume struct {
+	// Name is the unique name of the volume
+	Name string `json:"name"`
+	// Driver is the type of the volume's storage - local or tmpfs
+	Driver string `json:"driver"`
+	// Size is the max size of the volume's data in the form of 200m, 1.2g. Empty if the volume has no size quota
+	Size string `json:"size,omitempty"`
+	// Mountpoint is the path on the host where the volume's data is available
+	Mountpoint string `json:"mountpoint"`
+	// Created is the time of the volume's creation
+	Created string `json:"created"`
+	// Containers are the IDs of the containers that are using the volume
+	Containers []string `json:"containers,omitempty"`
+}
+
+// VolumeOpts represent the options for creating a named volume
+type VolumeOpts struct {
+	// Driver is the type of the volume's storage - local (the default) or tmpfs
+	Driver string `json:"driver,omitempty"`
+	// Size is the max size of the volume's data in the form of 200m, 1.2g
+	Size string `json:"size,omitempty"`
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/pkg/ioutils"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/eclipse-kanto/container-management/containerm/volumes/types"
+)
+
+const (
+	volumesRootDir       = "volumes"
+	volumeConfigFilename = "volume.json"
+	volumeDataDir        = "_data"
+	noSuchVolumeErrorMsg = "no such volume with name = %s exists"
+)
+
+type volumesMgr struct {
+	metaPath    string
+	volumes     map[string]*types.Volume
+	volumesLock sync.RWMutex
+}
+
+func newVolumesMgr(metaPath string) (*volumesMgr, error) {
+	volMgr := &volumesMgr{
+		metaPath: filepath.Join(metaPath, volumesRootDir),
+		volumes:  make(map[string]*types.Volume),
+	}
+	if err := util.MkDir(volMgr.metaPath); err != nil {
+		return nil, err
+	}
+	if err := volMgr.restore(); err != nil {
+		return nil, err
+	}
+	return volMgr, nil
+}
+
+func (volMgr *volumesMgr) Create(ctx context.Context, name string, opts *types.VolumeOpts) (*types.Volume, error) {
+	if err := validateVolume(name, opts); err != nil {
+		return nil, err
+	}
+	volMgr.volumesLock.Lock()
+	defer volMgr.volumesLock.Unlock()
+
+	if _, ok := volMgr.volumes[name]; ok {
+		return nil, log.NewErrorf("volume with name = %s already exists", name)
+	}
+	volume, err := volMgr.createVolume(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return copyVolume(volume), nil
+}
+
+func (volMgr *volumesMgr) Get(ctx context.Context, name string) (*types.Volume, error) {
+	volMgr.volumesLock.RLock()
+	defer volMgr.volumesLock.RUnlock()
+
+	volume, ok := volMgr.volumes[name]
+	if !ok {
+		return nil, log.NewErrorf(noSuchVolumeErrorMsg, name)
+	}
+	return copyVolume(volume), nil
+}
+
+func (volMgr *volumesMgr) List(ctx context.Context) ([]*types.Volume, error) {
+	volMgr.volumesLock.RLock()
+	defer volMgr.volumesLock.RUnlock()
+
+	volumes := make([]*types.Volume, 0, len(volMgr.volumes))
+	for _, volume := range volMgr.volumes {
+		volumes = append(volumes, copyVolume(volume))
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+	return volumes, nil
+}
+
+func (volMgr *volumesMgr) Remove(ctx context.Context, name string) error {
+	volMgr.volumesLock.Lock()
+	defer volMgr.volumesLock.Unlock()
+
+	volume, ok := volMgr.volumes[name]
+	if !ok {
+		return log.NewErrorf(noSuchVolumeErrorMsg, name)
+	}
+	if len(volume.Containers) > 0 {
+		return log.NewErrorf("volume with name = %s is in use by containers [%s]", name, strings.Join(volume.Containers, ", "))
+	}
+	if err := unmountVolume(volume); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(volMgr.getVolumeMetaPath(name)); err != nil {
+		return err
+	}
+	delete(volMgr.volumes, name)
+	log.Debug("removed volume with name = %s", name)
+	return nil
+}
+
+func (volMgr *volumesMgr) Acquire(ctx context.Context, name string, containerID string) (*types.Volume, error) {
+	volMgr.volumesLock.Lock()
+	defer volMgr.volumesLock.Unlock()
+
+	volume, ok := volMgr.volumes[name]
+	if !ok {
+		if err := validateVolume(name, nil); err != nil {
+			return nil, err
+		}
+		log.Debug("volume with name = %s does not exist - will create it with the default options for container ID = %s", name, containerID)
+		var err error
+		if volume, err = volMgr.createVolume(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	for _, ctrID := range volume.Containers {
+		if ctrID == containerID {
+			return copyVolume(volume), nil
+		}
+	}
+	volume.Containers = append(volume.Containers, containerID)
+	if err := volMgr.save(volume); err != nil {
+		volume.Containers = volume.Containers[:len(volume.Containers)-1]
+		return nil, err
+	}
+	log.Debug("volume with name = %s is used by container ID = %s", name, containerID)
+	return copyVolume(volume), nil
+}
+
+func (volMgr *volumesMgr) Release(ctx context.Context, name string, containerID string) error {
+	volMgr.volumesLock.Lock()
+	defer volMgr.volumesLock.Unlock()
+
+	volume, ok := volMgr.volumes[name]
+	if !ok {
+		return log.NewErrorf(noSuchVolumeErrorMsg, name)
+	}
+	for i, ctrID := range volume.Containers {
+		if ctrID == containerID {
+			containers := append(append([]string{}, volume.Containers[:i]...), volume.Containers[i+1:]...)
+			if len(containers) == 0 {
+				containers = nil
+			}
+			current := volume.Containers
+			volume.Containers = containers
+			if err := volMgr.save(volume); err != nil {
+				volume.Containers = current
+				return err
+			}
+			log.Debug("volume with name = %s is no longer used by container ID = %s", name, containerID)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (volMgr *volumesMgr) createVolume(name string, opts *types.VolumeOpts) (*types.Volume, error) {
+	volume := &types.Volume{
+		Name:       name,
+		Driver:     types.VolumeDriverLocal,
+		Mountpoint: filepath.Join(volMgr.getVolumeMetaPath(name), volumeDataDir),
+		Created:    time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if opts != nil {
+		if opts.Driver != "" {
+			volume.Driver = opts.Driver
+		}
+		volume.Size = opts.Size
+	}
+
+	var err error
+	defer func() {
+		if err != nil {
+			if cleanupErr := os.RemoveAll(volMgr.getVolumeMetaPath(name)); cleanupErr != nil {
+				log.ErrorErr(cleanupErr, "failed to delete the meta path for failed volume with name = %s", name)
+			}
+		}
+	}()
+	if err = util.MkDirs(volMgr.getVolumeMetaPath(name), volume.Mountpoint); err != nil {
+		return nil, err
+	}
+	if err = mountVolume(volume); err != nil {
+		return nil, err
+	}
+	if err = volMgr.save(volume); err != nil {
+		if unmountErr := unmountVolume(volume); unmountErr != nil {
+			log.ErrorErr(unmountErr, "failed to unmount failed volume with name = %s", name)
+		}
+		return nil, err
+	}
+	volMgr.volumes[name] = volume
+	log.Debug("created volume with name = %s and driver = %s", name, volume.Driver)
+	return volume, nil
+}
+
+// restore loads the volumes from the local storage and mounts the ones that have a dedicated storage
+func (volMgr *volumesMgr) restore() error {
+	entries, err := ioutil.ReadDir(volMgr.metaPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(volMgr.metaPath, entry.Name(), volumeConfigFilename))
+		if err != nil {
+			log.WarnErr(err, "could not read the configuration of volume with name = %s", entry.Name())
+			continue
+		}
+		volume := &types.Volume{}
+		if err = json.Unmarshal(data, volume); err != nil {
+			log.WarnErr(err, "could not parse the configuration of volume with name = %s", entry.Name())
+			continue
+		}
+		if !isVolumeMounted(volume) {
+			if err = mountVolume(volume); err != nil {
+				log.ErrorErr(err, "could not mount the storage of volume with name = %s", volume.Name)
+			}
+		}
+		volMgr.volumes[volume.Name] = volume
+		log.Debug("restored volume with name = %s", volume.Name)
+	}
+	return nil
+}
+
+func (volMgr *volumesMgr) save(volume *types.Volume) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(volume); err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(filepath.Join(volMgr.getVolumeMetaPath(volume.Name), volumeConfigFilename), buf.Bytes(), 0644)
+}
+
+func (volMgr *volumesMgr) getVolumeMetaPath(name string) string {
+	return filepath.Join(volMgr.metaPath, name)
+}
+
+func validateVolume(name string, opts *types.VolumeOpts) error {
+	if err := util.ValidateVolumeName(name); err != nil {
+		return err
+	}
+	if opts == nil {
+		return nil
+	}
+	if opts.Driver != "" && opts.Driver != types.VolumeDriverLocal && opts.Driver != types.VolumeDriverTmpfs {
+		return log.NewErrorf("unsupported volume driver %s", opts.Driver)
+	}
+	if opts.Size != "" {
+		if size, err := util.SizeToBytes(opts.Size); err != nil || size <= 0 {
+			return log.NewErrorf("invalid format of volume size - %s", opts.Size)
+		}
+	}
+	return nil
+}
+
+func copyVolume(volume *types.Volume) *types.Volume {
+	volumeCopy := *volume
+	if volume.Containers != nil {
+		volumeCopy.Containers = append([]string{}, volume.Containers...)
+	}
+	return &volumeCopy
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"context"
+
+	"github.com/eclipse-kanto/container-management/containerm/volumes/types"
+)
+
+// VolumeManager provides management of the named volumes that can be mounted in containers
+type VolumeManager interface {
+	// Create creates a new named volume
+	Create(ctx context.Context, name string, opts *types.VolumeOpts) (*types.Volume, error)
+
+	// Get returns information about a named volume
+	Get(ctx context.Context, name string) (*types.Volume, error)
+
+	// List returns information about all named volumes
+	List(ctx context.Context) ([]*types.Volume, error)
+
+	// Remove removes a named volume and its data if it is not used by any container
+	Remove(ctx context.Context, name string) error
+
+	// Acquire marks a named volume as used by a container, the volume is created with the default options if it does not exist
+	Acquire(ctx context.Context, name string, containerID string) (*types.Volume, error)
+
+	// Release marks a named volume as no longer used by a container
+	Release(ctx context.Context, name string, containerID string) error
+}
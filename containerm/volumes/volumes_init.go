@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"github.com/eclipse-kanto/container-management/containerm/registry"
+)
+
+const (
+	// VolumesManagerServiceLocalID is the ID of the local volumes manager service
+	VolumesManagerServiceLocalID = "container-management.service.local.v1.service-volumes-manager"
+)
+
+func init() {
+	registry.Register(&registry.Registration{
+		ID:       VolumesManagerServiceLocalID,
+		Type:     registry.VolumesManagerService,
+		InitFunc: registryInit,
+	})
+}
+
+func registryInit(registryCtx *registry.ServiceRegistryContext) (interface{}, error) {
+	volumesInitOpts := registryCtx.Config.([]VolumeManagerOpt)
+	opts := &volumeOpts{}
+	if err := applyOptsVolumes(opts, volumesInitOpts...); err != nil {
+		return nil, err
+	}
+	return newVolumesMgr(opts.metaPath)
+}
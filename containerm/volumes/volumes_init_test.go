@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/eclipse-kanto/container-management/containerm/registry"
+)
+
+func TestRegistryInit(t *testing.T) {
+	metaPath := t.TempDir()
+
+	registryCtx := registry.NewContext(context.Background(), []VolumeManagerOpt{WithVolumesMetaPath(metaPath)}, nil, registry.NewServiceInfoSet())
+	instance, err := registryInit(registryCtx)
+	testutil.AssertNil(t, err)
+
+	volMgr, ok := instance.(*volumesMgr)
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, filepath.Join(metaPath, volumesRootDir), volMgr.metaPath)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/util"
+	"github.com/eclipse-kanto/container-management/containerm/volumes/types"
+)
+
+const volumeImageFilename = "volume.img"
+
+// the system calls are replaceable to allow testing without privileges
+var (
+	mountFunc = func(m mount.Mount, target string) error {
+		return m.Mount(target)
+	}
+	unmountFunc = func(target string) error {
+		return mount.UnmountAll(target, 0)
+	}
+	isMountedFunc = func(target string) bool {
+		info, err := mount.Lookup(target)
+		return err == nil && info.Mountpoint == target
+	}
+	formatImageFunc = func(imagePath string) error {
+		if out, err := exec.Command("mkfs.ext4", "-q", "-F", imagePath).CombinedOutput(); err != nil {
+			return log.NewErrorf("could not format volume image %s: %v %s", imagePath, err, string(out))
+		}
+		return nil
+	}
+)
+
+// hasDedicatedStorage returns true if the volume's data is stored in a dedicated file system mounted at its mount point
+func hasDedicatedStorage(volume *types.Volume) bool {
+	return volume.Driver == types.VolumeDriverTmpfs || volume.Size != ""
+}
+
+// mountVolume mounts an in memory file system for tmpfs volumes or a loop device with a fixed size image for local volumes with a size quota
+func mountVolume(volume *types.Volume) error {
+	if !hasDedicatedStorage(volume) {
+		return nil
+	}
+	var size int64
+	if volume.Size != "" {
+		var err error
+		if size, err = util.SizeToBytes(volume.Size); err != nil {
+			return err
+		}
+	}
+	if volume.Driver == types.VolumeDriverTmpfs {
+		tmpfs := mount.Mount{Type: "tmpfs", Source: "tmpfs"}
+		if size > 0 {
+			tmpfs.Options = []string{"size=" + strconv.FormatInt(size, 10)}
+		}
+		return mountFunc(tmpfs, volume.Mountpoint)
+	}
+
+	imagePath := filepath.Join(filepath.Dir(volume.Mountpoint), volumeImageFilename)
+	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
+		if err = createVolumeImage(imagePath, size); err != nil {
+			return err
+		}
+	}
+	return mountFunc(mount.Mount{Type: "ext4", Source: imagePath, Options: []string{"loop"}}, volume.Mountpoint)
+}
+
+func createVolumeImage(imagePath string, size int64) error {
+	image, err := os.OpenFile(imagePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	err = image.Truncate(size)
+	if closeErr := image.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = formatImageFunc(imagePath)
+	}
+	if err != nil {
+		if rmErr := os.Remove(imagePath); rmErr != nil {
+			log.WarnErr(rmErr, "could not remove volume image %s", imagePath)
+		}
+	}
+	return err
+}
+
+func unmountVolume(volume *types.Volume) error {
+	if !hasDedicatedStorage(volume) || !isMountedFunc(volume.Mountpoint) {
+		return nil
+	}
+	return unmountFunc(volume.Mountpoint)
+}
+
+func isVolumeMounted(volume *types.Volume) bool {
+	return hasDedicatedStorage(volume) && isMountedFunc(volume.Mountpoint)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+// VolumeManagerOpt provides volume manager options
+type VolumeManagerOpt func(volumeOptions *volumeOpts) error
+
+type volumeOpts struct {
+	metaPath string
+}
+
+func applyOptsVolumes(volumeOpts *volumeOpts, opts ...VolumeManagerOpt) error {
+	for _, o := range opts {
+		if err := o(volumeOpts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithVolumesMetaPath sets the path to the directory where the volumes are stored.
+func WithVolumesMetaPath(metaPath string) VolumeManagerOpt {
+	return func(volumeOptions *volumeOpts) error {
+		volumeOptions.metaPath = metaPath
+		return nil
+	}
+}
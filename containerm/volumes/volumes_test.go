@@ -0,0 +1,257 @@
+// Copyright (c) 2026 Contributors to the Eclipse Foundation
+//
+// See the NOTICE file(s) distributed with this work for additional
+// information regarding copyright ownership.
+//
+// This program and the accompanying materials are made available under the
+// terms of the Eclipse Public License 2.0 which is available at
+// https://www.eclipse.org/legal/epl-2.0, or the Apache License, Version 2.0
+// which is available at https://www.apache.org/licenses/LICENSE-2.0.
+//
+// SPDX-License-Identifier: EPL-2.0 OR Apache-2.0
+
+package volumes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/eclipse-kanto/container-management/containerm/log"
+	"github.com/eclipse-kanto/container-management/containerm/pkg/testutil"
+	"github.com/eclipse-kanto/container-management/containerm/volumes/types"
+)
+
+const (
+	testVolumeName  = "test-volume"
+	testContainerID = "test-container-id"
+)
+
+type testMounter struct {
+	mounts   map[string]mount.Mount
+	mountErr error
+}
+
+func stubMounts(t *testing.T) *testMounter {
+	mounter := &testMounter{mounts: map[string]mount.Mount{}}
+
+	origMount, origUnmount, origIsMounted, origFormat := mountFunc, unmountFunc, isMountedFunc, formatImageFunc
+	mountFunc = func(m mount.Mount, target string) error {
+		if mounter.mountErr != nil {
+			return mounter.mountErr
+		}
+		mounter.mounts[target] = m
+		return nil
+	}
+	unmountFunc = func(target string) error {
+		delete(mounter.mounts, target)
+		return nil
+	}
+	isMountedFunc = func(target string) bool {
+		_, ok := mounter.mounts[target]
+		return ok
+	}
+	formatImageFunc = func(imagePath string) error {
+		return nil
+	}
+	t.Cleanup(func() {
+		mountFunc, unmountFunc, isMountedFunc, formatImageFunc = origMount, origUnmount, origIsMounted, origFormat
+	})
+	return mounter
+}
+
+func TestCreateVolume(t *testing.T) {
+	tests := map[string]struct {
+		opts          *types.VolumeOpts
+		mountErr      error
+		expectedMount *mount.Mount
+		expectedErr   error
+	}{
+		"test_create_default": {},
+		"test_create_local_with_size": {
+			opts:          &types.VolumeOpts{Driver: types.VolumeDriverLocal, Size: "1M"},
+			expectedMount: &mount.Mount{Type: "ext4", Options: []string{"loop"}},
+		},
+		"test_create_tmpfs": {
+			opts:          &types.VolumeOpts{Driver: types.VolumeDriverTmpfs},
+			expectedMount: &mount.Mount{Type: "tmpfs", Source: "tmpfs"},
+		},
+		"test_create_tmpfs_with_size": {
+			opts:          &types.VolumeOpts{Driver: types.VolumeDriverTmpfs, Size: "64M"},
+			expectedMount: &mount.Mount{Type: "tmpfs", Source: "tmpfs", Options: []string{"size=67108864"}},
+		},
+		"test_create_unsupported_driver": {
+			opts:        &types.VolumeOpts{Driver: "nfs"},
+			expectedErr: log.NewError("unsupported volume driver nfs"),
+		},
+		"test_create_invalid_size": {
+			opts:        &types.VolumeOpts{Size: "1X"},
+			expectedErr: log.NewError("invalid format of volume size - 1X"),
+		},
+		"test_create_mount_error": {
+			opts:        &types.VolumeOpts{Driver: types.VolumeDriverTmpfs},
+			mountErr:    log.NewError("test mount error"),
+			expectedErr: log.NewError("test mount error"),
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mounter := stubMounts(t)
+			mounter.mountErr = testCase.mountErr
+
+			volMgr, err := newVolumesMgr(t.TempDir())
+			testutil.AssertNil(t, err)
+
+			volume, err := volMgr.Create(context.Background(), testVolumeName, testCase.opts)
+			testutil.AssertError(t, testCase.expectedErr, err)
+			if testCase.expectedErr != nil {
+				testutil.AssertNil(t, volume)
+				_, statErr := os.Stat(volMgr.getVolumeMetaPath(testVolumeName))
+				testutil.AssertTrue(t, os.IsNotExist(statErr))
+				return
+			}
+
+			testutil.AssertEqual(t, testVolumeName, volume.Name)
+			testutil.AssertEqual(t, filepath.Join(volMgr.metaPath, testVolumeName, volumeDataDir), volume.Mountpoint)
+			if testCase.opts != nil && testCase.opts.Driver != "" {
+				testutil.AssertEqual(t, testCase.opts.Driver, volume.Driver)
+			} else {
+				testutil.AssertEqual(t, types.VolumeDriverLocal, volume.Driver)
+			}
+
+			mnt, mounted := mounter.mounts[volume.Mountpoint]
+			testutil.AssertEqual(t, testCase.expectedMount != nil, mounted)
+			if testCase.expectedMount != nil {
+				testutil.AssertEqual(t, testCase.expectedMount.Type, mnt.Type)
+				testutil.AssertEqual(t, testCase.expectedMount.Options, mnt.Options)
+				if testCase.expectedMount.Type == "ext4" {
+					info, statErr := os.Stat(mnt.Source)
+					testutil.AssertNil(t, statErr)
+					testutil.AssertEqual(t, int64(1024*1024), info.Size())
+				}
+			}
+
+			_, err = os.Stat(filepath.Join(volMgr.getVolumeMetaPath(testVolumeName), volumeConfigFilename))
+			testutil.AssertNil(t, err)
+		})
+	}
+}
+
+func TestCreateExistingVolume(t *testing.T) {
+	stubMounts(t)
+	volMgr, err := newVolumesMgr(t.TempDir())
+	testutil.AssertNil(t, err)
+
+	_, err = volMgr.Create(context.Background(), testVolumeName, nil)
+	testutil.AssertNil(t, err)
+	_, err = volMgr.Create(context.Background(), testVolumeName, nil)
+	testutil.AssertError(t, log.NewErrorf("volume with name = %s already exists", testVolumeName), err)
+}
+
+func TestGetAndListVolumes(t *testing.T) {
+	stubMounts(t)
+	volMgr, err := newVolumesMgr(t.TempDir())
+	testutil.AssertNil(t, err)
+	ctx := context.Background()
+
+	volumes, err := volMgr.List(ctx)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 0, len(volumes))
+
+	_, err = volMgr.Get(ctx, testVolumeName)
+	testutil.AssertError(t, log.NewErrorf(noSuchVolumeErrorMsg, testVolumeName), err)
+
+	for _, name := range []string{"volume-b", "volume-a"} {
+		_, err = volMgr.Create(ctx, name, nil)
+		testutil.AssertNil(t, err)
+	}
+
+	volume, err := volMgr.Get(ctx, "volume-a")
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, "volume-a", volume.Name)
+
+	volumes, err = volMgr.List(ctx)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 2, len(volumes))
+	testutil.AssertEqual(t, "volume-a", volumes[0].Name)
+	testutil.AssertEqual(t, "volume-b", volumes[1].Name)
+}
+
+func TestAcquireAndReleaseVolume(t *testing.T) {
+	stubMounts(t)
+	volMgr, err := newVolumesMgr(t.TempDir())
+	testutil.AssertNil(t, err)
+	ctx := context.Background()
+
+	volume, err := volMgr.Acquire(ctx, testVolumeName, testContainerID)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, types.VolumeDriverLocal, volume.Driver)
+	testutil.AssertEqual(t, []string{testContainerID}, volume.Containers)
+
+	// acquiring twice by the same container does not duplicate the reference
+	volume, err = volMgr.Acquire(ctx, testVolumeName, testContainerID)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []string{testContainerID}, volume.Containers)
+
+	err = volMgr.Remove(ctx, testVolumeName)
+	testutil.AssertError(t, log.NewErrorf("volume with name = %s is in use by containers [%s]", testVolumeName, testContainerID), err)
+
+	testutil.AssertNil(t, volMgr.Release(ctx, testVolumeName, testContainerID))
+	volume, err = volMgr.Get(ctx, testVolumeName)
+	testutil.AssertNil(t, err)
+	testutil.AssertNil(t, volume.Containers)
+
+	testutil.AssertError(t, log.NewErrorf(noSuchVolumeErrorMsg, "missing"), volMgr.Release(ctx, "missing", testContainerID))
+
+	_, err = volMgr.Acquire(ctx, "@invalid", testContainerID)
+	testutil.AssertError(t, log.NewError("invalid volume name @invalid, only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed"), err)
+}
+
+func TestRemoveVolume(t *testing.T) {
+	mounter := stubMounts(t)
+	volMgr, err := newVolumesMgr(t.TempDir())
+	testutil.AssertNil(t, err)
+	ctx := context.Background()
+
+	volume, err := volMgr.Create(ctx, testVolumeName, &types.VolumeOpts{Driver: types.VolumeDriverTmpfs})
+	testutil.AssertNil(t, err)
+	testutil.AssertTrue(t, isMountedFunc(volume.Mountpoint))
+
+	testutil.AssertNil(t, volMgr.Remove(ctx, testVolumeName))
+	testutil.AssertEqual(t, 0, len(mounter.mounts))
+	_, err = os.Stat(volMgr.getVolumeMetaPath(testVolumeName))
+	testutil.AssertTrue(t, os.IsNotExist(err))
+
+	testutil.AssertError(t, log.NewErrorf(noSuchVolumeErrorMsg, testVolumeName), volMgr.Remove(ctx, testVolumeName))
+}
+
+func TestRestoreVolumes(t *testing.T) {
+	mounter := stubMounts(t)
+	metaPath := t.TempDir()
+	ctx := context.Background()
+
+	volMgr, err := newVolumesMgr(metaPath)
+	testutil.AssertNil(t, err)
+	_, err = volMgr.Create(ctx, "local-volume", nil)
+	testutil.AssertNil(t, err)
+	tmpfsVolume, err := volMgr.Create(ctx, "tmpfs-volume", &types.VolumeOpts{Driver: types.VolumeDriverTmpfs})
+	testutil.AssertNil(t, err)
+	_, err = volMgr.Acquire(ctx, "local-volume", testContainerID)
+	testutil.AssertNil(t, err)
+	testutil.AssertNil(t, os.Mkdir(filepath.Join(volMgr.metaPath, "corrupted"), 0755))
+
+	// simulate a reboot that has dropped all mounts
+	delete(mounter.mounts, tmpfsVolume.Mountpoint)
+
+	restoredMgr, err := newVolumesMgr(metaPath)
+	testutil.AssertNil(t, err)
+	volumes, err := restoredMgr.List(ctx)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, 2, len(volumes))
+	testutil.AssertEqual(t, []string{testContainerID}, volumes[0].Containers)
+	testutil.AssertEqual(t, *tmpfsVolume, *volumes[1])
+	testutil.AssertTrue(t, isMountedFunc(tmpfsVolume.Mountpoint))
+}